@@ -55,7 +55,7 @@ func (x Friend_State) String() string {
 }
 
 func (Friend_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{28, 0}
+	return fileDescriptor_1b40cafcd4234784, []int{36, 0}
 }
 
 // The group role status.
@@ -91,7 +91,7 @@ func (x GroupUserList_GroupUser_State) String() string {
 }
 
 func (GroupUserList_GroupUser_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{33, 0, 0}
+	return fileDescriptor_1b40cafcd4234784, []int{43, 0, 0}
 }
 
 // The group role status.
@@ -127,7 +127,71 @@ func (x UserGroupList_UserGroup_State) String() string {
 }
 
 func (UserGroupList_UserGroup_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{74, 0, 0}
+	return fileDescriptor_1b40cafcd4234784, []int{94, 0, 0}
+}
+
+// The store the purchase was made in.
+type ValidatedPurchase_Store int32
+
+const (
+	// Apple App Store.
+	ValidatedPurchase_APPLE_APP_STORE ValidatedPurchase_Store = 0
+	// Google Play Store.
+	ValidatedPurchase_GOOGLE_PLAY_STORE ValidatedPurchase_Store = 1
+	// Huawei App Gallery.
+	ValidatedPurchase_HUAWEI_APP_GALLERY ValidatedPurchase_Store = 2
+)
+
+var ValidatedPurchase_Store_name = map[int32]string{
+	0: "APPLE_APP_STORE",
+	1: "GOOGLE_PLAY_STORE",
+	2: "HUAWEI_APP_GALLERY",
+}
+
+var ValidatedPurchase_Store_value = map[string]int32{
+	"APPLE_APP_STORE":    0,
+	"GOOGLE_PLAY_STORE":  1,
+	"HUAWEI_APP_GALLERY": 2,
+}
+
+func (x ValidatedPurchase_Store) String() string {
+	return proto.EnumName(ValidatedPurchase_Store_name, int32(x))
+}
+
+func (ValidatedPurchase_Store) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{100, 0}
+}
+
+// The environment the purchase was made in.
+type ValidatedPurchase_Environment int32
+
+const (
+	// Unknown environment.
+	ValidatedPurchase_UNKNOWN ValidatedPurchase_Environment = 0
+	// Sandbox/test environment.
+	ValidatedPurchase_SANDBOX ValidatedPurchase_Environment = 1
+	// Production environment.
+	ValidatedPurchase_PRODUCTION ValidatedPurchase_Environment = 2
+)
+
+var ValidatedPurchase_Environment_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SANDBOX",
+	2: "PRODUCTION",
+}
+
+var ValidatedPurchase_Environment_value = map[string]int32{
+	"UNKNOWN":    0,
+	"SANDBOX":    1,
+	"PRODUCTION": 2,
+}
+
+func (x ValidatedPurchase_Environment) String() string {
+	return proto.EnumName(ValidatedPurchase_Environment_name, int32(x))
+}
+
+func (ValidatedPurchase_Environment) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{100, 1}
 }
 
 // A user with additional account details. Always the current user.
@@ -143,10 +207,12 @@ type Account struct {
 	// The custom id in the user's account.
 	CustomId string `protobuf:"bytes,5,opt,name=custom_id,json=customId,proto3" json:"custom_id,omitempty"`
 	// The UNIX time when the user's email was verified.
-	VerifyTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=verify_time,json=verifyTime,proto3" json:"verify_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	VerifyTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=verify_time,json=verifyTime,proto3" json:"verify_time,omitempty"`
+	// The identities linked to the user's account, with when each was linked and last used.
+	Identities           []*AccountIdentity `protobuf:"bytes,7,rep,name=identities,proto3" json:"identities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Account) Reset()         { *m = Account{} }
@@ -216,6 +282,54 @@ func (m *Account) GetVerifyTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Account) GetIdentities() []*AccountIdentity {
+	if m != nil {
+		return m.Identities
+	}
+	return nil
+}
+
+// Send a Sign in with Apple identity token to the server. Used with authenticate/link/unlink.
+type AccountApple struct {
+	// The ID token received from Apple to validate.
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountApple) Reset()         { *m = AccountApple{} }
+func (m *AccountApple) String() string { return proto.CompactTextString(m) }
+func (*AccountApple) ProtoMessage()    {}
+func (*AccountApple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{1}
+}
+
+func (m *AccountApple) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountApple.Unmarshal(m, b)
+}
+func (m *AccountApple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountApple.Marshal(b, m, deterministic)
+}
+func (m *AccountApple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountApple.Merge(m, src)
+}
+func (m *AccountApple) XXX_Size() int {
+	return xxx_messageInfo_AccountApple.Size(m)
+}
+func (m *AccountApple) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountApple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountApple proto.InternalMessageInfo
+
+func (m *AccountApple) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 // Send a custom ID to the server. Used with authenticate/link/unlink.
 type AccountCustom struct {
 	// A custom identifier.
@@ -229,7 +343,7 @@ func (m *AccountCustom) Reset()         { *m = AccountCustom{} }
 func (m *AccountCustom) String() string { return proto.CompactTextString(m) }
 func (*AccountCustom) ProtoMessage()    {}
 func (*AccountCustom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{1}
+	return fileDescriptor_1b40cafcd4234784, []int{2}
 }
 
 func (m *AccountCustom) XXX_Unmarshal(b []byte) error {
@@ -270,7 +384,7 @@ func (m *AccountDevice) Reset()         { *m = AccountDevice{} }
 func (m *AccountDevice) String() string { return proto.CompactTextString(m) }
 func (*AccountDevice) ProtoMessage()    {}
 func (*AccountDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{2}
+	return fileDescriptor_1b40cafcd4234784, []int{3}
 }
 
 func (m *AccountDevice) XXX_Unmarshal(b []byte) error {
@@ -313,7 +427,7 @@ func (m *AccountEmail) Reset()         { *m = AccountEmail{} }
 func (m *AccountEmail) String() string { return proto.CompactTextString(m) }
 func (*AccountEmail) ProtoMessage()    {}
 func (*AccountEmail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{3}
+	return fileDescriptor_1b40cafcd4234784, []int{4}
 }
 
 func (m *AccountEmail) XXX_Unmarshal(b []byte) error {
@@ -348,6 +462,97 @@ func (m *AccountEmail) GetPassword() string {
 	return ""
 }
 
+// A pending request to erase the current user's account.
+type AccountErasure struct {
+	// The UNIX time when erasure was requested.
+	RequestTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=request_time,json=requestTime,proto3" json:"request_time,omitempty"`
+	// The UNIX time after which the account will be erased, unless the request is cancelled.
+	EraseTime            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=erase_time,json=eraseTime,proto3" json:"erase_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AccountErasure) Reset()         { *m = AccountErasure{} }
+func (m *AccountErasure) String() string { return proto.CompactTextString(m) }
+func (*AccountErasure) ProtoMessage()    {}
+func (*AccountErasure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{5}
+}
+
+func (m *AccountErasure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountErasure.Unmarshal(m, b)
+}
+func (m *AccountErasure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountErasure.Marshal(b, m, deterministic)
+}
+func (m *AccountErasure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountErasure.Merge(m, src)
+}
+func (m *AccountErasure) XXX_Size() int {
+	return xxx_messageInfo_AccountErasure.Size(m)
+}
+func (m *AccountErasure) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountErasure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountErasure proto.InternalMessageInfo
+
+func (m *AccountErasure) GetRequestTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.RequestTime
+	}
+	return nil
+}
+
+func (m *AccountErasure) GetEraseTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EraseTime
+	}
+	return nil
+}
+
+// An archive of all data stored for the current user's account.
+type AccountExportArchive struct {
+	// A zip archive holding one JSON file for each kind of data.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountExportArchive) Reset()         { *m = AccountExportArchive{} }
+func (m *AccountExportArchive) String() string { return proto.CompactTextString(m) }
+func (*AccountExportArchive) ProtoMessage()    {}
+func (*AccountExportArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{6}
+}
+
+func (m *AccountExportArchive) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountExportArchive.Unmarshal(m, b)
+}
+func (m *AccountExportArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountExportArchive.Marshal(b, m, deterministic)
+}
+func (m *AccountExportArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountExportArchive.Merge(m, src)
+}
+func (m *AccountExportArchive) XXX_Size() int {
+	return xxx_messageInfo_AccountExportArchive.Size(m)
+}
+func (m *AccountExportArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountExportArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountExportArchive proto.InternalMessageInfo
+
+func (m *AccountExportArchive) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // Send a Facebook token to the server. Used with authenticate/link/unlink.
 type AccountFacebook struct {
 	// The OAuth token received from Facebook to access their profile API.
@@ -361,7 +566,7 @@ func (m *AccountFacebook) Reset()         { *m = AccountFacebook{} }
 func (m *AccountFacebook) String() string { return proto.CompactTextString(m) }
 func (*AccountFacebook) ProtoMessage()    {}
 func (*AccountFacebook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{4}
+	return fileDescriptor_1b40cafcd4234784, []int{7}
 }
 
 func (m *AccountFacebook) XXX_Unmarshal(b []byte) error {
@@ -412,7 +617,7 @@ func (m *AccountGameCenter) Reset()         { *m = AccountGameCenter{} }
 func (m *AccountGameCenter) String() string { return proto.CompactTextString(m) }
 func (*AccountGameCenter) ProtoMessage()    {}
 func (*AccountGameCenter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{5}
+	return fileDescriptor_1b40cafcd4234784, []int{8}
 }
 
 func (m *AccountGameCenter) XXX_Unmarshal(b []byte) error {
@@ -488,7 +693,7 @@ func (m *AccountGoogle) Reset()         { *m = AccountGoogle{} }
 func (m *AccountGoogle) String() string { return proto.CompactTextString(m) }
 func (*AccountGoogle) ProtoMessage()    {}
 func (*AccountGoogle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{6}
+	return fileDescriptor_1b40cafcd4234784, []int{9}
 }
 
 func (m *AccountGoogle) XXX_Unmarshal(b []byte) error {
@@ -516,6 +721,124 @@ func (m *AccountGoogle) GetToken() string {
 	return ""
 }
 
+// An identity linked to a user's account.
+type AccountIdentity struct {
+	// The identity provider, one of "device", "custom", "email", "facebook", "gamecenter", "google", "steam", or "apple".
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The identifier of the user with the provider.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The UNIX time when the identity was linked to the account, if known.
+	LinkTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
+	// The UNIX time when the identity was last used to authenticate, if it has been.
+	LastAuthTime         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_auth_time,json=lastAuthTime,proto3" json:"last_auth_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AccountIdentity) Reset()         { *m = AccountIdentity{} }
+func (m *AccountIdentity) String() string { return proto.CompactTextString(m) }
+func (*AccountIdentity) ProtoMessage()    {}
+func (*AccountIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{10}
+}
+
+func (m *AccountIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountIdentity.Unmarshal(m, b)
+}
+func (m *AccountIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountIdentity.Marshal(b, m, deterministic)
+}
+func (m *AccountIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountIdentity.Merge(m, src)
+}
+func (m *AccountIdentity) XXX_Size() int {
+	return xxx_messageInfo_AccountIdentity.Size(m)
+}
+func (m *AccountIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountIdentity proto.InternalMessageInfo
+
+func (m *AccountIdentity) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *AccountIdentity) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AccountIdentity) GetLinkTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LinkTime
+	}
+	return nil
+}
+
+func (m *AccountIdentity) GetLastAuthTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastAuthTime
+	}
+	return nil
+}
+
+// Send an OpenID Connect identity token to the server. Used with authenticate/link/unlink.
+type AccountOidc struct {
+	// The name of the OpenID Connect provider, as configured on the server.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The ID token received from the provider to validate.
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountOidc) Reset()         { *m = AccountOidc{} }
+func (m *AccountOidc) String() string { return proto.CompactTextString(m) }
+func (*AccountOidc) ProtoMessage()    {}
+func (*AccountOidc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{11}
+}
+
+func (m *AccountOidc) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountOidc.Unmarshal(m, b)
+}
+func (m *AccountOidc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountOidc.Marshal(b, m, deterministic)
+}
+func (m *AccountOidc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountOidc.Merge(m, src)
+}
+func (m *AccountOidc) XXX_Size() int {
+	return xxx_messageInfo_AccountOidc.Size(m)
+}
+func (m *AccountOidc) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountOidc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountOidc proto.InternalMessageInfo
+
+func (m *AccountOidc) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *AccountOidc) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 // Send a Steam token to the server. Used with authenticate/link/unlink.
 type AccountSteam struct {
 	// The account token received from Steam to access their profile API.
@@ -529,7 +852,7 @@ func (m *AccountSteam) Reset()         { *m = AccountSteam{} }
 func (m *AccountSteam) String() string { return proto.CompactTextString(m) }
 func (*AccountSteam) ProtoMessage()    {}
 func (*AccountSteam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{7}
+	return fileDescriptor_1b40cafcd4234784, []int{12}
 }
 
 func (m *AccountSteam) XXX_Unmarshal(b []byte) error {
@@ -572,7 +895,7 @@ func (m *AddFriendsRequest) Reset()         { *m = AddFriendsRequest{} }
 func (m *AddFriendsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddFriendsRequest) ProtoMessage()    {}
 func (*AddFriendsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{8}
+	return fileDescriptor_1b40cafcd4234784, []int{13}
 }
 
 func (m *AddFriendsRequest) XXX_Unmarshal(b []byte) error {
@@ -622,7 +945,7 @@ func (m *AddGroupUsersRequest) Reset()         { *m = AddGroupUsersRequest{} }
 func (m *AddGroupUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*AddGroupUsersRequest) ProtoMessage()    {}
 func (*AddGroupUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{9}
+	return fileDescriptor_1b40cafcd4234784, []int{14}
 }
 
 func (m *AddGroupUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -657,6 +980,65 @@ func (m *AddGroupUsersRequest) GetUserIds() []string {
 	return nil
 }
 
+// Authenticate against the server with Sign in with Apple.
+type AuthenticateAppleRequest struct {
+	// The Apple account details.
+	Account *AccountApple `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Register the account if the user does not already exist.
+	Create *wrappers.BoolValue `protobuf:"bytes,2,opt,name=create,proto3" json:"create,omitempty"`
+	// Set the username on the account at register. Must be unique.
+	Username             string   `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthenticateAppleRequest) Reset()         { *m = AuthenticateAppleRequest{} }
+func (m *AuthenticateAppleRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthenticateAppleRequest) ProtoMessage()    {}
+func (*AuthenticateAppleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{15}
+}
+
+func (m *AuthenticateAppleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthenticateAppleRequest.Unmarshal(m, b)
+}
+func (m *AuthenticateAppleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthenticateAppleRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthenticateAppleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticateAppleRequest.Merge(m, src)
+}
+func (m *AuthenticateAppleRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthenticateAppleRequest.Size(m)
+}
+func (m *AuthenticateAppleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticateAppleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticateAppleRequest proto.InternalMessageInfo
+
+func (m *AuthenticateAppleRequest) GetAccount() *AccountApple {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AuthenticateAppleRequest) GetCreate() *wrappers.BoolValue {
+	if m != nil {
+		return m.Create
+	}
+	return nil
+}
+
+func (m *AuthenticateAppleRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
 // Authenticate against the server with a custom ID.
 type AuthenticateCustomRequest struct {
 	// The custom account details.
@@ -674,7 +1056,7 @@ func (m *AuthenticateCustomRequest) Reset()         { *m = AuthenticateCustomReq
 func (m *AuthenticateCustomRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateCustomRequest) ProtoMessage()    {}
 func (*AuthenticateCustomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{10}
+	return fileDescriptor_1b40cafcd4234784, []int{16}
 }
 
 func (m *AuthenticateCustomRequest) XXX_Unmarshal(b []byte) error {
@@ -733,7 +1115,7 @@ func (m *AuthenticateDeviceRequest) Reset()         { *m = AuthenticateDeviceReq
 func (m *AuthenticateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateDeviceRequest) ProtoMessage()    {}
 func (*AuthenticateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{11}
+	return fileDescriptor_1b40cafcd4234784, []int{17}
 }
 
 func (m *AuthenticateDeviceRequest) XXX_Unmarshal(b []byte) error {
@@ -792,7 +1174,7 @@ func (m *AuthenticateEmailRequest) Reset()         { *m = AuthenticateEmailReque
 func (m *AuthenticateEmailRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateEmailRequest) ProtoMessage()    {}
 func (*AuthenticateEmailRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{12}
+	return fileDescriptor_1b40cafcd4234784, []int{18}
 }
 
 func (m *AuthenticateEmailRequest) XXX_Unmarshal(b []byte) error {
@@ -853,7 +1235,7 @@ func (m *AuthenticateFacebookRequest) Reset()         { *m = AuthenticateFaceboo
 func (m *AuthenticateFacebookRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateFacebookRequest) ProtoMessage()    {}
 func (*AuthenticateFacebookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{13}
+	return fileDescriptor_1b40cafcd4234784, []int{19}
 }
 
 func (m *AuthenticateFacebookRequest) XXX_Unmarshal(b []byte) error {
@@ -919,7 +1301,7 @@ func (m *AuthenticateGameCenterRequest) Reset()         { *m = AuthenticateGameC
 func (m *AuthenticateGameCenterRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateGameCenterRequest) ProtoMessage()    {}
 func (*AuthenticateGameCenterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{14}
+	return fileDescriptor_1b40cafcd4234784, []int{20}
 }
 
 func (m *AuthenticateGameCenterRequest) XXX_Unmarshal(b []byte) error {
@@ -978,7 +1360,7 @@ func (m *AuthenticateGoogleRequest) Reset()         { *m = AuthenticateGoogleReq
 func (m *AuthenticateGoogleRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateGoogleRequest) ProtoMessage()    {}
 func (*AuthenticateGoogleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{15}
+	return fileDescriptor_1b40cafcd4234784, []int{21}
 }
 
 func (m *AuthenticateGoogleRequest) XXX_Unmarshal(b []byte) error {
@@ -1020,6 +1402,65 @@ func (m *AuthenticateGoogleRequest) GetUsername() string {
 	return ""
 }
 
+// Authenticate against the server with an OpenID Connect provider.
+type AuthenticateOidcRequest struct {
+	// The OpenID Connect account details.
+	Account *AccountOidc `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Register the account if the user does not already exist.
+	Create *wrappers.BoolValue `protobuf:"bytes,2,opt,name=create,proto3" json:"create,omitempty"`
+	// Set the username on the account at register. Must be unique.
+	Username             string   `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthenticateOidcRequest) Reset()         { *m = AuthenticateOidcRequest{} }
+func (m *AuthenticateOidcRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthenticateOidcRequest) ProtoMessage()    {}
+func (*AuthenticateOidcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{22}
+}
+
+func (m *AuthenticateOidcRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthenticateOidcRequest.Unmarshal(m, b)
+}
+func (m *AuthenticateOidcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthenticateOidcRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthenticateOidcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticateOidcRequest.Merge(m, src)
+}
+func (m *AuthenticateOidcRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthenticateOidcRequest.Size(m)
+}
+func (m *AuthenticateOidcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticateOidcRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticateOidcRequest proto.InternalMessageInfo
+
+func (m *AuthenticateOidcRequest) GetAccount() *AccountOidc {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AuthenticateOidcRequest) GetCreate() *wrappers.BoolValue {
+	if m != nil {
+		return m.Create
+	}
+	return nil
+}
+
+func (m *AuthenticateOidcRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
 // Authenticate against the server with Steam.
 type AuthenticateSteamRequest struct {
 	// The Steam account details.
@@ -1037,7 +1478,7 @@ func (m *AuthenticateSteamRequest) Reset()         { *m = AuthenticateSteamReque
 func (m *AuthenticateSteamRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateSteamRequest) ProtoMessage()    {}
 func (*AuthenticateSteamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{16}
+	return fileDescriptor_1b40cafcd4234784, []int{23}
 }
 
 func (m *AuthenticateSteamRequest) XXX_Unmarshal(b []byte) error {
@@ -1094,7 +1535,7 @@ func (m *BlockFriendsRequest) Reset()         { *m = BlockFriendsRequest{} }
 func (m *BlockFriendsRequest) String() string { return proto.CompactTextString(m) }
 func (*BlockFriendsRequest) ProtoMessage()    {}
 func (*BlockFriendsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{17}
+	return fileDescriptor_1b40cafcd4234784, []int{24}
 }
 
 func (m *BlockFriendsRequest) XXX_Unmarshal(b []byte) error {
@@ -1158,7 +1599,7 @@ func (m *ChannelMessage) Reset()         { *m = ChannelMessage{} }
 func (m *ChannelMessage) String() string { return proto.CompactTextString(m) }
 func (*ChannelMessage) ProtoMessage()    {}
 func (*ChannelMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{18}
+	return fileDescriptor_1b40cafcd4234784, []int{25}
 }
 
 func (m *ChannelMessage) XXX_Unmarshal(b []byte) error {
@@ -1259,7 +1700,7 @@ func (m *ChannelMessageList) Reset()         { *m = ChannelMessageList{} }
 func (m *ChannelMessageList) String() string { return proto.CompactTextString(m) }
 func (*ChannelMessageList) ProtoMessage()    {}
 func (*ChannelMessageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{19}
+	return fileDescriptor_1b40cafcd4234784, []int{26}
 }
 
 func (m *ChannelMessageList) XXX_Unmarshal(b []byte) error {
@@ -1322,7 +1763,7 @@ func (m *CreateGroupRequest) Reset()         { *m = CreateGroupRequest{} }
 func (m *CreateGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateGroupRequest) ProtoMessage()    {}
 func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{20}
+	return fileDescriptor_1b40cafcd4234784, []int{27}
 }
 
 func (m *CreateGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -1357,25 +1798,75 @@ func (m *CreateGroupRequest) GetDescription() string {
 	return ""
 }
 
-func (m *CreateGroupRequest) GetLangTag() string {
-	if m != nil {
-		return m.LangTag
-	}
-	return ""
+func (m *CreateGroupRequest) GetLangTag() string {
+	if m != nil {
+		return m.LangTag
+	}
+	return ""
+}
+
+func (m *CreateGroupRequest) GetAvatarUrl() string {
+	if m != nil {
+		return m.AvatarUrl
+	}
+	return ""
+}
+
+func (m *CreateGroupRequest) GetOpen() bool {
+	if m != nil {
+		return m.Open
+	}
+	return false
+}
+
+// Decline pending requests to join a group.
+type DeclineGroupJoinRequestsRequest struct {
+	// The group ID to decline join requests for.
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The users whose join requests to decline.
+	UserIds              []string `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclineGroupJoinRequestsRequest) Reset()         { *m = DeclineGroupJoinRequestsRequest{} }
+func (m *DeclineGroupJoinRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeclineGroupJoinRequestsRequest) ProtoMessage()    {}
+func (*DeclineGroupJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{28}
+}
+
+func (m *DeclineGroupJoinRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclineGroupJoinRequestsRequest.Unmarshal(m, b)
+}
+func (m *DeclineGroupJoinRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclineGroupJoinRequestsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeclineGroupJoinRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclineGroupJoinRequestsRequest.Merge(m, src)
+}
+func (m *DeclineGroupJoinRequestsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeclineGroupJoinRequestsRequest.Size(m)
+}
+func (m *DeclineGroupJoinRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclineGroupJoinRequestsRequest.DiscardUnknown(m)
 }
 
-func (m *CreateGroupRequest) GetAvatarUrl() string {
+var xxx_messageInfo_DeclineGroupJoinRequestsRequest proto.InternalMessageInfo
+
+func (m *DeclineGroupJoinRequestsRequest) GetGroupId() string {
 	if m != nil {
-		return m.AvatarUrl
+		return m.GroupId
 	}
 	return ""
 }
 
-func (m *CreateGroupRequest) GetOpen() bool {
+func (m *DeclineGroupJoinRequestsRequest) GetUserIds() []string {
 	if m != nil {
-		return m.Open
+		return m.UserIds
 	}
-	return false
+	return nil
 }
 
 // Delete one or more friends for the current user.
@@ -1393,7 +1884,7 @@ func (m *DeleteFriendsRequest) Reset()         { *m = DeleteFriendsRequest{} }
 func (m *DeleteFriendsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteFriendsRequest) ProtoMessage()    {}
 func (*DeleteFriendsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{21}
+	return fileDescriptor_1b40cafcd4234784, []int{29}
 }
 
 func (m *DeleteFriendsRequest) XXX_Unmarshal(b []byte) error {
@@ -1441,7 +1932,7 @@ func (m *DeleteGroupRequest) Reset()         { *m = DeleteGroupRequest{} }
 func (m *DeleteGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteGroupRequest) ProtoMessage()    {}
 func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{22}
+	return fileDescriptor_1b40cafcd4234784, []int{30}
 }
 
 func (m *DeleteGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -1482,7 +1973,7 @@ func (m *DeleteLeaderboardRecordRequest) Reset()         { *m = DeleteLeaderboar
 func (m *DeleteLeaderboardRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteLeaderboardRecordRequest) ProtoMessage()    {}
 func (*DeleteLeaderboardRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{23}
+	return fileDescriptor_1b40cafcd4234784, []int{31}
 }
 
 func (m *DeleteLeaderboardRecordRequest) XXX_Unmarshal(b []byte) error {
@@ -1523,7 +2014,7 @@ func (m *DeleteNotificationsRequest) Reset()         { *m = DeleteNotificationsR
 func (m *DeleteNotificationsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteNotificationsRequest) ProtoMessage()    {}
 func (*DeleteNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{24}
+	return fileDescriptor_1b40cafcd4234784, []int{32}
 }
 
 func (m *DeleteNotificationsRequest) XXX_Unmarshal(b []byte) error {
@@ -1568,7 +2059,7 @@ func (m *DeleteStorageObjectId) Reset()         { *m = DeleteStorageObjectId{} }
 func (m *DeleteStorageObjectId) String() string { return proto.CompactTextString(m) }
 func (*DeleteStorageObjectId) ProtoMessage()    {}
 func (*DeleteStorageObjectId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{25}
+	return fileDescriptor_1b40cafcd4234784, []int{33}
 }
 
 func (m *DeleteStorageObjectId) XXX_Unmarshal(b []byte) error {
@@ -1623,7 +2114,7 @@ func (m *DeleteStorageObjectsRequest) Reset()         { *m = DeleteStorageObject
 func (m *DeleteStorageObjectsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteStorageObjectsRequest) ProtoMessage()    {}
 func (*DeleteStorageObjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{26}
+	return fileDescriptor_1b40cafcd4234784, []int{34}
 }
 
 func (m *DeleteStorageObjectsRequest) XXX_Unmarshal(b []byte) error {
@@ -1668,7 +2159,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{27}
+	return fileDescriptor_1b40cafcd4234784, []int{35}
 }
 
 func (m *Event) XXX_Unmarshal(b []byte) error {
@@ -1715,17 +2206,21 @@ type Friend struct {
 	// The user object.
 	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	// The friend status.
-	State                *wrappers.Int32Value `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	State *wrappers.Int32Value `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// The UNIX time when the friend status last changed, for example when an invite was accepted.
+	UpdateTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Metadata the server has stored for this relationship on behalf of the current user.
+	Metadata             string   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Friend) Reset()         { *m = Friend{} }
 func (m *Friend) String() string { return proto.CompactTextString(m) }
 func (*Friend) ProtoMessage()    {}
 func (*Friend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{28}
+	return fileDescriptor_1b40cafcd4234784, []int{36}
 }
 
 func (m *Friend) XXX_Unmarshal(b []byte) error {
@@ -1760,20 +2255,36 @@ func (m *Friend) GetState() *wrappers.Int32Value {
 	return nil
 }
 
+func (m *Friend) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+func (m *Friend) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
 // A collection of zero or more friends of the user.
 type Friends struct {
 	// The Friend objects.
-	Friends              []*Friend `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Friends []*Friend `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	// Cursor for the next page of results, if any.
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Friends) Reset()         { *m = Friends{} }
 func (m *Friends) String() string { return proto.CompactTextString(m) }
 func (*Friends) ProtoMessage()    {}
 func (*Friends) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{29}
+	return fileDescriptor_1b40cafcd4234784, []int{37}
 }
 
 func (m *Friends) XXX_Unmarshal(b []byte) error {
@@ -1801,6 +2312,122 @@ func (m *Friends) GetFriends() []*Friend {
 	return nil
 }
 
+func (m *Friends) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// A user suggested as a new friend, with the reasons they were suggested.
+type FriendSuggestion struct {
+	// The suggested user.
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// The number of friends the suggested user shares with the current user.
+	MutualFriendCount int32 `protobuf:"varint,2,opt,name=mutual_friend_count,json=mutualFriendCount,proto3" json:"mutual_friend_count,omitempty"`
+	// The number of groups the suggested user shares with the current user.
+	SharedGroupCount int32 `protobuf:"varint,3,opt,name=shared_group_count,json=sharedGroupCount,proto3" json:"shared_group_count,omitempty"`
+	// True if the suggested user recently played in the same match as the current user.
+	PlayedRecently       bool     `protobuf:"varint,4,opt,name=played_recently,json=playedRecently,proto3" json:"played_recently,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FriendSuggestion) Reset()         { *m = FriendSuggestion{} }
+func (m *FriendSuggestion) String() string { return proto.CompactTextString(m) }
+func (*FriendSuggestion) ProtoMessage()    {}
+func (*FriendSuggestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{38}
+}
+
+func (m *FriendSuggestion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FriendSuggestion.Unmarshal(m, b)
+}
+func (m *FriendSuggestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FriendSuggestion.Marshal(b, m, deterministic)
+}
+func (m *FriendSuggestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FriendSuggestion.Merge(m, src)
+}
+func (m *FriendSuggestion) XXX_Size() int {
+	return xxx_messageInfo_FriendSuggestion.Size(m)
+}
+func (m *FriendSuggestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FriendSuggestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FriendSuggestion proto.InternalMessageInfo
+
+func (m *FriendSuggestion) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *FriendSuggestion) GetMutualFriendCount() int32 {
+	if m != nil {
+		return m.MutualFriendCount
+	}
+	return 0
+}
+
+func (m *FriendSuggestion) GetSharedGroupCount() int32 {
+	if m != nil {
+		return m.SharedGroupCount
+	}
+	return 0
+}
+
+func (m *FriendSuggestion) GetPlayedRecently() bool {
+	if m != nil {
+		return m.PlayedRecently
+	}
+	return false
+}
+
+// A list of friend suggestions, best suggestions first.
+type FriendSuggestionList struct {
+	// The friend suggestions.
+	Suggestions          []*FriendSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *FriendSuggestionList) Reset()         { *m = FriendSuggestionList{} }
+func (m *FriendSuggestionList) String() string { return proto.CompactTextString(m) }
+func (*FriendSuggestionList) ProtoMessage()    {}
+func (*FriendSuggestionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{39}
+}
+
+func (m *FriendSuggestionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FriendSuggestionList.Unmarshal(m, b)
+}
+func (m *FriendSuggestionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FriendSuggestionList.Marshal(b, m, deterministic)
+}
+func (m *FriendSuggestionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FriendSuggestionList.Merge(m, src)
+}
+func (m *FriendSuggestionList) XXX_Size() int {
+	return xxx_messageInfo_FriendSuggestionList.Size(m)
+}
+func (m *FriendSuggestionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FriendSuggestionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FriendSuggestionList proto.InternalMessageInfo
+
+func (m *FriendSuggestionList) GetSuggestions() []*FriendSuggestion {
+	if m != nil {
+		return m.Suggestions
+	}
+	return nil
+}
+
 // Fetch a batch of zero or more users from the server.
 type GetUsersRequest struct {
 	// The account id of a user.
@@ -1818,7 +2445,7 @@ func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
 func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUsersRequest) ProtoMessage()    {}
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{30}
+	return fileDescriptor_1b40cafcd4234784, []int{40}
 }
 
 func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -1895,7 +2522,7 @@ func (m *Group) Reset()         { *m = Group{} }
 func (m *Group) String() string { return proto.CompactTextString(m) }
 func (*Group) ProtoMessage()    {}
 func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{31}
+	return fileDescriptor_1b40cafcd4234784, []int{41}
 }
 
 func (m *Group) XXX_Unmarshal(b []byte) error {
@@ -2015,7 +2642,7 @@ func (m *GroupList) Reset()         { *m = GroupList{} }
 func (m *GroupList) String() string { return proto.CompactTextString(m) }
 func (*GroupList) ProtoMessage()    {}
 func (*GroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{32}
+	return fileDescriptor_1b40cafcd4234784, []int{42}
 }
 
 func (m *GroupList) XXX_Unmarshal(b []byte) error {
@@ -2053,17 +2680,19 @@ func (m *GroupList) GetCursor() string {
 // A list of users belonging to a group, along with their role.
 type GroupUserList struct {
 	// User-role pairs for a group.
-	GroupUsers           []*GroupUserList_GroupUser `protobuf:"bytes,1,rep,name=group_users,json=groupUsers,proto3" json:"group_users,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	GroupUsers []*GroupUserList_GroupUser `protobuf:"bytes,1,rep,name=group_users,json=groupUsers,proto3" json:"group_users,omitempty"`
+	// Cursor for the next page of results, if any.
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GroupUserList) Reset()         { *m = GroupUserList{} }
 func (m *GroupUserList) String() string { return proto.CompactTextString(m) }
 func (*GroupUserList) ProtoMessage()    {}
 func (*GroupUserList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{33}
+	return fileDescriptor_1b40cafcd4234784, []int{43}
 }
 
 func (m *GroupUserList) XXX_Unmarshal(b []byte) error {
@@ -2091,6 +2720,13 @@ func (m *GroupUserList) GetGroupUsers() []*GroupUserList_GroupUser {
 	return nil
 }
 
+func (m *GroupUserList) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 // A single user-role pair.
 type GroupUserList_GroupUser struct {
 	// User.
@@ -2106,7 +2742,7 @@ func (m *GroupUserList_GroupUser) Reset()         { *m = GroupUserList_GroupUser
 func (m *GroupUserList_GroupUser) String() string { return proto.CompactTextString(m) }
 func (*GroupUserList_GroupUser) ProtoMessage()    {}
 func (*GroupUserList_GroupUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{33, 0}
+	return fileDescriptor_1b40cafcd4234784, []int{43, 0}
 }
 
 func (m *GroupUserList_GroupUser) XXX_Unmarshal(b []byte) error {
@@ -2156,7 +2792,7 @@ func (m *ImportFacebookFriendsRequest) Reset()         { *m = ImportFacebookFrie
 func (m *ImportFacebookFriendsRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportFacebookFriendsRequest) ProtoMessage()    {}
 func (*ImportFacebookFriendsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{34}
+	return fileDescriptor_1b40cafcd4234784, []int{44}
 }
 
 func (m *ImportFacebookFriendsRequest) XXX_Unmarshal(b []byte) error {
@@ -2204,7 +2840,7 @@ func (m *JoinGroupRequest) Reset()         { *m = JoinGroupRequest{} }
 func (m *JoinGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*JoinGroupRequest) ProtoMessage()    {}
 func (*JoinGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{35}
+	return fileDescriptor_1b40cafcd4234784, []int{45}
 }
 
 func (m *JoinGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -2245,7 +2881,7 @@ func (m *JoinTournamentRequest) Reset()         { *m = JoinTournamentRequest{} }
 func (m *JoinTournamentRequest) String() string { return proto.CompactTextString(m) }
 func (*JoinTournamentRequest) ProtoMessage()    {}
 func (*JoinTournamentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{36}
+	return fileDescriptor_1b40cafcd4234784, []int{46}
 }
 
 func (m *JoinTournamentRequest) XXX_Unmarshal(b []byte) error {
@@ -2288,7 +2924,7 @@ func (m *KickGroupUsersRequest) Reset()         { *m = KickGroupUsersRequest{} }
 func (m *KickGroupUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*KickGroupUsersRequest) ProtoMessage()    {}
 func (*KickGroupUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{37}
+	return fileDescriptor_1b40cafcd4234784, []int{47}
 }
 
 func (m *KickGroupUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -2358,7 +2994,7 @@ func (m *LeaderboardRecord) Reset()         { *m = LeaderboardRecord{} }
 func (m *LeaderboardRecord) String() string { return proto.CompactTextString(m) }
 func (*LeaderboardRecord) ProtoMessage()    {}
 func (*LeaderboardRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{38}
+	return fileDescriptor_1b40cafcd4234784, []int{48}
 }
 
 func (m *LeaderboardRecord) XXX_Unmarshal(b []byte) error {
@@ -2482,7 +3118,7 @@ func (m *LeaderboardRecordList) Reset()         { *m = LeaderboardRecordList{} }
 func (m *LeaderboardRecordList) String() string { return proto.CompactTextString(m) }
 func (*LeaderboardRecordList) ProtoMessage()    {}
 func (*LeaderboardRecordList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{39}
+	return fileDescriptor_1b40cafcd4234784, []int{49}
 }
 
 func (m *LeaderboardRecordList) XXX_Unmarshal(b []byte) error {
@@ -2544,7 +3180,7 @@ func (m *LeaveGroupRequest) Reset()         { *m = LeaveGroupRequest{} }
 func (m *LeaveGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaveGroupRequest) ProtoMessage()    {}
 func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{40}
+	return fileDescriptor_1b40cafcd4234784, []int{50}
 }
 
 func (m *LeaveGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -2587,7 +3223,7 @@ func (m *LinkFacebookRequest) Reset()         { *m = LinkFacebookRequest{} }
 func (m *LinkFacebookRequest) String() string { return proto.CompactTextString(m) }
 func (*LinkFacebookRequest) ProtoMessage()    {}
 func (*LinkFacebookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{41}
+	return fileDescriptor_1b40cafcd4234784, []int{51}
 }
 
 func (m *LinkFacebookRequest) XXX_Unmarshal(b []byte) error {
@@ -2605,85 +3241,185 @@ func (m *LinkFacebookRequest) XXX_Size() int {
 func (m *LinkFacebookRequest) XXX_DiscardUnknown() {
 	xxx_messageInfo_LinkFacebookRequest.DiscardUnknown(m)
 }
-
-var xxx_messageInfo_LinkFacebookRequest proto.InternalMessageInfo
-
-func (m *LinkFacebookRequest) GetAccount() *AccountFacebook {
-	if m != nil {
-		return m.Account
-	}
-	return nil
+
+var xxx_messageInfo_LinkFacebookRequest proto.InternalMessageInfo
+
+func (m *LinkFacebookRequest) GetAccount() *AccountFacebook {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *LinkFacebookRequest) GetSync() *wrappers.BoolValue {
+	if m != nil {
+		return m.Sync
+	}
+	return nil
+}
+
+// List a channel's message history.
+type ListChannelMessagesRequest struct {
+	// The channel ID to list from.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Max number of records to return. Between 1 and 100.
+	Limit *wrappers.Int32Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// True if listing should be older messages to newer, false if reverse.
+	Forward *wrappers.BoolValue `protobuf:"bytes,3,opt,name=forward,proto3" json:"forward,omitempty"`
+	// A pagination cursor, if any.
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChannelMessagesRequest) Reset()         { *m = ListChannelMessagesRequest{} }
+func (m *ListChannelMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChannelMessagesRequest) ProtoMessage()    {}
+func (*ListChannelMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{52}
+}
+
+func (m *ListChannelMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelMessagesRequest.Unmarshal(m, b)
+}
+func (m *ListChannelMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelMessagesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListChannelMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelMessagesRequest.Merge(m, src)
+}
+func (m *ListChannelMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChannelMessagesRequest.Size(m)
+}
+func (m *ListChannelMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelMessagesRequest proto.InternalMessageInfo
+
+func (m *ListChannelMessagesRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ListChannelMessagesRequest) GetLimit() *wrappers.Int32Value {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *ListChannelMessagesRequest) GetForward() *wrappers.BoolValue {
+	if m != nil {
+		return m.Forward
+	}
+	return nil
+}
+
+func (m *ListChannelMessagesRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// List users the current user may want to add as friends.
+type ListFriendSuggestionsRequest struct {
+	// Max number of suggestions to return. Between 1 and 100, default 100.
+	Limit                *wrappers.Int32Value `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListFriendSuggestionsRequest) Reset()         { *m = ListFriendSuggestionsRequest{} }
+func (m *ListFriendSuggestionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFriendSuggestionsRequest) ProtoMessage()    {}
+func (*ListFriendSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{53}
+}
+
+func (m *ListFriendSuggestionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFriendSuggestionsRequest.Unmarshal(m, b)
+}
+func (m *ListFriendSuggestionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFriendSuggestionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListFriendSuggestionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFriendSuggestionsRequest.Merge(m, src)
+}
+func (m *ListFriendSuggestionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListFriendSuggestionsRequest.Size(m)
+}
+func (m *ListFriendSuggestionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFriendSuggestionsRequest.DiscardUnknown(m)
 }
 
-func (m *LinkFacebookRequest) GetSync() *wrappers.BoolValue {
+var xxx_messageInfo_ListFriendSuggestionsRequest proto.InternalMessageInfo
+
+func (m *ListFriendSuggestionsRequest) GetLimit() *wrappers.Int32Value {
 	if m != nil {
-		return m.Sync
+		return m.Limit
 	}
 	return nil
 }
 
-// List a channel's message history.
-type ListChannelMessagesRequest struct {
-	// The channel ID to list from.
-	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	// Max number of records to return. Between 1 and 100.
-	Limit *wrappers.Int32Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	// True if listing should be older messages to newer, false if reverse.
-	Forward *wrappers.BoolValue `protobuf:"bytes,3,opt,name=forward,proto3" json:"forward,omitempty"`
-	// A pagination cursor, if any.
-	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+// List the current user's friends, invites and blocked users.
+type ListFriendsRequest struct {
+	// Max number of friends to return. Between 1 and 100, default 100.
+	Limit *wrappers.Int32Value `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only list friends with this friend status.
+	State *wrappers.Int32Value `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// An optional next page cursor.
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListChannelMessagesRequest) Reset()         { *m = ListChannelMessagesRequest{} }
-func (m *ListChannelMessagesRequest) String() string { return proto.CompactTextString(m) }
-func (*ListChannelMessagesRequest) ProtoMessage()    {}
-func (*ListChannelMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{42}
+func (m *ListFriendsRequest) Reset()         { *m = ListFriendsRequest{} }
+func (m *ListFriendsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFriendsRequest) ProtoMessage()    {}
+func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{54}
 }
 
-func (m *ListChannelMessagesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListChannelMessagesRequest.Unmarshal(m, b)
+func (m *ListFriendsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFriendsRequest.Unmarshal(m, b)
 }
-func (m *ListChannelMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListChannelMessagesRequest.Marshal(b, m, deterministic)
+func (m *ListFriendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFriendsRequest.Marshal(b, m, deterministic)
 }
-func (m *ListChannelMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListChannelMessagesRequest.Merge(m, src)
+func (m *ListFriendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFriendsRequest.Merge(m, src)
 }
-func (m *ListChannelMessagesRequest) XXX_Size() int {
-	return xxx_messageInfo_ListChannelMessagesRequest.Size(m)
+func (m *ListFriendsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListFriendsRequest.Size(m)
 }
-func (m *ListChannelMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListChannelMessagesRequest.DiscardUnknown(m)
+func (m *ListFriendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFriendsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListChannelMessagesRequest proto.InternalMessageInfo
-
-func (m *ListChannelMessagesRequest) GetChannelId() string {
-	if m != nil {
-		return m.ChannelId
-	}
-	return ""
-}
+var xxx_messageInfo_ListFriendsRequest proto.InternalMessageInfo
 
-func (m *ListChannelMessagesRequest) GetLimit() *wrappers.Int32Value {
+func (m *ListFriendsRequest) GetLimit() *wrappers.Int32Value {
 	if m != nil {
 		return m.Limit
 	}
 	return nil
 }
 
-func (m *ListChannelMessagesRequest) GetForward() *wrappers.BoolValue {
+func (m *ListFriendsRequest) GetState() *wrappers.Int32Value {
 	if m != nil {
-		return m.Forward
+		return m.State
 	}
 	return nil
 }
 
-func (m *ListChannelMessagesRequest) GetCursor() string {
+func (m *ListFriendsRequest) GetCursor() string {
 	if m != nil {
 		return m.Cursor
 	}
@@ -2707,7 +3443,7 @@ func (m *ListGroupsRequest) Reset()         { *m = ListGroupsRequest{} }
 func (m *ListGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGroupsRequest) ProtoMessage()    {}
 func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{43}
+	return fileDescriptor_1b40cafcd4234784, []int{55}
 }
 
 func (m *ListGroupsRequest) XXX_Unmarshal(b []byte) error {
@@ -2749,6 +3485,65 @@ func (m *ListGroupsRequest) GetLimit() *wrappers.Int32Value {
 	return nil
 }
 
+// List pending requests to join a group.
+type ListGroupJoinRequestsRequest struct {
+	// The group ID to list join requests for.
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Max number of join requests to return. Between 1 and 100, default 100.
+	Limit *wrappers.Int32Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// An optional next page cursor.
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGroupJoinRequestsRequest) Reset()         { *m = ListGroupJoinRequestsRequest{} }
+func (m *ListGroupJoinRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGroupJoinRequestsRequest) ProtoMessage()    {}
+func (*ListGroupJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{56}
+}
+
+func (m *ListGroupJoinRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupJoinRequestsRequest.Unmarshal(m, b)
+}
+func (m *ListGroupJoinRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupJoinRequestsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListGroupJoinRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupJoinRequestsRequest.Merge(m, src)
+}
+func (m *ListGroupJoinRequestsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGroupJoinRequestsRequest.Size(m)
+}
+func (m *ListGroupJoinRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupJoinRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGroupJoinRequestsRequest proto.InternalMessageInfo
+
+func (m *ListGroupJoinRequestsRequest) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *ListGroupJoinRequestsRequest) GetLimit() *wrappers.Int32Value {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *ListGroupJoinRequestsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 // List all users that are part of a group.
 type ListGroupUsersRequest struct {
 	// The group ID to list from.
@@ -2762,7 +3557,7 @@ func (m *ListGroupUsersRequest) Reset()         { *m = ListGroupUsersRequest{} }
 func (m *ListGroupUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGroupUsersRequest) ProtoMessage()    {}
 func (*ListGroupUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{44}
+	return fileDescriptor_1b40cafcd4234784, []int{57}
 }
 
 func (m *ListGroupUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -2809,7 +3604,7 @@ func (m *ListLeaderboardRecordsAroundOwnerRequest) Reset() {
 func (m *ListLeaderboardRecordsAroundOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*ListLeaderboardRecordsAroundOwnerRequest) ProtoMessage()    {}
 func (*ListLeaderboardRecordsAroundOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{45}
+	return fileDescriptor_1b40cafcd4234784, []int{58}
 }
 
 func (m *ListLeaderboardRecordsAroundOwnerRequest) XXX_Unmarshal(b []byte) error {
@@ -2870,7 +3665,7 @@ func (m *ListLeaderboardRecordsRequest) Reset()         { *m = ListLeaderboardRe
 func (m *ListLeaderboardRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListLeaderboardRecordsRequest) ProtoMessage()    {}
 func (*ListLeaderboardRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{46}
+	return fileDescriptor_1b40cafcd4234784, []int{59}
 }
 
 func (m *ListLeaderboardRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -2942,7 +3737,7 @@ func (m *ListMatchesRequest) Reset()         { *m = ListMatchesRequest{} }
 func (m *ListMatchesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMatchesRequest) ProtoMessage()    {}
 func (*ListMatchesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{47}
+	return fileDescriptor_1b40cafcd4234784, []int{60}
 }
 
 func (m *ListMatchesRequest) XXX_Unmarshal(b []byte) error {
@@ -3005,6 +3800,56 @@ func (m *ListMatchesRequest) GetQuery() *wrappers.StringValue {
 	return nil
 }
 
+// List the friends the current user shares with another user.
+type ListMutualFriendsRequest struct {
+	// The other user.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Max number of mutual friends to return. Between 1 and 100, default 100.
+	Limit                *wrappers.Int32Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListMutualFriendsRequest) Reset()         { *m = ListMutualFriendsRequest{} }
+func (m *ListMutualFriendsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMutualFriendsRequest) ProtoMessage()    {}
+func (*ListMutualFriendsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{61}
+}
+
+func (m *ListMutualFriendsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMutualFriendsRequest.Unmarshal(m, b)
+}
+func (m *ListMutualFriendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMutualFriendsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMutualFriendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMutualFriendsRequest.Merge(m, src)
+}
+func (m *ListMutualFriendsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMutualFriendsRequest.Size(m)
+}
+func (m *ListMutualFriendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMutualFriendsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMutualFriendsRequest proto.InternalMessageInfo
+
+func (m *ListMutualFriendsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ListMutualFriendsRequest) GetLimit() *wrappers.Int32Value {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
 // Get a list of unexpired notifications.
 type ListNotificationsRequest struct {
 	// The number of notifications to get. Between 1 and 100.
@@ -3020,7 +3865,7 @@ func (m *ListNotificationsRequest) Reset()         { *m = ListNotificationsReque
 func (m *ListNotificationsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListNotificationsRequest) ProtoMessage()    {}
 func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{48}
+	return fileDescriptor_1b40cafcd4234784, []int{62}
 }
 
 func (m *ListNotificationsRequest) XXX_Unmarshal(b []byte) error {
@@ -3055,6 +3900,56 @@ func (m *ListNotificationsRequest) GetCacheableCursor() string {
 	return ""
 }
 
+// List validated purchases for the current user.
+type ListPurchasesRequest struct {
+	// Max number of records to return. Between 1 and 100.
+	Limit *wrappers.Int32Value `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// An optional next page cursor.
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPurchasesRequest) Reset()         { *m = ListPurchasesRequest{} }
+func (m *ListPurchasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPurchasesRequest) ProtoMessage()    {}
+func (*ListPurchasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{63}
+}
+
+func (m *ListPurchasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPurchasesRequest.Unmarshal(m, b)
+}
+func (m *ListPurchasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPurchasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPurchasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPurchasesRequest.Merge(m, src)
+}
+func (m *ListPurchasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPurchasesRequest.Size(m)
+}
+func (m *ListPurchasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPurchasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPurchasesRequest proto.InternalMessageInfo
+
+func (m *ListPurchasesRequest) GetLimit() *wrappers.Int32Value {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *ListPurchasesRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 // List publicly readable storage objects in a given collection.
 type ListStorageObjectsRequest struct {
 	// ID of the user.
@@ -3074,7 +3969,7 @@ func (m *ListStorageObjectsRequest) Reset()         { *m = ListStorageObjectsReq
 func (m *ListStorageObjectsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListStorageObjectsRequest) ProtoMessage()    {}
 func (*ListStorageObjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{49}
+	return fileDescriptor_1b40cafcd4234784, []int{64}
 }
 
 func (m *ListStorageObjectsRequest) XXX_Unmarshal(b []byte) error {
@@ -3142,7 +4037,7 @@ func (m *ListTournamentRecordsAroundOwnerRequest) Reset() {
 func (m *ListTournamentRecordsAroundOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTournamentRecordsAroundOwnerRequest) ProtoMessage()    {}
 func (*ListTournamentRecordsAroundOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{50}
+	return fileDescriptor_1b40cafcd4234784, []int{65}
 }
 
 func (m *ListTournamentRecordsAroundOwnerRequest) XXX_Unmarshal(b []byte) error {
@@ -3203,7 +4098,7 @@ func (m *ListTournamentRecordsRequest) Reset()         { *m = ListTournamentReco
 func (m *ListTournamentRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTournamentRecordsRequest) ProtoMessage()    {}
 func (*ListTournamentRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{51}
+	return fileDescriptor_1b40cafcd4234784, []int{66}
 }
 
 func (m *ListTournamentRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3275,7 +4170,7 @@ func (m *ListTournamentsRequest) Reset()         { *m = ListTournamentsRequest{}
 func (m *ListTournamentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTournamentsRequest) ProtoMessage()    {}
 func (*ListTournamentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{52}
+	return fileDescriptor_1b40cafcd4234784, []int{67}
 }
 
 func (m *ListTournamentsRequest) XXX_Unmarshal(b []byte) error {
@@ -3351,7 +4246,7 @@ func (m *ListUserGroupsRequest) Reset()         { *m = ListUserGroupsRequest{} }
 func (m *ListUserGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUserGroupsRequest) ProtoMessage()    {}
 func (*ListUserGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{53}
+	return fileDescriptor_1b40cafcd4234784, []int{68}
 }
 
 func (m *ListUserGroupsRequest) XXX_Unmarshal(b []byte) error {
@@ -3398,7 +4293,7 @@ func (m *Match) Reset()         { *m = Match{} }
 func (m *Match) String() string { return proto.CompactTextString(m) }
 func (*Match) ProtoMessage()    {}
 func (*Match) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{54}
+	return fileDescriptor_1b40cafcd4234784, []int{69}
 }
 
 func (m *Match) XXX_Unmarshal(b []byte) error {
@@ -3460,7 +4355,7 @@ func (m *MatchList) Reset()         { *m = MatchList{} }
 func (m *MatchList) String() string { return proto.CompactTextString(m) }
 func (*MatchList) ProtoMessage()    {}
 func (*MatchList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{55}
+	return fileDescriptor_1b40cafcd4234784, []int{70}
 }
 
 func (m *MatchList) XXX_Unmarshal(b []byte) error {
@@ -3513,7 +4408,7 @@ func (m *Notification) Reset()         { *m = Notification{} }
 func (m *Notification) String() string { return proto.CompactTextString(m) }
 func (*Notification) ProtoMessage()    {}
 func (*Notification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{56}
+	return fileDescriptor_1b40cafcd4234784, []int{71}
 }
 
 func (m *Notification) XXX_Unmarshal(b []byte) error {
@@ -3598,7 +4493,7 @@ func (m *NotificationList) Reset()         { *m = NotificationList{} }
 func (m *NotificationList) String() string { return proto.CompactTextString(m) }
 func (*NotificationList) ProtoMessage()    {}
 func (*NotificationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{57}
+	return fileDescriptor_1b40cafcd4234784, []int{72}
 }
 
 func (m *NotificationList) XXX_Unmarshal(b []byte) error {
@@ -3648,7 +4543,7 @@ func (m *PromoteGroupUsersRequest) Reset()         { *m = PromoteGroupUsersReque
 func (m *PromoteGroupUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteGroupUsersRequest) ProtoMessage()    {}
 func (*PromoteGroupUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{58}
+	return fileDescriptor_1b40cafcd4234784, []int{73}
 }
 
 func (m *PromoteGroupUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -3683,6 +4578,56 @@ func (m *PromoteGroupUsersRequest) GetUserIds() []string {
 	return nil
 }
 
+// A list of validated purchases stored by Nakama.
+type PurchaseList struct {
+	// Stored validated purchases.
+	ValidatedPurchases []*ValidatedPurchase `protobuf:"bytes,1,rep,name=validated_purchases,json=validatedPurchases,proto3" json:"validated_purchases,omitempty"`
+	// The cursor to send when retrieving the next page, if any.
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseList) Reset()         { *m = PurchaseList{} }
+func (m *PurchaseList) String() string { return proto.CompactTextString(m) }
+func (*PurchaseList) ProtoMessage()    {}
+func (*PurchaseList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{74}
+}
+
+func (m *PurchaseList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchaseList.Unmarshal(m, b)
+}
+func (m *PurchaseList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchaseList.Marshal(b, m, deterministic)
+}
+func (m *PurchaseList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseList.Merge(m, src)
+}
+func (m *PurchaseList) XXX_Size() int {
+	return xxx_messageInfo_PurchaseList.Size(m)
+}
+func (m *PurchaseList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseList proto.InternalMessageInfo
+
+func (m *PurchaseList) GetValidatedPurchases() []*ValidatedPurchase {
+	if m != nil {
+		return m.ValidatedPurchases
+	}
+	return nil
+}
+
+func (m *PurchaseList) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 // Storage objects to get.
 type ReadStorageObjectId struct {
 	// The collection which stores the object.
@@ -3700,7 +4645,7 @@ func (m *ReadStorageObjectId) Reset()         { *m = ReadStorageObjectId{} }
 func (m *ReadStorageObjectId) String() string { return proto.CompactTextString(m) }
 func (*ReadStorageObjectId) ProtoMessage()    {}
 func (*ReadStorageObjectId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{59}
+	return fileDescriptor_1b40cafcd4234784, []int{75}
 }
 
 func (m *ReadStorageObjectId) XXX_Unmarshal(b []byte) error {
@@ -3755,7 +4700,7 @@ func (m *ReadStorageObjectsRequest) Reset()         { *m = ReadStorageObjectsReq
 func (m *ReadStorageObjectsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadStorageObjectsRequest) ProtoMessage()    {}
 func (*ReadStorageObjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{60}
+	return fileDescriptor_1b40cafcd4234784, []int{76}
 }
 
 func (m *ReadStorageObjectsRequest) XXX_Unmarshal(b []byte) error {
@@ -3783,6 +4728,56 @@ func (m *ReadStorageObjectsRequest) GetObjectIds() []*ReadStorageObjectId {
 	return nil
 }
 
+// Reset the password of an account with a token sent by email.
+type ResetPasswordRequest struct {
+	// The password reset token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The new password for the account.
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{77}
+}
+
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(m, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
 // Execute an Lua function on the server.
 type Rpc struct {
 	// The identifier of the function.
@@ -3800,7 +4795,7 @@ func (m *Rpc) Reset()         { *m = Rpc{} }
 func (m *Rpc) String() string { return proto.CompactTextString(m) }
 func (*Rpc) ProtoMessage()    {}
 func (*Rpc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{61}
+	return fileDescriptor_1b40cafcd4234784, []int{78}
 }
 
 func (m *Rpc) XXX_Unmarshal(b []byte) error {
@@ -3828,16 +4823,57 @@ func (m *Rpc) GetId() string {
 	return ""
 }
 
-func (m *Rpc) GetPayload() string {
-	if m != nil {
-		return m.Payload
-	}
-	return ""
+func (m *Rpc) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *Rpc) GetHttpKey() string {
+	if m != nil {
+		return m.HttpKey
+	}
+	return ""
+}
+
+// Request a password reset email for an account.
+type SendPasswordResetRequest struct {
+	// The email address of the account.
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendPasswordResetRequest) Reset()         { *m = SendPasswordResetRequest{} }
+func (m *SendPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*SendPasswordResetRequest) ProtoMessage()    {}
+func (*SendPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{79}
 }
 
-func (m *Rpc) GetHttpKey() string {
+func (m *SendPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *SendPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (m *SendPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendPasswordResetRequest.Merge(m, src)
+}
+func (m *SendPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_SendPasswordResetRequest.Size(m)
+}
+func (m *SendPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendPasswordResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendPasswordResetRequest proto.InternalMessageInfo
+
+func (m *SendPasswordResetRequest) GetEmail() string {
 	if m != nil {
-		return m.HttpKey
+		return m.Email
 	}
 	return ""
 }
@@ -3849,7 +4885,9 @@ type Session struct {
 	// Authentication credentials.
 	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	// rUDP specific authentication credentials.
-	UdpToken             string   `protobuf:"bytes,3,opt,name=udp_token,json=udpToken,proto3" json:"udp_token,omitempty"`
+	UdpToken string `protobuf:"bytes,3,opt,name=udp_token,json=udpToken,proto3" json:"udp_token,omitempty"`
+	// Refresh token that can be used to obtain a new session once this one expires.
+	RefreshToken         string   `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3859,7 +4897,7 @@ func (m *Session) Reset()         { *m = Session{} }
 func (m *Session) String() string { return proto.CompactTextString(m) }
 func (*Session) ProtoMessage()    {}
 func (*Session) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{62}
+	return fileDescriptor_1b40cafcd4234784, []int{80}
 }
 
 func (m *Session) XXX_Unmarshal(b []byte) error {
@@ -3901,6 +4939,104 @@ func (m *Session) GetUdpToken() string {
 	return ""
 }
 
+func (m *Session) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+// Log out a session, invalidating its session and refresh tokens.
+type SessionLogoutRequest struct {
+	// Session token to log out. If neither token is set, all of the user's sessions are logged out.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Refresh token to invalidate.
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionLogoutRequest) Reset()         { *m = SessionLogoutRequest{} }
+func (m *SessionLogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionLogoutRequest) ProtoMessage()    {}
+func (*SessionLogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{81}
+}
+
+func (m *SessionLogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionLogoutRequest.Unmarshal(m, b)
+}
+func (m *SessionLogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionLogoutRequest.Marshal(b, m, deterministic)
+}
+func (m *SessionLogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionLogoutRequest.Merge(m, src)
+}
+func (m *SessionLogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_SessionLogoutRequest.Size(m)
+}
+func (m *SessionLogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionLogoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionLogoutRequest proto.InternalMessageInfo
+
+func (m *SessionLogoutRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *SessionLogoutRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+// Authenticate against the server with a refresh token.
+type SessionRefreshRequest struct {
+	// Refresh token.
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionRefreshRequest) Reset()         { *m = SessionRefreshRequest{} }
+func (m *SessionRefreshRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionRefreshRequest) ProtoMessage()    {}
+func (*SessionRefreshRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{82}
+}
+
+func (m *SessionRefreshRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionRefreshRequest.Unmarshal(m, b)
+}
+func (m *SessionRefreshRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionRefreshRequest.Marshal(b, m, deterministic)
+}
+func (m *SessionRefreshRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionRefreshRequest.Merge(m, src)
+}
+func (m *SessionRefreshRequest) XXX_Size() int {
+	return xxx_messageInfo_SessionRefreshRequest.Size(m)
+}
+func (m *SessionRefreshRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionRefreshRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionRefreshRequest proto.InternalMessageInfo
+
+func (m *SessionRefreshRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 // An object within the storage engine.
 type StorageObject struct {
 	// The collection which stores the object.
@@ -3930,7 +5066,7 @@ func (m *StorageObject) Reset()         { *m = StorageObject{} }
 func (m *StorageObject) String() string { return proto.CompactTextString(m) }
 func (*StorageObject) ProtoMessage()    {}
 func (*StorageObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{63}
+	return fileDescriptor_1b40cafcd4234784, []int{83}
 }
 
 func (m *StorageObject) XXX_Unmarshal(b []byte) error {
@@ -4033,7 +5169,7 @@ func (m *StorageObjectAck) Reset()         { *m = StorageObjectAck{} }
 func (m *StorageObjectAck) String() string { return proto.CompactTextString(m) }
 func (*StorageObjectAck) ProtoMessage()    {}
 func (*StorageObjectAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{64}
+	return fileDescriptor_1b40cafcd4234784, []int{84}
 }
 
 func (m *StorageObjectAck) XXX_Unmarshal(b []byte) error {
@@ -4095,7 +5231,7 @@ func (m *StorageObjectAcks) Reset()         { *m = StorageObjectAcks{} }
 func (m *StorageObjectAcks) String() string { return proto.CompactTextString(m) }
 func (*StorageObjectAcks) ProtoMessage()    {}
 func (*StorageObjectAcks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{65}
+	return fileDescriptor_1b40cafcd4234784, []int{85}
 }
 
 func (m *StorageObjectAcks) XXX_Unmarshal(b []byte) error {
@@ -4136,7 +5272,7 @@ func (m *StorageObjects) Reset()         { *m = StorageObjects{} }
 func (m *StorageObjects) String() string { return proto.CompactTextString(m) }
 func (*StorageObjects) ProtoMessage()    {}
 func (*StorageObjects) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{66}
+	return fileDescriptor_1b40cafcd4234784, []int{86}
 }
 
 func (m *StorageObjects) XXX_Unmarshal(b []byte) error {
@@ -4179,7 +5315,7 @@ func (m *StorageObjectList) Reset()         { *m = StorageObjectList{} }
 func (m *StorageObjectList) String() string { return proto.CompactTextString(m) }
 func (*StorageObjectList) ProtoMessage()    {}
 func (*StorageObjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{67}
+	return fileDescriptor_1b40cafcd4234784, []int{87}
 }
 
 func (m *StorageObjectList) XXX_Unmarshal(b []byte) error {
@@ -4257,7 +5393,7 @@ func (m *Tournament) Reset()         { *m = Tournament{} }
 func (m *Tournament) String() string { return proto.CompactTextString(m) }
 func (*Tournament) ProtoMessage()    {}
 func (*Tournament) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{68}
+	return fileDescriptor_1b40cafcd4234784, []int{88}
 }
 
 func (m *Tournament) XXX_Unmarshal(b []byte) error {
@@ -4405,7 +5541,7 @@ func (m *TournamentList) Reset()         { *m = TournamentList{} }
 func (m *TournamentList) String() string { return proto.CompactTextString(m) }
 func (*TournamentList) ProtoMessage()    {}
 func (*TournamentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{69}
+	return fileDescriptor_1b40cafcd4234784, []int{89}
 }
 
 func (m *TournamentList) XXX_Unmarshal(b []byte) error {
@@ -4459,7 +5595,7 @@ func (m *TournamentRecordList) Reset()         { *m = TournamentRecordList{} }
 func (m *TournamentRecordList) String() string { return proto.CompactTextString(m) }
 func (*TournamentRecordList) ProtoMessage()    {}
 func (*TournamentRecordList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{70}
+	return fileDescriptor_1b40cafcd4234784, []int{90}
 }
 
 func (m *TournamentRecordList) XXX_Unmarshal(b []byte) error {
@@ -4531,7 +5667,7 @@ func (m *UpdateAccountRequest) Reset()         { *m = UpdateAccountRequest{} }
 func (m *UpdateAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateAccountRequest) ProtoMessage()    {}
 func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{71}
+	return fileDescriptor_1b40cafcd4234784, []int{91}
 }
 
 func (m *UpdateAccountRequest) XXX_Unmarshal(b []byte) error {
@@ -4617,7 +5753,7 @@ func (m *UpdateGroupRequest) Reset()         { *m = UpdateGroupRequest{} }
 func (m *UpdateGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateGroupRequest) ProtoMessage()    {}
 func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{72}
+	return fileDescriptor_1b40cafcd4234784, []int{92}
 }
 
 func (m *UpdateGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -4713,17 +5849,19 @@ type User struct {
 	// The UNIX time when the user was created.
 	CreateTime *timestamp.Timestamp `protobuf:"bytes,15,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// The UNIX time when the user was last updated.
-	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,16,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	UpdateTime *timestamp.Timestamp `protobuf:"bytes,16,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The Apple Sign In ID in the user's account.
+	AppleId              string   `protobuf:"bytes,17,opt,name=apple_id,json=appleId,proto3" json:"apple_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{73}
+	return fileDescriptor_1b40cafcd4234784, []int{93}
 }
 
 func (m *User) XXX_Unmarshal(b []byte) error {
@@ -4856,6 +5994,13 @@ func (m *User) GetUpdateTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *User) GetAppleId() string {
+	if m != nil {
+		return m.AppleId
+	}
+	return ""
+}
+
 // A list of groups belonging to a user, along with the user's role in each group.
 type UserGroupList struct {
 	// Group-role pairs for a user.
@@ -4869,7 +6014,7 @@ func (m *UserGroupList) Reset()         { *m = UserGroupList{} }
 func (m *UserGroupList) String() string { return proto.CompactTextString(m) }
 func (*UserGroupList) ProtoMessage()    {}
 func (*UserGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{74}
+	return fileDescriptor_1b40cafcd4234784, []int{94}
 }
 
 func (m *UserGroupList) XXX_Unmarshal(b []byte) error {
@@ -4912,7 +6057,7 @@ func (m *UserGroupList_UserGroup) Reset()         { *m = UserGroupList_UserGroup
 func (m *UserGroupList_UserGroup) String() string { return proto.CompactTextString(m) }
 func (*UserGroupList_UserGroup) ProtoMessage()    {}
 func (*UserGroupList_UserGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{74, 0}
+	return fileDescriptor_1b40cafcd4234784, []int{94, 0}
 }
 
 func (m *UserGroupList_UserGroup) XXX_Unmarshal(b []byte) error {
@@ -4960,7 +6105,7 @@ func (m *Users) Reset()         { *m = Users{} }
 func (m *Users) String() string { return proto.CompactTextString(m) }
 func (*Users) ProtoMessage()    {}
 func (*Users) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{75}
+	return fileDescriptor_1b40cafcd4234784, []int{95}
 }
 
 func (m *Users) XXX_Unmarshal(b []byte) error {
@@ -4988,6 +6133,324 @@ func (m *Users) GetUsers() []*User {
 	return nil
 }
 
+// Apple IAP Purchases validation request.
+type ValidatePurchaseAppleRequest struct {
+	// Base64 encoded Apple receipt data payload.
+	Receipt              string   `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePurchaseAppleRequest) Reset()         { *m = ValidatePurchaseAppleRequest{} }
+func (m *ValidatePurchaseAppleRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatePurchaseAppleRequest) ProtoMessage()    {}
+func (*ValidatePurchaseAppleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{96}
+}
+
+func (m *ValidatePurchaseAppleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePurchaseAppleRequest.Unmarshal(m, b)
+}
+func (m *ValidatePurchaseAppleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePurchaseAppleRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatePurchaseAppleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePurchaseAppleRequest.Merge(m, src)
+}
+func (m *ValidatePurchaseAppleRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatePurchaseAppleRequest.Size(m)
+}
+func (m *ValidatePurchaseAppleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePurchaseAppleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePurchaseAppleRequest proto.InternalMessageInfo
+
+func (m *ValidatePurchaseAppleRequest) GetReceipt() string {
+	if m != nil {
+		return m.Receipt
+	}
+	return ""
+}
+
+// Google IAP Purchase validation request.
+type ValidatePurchaseGoogleRequest struct {
+	// JSON encoded Google purchase payload, with "packageName", "productId" and "purchaseToken" fields.
+	Purchase             string   `protobuf:"bytes,1,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePurchaseGoogleRequest) Reset()         { *m = ValidatePurchaseGoogleRequest{} }
+func (m *ValidatePurchaseGoogleRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatePurchaseGoogleRequest) ProtoMessage()    {}
+func (*ValidatePurchaseGoogleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{97}
+}
+
+func (m *ValidatePurchaseGoogleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePurchaseGoogleRequest.Unmarshal(m, b)
+}
+func (m *ValidatePurchaseGoogleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePurchaseGoogleRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatePurchaseGoogleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePurchaseGoogleRequest.Merge(m, src)
+}
+func (m *ValidatePurchaseGoogleRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatePurchaseGoogleRequest.Size(m)
+}
+func (m *ValidatePurchaseGoogleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePurchaseGoogleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePurchaseGoogleRequest proto.InternalMessageInfo
+
+func (m *ValidatePurchaseGoogleRequest) GetPurchase() string {
+	if m != nil {
+		return m.Purchase
+	}
+	return ""
+}
+
+// Huawei IAP Purchase validation request.
+type ValidatePurchaseHuaweiRequest struct {
+	// JSON encoded Huawei InAppPurchaseData.
+	Purchase string `protobuf:"bytes,1,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	// InAppPurchaseData signature.
+	Signature            string   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePurchaseHuaweiRequest) Reset()         { *m = ValidatePurchaseHuaweiRequest{} }
+func (m *ValidatePurchaseHuaweiRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatePurchaseHuaweiRequest) ProtoMessage()    {}
+func (*ValidatePurchaseHuaweiRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{98}
+}
+
+func (m *ValidatePurchaseHuaweiRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePurchaseHuaweiRequest.Unmarshal(m, b)
+}
+func (m *ValidatePurchaseHuaweiRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePurchaseHuaweiRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatePurchaseHuaweiRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePurchaseHuaweiRequest.Merge(m, src)
+}
+func (m *ValidatePurchaseHuaweiRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatePurchaseHuaweiRequest.Size(m)
+}
+func (m *ValidatePurchaseHuaweiRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePurchaseHuaweiRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePurchaseHuaweiRequest proto.InternalMessageInfo
+
+func (m *ValidatePurchaseHuaweiRequest) GetPurchase() string {
+	if m != nil {
+		return m.Purchase
+	}
+	return ""
+}
+
+func (m *ValidatePurchaseHuaweiRequest) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// Validate IAP response.
+type ValidatePurchaseResponse struct {
+	// Newly seen validated purchases.
+	ValidatedPurchases   []*ValidatedPurchase `protobuf:"bytes,1,rep,name=validated_purchases,json=validatedPurchases,proto3" json:"validated_purchases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ValidatePurchaseResponse) Reset()         { *m = ValidatePurchaseResponse{} }
+func (m *ValidatePurchaseResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatePurchaseResponse) ProtoMessage()    {}
+func (*ValidatePurchaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{99}
+}
+
+func (m *ValidatePurchaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePurchaseResponse.Unmarshal(m, b)
+}
+func (m *ValidatePurchaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePurchaseResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatePurchaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePurchaseResponse.Merge(m, src)
+}
+func (m *ValidatePurchaseResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatePurchaseResponse.Size(m)
+}
+func (m *ValidatePurchaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePurchaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePurchaseResponse proto.InternalMessageInfo
+
+func (m *ValidatePurchaseResponse) GetValidatedPurchases() []*ValidatedPurchase {
+	if m != nil {
+		return m.ValidatedPurchases
+	}
+	return nil
+}
+
+// Validated Purchase stored by Nakama.
+type ValidatedPurchase struct {
+	// Purchase Product ID.
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Purchase Transaction ID.
+	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Store identifier.
+	Store *wrappers.Int32Value `protobuf:"bytes,3,opt,name=store,proto3" json:"store,omitempty"`
+	// UNIX Timestamp when the purchase was done.
+	PurchaseTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=purchase_time,json=purchaseTime,proto3" json:"purchase_time,omitempty"`
+	// UNIX Timestamp when the receipt validation was stored in DB.
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// UNIX Timestamp when the receipt validation was updated in DB.
+	UpdateTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Raw provider validation response.
+	ProviderResponse string `protobuf:"bytes,7,opt,name=provider_response,json=providerResponse,proto3" json:"provider_response,omitempty"`
+	// Whether the purchase was done in production or sandbox environment.
+	Environment          *wrappers.Int32Value `protobuf:"bytes,8,opt,name=environment,proto3" json:"environment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ValidatedPurchase) Reset()         { *m = ValidatedPurchase{} }
+func (m *ValidatedPurchase) String() string { return proto.CompactTextString(m) }
+func (*ValidatedPurchase) ProtoMessage()    {}
+func (*ValidatedPurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{100}
+}
+
+func (m *ValidatedPurchase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatedPurchase.Unmarshal(m, b)
+}
+func (m *ValidatedPurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatedPurchase.Marshal(b, m, deterministic)
+}
+func (m *ValidatedPurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatedPurchase.Merge(m, src)
+}
+func (m *ValidatedPurchase) XXX_Size() int {
+	return xxx_messageInfo_ValidatedPurchase.Size(m)
+}
+func (m *ValidatedPurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatedPurchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatedPurchase proto.InternalMessageInfo
+
+func (m *ValidatedPurchase) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *ValidatedPurchase) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *ValidatedPurchase) GetStore() *wrappers.Int32Value {
+	if m != nil {
+		return m.Store
+	}
+	return nil
+}
+
+func (m *ValidatedPurchase) GetPurchaseTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.PurchaseTime
+	}
+	return nil
+}
+
+func (m *ValidatedPurchase) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *ValidatedPurchase) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+func (m *ValidatedPurchase) GetProviderResponse() string {
+	if m != nil {
+		return m.ProviderResponse
+	}
+	return ""
+}
+
+func (m *ValidatedPurchase) GetEnvironment() *wrappers.Int32Value {
+	if m != nil {
+		return m.Environment
+	}
+	return nil
+}
+
+// Verify the email address of an account with a token sent by email.
+type VerifyEmailRequest struct {
+	// The email verification token.
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyEmailRequest) Reset()         { *m = VerifyEmailRequest{} }
+func (m *VerifyEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailRequest) ProtoMessage()    {}
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b40cafcd4234784, []int{101}
+}
+
+func (m *VerifyEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailRequest.Unmarshal(m, b)
+}
+func (m *VerifyEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailRequest.Merge(m, src)
+}
+func (m *VerifyEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailRequest.Size(m)
+}
+func (m *VerifyEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyEmailRequest proto.InternalMessageInfo
+
+func (m *VerifyEmailRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 // A request to submit a score to a leaderboard.
 type WriteLeaderboardRecordRequest struct {
 	// The ID of the leaderboard to write to.
@@ -5003,7 +6466,7 @@ func (m *WriteLeaderboardRecordRequest) Reset()         { *m = WriteLeaderboardR
 func (m *WriteLeaderboardRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*WriteLeaderboardRecordRequest) ProtoMessage()    {}
 func (*WriteLeaderboardRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{76}
+	return fileDescriptor_1b40cafcd4234784, []int{102}
 }
 
 func (m *WriteLeaderboardRecordRequest) XXX_Unmarshal(b []byte) error {
@@ -5059,7 +6522,7 @@ func (m *WriteLeaderboardRecordRequest_LeaderboardRecordWrite) String() string {
 }
 func (*WriteLeaderboardRecordRequest_LeaderboardRecordWrite) ProtoMessage() {}
 func (*WriteLeaderboardRecordRequest_LeaderboardRecordWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{76, 0}
+	return fileDescriptor_1b40cafcd4234784, []int{102, 0}
 }
 
 func (m *WriteLeaderboardRecordRequest_LeaderboardRecordWrite) XXX_Unmarshal(b []byte) error {
@@ -5124,7 +6587,7 @@ func (m *WriteStorageObject) Reset()         { *m = WriteStorageObject{} }
 func (m *WriteStorageObject) String() string { return proto.CompactTextString(m) }
 func (*WriteStorageObject) ProtoMessage()    {}
 func (*WriteStorageObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{77}
+	return fileDescriptor_1b40cafcd4234784, []int{103}
 }
 
 func (m *WriteStorageObject) XXX_Unmarshal(b []byte) error {
@@ -5200,7 +6663,7 @@ func (m *WriteStorageObjectsRequest) Reset()         { *m = WriteStorageObjectsR
 func (m *WriteStorageObjectsRequest) String() string { return proto.CompactTextString(m) }
 func (*WriteStorageObjectsRequest) ProtoMessage()    {}
 func (*WriteStorageObjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{78}
+	return fileDescriptor_1b40cafcd4234784, []int{104}
 }
 
 func (m *WriteStorageObjectsRequest) XXX_Unmarshal(b []byte) error {
@@ -5243,7 +6706,7 @@ func (m *WriteTournamentRecordRequest) Reset()         { *m = WriteTournamentRec
 func (m *WriteTournamentRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*WriteTournamentRecordRequest) ProtoMessage()    {}
 func (*WriteTournamentRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{79}
+	return fileDescriptor_1b40cafcd4234784, []int{105}
 }
 
 func (m *WriteTournamentRecordRequest) XXX_Unmarshal(b []byte) error {
@@ -5299,7 +6762,7 @@ func (m *WriteTournamentRecordRequest_TournamentRecordWrite) String() string {
 }
 func (*WriteTournamentRecordRequest_TournamentRecordWrite) ProtoMessage() {}
 func (*WriteTournamentRecordRequest_TournamentRecordWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b40cafcd4234784, []int{79, 0}
+	return fileDescriptor_1b40cafcd4234784, []int{105, 0}
 }
 
 func (m *WriteTournamentRecordRequest_TournamentRecordWrite) XXX_Unmarshal(b []byte) error {
@@ -5345,27 +6808,37 @@ func init() {
 	proto.RegisterEnum("nakama.api.Friend_State", Friend_State_name, Friend_State_value)
 	proto.RegisterEnum("nakama.api.GroupUserList_GroupUser_State", GroupUserList_GroupUser_State_name, GroupUserList_GroupUser_State_value)
 	proto.RegisterEnum("nakama.api.UserGroupList_UserGroup_State", UserGroupList_UserGroup_State_name, UserGroupList_UserGroup_State_value)
+	proto.RegisterEnum("nakama.api.ValidatedPurchase_Store", ValidatedPurchase_Store_name, ValidatedPurchase_Store_value)
+	proto.RegisterEnum("nakama.api.ValidatedPurchase_Environment", ValidatedPurchase_Environment_name, ValidatedPurchase_Environment_value)
 	proto.RegisterType((*Account)(nil), "nakama.api.Account")
+	proto.RegisterType((*AccountApple)(nil), "nakama.api.AccountApple")
 	proto.RegisterType((*AccountCustom)(nil), "nakama.api.AccountCustom")
 	proto.RegisterType((*AccountDevice)(nil), "nakama.api.AccountDevice")
 	proto.RegisterType((*AccountEmail)(nil), "nakama.api.AccountEmail")
+	proto.RegisterType((*AccountErasure)(nil), "nakama.api.AccountErasure")
+	proto.RegisterType((*AccountExportArchive)(nil), "nakama.api.AccountExportArchive")
 	proto.RegisterType((*AccountFacebook)(nil), "nakama.api.AccountFacebook")
 	proto.RegisterType((*AccountGameCenter)(nil), "nakama.api.AccountGameCenter")
 	proto.RegisterType((*AccountGoogle)(nil), "nakama.api.AccountGoogle")
+	proto.RegisterType((*AccountIdentity)(nil), "nakama.api.AccountIdentity")
+	proto.RegisterType((*AccountOidc)(nil), "nakama.api.AccountOidc")
 	proto.RegisterType((*AccountSteam)(nil), "nakama.api.AccountSteam")
 	proto.RegisterType((*AddFriendsRequest)(nil), "nakama.api.AddFriendsRequest")
 	proto.RegisterType((*AddGroupUsersRequest)(nil), "nakama.api.AddGroupUsersRequest")
+	proto.RegisterType((*AuthenticateAppleRequest)(nil), "nakama.api.AuthenticateAppleRequest")
 	proto.RegisterType((*AuthenticateCustomRequest)(nil), "nakama.api.AuthenticateCustomRequest")
 	proto.RegisterType((*AuthenticateDeviceRequest)(nil), "nakama.api.AuthenticateDeviceRequest")
 	proto.RegisterType((*AuthenticateEmailRequest)(nil), "nakama.api.AuthenticateEmailRequest")
 	proto.RegisterType((*AuthenticateFacebookRequest)(nil), "nakama.api.AuthenticateFacebookRequest")
 	proto.RegisterType((*AuthenticateGameCenterRequest)(nil), "nakama.api.AuthenticateGameCenterRequest")
 	proto.RegisterType((*AuthenticateGoogleRequest)(nil), "nakama.api.AuthenticateGoogleRequest")
+	proto.RegisterType((*AuthenticateOidcRequest)(nil), "nakama.api.AuthenticateOidcRequest")
 	proto.RegisterType((*AuthenticateSteamRequest)(nil), "nakama.api.AuthenticateSteamRequest")
 	proto.RegisterType((*BlockFriendsRequest)(nil), "nakama.api.BlockFriendsRequest")
 	proto.RegisterType((*ChannelMessage)(nil), "nakama.api.ChannelMessage")
 	proto.RegisterType((*ChannelMessageList)(nil), "nakama.api.ChannelMessageList")
 	proto.RegisterType((*CreateGroupRequest)(nil), "nakama.api.CreateGroupRequest")
+	proto.RegisterType((*DeclineGroupJoinRequestsRequest)(nil), "nakama.api.DeclineGroupJoinRequestsRequest")
 	proto.RegisterType((*DeleteFriendsRequest)(nil), "nakama.api.DeleteFriendsRequest")
 	proto.RegisterType((*DeleteGroupRequest)(nil), "nakama.api.DeleteGroupRequest")
 	proto.RegisterType((*DeleteLeaderboardRecordRequest)(nil), "nakama.api.DeleteLeaderboardRecordRequest")
@@ -5376,6 +6849,8 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "nakama.api.Event.PropertiesEntry")
 	proto.RegisterType((*Friend)(nil), "nakama.api.Friend")
 	proto.RegisterType((*Friends)(nil), "nakama.api.Friends")
+	proto.RegisterType((*FriendSuggestion)(nil), "nakama.api.FriendSuggestion")
+	proto.RegisterType((*FriendSuggestionList)(nil), "nakama.api.FriendSuggestionList")
 	proto.RegisterType((*GetUsersRequest)(nil), "nakama.api.GetUsersRequest")
 	proto.RegisterType((*Group)(nil), "nakama.api.Group")
 	proto.RegisterType((*GroupList)(nil), "nakama.api.GroupList")
@@ -5390,12 +6865,17 @@ func init() {
 	proto.RegisterType((*LeaveGroupRequest)(nil), "nakama.api.LeaveGroupRequest")
 	proto.RegisterType((*LinkFacebookRequest)(nil), "nakama.api.LinkFacebookRequest")
 	proto.RegisterType((*ListChannelMessagesRequest)(nil), "nakama.api.ListChannelMessagesRequest")
+	proto.RegisterType((*ListFriendSuggestionsRequest)(nil), "nakama.api.ListFriendSuggestionsRequest")
+	proto.RegisterType((*ListFriendsRequest)(nil), "nakama.api.ListFriendsRequest")
 	proto.RegisterType((*ListGroupsRequest)(nil), "nakama.api.ListGroupsRequest")
+	proto.RegisterType((*ListGroupJoinRequestsRequest)(nil), "nakama.api.ListGroupJoinRequestsRequest")
 	proto.RegisterType((*ListGroupUsersRequest)(nil), "nakama.api.ListGroupUsersRequest")
 	proto.RegisterType((*ListLeaderboardRecordsAroundOwnerRequest)(nil), "nakama.api.ListLeaderboardRecordsAroundOwnerRequest")
 	proto.RegisterType((*ListLeaderboardRecordsRequest)(nil), "nakama.api.ListLeaderboardRecordsRequest")
 	proto.RegisterType((*ListMatchesRequest)(nil), "nakama.api.ListMatchesRequest")
+	proto.RegisterType((*ListMutualFriendsRequest)(nil), "nakama.api.ListMutualFriendsRequest")
 	proto.RegisterType((*ListNotificationsRequest)(nil), "nakama.api.ListNotificationsRequest")
+	proto.RegisterType((*ListPurchasesRequest)(nil), "nakama.api.ListPurchasesRequest")
 	proto.RegisterType((*ListStorageObjectsRequest)(nil), "nakama.api.ListStorageObjectsRequest")
 	proto.RegisterType((*ListTournamentRecordsAroundOwnerRequest)(nil), "nakama.api.ListTournamentRecordsAroundOwnerRequest")
 	proto.RegisterType((*ListTournamentRecordsRequest)(nil), "nakama.api.ListTournamentRecordsRequest")
@@ -5406,10 +6886,15 @@ func init() {
 	proto.RegisterType((*Notification)(nil), "nakama.api.Notification")
 	proto.RegisterType((*NotificationList)(nil), "nakama.api.NotificationList")
 	proto.RegisterType((*PromoteGroupUsersRequest)(nil), "nakama.api.PromoteGroupUsersRequest")
+	proto.RegisterType((*PurchaseList)(nil), "nakama.api.PurchaseList")
 	proto.RegisterType((*ReadStorageObjectId)(nil), "nakama.api.ReadStorageObjectId")
 	proto.RegisterType((*ReadStorageObjectsRequest)(nil), "nakama.api.ReadStorageObjectsRequest")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "nakama.api.ResetPasswordRequest")
 	proto.RegisterType((*Rpc)(nil), "nakama.api.Rpc")
+	proto.RegisterType((*SendPasswordResetRequest)(nil), "nakama.api.SendPasswordResetRequest")
 	proto.RegisterType((*Session)(nil), "nakama.api.Session")
+	proto.RegisterType((*SessionLogoutRequest)(nil), "nakama.api.SessionLogoutRequest")
+	proto.RegisterType((*SessionRefreshRequest)(nil), "nakama.api.SessionRefreshRequest")
 	proto.RegisterType((*StorageObject)(nil), "nakama.api.StorageObject")
 	proto.RegisterType((*StorageObjectAck)(nil), "nakama.api.StorageObjectAck")
 	proto.RegisterType((*StorageObjectAcks)(nil), "nakama.api.StorageObjectAcks")
@@ -5424,6 +6909,12 @@ func init() {
 	proto.RegisterType((*UserGroupList)(nil), "nakama.api.UserGroupList")
 	proto.RegisterType((*UserGroupList_UserGroup)(nil), "nakama.api.UserGroupList.UserGroup")
 	proto.RegisterType((*Users)(nil), "nakama.api.Users")
+	proto.RegisterType((*ValidatePurchaseAppleRequest)(nil), "nakama.api.ValidatePurchaseAppleRequest")
+	proto.RegisterType((*ValidatePurchaseGoogleRequest)(nil), "nakama.api.ValidatePurchaseGoogleRequest")
+	proto.RegisterType((*ValidatePurchaseHuaweiRequest)(nil), "nakama.api.ValidatePurchaseHuaweiRequest")
+	proto.RegisterType((*ValidatePurchaseResponse)(nil), "nakama.api.ValidatePurchaseResponse")
+	proto.RegisterType((*ValidatedPurchase)(nil), "nakama.api.ValidatedPurchase")
+	proto.RegisterType((*VerifyEmailRequest)(nil), "nakama.api.VerifyEmailRequest")
 	proto.RegisterType((*WriteLeaderboardRecordRequest)(nil), "nakama.api.WriteLeaderboardRecordRequest")
 	proto.RegisterType((*WriteLeaderboardRecordRequest_LeaderboardRecordWrite)(nil), "nakama.api.WriteLeaderboardRecordRequest.LeaderboardRecordWrite")
 	proto.RegisterType((*WriteStorageObject)(nil), "nakama.api.WriteStorageObject")
@@ -5435,214 +6926,266 @@ func init() {
 func init() { proto.RegisterFile("api/api.proto", fileDescriptor_1b40cafcd4234784) }
 
 var fileDescriptor_1b40cafcd4234784 = []byte{
-	// 3343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x5b, 0x8f, 0x1c, 0x47,
-	0xd5, 0xe9, 0xb9, 0xcf, 0x99, 0x9d, 0xdd, 0x71, 0xf9, 0xf2, 0x8d, 0xc7, 0xd7, 0xb4, 0x9d, 0xcf,
-	0x8e, 0xf2, 0x7d, 0xe3, 0x64, 0x4d, 0xb0, 0x49, 0x88, 0xe3, 0xbd, 0x8c, 0xad, 0x89, 0xed, 0xb5,
-	0xe9, 0xb5, 0x0d, 0x82, 0x87, 0x49, 0x6d, 0x77, 0x79, 0xb6, 0xd9, 0x9e, 0xee, 0x4e, 0x77, 0xcf,
-	0x5e, 0x22, 0x78, 0x00, 0x21, 0x91, 0x27, 0x84, 0x78, 0xe2, 0x05, 0x88, 0x10, 0x42, 0x09, 0x48,
-	0xfc, 0x02, 0xde, 0x79, 0x47, 0xdc, 0xde, 0x80, 0x47, 0x24, 0xfe, 0x01, 0x12, 0x42, 0x75, 0xaa,
-	0xfa, 0x3a, 0x33, 0xbb, 0x33, 0xde, 0x75, 0x22, 0xf1, 0x32, 0xea, 0x3a, 0x75, 0x4e, 0xd5, 0xa9,
-	0x53, 0xe7, 0x5e, 0x03, 0x75, 0xea, 0x9a, 0xd7, 0xa8, 0x6b, 0xb6, 0x5d, 0xcf, 0x09, 0x1c, 0x02,
-	0x36, 0xdd, 0xa2, 0x03, 0xda, 0xa6, 0xae, 0xd9, 0xba, 0xd0, 0x77, 0x9c, 0xbe, 0xc5, 0xae, 0xe1,
-	0xcc, 0xc6, 0xf0, 0xd9, 0xb5, 0xc0, 0x1c, 0x30, 0x3f, 0xa0, 0x03, 0x57, 0x20, 0xb7, 0xce, 0x67,
-	0x11, 0x76, 0x3c, 0xea, 0xba, 0xcc, 0xf3, 0xc5, 0xbc, 0xfa, 0x4f, 0x05, 0xca, 0x4b, 0xba, 0xee,
-	0x0c, 0xed, 0x80, 0x5c, 0x86, 0xc2, 0xd0, 0x67, 0x5e, 0x53, 0xb9, 0xa8, 0x5c, 0xad, 0x2d, 0x36,
-	0xda, 0xf1, 0x3e, 0xed, 0x27, 0x3e, 0xf3, 0x34, 0x9c, 0x25, 0xa7, 0xa0, 0xb4, 0x43, 0x2d, 0x8b,
-	0x05, 0xcd, 0xdc, 0x45, 0xe5, 0x6a, 0x55, 0x93, 0x23, 0x72, 0x02, 0x8a, 0x6c, 0x40, 0x4d, 0xab,
-	0x99, 0x47, 0xb0, 0x18, 0x90, 0xeb, 0x50, 0x36, 0xd8, 0xb6, 0xa9, 0x33, 0xbf, 0x59, 0xb8, 0x98,
-	0xbf, 0x5a, 0x5b, 0x3c, 0x9d, 0x5c, 0x56, 0xee, 0xbc, 0x8a, 0x18, 0x5a, 0x88, 0x49, 0xce, 0x40,
-	0x55, 0x1f, 0xfa, 0x81, 0x33, 0xe8, 0x99, 0x46, 0xb3, 0x88, 0xcb, 0x55, 0x04, 0xa0, 0x6b, 0x90,
-	0xb7, 0xa1, 0xb6, 0xcd, 0x3c, 0xf3, 0xd9, 0x5e, 0x8f, 0x9f, 0xb5, 0x59, 0x42, 0x66, 0x5b, 0x6d,
-	0x71, 0xce, 0x76, 0x78, 0xce, 0xf6, 0xe3, 0x50, 0x10, 0x1a, 0x08, 0x74, 0x0e, 0x50, 0x2f, 0x40,
-	0x5d, 0xee, 0xb9, 0x82, 0xeb, 0x91, 0x79, 0xc8, 0x99, 0x06, 0x9e, 0xb8, 0xaa, 0xe5, 0x4c, 0x23,
-	0x81, 0x20, 0x98, 0x1a, 0x41, 0xb8, 0x0d, 0x73, 0x12, 0xa1, 0x83, 0x07, 0x8c, 0x8e, 0xad, 0x24,
-	0x8f, 0xdd, 0x82, 0x8a, 0x4b, 0x7d, 0x7f, 0xc7, 0xf1, 0x0c, 0x29, 0xa6, 0x68, 0xac, 0x5e, 0x81,
-	0x05, 0xb9, 0xc2, 0x1d, 0xaa, 0xb3, 0x0d, 0xc7, 0xd9, 0xe2, 0x8b, 0x04, 0xce, 0x16, 0xb3, 0xc3,
-	0x45, 0x70, 0xa0, 0xfe, 0x5e, 0x81, 0x63, 0x12, 0xf3, 0x2e, 0x1d, 0xb0, 0x15, 0x66, 0x07, 0xcc,
-	0xe3, 0xc2, 0x71, 0x2d, 0xba, 0xc7, 0xbc, 0x5e, 0xc4, 0x57, 0x45, 0x00, 0xba, 0x06, 0x9f, 0xdc,
-	0x18, 0xda, 0x86, 0xc5, 0xf8, 0xa4, 0xdc, 0x58, 0x00, 0xba, 0x06, 0x79, 0x0d, 0x8e, 0x45, 0xea,
-	0xd1, 0xf3, 0x99, 0xee, 0xd8, 0x86, 0x8f, 0xb7, 0x95, 0xd7, 0x1a, 0xd1, 0xc4, 0xba, 0x80, 0x13,
-	0x02, 0x05, 0x9f, 0x5a, 0x41, 0xb3, 0x80, 0x8b, 0xe0, 0x37, 0x39, 0x0b, 0x55, 0xdf, 0xec, 0xdb,
-	0x34, 0x18, 0x7a, 0x4c, 0xde, 0x4b, 0x0c, 0x20, 0x97, 0x61, 0xde, 0x1d, 0x6e, 0x58, 0xa6, 0xde,
-	0xdb, 0x62, 0x7b, 0xbd, 0xa1, 0x67, 0xe1, 0xdd, 0x54, 0xb5, 0x39, 0x01, 0xbd, 0xc7, 0xf6, 0x9e,
-	0x78, 0x96, 0xfa, 0x4a, 0x24, 0xe0, 0xbb, 0x78, 0x63, 0x13, 0xce, 0x7e, 0x39, 0x12, 0xf3, 0x7a,
-	0xc0, 0xe8, 0x60, 0x02, 0xd6, 0x0a, 0x1c, 0x5b, 0x32, 0x8c, 0x3b, 0x9e, 0xc9, 0x6c, 0xc3, 0xd7,
-	0xd8, 0x07, 0x43, 0xe6, 0x07, 0xa4, 0x01, 0x79, 0xd3, 0xf0, 0x9b, 0xca, 0xc5, 0xfc, 0xd5, 0xaa,
-	0xc6, 0x3f, 0x39, 0xdf, 0x5c, 0x75, 0x6d, 0x3a, 0x60, 0x7e, 0x33, 0x87, 0xf0, 0x18, 0xa0, 0xde,
-	0x87, 0x13, 0x4b, 0x86, 0x71, 0xd7, 0x73, 0x86, 0x2e, 0x57, 0xf3, 0x68, 0x9d, 0xd3, 0x50, 0xe9,
-	0x73, 0x60, 0x2c, 0xe7, 0x32, 0x8e, 0xbb, 0x06, 0x9f, 0xe2, 0xf4, 0x3d, 0xbe, 0x8f, 0x58, 0xaf,
-	0xcc, 0xc7, 0x5d, 0xc3, 0x57, 0x3f, 0x56, 0xe0, 0xf4, 0xd2, 0x30, 0xd8, 0x64, 0x76, 0x60, 0xea,
-	0x34, 0x60, 0x42, 0xcf, 0xc2, 0x35, 0xaf, 0x43, 0x99, 0x8a, 0x63, 0x49, 0x2b, 0x1b, 0x67, 0x0e,
-	0x92, 0x24, 0xc4, 0x24, 0x8b, 0x50, 0xd2, 0x3d, 0x46, 0x03, 0x86, 0x37, 0x3a, 0x4e, 0xd9, 0x97,
-	0x1d, 0xc7, 0x7a, 0x4a, 0xad, 0x21, 0xd3, 0x24, 0x26, 0x57, 0xc0, 0xf0, 0x84, 0xd2, 0x20, 0xa3,
-	0xf1, 0x08, 0x8b, 0xd2, 0xfc, 0x66, 0x61, 0x31, 0xb4, 0xd8, 0x17, 0xc5, 0xe2, 0x4f, 0x15, 0x68,
-	0x26, 0x59, 0x44, 0x5b, 0x0b, 0x39, 0x5c, 0xcc, 0x72, 0xd8, 0x1c, 0xc3, 0xa1, 0xa0, 0x78, 0x61,
-	0x0c, 0xfe, 0x41, 0x81, 0x33, 0x49, 0x06, 0x43, 0x53, 0x0e, 0x79, 0x7c, 0x33, 0xcb, 0xe3, 0x99,
-	0x31, 0x3c, 0x46, 0x44, 0x2f, 0x8a, 0x4d, 0xd2, 0x86, 0x82, 0xbf, 0x67, 0xeb, 0x68, 0xc5, 0xfb,
-	0xaf, 0x86, 0x78, 0xea, 0x27, 0x0a, 0x9c, 0x4b, 0x1e, 0x2b, 0xf6, 0x3b, 0xe1, 0xc1, 0x6e, 0x64,
-	0x0f, 0x76, 0x6e, 0xcc, 0xc1, 0x12, 0x64, 0x9f, 0x99, 0x16, 0x0b, 0x77, 0x32, 0x93, 0x16, 0x4b,
-	0x92, 0xcf, 0x4c, 0x8b, 0xd1, 0x95, 0xcd, 0xa4, 0xc5, 0x82, 0xe2, 0x85, 0x31, 0xd8, 0x81, 0xe3,
-	0xcb, 0x96, 0xa3, 0x6f, 0x1d, 0xd2, 0x83, 0x7e, 0x94, 0x87, 0xf9, 0x95, 0x4d, 0x6a, 0xdb, 0xcc,
-	0x7a, 0xc0, 0x7c, 0x9f, 0xf6, 0x19, 0x39, 0x07, 0xa0, 0x0b, 0x48, 0xec, 0x3e, 0xab, 0x12, 0xd2,
-	0x35, 0xf8, 0xf4, 0x40, 0x60, 0xc6, 0x81, 0xaa, 0x2a, 0x21, 0x5d, 0x83, 0x5c, 0x83, 0x82, 0xee,
-	0x18, 0x82, 0x5f, 0x6e, 0x3a, 0xd9, 0x53, 0x76, 0xed, 0xe0, 0xfa, 0xa2, 0xd4, 0x5b, 0x8e, 0xc8,
-	0xe3, 0x9e, 0xcf, 0x6c, 0x43, 0x04, 0x45, 0x11, 0xb2, 0x2a, 0x02, 0xd0, 0x35, 0x52, 0x12, 0x28,
-	0x66, 0x0c, 0xa4, 0x09, 0x65, 0xdd, 0xb1, 0x03, 0x66, 0x07, 0x32, 0x5a, 0x85, 0x43, 0x9e, 0x67,
-	0x08, 0x09, 0x8a, 0x3c, 0xa3, 0x7c, 0x70, 0x9e, 0x21, 0xd0, 0x39, 0x80, 0x13, 0x0f, 0x5d, 0x23,
-	0x22, 0xae, 0x1c, 0x4c, 0x2c, 0xd0, 0x91, 0xf8, 0x2d, 0x00, 0x9e, 0xa1, 0x99, 0x3e, 0xb2, 0x55,
-	0x3d, 0xf0, 0xa6, 0x13, 0xd8, 0xea, 0x0f, 0x14, 0x20, 0xe9, 0xab, 0xb8, 0x6f, 0xfa, 0x01, 0xf9,
-	0x22, 0x54, 0xa4, 0x74, 0xc5, 0xb5, 0xf2, 0x05, 0x13, 0xda, 0x96, 0xa6, 0xd0, 0x22, 0x5c, 0x72,
-	0x01, 0x6a, 0x36, 0xdb, 0x0d, 0x7a, 0xfa, 0xd0, 0xf3, 0x1d, 0x4f, 0x5e, 0x14, 0x70, 0xd0, 0x0a,
-	0x42, 0x38, 0x82, 0xeb, 0xb1, 0xed, 0x10, 0x41, 0x28, 0x18, 0x70, 0x90, 0x40, 0x50, 0x7f, 0xcc,
-	0x19, 0x42, 0xc1, 0x60, 0x84, 0x0d, 0x55, 0x8c, 0x40, 0x01, 0xef, 0x43, 0x68, 0x06, 0x7e, 0x93,
-	0x8b, 0x50, 0x33, 0x98, 0xaf, 0x7b, 0xa6, 0x1b, 0x98, 0x8e, 0x2d, 0x37, 0x4b, 0x82, 0x78, 0xdc,
-	0xb5, 0xa8, 0xdd, 0xef, 0x05, 0xb4, 0x2f, 0xb7, 0x2a, 0xf3, 0xf1, 0x63, 0xda, 0xe7, 0x1a, 0x45,
-	0xb7, 0x69, 0x40, 0x3d, 0xcc, 0x3c, 0x84, 0x0a, 0x54, 0x05, 0xe4, 0x89, 0x67, 0xf1, 0xfd, 0x1c,
-	0x97, 0xd9, 0x78, 0xff, 0x15, 0x0d, 0xbf, 0xd5, 0x3b, 0x70, 0x62, 0x95, 0x59, 0x2c, 0x60, 0x87,
-	0x54, 0xff, 0x6b, 0x40, 0xc4, 0x3a, 0xa9, 0x13, 0x4e, 0x4e, 0x1f, 0xd4, 0xbb, 0x70, 0x5e, 0x10,
-	0xdc, 0x67, 0xd4, 0x60, 0xde, 0x86, 0x43, 0x3d, 0x43, 0x63, 0xba, 0xc3, 0x7f, 0x05, 0xf1, 0x2b,
-	0x30, 0x6f, 0xc5, 0x73, 0xf1, 0x12, 0xf5, 0x04, 0xb4, 0x6b, 0xa8, 0x6d, 0x68, 0x89, 0x85, 0xd6,
-	0x9c, 0xc0, 0x7c, 0xc6, 0x7d, 0x8c, 0xe9, 0xd8, 0x93, 0xcf, 0xa1, 0xea, 0x70, 0x52, 0xe0, 0xaf,
-	0x07, 0x8e, 0x47, 0xfb, 0xec, 0xe1, 0xc6, 0x37, 0x99, 0x1e, 0x74, 0x0d, 0x72, 0x1e, 0x40, 0x77,
-	0x2c, 0x8b, 0xe9, 0x28, 0x79, 0xb1, 0x57, 0x02, 0xc2, 0x97, 0xda, 0x62, 0x7b, 0xf2, 0x4a, 0xf8,
-	0x27, 0x37, 0x9c, 0x6d, 0xae, 0x76, 0x8e, 0x1d, 0xde, 0x84, 0x1c, 0xaa, 0x3d, 0x38, 0x33, 0x66,
-	0x93, 0x88, 0xab, 0xdb, 0x00, 0x0e, 0x42, 0x7a, 0x21, 0x73, 0xb5, 0xc5, 0x97, 0x93, 0xca, 0x38,
-	0x96, 0x43, 0xad, 0xea, 0xc8, 0x2f, 0x5f, 0xfd, 0xb3, 0x02, 0xc5, 0xce, 0x36, 0xb7, 0xd1, 0x71,
-	0x5a, 0xb4, 0x04, 0xe0, 0x7a, 0x8e, 0xcb, 0xbc, 0xc0, 0x94, 0x97, 0x95, 0x59, 0x1f, 0x49, 0xdb,
-	0x8f, 0x22, 0x9c, 0x8e, 0x1d, 0x78, 0x7b, 0x5a, 0x82, 0x88, 0xdc, 0x84, 0x6a, 0x94, 0x0f, 0x4b,
-	0x1f, 0xb4, 0x9f, 0xed, 0xc6, 0xc8, 0xad, 0x77, 0x60, 0x21, 0xb3, 0x70, 0x28, 0x3a, 0x25, 0x16,
-	0xdd, 0x09, 0x28, 0x6e, 0x73, 0xc3, 0x95, 0xe2, 0x14, 0x83, 0xb7, 0x72, 0x37, 0x15, 0xf5, 0x53,
-	0x05, 0x4a, 0x42, 0x19, 0xa7, 0x2c, 0xc6, 0xde, 0x80, 0xa2, 0x1f, 0xc4, 0xf1, 0x60, 0x5f, 0x4f,
-	0x29, 0x30, 0xd5, 0x3b, 0x50, 0x5c, 0xe7, 0x1f, 0x04, 0xa0, 0x74, 0x47, 0xeb, 0x76, 0xd6, 0x56,
-	0x1b, 0x2f, 0x91, 0x05, 0xa8, 0x75, 0xd7, 0x9e, 0x76, 0x1f, 0x77, 0x7a, 0xeb, 0x9d, 0xb5, 0xc7,
-	0x0d, 0x85, 0x1c, 0x87, 0x05, 0x09, 0xd0, 0x3a, 0x2b, 0x9d, 0xee, 0xd3, 0xce, 0x6a, 0x23, 0x47,
-	0x6a, 0x50, 0x5e, 0xbe, 0xff, 0x70, 0xe5, 0x5e, 0x67, 0xb5, 0x91, 0x57, 0x6f, 0x40, 0x59, 0xda,
-	0x0d, 0xf9, 0x3f, 0x28, 0x3f, 0x13, 0x9f, 0xf2, 0x3e, 0x49, 0x92, 0x5d, 0x81, 0xa5, 0x85, 0x28,
-	0xaa, 0x01, 0x0b, 0x77, 0x59, 0x90, 0x4a, 0xb5, 0x67, 0xb4, 0x38, 0xf2, 0x32, 0xcc, 0x3d, 0x93,
-	0xb9, 0x13, 0x6a, 0x51, 0x1e, 0x11, 0x6a, 0x21, 0x8c, 0x2b, 0xc9, 0x27, 0x79, 0x28, 0xa2, 0x3d,
-	0x66, 0x2b, 0x38, 0x0c, 0x4d, 0xdc, 0x21, 0x39, 0x5e, 0x22, 0xf6, 0x48, 0x48, 0xd7, 0x88, 0x74,
-	0x2a, 0x3f, 0xd9, 0x33, 0x15, 0xf6, 0xf7, 0x4c, 0xc5, 0xb4, 0x67, 0x6a, 0x71, 0xdf, 0x1b, 0x50,
-	0x83, 0x06, 0x54, 0xc6, 0x98, 0x68, 0x9c, 0xf1, 0x5a, 0xe5, 0xac, 0xd7, 0x6a, 0x4b, 0xaf, 0x55,
-	0x39, 0x38, 0x7d, 0xe3, 0x78, 0x7c, 0x39, 0x66, 0xf4, 0x59, 0x4f, 0xa4, 0x15, 0x3c, 0x72, 0x14,
-	0xb5, 0x2a, 0x87, 0xac, 0x60, 0xfa, 0x70, 0x06, 0xaa, 0x03, 0xba, 0x2b, 0x67, 0x01, 0x67, 0x2b,
-	0x03, 0xba, 0x2b, 0x26, 0x33, 0xf1, 0xae, 0x76, 0x98, 0x78, 0x37, 0x37, 0x4b, 0xbc, 0x53, 0xd7,
-	0xa0, 0x8a, 0x37, 0x85, 0x91, 0xea, 0x55, 0x28, 0xa1, 0x9b, 0x0c, 0x55, 0xe9, 0x58, 0x52, 0x95,
-	0x84, 0x83, 0x95, 0x08, 0xe4, 0x14, 0x94, 0x52, 0x71, 0x49, 0x8e, 0xd4, 0x7f, 0x2b, 0x50, 0x8f,
-	0xca, 0x39, 0x5c, 0x74, 0x15, 0x6a, 0xc2, 0x17, 0x73, 0x15, 0x0a, 0x57, 0xbe, 0x34, 0xb2, 0x72,
-	0x88, 0x1f, 0x8f, 0x34, 0xe8, 0x47, 0x75, 0x61, 0xeb, 0x97, 0x8a, 0x64, 0x94, 0x0f, 0x5f, 0x9c,
-	0x81, 0xde, 0x0e, 0x0d, 0x74, 0x1e, 0x60, 0xfd, 0xc9, 0xa3, 0x8e, 0xb6, 0xb4, 0xfa, 0xa0, 0xbb,
-	0xd6, 0x78, 0x89, 0x54, 0xa1, 0x28, 0x3e, 0x15, 0x6e, 0xbb, 0x0f, 0x3a, 0x0f, 0x96, 0x3b, 0x5a,
-	0x23, 0x47, 0x1a, 0x30, 0xf7, 0xde, 0xc3, 0xee, 0x5a, 0x4f, 0xeb, 0x7c, 0xe5, 0x49, 0x67, 0xfd,
-	0x71, 0x23, 0xaf, 0x7e, 0x5f, 0x81, 0xb3, 0xdd, 0x81, 0xeb, 0x78, 0x51, 0x85, 0x91, 0x89, 0x70,
-	0xcf, 0x59, 0x9d, 0xbc, 0x0e, 0x45, 0x8f, 0xf9, 0xb2, 0xf3, 0xb3, 0xbf, 0x3e, 0x0a, 0x44, 0xf5,
-	0xff, 0xa1, 0xf1, 0x9e, 0x63, 0xda, 0xd3, 0x06, 0xc6, 0x2f, 0xc3, 0x49, 0x8e, 0xfe, 0xd8, 0x19,
-	0xa2, 0xa1, 0xdb, 0x41, 0x48, 0x73, 0x09, 0xea, 0x41, 0x04, 0x8c, 0x09, 0xe7, 0x62, 0x60, 0xd7,
-	0x50, 0x1f, 0xc0, 0xc9, 0x7b, 0xa6, 0xbe, 0x75, 0x54, 0x95, 0xfc, 0x3f, 0xf2, 0x70, 0x6c, 0x24,
-	0x40, 0x4f, 0x19, 0x99, 0xf9, 0xba, 0xce, 0x8e, 0xcd, 0x12, 0x2e, 0xa6, 0x8c, 0xe3, 0xae, 0x41,
-	0x6e, 0x66, 0x12, 0xf2, 0xda, 0xe2, 0xd9, 0x11, 0x41, 0xae, 0x07, 0x9e, 0x69, 0xf7, 0x85, 0x28,
-	0xe3, 0x64, 0xf5, 0x04, 0x14, 0x7d, 0xdd, 0xf1, 0x18, 0x3a, 0xa0, 0xbc, 0x26, 0x06, 0xdc, 0xbf,
-	0xf8, 0xc3, 0x0d, 0x31, 0x51, 0xc4, 0x89, 0x68, 0xcc, 0x2d, 0xde, 0x1e, 0x0e, 0x7a, 0x62, 0xb2,
-	0x24, 0x2c, 0xde, 0x1e, 0x0e, 0xd6, 0x43, 0xc2, 0xc8, 0x31, 0x95, 0x33, 0x8e, 0x29, 0xe3, 0x0d,
-	0x2a, 0x87, 0xf1, 0x06, 0xd5, 0x99, 0xb2, 0xdf, 0xb7, 0xa1, 0xc6, 0x76, 0x5d, 0xd3, 0x93, 0xfd,
-	0x3d, 0x38, 0x98, 0x58, 0xa0, 0x23, 0x31, 0x81, 0x82, 0x47, 0xed, 0x2d, 0xf4, 0x5e, 0x79, 0x0d,
-	0xbf, 0x89, 0x0a, 0x75, 0xee, 0xf5, 0x62, 0x39, 0x70, 0xef, 0x54, 0xd7, 0x6a, 0x03, 0xba, 0xbb,
-	0x26, 0x45, 0xa1, 0xfe, 0x49, 0x81, 0x93, 0x23, 0x77, 0x8d, 0xae, 0xe3, 0x06, 0x94, 0x3d, 0x1c,
-	0x85, 0x6e, 0x23, 0x55, 0xef, 0x8e, 0x26, 0x70, 0x21, 0x36, 0x59, 0x86, 0xba, 0xd0, 0x80, 0x90,
-	0x3c, 0x37, 0x0d, 0xf9, 0x1c, 0xd2, 0x68, 0x72, 0x8d, 0x4c, 0xfa, 0x9d, 0x3f, 0x28, 0xfd, 0x2e,
-	0x8c, 0xa4, 0xdf, 0x6d, 0xd4, 0xe1, 0xed, 0xa9, 0x53, 0xd3, 0x6f, 0xc1, 0xf1, 0xfb, 0xa6, 0xbd,
-	0x75, 0x44, 0xed, 0x8c, 0x59, 0xdb, 0x0f, 0xbf, 0x55, 0xa0, 0xc5, 0xa5, 0x9e, 0xae, 0x47, 0x22,
-	0x3b, 0x3e, 0xa0, 0xa8, 0x7c, 0x03, 0x8a, 0x96, 0x39, 0x30, 0x83, 0xa9, 0x7c, 0x2d, 0x62, 0x92,
-	0x2f, 0x40, 0xf9, 0x99, 0xe3, 0xed, 0x50, 0xcf, 0x98, 0x98, 0xe7, 0xc5, 0x3c, 0x86, 0xa8, 0x89,
-	0xc0, 0x53, 0x48, 0x05, 0x1e, 0x0f, 0x8e, 0x71, 0xee, 0x51, 0xd6, 0xfe, 0x7e, 0x95, 0xce, 0x84,
-	0xc8, 0x15, 0x9f, 0x20, 0x3f, 0xed, 0x09, 0xd4, 0x45, 0x38, 0x19, 0xed, 0x39, 0xa5, 0xd3, 0x53,
-	0x3f, 0x56, 0xe0, 0x2a, 0x27, 0x1a, 0x51, 0x3f, 0x7f, 0xc9, 0x73, 0x86, 0xb6, 0xf1, 0x50, 0xe8,
-	0xe0, 0x2c, 0xa5, 0x08, 0x59, 0x4c, 0x0b, 0x7f, 0xd4, 0xa5, 0x3d, 0x19, 0x95, 0x7e, 0xd2, 0x49,
-	0xe6, 0x53, 0x4e, 0x52, 0xfd, 0x8d, 0x02, 0xe7, 0xc6, 0xb3, 0x38, 0x23, 0x5f, 0x67, 0xa0, 0x1a,
-	0xee, 0x11, 0x7a, 0xf8, 0x8a, 0xdc, 0xc4, 0x7f, 0x0e, 0x79, 0x4f, 0xbc, 0xfb, 0xbf, 0xe7, 0x80,
-	0x70, 0x86, 0x1f, 0xd0, 0x40, 0xdf, 0x8c, 0x55, 0x36, 0xda, 0x41, 0x99, 0x7a, 0x87, 0xdb, 0x50,
-	0xa7, 0xc3, 0x60, 0xd3, 0xf1, 0xcc, 0x80, 0x06, 0xe6, 0xf6, 0x34, 0xbd, 0x9e, 0x34, 0x01, 0xde,
-	0x05, 0xdd, 0x60, 0xd6, 0x54, 0xe1, 0x45, 0xa0, 0x62, 0x87, 0xc0, 0xb4, 0x7b, 0xbe, 0xf9, 0x21,
-	0x93, 0xe6, 0xba, 0x2f, 0xaf, 0xe5, 0x81, 0x69, 0xaf, 0x9b, 0x1f, 0x32, 0xa4, 0xa3, 0xbb, 0x82,
-	0xae, 0x38, 0x0d, 0x1d, 0xdd, 0x45, 0xba, 0x45, 0x28, 0x7e, 0x30, 0x64, 0xde, 0x9e, 0x7c, 0xc0,
-	0x39, 0x80, 0x47, 0x44, 0x55, 0x77, 0xa1, 0xc9, 0x45, 0x3c, 0xb6, 0xd8, 0x7d, 0x0e, 0x41, 0xbf,
-	0x0a, 0x0d, 0x9d, 0xea, 0x9b, 0x8c, 0x6e, 0x58, 0x2c, 0xdd, 0xe1, 0x58, 0x88, 0xe0, 0xd2, 0x8d,
-	0xfe, 0x4c, 0x81, 0xd3, 0x7c, 0xeb, 0xf1, 0x25, 0xed, 0xff, 0x40, 0x59, 0x26, 0x11, 0x52, 0x07,
-	0x4b, 0x22, 0x87, 0xc8, 0x94, 0xd5, 0xb9, 0x91, 0xb2, 0xfa, 0x08, 0xf5, 0xef, 0x27, 0x0a, 0x5c,
-	0xe1, 0x1c, 0x26, 0x73, 0xa7, 0x49, 0x26, 0x3d, 0x4d, 0x36, 0x75, 0xd4, 0x06, 0xfd, 0x6b, 0x05,
-	0xce, 0x8e, 0xe5, 0x6f, 0x26, 0xa6, 0x3e, 0x2b, 0x6b, 0xfe, 0x6b, 0x0e, 0x4e, 0xa5, 0xb9, 0x8d,
-	0xf8, 0x5c, 0x81, 0x79, 0x9d, 0x06, 0xac, 0xef, 0x78, 0x7b, 0x3d, 0x3f, 0xa0, 0x5e, 0xa8, 0x71,
-	0xfb, 0x0b, 0xa8, 0x1e, 0xd2, 0xac, 0x73, 0x12, 0xf2, 0x2e, 0xcc, 0x45, 0x8b, 0x30, 0xdb, 0x98,
-	0x4a, 0xc6, 0xb5, 0x90, 0xa2, 0x63, 0x1b, 0xe4, 0x6d, 0x00, 0xdc, 0x5c, 0x24, 0x49, 0xf9, 0x29,
-	0xc8, 0xab, 0x88, 0x8f, 0x59, 0xd2, 0x0d, 0xa8, 0x30, 0xdb, 0x10, 0xa4, 0x85, 0x29, 0x48, 0xcb,
-	0xcc, 0x36, 0x90, 0x30, 0x92, 0x70, 0xe9, 0x39, 0x24, 0x5c, 0x49, 0x49, 0xf8, 0x75, 0x11, 0xb7,
-	0x78, 0xc8, 0x4a, 0xc7, 0xcb, 0x49, 0xc6, 0xa4, 0xfe, 0x50, 0x81, 0x22, 0x7a, 0x57, 0xae, 0x66,
-	0x03, 0xfe, 0x91, 0x08, 0x6d, 0x38, 0xee, 0x1a, 0xe4, 0xf2, 0x38, 0xe7, 0x59, 0x39, 0x0a, 0x07,
-	0x49, 0xa0, 0x10, 0x39, 0xc7, 0xa2, 0x86, 0xdf, 0xea, 0x4d, 0xa8, 0x22, 0x47, 0x98, 0x29, 0xbe,
-	0x06, 0x82, 0x0b, 0x36, 0xb6, 0x74, 0x45, 0x3c, 0x2d, 0xc4, 0x50, 0xff, 0xa6, 0xc0, 0x5c, 0xd2,
-	0x8f, 0x8d, 0x74, 0x29, 0x9a, 0x50, 0xf6, 0x87, 0xe8, 0x66, 0xc2, 0xfa, 0x41, 0x0e, 0x93, 0x2d,
-	0xeb, 0x7c, 0xba, 0x65, 0x4d, 0x64, 0xdb, 0x5c, 0xb2, 0x38, 0xda, 0x19, 0x2f, 0x66, 0x3a, 0xe3,
-	0x99, 0x2c, 0xbf, 0x34, 0x53, 0x96, 0x7f, 0x3e, 0xd5, 0xa6, 0x2e, 0xa3, 0x9c, 0x93, 0xad, 0xe8,
-	0x6f, 0x43, 0x23, 0x79, 0x42, 0x94, 0xd1, 0x2d, 0xa8, 0xdb, 0x49, 0xef, 0x2d, 0x25, 0x95, 0x7a,
-	0xfa, 0x48, 0x12, 0x69, 0x69, 0xf4, 0x59, 0x5c, 0xf6, 0x23, 0x68, 0x3e, 0xf2, 0x9c, 0x81, 0x23,
-	0xdb, 0xb2, 0x47, 0x50, 0x10, 0xbe, 0x0f, 0xc7, 0x35, 0x46, 0x8d, 0xc3, 0xf7, 0x4e, 0x13, 0x2a,
-	0x9e, 0x4f, 0xa9, 0xf8, 0x37, 0xe0, 0xf4, 0xc8, 0x0e, 0x11, 0xd3, 0xb7, 0xc6, 0x34, 0x4e, 0x2f,
-	0x24, 0x05, 0x37, 0x86, 0xb9, 0x64, 0xdb, 0xf4, 0x3d, 0xc8, 0x6b, 0xae, 0x3e, 0x4e, 0xd1, 0x5c,
-	0xba, 0x67, 0x39, 0x34, 0x2a, 0x54, 0xe5, 0x90, 0x8b, 0x62, 0x33, 0x08, 0xdc, 0x1e, 0xe7, 0x5e,
-	0x6a, 0x1a, 0x1f, 0xdf, 0x63, 0x7b, 0xea, 0x53, 0x28, 0xaf, 0x33, 0xdf, 0xe7, 0xc7, 0xe3, 0xea,
-	0x88, 0x4a, 0x21, 0x16, 0xad, 0x68, 0xe1, 0x30, 0x7e, 0xb3, 0xcf, 0x25, 0xde, 0xec, 0xb9, 0x42,
-	0x0e, 0x0d, 0xb7, 0x27, 0x66, 0xc2, 0x07, 0x29, 0xc3, 0x7d, 0x8c, 0x0f, 0xfa, 0x7f, 0xc9, 0x41,
-	0x3d, 0x75, 0x84, 0x23, 0x94, 0x6e, 0xdc, 0x77, 0x2d, 0x24, 0xfa, 0xae, 0xc9, 0x46, 0x76, 0x31,
-	0xd5, 0xc8, 0x26, 0x57, 0x60, 0xc1, 0x65, 0xde, 0xc0, 0xc4, 0x73, 0xf6, 0x3c, 0x46, 0x0d, 0x59,
-	0x42, 0xcf, 0xc7, 0x60, 0x2e, 0x73, 0xae, 0x95, 0x09, 0xc4, 0x1d, 0xcf, 0x0c, 0xc4, 0x7b, 0x51,
-	0x51, 0x4b, 0x2c, 0xf0, 0x55, 0x0e, 0xfe, 0xfc, 0xea, 0x6a, 0x75, 0x07, 0x1a, 0x29, 0xc9, 0x2e,
-	0xe9, 0x5b, 0x47, 0xd9, 0xf6, 0x4f, 0x8a, 0xbd, 0x90, 0x52, 0xea, 0x0e, 0x1c, 0xcb, 0x6e, 0xec,
-	0x93, 0xd7, 0xa1, 0x40, 0xf5, 0xad, 0x50, 0x8d, 0xcf, 0x26, 0xd5, 0x38, 0x8b, 0xac, 0x21, 0xa6,
-	0xda, 0x81, 0xf9, 0xb4, 0x5d, 0x90, 0xeb, 0x50, 0x16, 0xda, 0x1d, 0x2e, 0x73, 0x7a, 0xe2, 0x32,
-	0x5a, 0x88, 0xa9, 0xbe, 0x9f, 0xe1, 0x06, 0xdd, 0xd2, 0xf3, 0xac, 0x34, 0xb1, 0xfd, 0xf8, 0x8b,
-	0x02, 0x40, 0x9c, 0x37, 0x8c, 0xd8, 0x1b, 0xb7, 0x0a, 0x33, 0xb0, 0xa2, 0xee, 0x3f, 0x0e, 0xb2,
-	0x1d, 0xe6, 0xfc, 0x68, 0x87, 0xb9, 0x05, 0x95, 0x30, 0x01, 0x40, 0x01, 0xd7, 0xb5, 0x68, 0xcc,
-	0x0b, 0x63, 0xdf, 0xf1, 0x82, 0x9e, 0xe3, 0x19, 0xcc, 0x43, 0x35, 0xae, 0x6b, 0x55, 0x0e, 0x79,
-	0xc8, 0x01, 0x51, 0xe8, 0x2a, 0xe1, 0x04, 0x7e, 0x8b, 0x18, 0x2a, 0xf3, 0xf6, 0x32, 0xc2, 0xa3,
-	0xd4, 0x7c, 0xa4, 0x61, 0x52, 0x19, 0x69, 0x98, 0xe0, 0x5f, 0xb4, 0xa8, 0xdd, 0xc3, 0x37, 0x7e,
-	0x54, 0xc4, 0x0a, 0x67, 0xc7, 0xee, 0xe0, 0x5f, 0x94, 0xce, 0x01, 0xf0, 0xfc, 0x82, 0xea, 0x18,
-	0x81, 0x41, 0xb0, 0xc3, 0x6c, 0x63, 0x09, 0x01, 0x7c, 0x1a, 0xbb, 0x1a, 0xa2, 0x97, 0x58, 0x13,
-	0xd3, 0x1c, 0xa2, 0x71, 0x40, 0xaa, 0x2d, 0x35, 0xb7, 0x7f, 0x5b, 0xaa, 0x3e, 0x93, 0xf9, 0x7c,
-	0x29, 0x95, 0x33, 0xcd, 0x1f, 0xfc, 0xae, 0x13, 0x67, 0x4c, 0x6f, 0x26, 0x32, 0xa6, 0x85, 0x03,
-	0x09, 0xa3, 0x7c, 0xa9, 0x05, 0x15, 0x63, 0xe8, 0x61, 0xec, 0x6a, 0x36, 0xc4, 0x9d, 0x85, 0x63,
-	0x75, 0x03, 0xe6, 0x63, 0x2d, 0x41, 0x2d, 0xbc, 0x09, 0xb5, 0x38, 0xd9, 0x0d, 0x35, 0xf1, 0x54,
-	0x52, 0x13, 0x13, 0xc9, 0x73, 0x12, 0x75, 0xa2, 0x2a, 0xfe, 0x51, 0x81, 0x13, 0xd9, 0x84, 0xfb,
-	0xbf, 0xa1, 0xab, 0xf5, 0xaf, 0x1c, 0x9c, 0x78, 0x82, 0xae, 0x4d, 0xb6, 0x9e, 0xc2, 0x18, 0x99,
-	0xec, 0xad, 0x2a, 0x33, 0xf5, 0x56, 0xdf, 0x85, 0x39, 0xc3, 0xf4, 0x5d, 0x8b, 0xee, 0xf5, 0x90,
-	0x3a, 0x37, 0x05, 0x75, 0x4d, 0x52, 0xac, 0x51, 0x74, 0xce, 0xc9, 0xa7, 0x9c, 0x69, 0x12, 0xcb,
-	0xc4, 0x43, 0xcf, 0x8d, 0xc4, 0xf3, 0x51, 0x61, 0x0a, 0xd2, 0xe8, 0x71, 0xe9, 0x26, 0x54, 0x2c,
-	0x47, 0x64, 0x47, 0xb2, 0xfc, 0x3e, 0xe0, 0xc0, 0x21, 0x36, 0xa7, 0xe4, 0xea, 0xfc, 0xa1, 0x63,
-	0xb3, 0xa9, 0x6a, 0xf0, 0x08, 0x5b, 0xfd, 0x5d, 0x0e, 0x88, 0x90, 0xfe, 0x94, 0x5d, 0x45, 0xee,
-	0xed, 0xa7, 0x16, 0xaa, 0xe8, 0x90, 0xdd, 0x1a, 0xf5, 0x87, 0x07, 0xdf, 0x46, 0xc2, 0x5b, 0x3e,
-	0xb7, 0x40, 0xd3, 0xd7, 0x58, 0x9c, 0xed, 0x1a, 0xc3, 0xf7, 0xba, 0xd2, 0x74, 0xef, 0x75, 0xea,
-	0x8f, 0x0a, 0x50, 0xc0, 0xc7, 0xa4, 0x6c, 0x90, 0x48, 0xfe, 0x65, 0x25, 0x97, 0xf9, 0xcb, 0xca,
-	0xcb, 0x19, 0x4d, 0x0d, 0x63, 0x45, 0x42, 0x17, 0x0f, 0xf8, 0x33, 0xc4, 0xfe, 0x8f, 0x95, 0x91,
-	0x3e, 0xc9, 0xc7, 0xca, 0x48, 0x63, 0x5a, 0x09, 0x8d, 0x91, 0xef, 0x05, 0xe1, 0x38, 0xe5, 0xb4,
-	0x2b, 0x19, 0xa7, 0x7d, 0x01, 0x6a, 0x89, 0xd7, 0x5a, 0x8c, 0x16, 0x55, 0x0d, 0xe2, 0xc7, 0x5a,
-	0x1e, 0x4c, 0x84, 0xa4, 0xf8, 0x34, 0x08, 0x6a, 0x01, 0xe8, 0x1a, 0xe4, 0x12, 0xd4, 0xfb, 0x74,
-	0xc0, 0x74, 0x0c, 0x35, 0x1c, 0xa1, 0x26, 0xfa, 0x02, 0x31, 0x50, 0x64, 0xed, 0x7e, 0xc0, 0x28,
-	0xfe, 0x61, 0x78, 0x4e, 0x96, 0x4b, 0x7c, 0xdc, 0xc5, 0x66, 0xad, 0x63, 0x5b, 0xa6, 0x2d, 0xa2,
-	0x45, 0x45, 0x93, 0xa3, 0xcc, 0x5b, 0xe9, 0x7c, 0xf6, 0xad, 0x34, 0x13, 0x69, 0x16, 0x0e, 0x93,
-	0xa8, 0x35, 0x66, 0x4a, 0xd4, 0xbe, 0x93, 0x83, 0x7a, 0x54, 0x16, 0x87, 0xcf, 0x97, 0x98, 0x5a,
-	0xa5, 0x1e, 0x46, 0x2f, 0x65, 0x5f, 0x1c, 0x23, 0xfc, 0x78, 0xa4, 0xc1, 0x30, 0xaa, 0xaf, 0x5b,
-	0x9f, 0x2a, 0x50, 0x8d, 0x66, 0xc8, 0x15, 0x28, 0xe2, 0x72, 0xd2, 0x4d, 0x8e, 0x79, 0x66, 0x15,
-	0xf3, 0x9f, 0xcf, 0x0b, 0xe6, 0x35, 0x28, 0x62, 0xc1, 0x46, 0xfe, 0x17, 0x8a, 0xc9, 0x37, 0xdb,
-	0xd1, 0x67, 0x56, 0x31, 0xad, 0x7e, 0x94, 0x83, 0x73, 0x98, 0x61, 0x1f, 0xf2, 0x2f, 0x35, 0xe4,
-	0x6b, 0x50, 0x12, 0xa1, 0x4d, 0x9e, 0xf7, 0x76, 0x72, 0xc7, 0x7d, 0x77, 0x18, 0x8d, 0x7b, 0x88,
-	0xae, 0xc9, 0xf5, 0x5a, 0xcf, 0xe0, 0xd4, 0x78, 0x8c, 0xf8, 0x5d, 0x4f, 0x99, 0xf4, 0xae, 0x97,
-	0xcb, 0xbc, 0xeb, 0x25, 0xcd, 0x2d, 0x9f, 0x36, 0x37, 0xf5, 0x7b, 0x39, 0x20, 0xb8, 0xee, 0x61,
-	0x0b, 0xa9, 0xa8, 0x5e, 0xca, 0x4f, 0xa8, 0x97, 0x0a, 0xe9, 0x0a, 0x60, 0x75, 0xb4, 0x5e, 0x9a,
-	0xa2, 0x23, 0x9c, 0x2d, 0xa6, 0xee, 0x8c, 0x29, 0xa6, 0xa6, 0x68, 0x37, 0x65, 0x2b, 0x2d, 0xf5,
-	0x29, 0xb4, 0x46, 0xa5, 0xe0, 0xc7, 0x89, 0x42, 0x26, 0xe3, 0x3f, 0x3f, 0x72, 0xcf, 0x13, 0x0a,
-	0x88, 0xef, 0xe6, 0xe0, 0x2c, 0xce, 0x67, 0x13, 0xab, 0x99, 0x1a, 0x99, 0x4f, 0x33, 0x6a, 0x76,
-	0x6b, 0x64, 0xfb, 0x09, 0xcb, 0xb7, 0xb3, 0xf0, 0xb4, 0x92, 0x31, 0x38, 0x39, 0x16, 0xe1, 0x68,
-	0x75, 0x6c, 0xf9, 0x1d, 0x38, 0xad, 0x3b, 0x83, 0xf6, 0x26, 0xf3, 0x1c, 0x53, 0xb7, 0xe8, 0x86,
-	0x9f, 0x60, 0x7f, 0xb9, 0xba, 0x86, 0xdf, 0x4b, 0xae, 0xf9, 0x48, 0xf9, 0x7a, 0x9e, 0xba, 0xe6,
-	0xcf, 0x73, 0x85, 0xb5, 0x7b, 0x8f, 0x96, 0x7f, 0x95, 0x2b, 0x89, 0x99, 0x8d, 0x12, 0xde, 0xe0,
-	0xf5, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x03, 0x76, 0x61, 0x6e, 0xda, 0x32, 0x00, 0x00,
+	// 4166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x5d, 0x6f, 0x23, 0x47,
+	0x72, 0x1e, 0x7e, 0xb3, 0x28, 0x4a, 0xd4, 0xac, 0x76, 0x4d, 0x69, 0x3f, 0x3d, 0xf6, 0xc5, 0x7b,
+	0xe7, 0x3b, 0xad, 0x2d, 0xe7, 0xb2, 0x7b, 0xe7, 0xd8, 0x5e, 0x4a, 0xe2, 0xae, 0xe9, 0xd5, 0x4a,
+	0xf2, 0x48, 0x5a, 0x9f, 0x93, 0x07, 0x5e, 0x6b, 0xa6, 0x25, 0xcd, 0x89, 0x9c, 0xe1, 0xf5, 0x0c,
+	0xb5, 0x92, 0x93, 0x3c, 0xe4, 0x70, 0x40, 0x0e, 0x79, 0x08, 0x82, 0x3c, 0x04, 0x79, 0xc8, 0x17,
+	0x82, 0x20, 0xb8, 0x4b, 0x90, 0x3c, 0x27, 0x40, 0x80, 0x3c, 0xe6, 0x3d, 0xc8, 0xd7, 0x5b, 0x92,
+	0xc7, 0xfc, 0x86, 0xbc, 0x04, 0x5d, 0xdd, 0x3d, 0x5f, 0x24, 0x45, 0x52, 0xd2, 0xfa, 0x80, 0x7b,
+	0x9b, 0xae, 0xae, 0xea, 0xae, 0xae, 0xae, 0xae, 0xaa, 0xae, 0xea, 0x81, 0x2a, 0xe9, 0x39, 0x0f,
+	0x48, 0xcf, 0x59, 0xee, 0x31, 0x2f, 0xf0, 0x74, 0x70, 0xc9, 0x31, 0xe9, 0x92, 0x65, 0xd2, 0x73,
+	0x96, 0xee, 0x1e, 0x7a, 0xde, 0x61, 0x87, 0x3e, 0xc0, 0x9e, 0xfd, 0xfe, 0xc1, 0x83, 0xc0, 0xe9,
+	0x52, 0x3f, 0x20, 0xdd, 0x9e, 0x40, 0x5e, 0xba, 0x93, 0x46, 0x78, 0xc9, 0x48, 0xaf, 0x47, 0x99,
+	0x2f, 0xfa, 0x8d, 0x9f, 0x65, 0xa0, 0xd8, 0xb0, 0x2c, 0xaf, 0xef, 0x06, 0xfa, 0x5b, 0x90, 0xeb,
+	0xfb, 0x94, 0xd5, 0xb5, 0x7b, 0xda, 0xfd, 0xca, 0x4a, 0x6d, 0x39, 0x9a, 0x67, 0x79, 0xcf, 0xa7,
+	0xcc, 0xc4, 0x5e, 0xfd, 0x06, 0x14, 0x5e, 0x92, 0x4e, 0x87, 0x06, 0xf5, 0xcc, 0x3d, 0xed, 0x7e,
+	0xd9, 0x94, 0x2d, 0x7d, 0x01, 0xf2, 0xb4, 0x4b, 0x9c, 0x4e, 0x3d, 0x8b, 0x60, 0xd1, 0xd0, 0xdf,
+	0x87, 0xa2, 0x4d, 0x4f, 0x1c, 0x8b, 0xfa, 0xf5, 0xdc, 0xbd, 0xec, 0xfd, 0xca, 0xca, 0x62, 0x7c,
+	0x58, 0x39, 0xf3, 0x3a, 0x62, 0x98, 0x0a, 0x53, 0xbf, 0x09, 0x65, 0xab, 0xef, 0x07, 0x5e, 0xb7,
+	0xed, 0xd8, 0xf5, 0x3c, 0x0e, 0x57, 0x12, 0x80, 0x96, 0xad, 0x7f, 0x00, 0x95, 0x13, 0xca, 0x9c,
+	0x83, 0xb3, 0x36, 0x5f, 0x6b, 0xbd, 0x80, 0xcc, 0x2e, 0x2d, 0x8b, 0x75, 0x2e, 0xab, 0x75, 0x2e,
+	0xef, 0x2a, 0x41, 0x98, 0x20, 0xd0, 0x39, 0x40, 0xff, 0x00, 0xc0, 0xb1, 0xa9, 0x1b, 0x38, 0x81,
+	0x43, 0xfd, 0x7a, 0x11, 0x39, 0xba, 0x39, 0x84, 0xa3, 0x96, 0x40, 0x3a, 0x33, 0x63, 0xe8, 0xc6,
+	0x5b, 0x30, 0x23, 0xbb, 0x1b, 0xbd, 0x5e, 0x87, 0xf2, 0x15, 0x07, 0xde, 0x31, 0x75, 0x51, 0x60,
+	0x65, 0x53, 0x34, 0x8c, 0xbb, 0x50, 0x95, 0x58, 0x6b, 0xc8, 0xb2, 0x3e, 0x0b, 0x19, 0xc7, 0x96,
+	0x38, 0x19, 0xc7, 0x8e, 0x21, 0x88, 0x75, 0x0f, 0x20, 0x3c, 0x0e, 0xe7, 0x69, 0xa2, 0x0c, 0x43,
+	0xc9, 0x6a, 0x71, 0xc9, 0x2e, 0x41, 0xa9, 0x47, 0x7c, 0xff, 0xa5, 0xc7, 0x6c, 0xb9, 0x13, 0x61,
+	0xdb, 0xf8, 0x5d, 0x0d, 0x66, 0xd5, 0x10, 0x8c, 0xf8, 0x7d, 0x46, 0xf5, 0x0f, 0x61, 0x86, 0xd1,
+	0x1f, 0xf6, 0xa9, 0x1f, 0x08, 0xb9, 0x69, 0x63, 0xe5, 0x56, 0x91, 0xf8, 0x28, 0xb8, 0xef, 0x00,
+	0x50, 0x46, 0x7c, 0x2a, 0x88, 0x33, 0x63, 0x89, 0xcb, 0x88, 0xcd, 0xdb, 0xc6, 0x37, 0x60, 0x41,
+	0xf1, 0x72, 0xda, 0xf3, 0x58, 0xd0, 0x60, 0xd6, 0x91, 0x73, 0x42, 0x75, 0x1d, 0x72, 0x36, 0x09,
+	0x08, 0x72, 0x32, 0x63, 0xe2, 0xb7, 0xf1, 0x36, 0xcc, 0x49, 0xdc, 0x27, 0xc4, 0xa2, 0xfb, 0x9e,
+	0x77, 0x3c, 0x42, 0xca, 0xff, 0xa2, 0xc1, 0xbc, 0xc4, 0x7c, 0x4a, 0xba, 0x74, 0x8d, 0xba, 0x01,
+	0x65, 0x5c, 0x71, 0x7a, 0x1d, 0x72, 0x46, 0x59, 0x3b, 0x14, 0x68, 0x49, 0x00, 0x5a, 0x36, 0xef,
+	0xdc, 0xef, 0xbb, 0x76, 0x87, 0xf2, 0x4e, 0x29, 0x31, 0x01, 0x68, 0xd9, 0xfa, 0x3b, 0x30, 0x1f,
+	0x1e, 0x9d, 0xb6, 0x4f, 0x2d, 0xcf, 0xb5, 0x7d, 0xd4, 0xe4, 0xac, 0x59, 0x0b, 0x3b, 0x76, 0x04,
+	0x9c, 0x73, 0xee, 0x93, 0x4e, 0x50, 0xcf, 0xe1, 0x20, 0xf8, 0xad, 0xdf, 0x82, 0xb2, 0xef, 0x1c,
+	0xba, 0x24, 0xe8, 0x33, 0x2a, 0x75, 0x36, 0x02, 0xe8, 0x6f, 0xc1, 0x6c, 0xaf, 0xbf, 0xdf, 0x71,
+	0xac, 0xf6, 0x31, 0x3d, 0x6b, 0xf7, 0x59, 0x07, 0xf5, 0xb6, 0x6c, 0xce, 0x08, 0xe8, 0x33, 0x7a,
+	0xb6, 0xc7, 0x3a, 0xc6, 0xd7, 0x42, 0xcd, 0x78, 0x8a, 0x82, 0x1d, 0xb1, 0xf6, 0xbf, 0xd7, 0x60,
+	0x2e, 0xa5, 0xa7, 0xa8, 0x0d, 0xcc, 0x3b, 0x71, 0x6c, 0xca, 0xc2, 0x85, 0xcb, 0xb6, 0xd4, 0xaf,
+	0x8c, 0xd2, 0x2f, 0xfd, 0x21, 0x94, 0x3b, 0x8e, 0x7b, 0x2c, 0xb6, 0x32, 0x3b, 0x76, 0x2b, 0x4b,
+	0x1c, 0x99, 0x37, 0xf5, 0xc7, 0x30, 0xdb, 0x21, 0x7e, 0xd0, 0x26, 0xfd, 0xe0, 0x48, 0x50, 0xe7,
+	0xc6, 0x52, 0xcf, 0x70, 0x8a, 0x46, 0x3f, 0x38, 0x42, 0x5d, 0xf8, 0x18, 0x2a, 0x92, 0xf3, 0x2d,
+	0xc7, 0xb6, 0xce, 0xe5, 0x3a, 0x5c, 0x7b, 0x26, 0xbe, 0xf6, 0xe8, 0x0c, 0xee, 0x04, 0x94, 0x74,
+	0x47, 0x48, 0x68, 0x0d, 0xe6, 0x1b, 0xb6, 0xfd, 0x84, 0x39, 0xd4, 0xb5, 0x7d, 0x53, 0xa8, 0xb1,
+	0x5e, 0x83, 0xac, 0x63, 0xfb, 0x75, 0xed, 0x5e, 0xf6, 0x7e, 0xd9, 0xe4, 0x9f, 0x7c, 0xcf, 0xb8,
+	0x49, 0x73, 0x49, 0x97, 0xfa, 0xf5, 0x0c, 0xc2, 0x23, 0x80, 0xb1, 0x01, 0x0b, 0x0d, 0xdb, 0x7e,
+	0xca, 0xbc, 0x7e, 0x8f, 0x9b, 0xbf, 0x70, 0x9c, 0x45, 0x28, 0x1d, 0x72, 0x60, 0xa4, 0x63, 0x45,
+	0x6c, 0xb7, 0x6c, 0xde, 0xc5, 0xe9, 0xdb, 0x8e, 0xad, 0xc6, 0x2b, 0xf2, 0x76, 0xcb, 0xf6, 0x8d,
+	0x3f, 0xd5, 0xa0, 0xce, 0xc5, 0xc0, 0xf7, 0xcb, 0x22, 0x01, 0x45, 0x13, 0xa2, 0x86, 0x5c, 0x81,
+	0x22, 0x11, 0xab, 0x92, 0xe7, 0xb2, 0x3e, 0xc4, 0x26, 0x09, 0x0a, 0x85, 0xa8, 0xaf, 0x40, 0xc1,
+	0x62, 0x94, 0x04, 0xa3, 0x4f, 0xe3, 0xaa, 0xe7, 0x75, 0x5e, 0x90, 0x4e, 0x9f, 0x9a, 0x12, 0x93,
+	0xcb, 0x5b, 0xad, 0x4f, 0x9a, 0xe9, 0xb0, 0x6d, 0xfc, 0xb9, 0x06, 0x8b, 0x71, 0x06, 0x85, 0xf5,
+	0x52, 0x1c, 0xbe, 0x9f, 0xe6, 0x70, 0x98, 0x1d, 0x97, 0x24, 0x5f, 0x19, 0x8b, 0xd2, 0x6f, 0x4c,
+	0xc3, 0xa2, 0x72, 0x35, 0xaf, 0x8a, 0xc5, 0xf4, 0x36, 0xa3, 0x05, 0x9f, 0x6a, 0x9b, 0x05, 0xc5,
+	0x2b, 0x63, 0xf0, 0x5f, 0x35, 0xb8, 0x19, 0x67, 0x50, 0xd9, 0x59, 0xc5, 0xe3, 0xb7, 0xd3, 0x3c,
+	0x0e, 0x73, 0x8f, 0x21, 0xd1, 0xab, 0x62, 0x53, 0x5f, 0x86, 0x9c, 0x7f, 0xe6, 0x5a, 0xf5, 0xdc,
+	0xd8, 0xd1, 0x10, 0xcf, 0xf8, 0xa9, 0x06, 0xb7, 0xe3, 0xcb, 0x8a, 0x9c, 0x82, 0x5a, 0xd8, 0xc3,
+	0xf4, 0xc2, 0x6e, 0x0f, 0x59, 0x58, 0x8c, 0xec, 0x2b, 0xd3, 0x62, 0x61, 0xeb, 0xa7, 0xd2, 0x62,
+	0x49, 0xf2, 0xca, 0x58, 0xfc, 0x63, 0x0d, 0x5e, 0x8f, 0xb3, 0xc8, 0x8d, 0xb5, 0x62, 0xf0, 0xbd,
+	0x34, 0x83, 0xaf, 0x0f, 0x61, 0x10, 0x09, 0xbe, 0xb2, 0x43, 0x86, 0xae, 0x60, 0xaa, 0x43, 0x26,
+	0x28, 0x5e, 0x19, 0x83, 0x4d, 0xb8, 0xb6, 0xda, 0xf1, 0xac, 0xe3, 0x4b, 0x7a, 0xa0, 0x9f, 0x64,
+	0x61, 0x76, 0xed, 0x88, 0xb8, 0x2e, 0xed, 0x3c, 0xa7, 0xbe, 0x4f, 0x0e, 0xa9, 0x7e, 0x1b, 0xc0,
+	0x12, 0x90, 0xc8, 0xfd, 0x94, 0x25, 0xa4, 0x65, 0xf3, 0xee, 0xae, 0xc0, 0x8c, 0x82, 0x9c, 0xb2,
+	0x84, 0xb4, 0x6c, 0xfd, 0x01, 0xe4, 0x2c, 0xcf, 0x56, 0x4e, 0xff, 0xe6, 0xc0, 0x2a, 0x5b, 0x6e,
+	0xf0, 0xfe, 0x8a, 0x3c, 0x56, 0x1c, 0x91, 0xc7, 0x4c, 0x3e, 0x75, 0x6d, 0x11, 0x50, 0x89, 0x70,
+	0xa7, 0x24, 0x00, 0x2d, 0x3b, 0x21, 0x81, 0x7c, 0xea, 0xfc, 0xd6, 0xa1, 0x68, 0x79, 0x6e, 0x40,
+	0xdd, 0x40, 0x46, 0x3a, 0xaa, 0xc9, 0xe3, 0x77, 0x21, 0x41, 0x11, 0x41, 0x14, 0xc7, 0xc7, 0xef,
+	0x02, 0x5d, 0xc6, 0xef, 0x95, 0x7e, 0xcf, 0x0e, 0x89, 0x4b, 0xe3, 0x89, 0x05, 0x3a, 0x12, 0x7f,
+	0x17, 0x80, 0xdf, 0x7c, 0x1c, 0x1f, 0xd9, 0x2a, 0x8f, 0xdd, 0xe9, 0x18, 0xb6, 0xf1, 0x7b, 0x1a,
+	0xe8, 0xc9, 0xad, 0xd8, 0x70, 0xfc, 0x40, 0xff, 0x15, 0x28, 0x49, 0xe9, 0x8a, 0x6d, 0xe5, 0x03,
+	0xc6, 0xb4, 0x2d, 0x49, 0x61, 0x86, 0xb8, 0xfa, 0x5d, 0xa8, 0xb8, 0xf4, 0x34, 0x68, 0x5b, 0x7d,
+	0xe6, 0x7b, 0x4c, 0x6e, 0x14, 0x70, 0xd0, 0x1a, 0x42, 0x38, 0x42, 0x8f, 0xd1, 0x13, 0x85, 0x20,
+	0x14, 0x0c, 0x38, 0x48, 0x20, 0x18, 0x7f, 0xc4, 0x19, 0x42, 0xc1, 0x60, 0x84, 0xa2, 0x54, 0x4c,
+	0x87, 0x1c, 0xee, 0x87, 0xd0, 0x0c, 0xfc, 0xd6, 0xef, 0x41, 0xc5, 0xa6, 0xbe, 0xc5, 0x9c, 0x5e,
+	0xe0, 0x78, 0x2a, 0x9e, 0x8a, 0x83, 0x78, 0xdc, 0xd2, 0x21, 0xee, 0x61, 0x3b, 0x20, 0x87, 0x72,
+	0xaa, 0x22, 0x6f, 0xef, 0x92, 0x43, 0xae, 0x51, 0xe4, 0x84, 0x04, 0x84, 0x61, 0xd4, 0x2a, 0x54,
+	0xa0, 0x2c, 0x20, 0x7b, 0xac, 0xc3, 0xe7, 0xf3, 0x7a, 0xd4, 0xc5, 0xfd, 0x2f, 0x99, 0xf8, 0x6d,
+	0x7c, 0x0e, 0x77, 0xd7, 0xa9, 0xd5, 0x71, 0x5c, 0xc1, 0xda, 0xa7, 0x9e, 0xe3, 0x4a, 0xf6, 0x2e,
+	0x19, 0x43, 0x3d, 0x81, 0x85, 0x75, 0xda, 0xa1, 0x01, 0xbd, 0xe4, 0xb9, 0x7a, 0x00, 0xba, 0x18,
+	0x27, 0x21, 0xba, 0xd1, 0x3c, 0x19, 0x4f, 0xe1, 0x8e, 0x20, 0xd8, 0xa0, 0xc4, 0xa6, 0x6c, 0xdf,
+	0x23, 0xcc, 0x36, 0xa9, 0xe5, 0x31, 0x5b, 0x11, 0x7f, 0x0d, 0x66, 0x3b, 0x51, 0x5f, 0x34, 0x44,
+	0x35, 0x06, 0x6d, 0xd9, 0xc6, 0x32, 0x2c, 0x89, 0x81, 0x36, 0xbd, 0xc0, 0x39, 0xe0, 0xc6, 0xcb,
+	0xf1, 0xdc, 0xd1, 0xeb, 0x30, 0x2c, 0xb8, 0x2e, 0xf0, 0x77, 0x02, 0x8f, 0x91, 0x43, 0xba, 0xb5,
+	0xff, 0x03, 0x6a, 0x05, 0x2d, 0x5b, 0xbf, 0x03, 0x60, 0x79, 0x9d, 0x0e, 0xb5, 0x70, 0x4b, 0xc5,
+	0x5c, 0x31, 0x08, 0x1f, 0xea, 0x98, 0x9e, 0xc9, 0xbd, 0xe6, 0x9f, 0xfc, 0x44, 0x9e, 0x70, 0x7d,
+	0xf6, 0x5c, 0xb5, 0xc5, 0xb2, 0x69, 0xb4, 0xe1, 0xe6, 0x90, 0x49, 0x42, 0xae, 0x1e, 0x03, 0x78,
+	0x08, 0x69, 0x2b, 0xe6, 0x2a, 0x2b, 0x6f, 0xc4, 0xb5, 0x7c, 0x28, 0x87, 0x66, 0xd9, 0x93, 0x5f,
+	0xbe, 0xf1, 0x1f, 0x1a, 0xe4, 0x9b, 0x27, 0xd4, 0x1d, 0xae, 0x9e, 0x0d, 0x80, 0x1e, 0xf3, 0x7a,
+	0x94, 0x05, 0x8e, 0xdc, 0xac, 0xd4, 0xf8, 0x48, 0xba, 0xbc, 0x1d, 0xe2, 0x34, 0xdd, 0x80, 0x9d,
+	0x99, 0x31, 0x22, 0xfd, 0x11, 0x94, 0xc3, 0x4b, 0xda, 0x04, 0x37, 0x9a, 0x08, 0x79, 0xe9, 0x43,
+	0x98, 0x4b, 0x0d, 0xac, 0x44, 0xa7, 0x45, 0xa2, 0x5b, 0x80, 0xfc, 0x09, 0xb7, 0x08, 0xea, 0x2a,
+	0x82, 0x8d, 0xef, 0x66, 0x1e, 0x69, 0xc6, 0x8f, 0x32, 0x50, 0x10, 0xca, 0x38, 0x61, 0xf6, 0xe4,
+	0x3d, 0xc8, 0xfb, 0x41, 0xe4, 0x68, 0xce, 0x35, 0xc1, 0x02, 0x33, 0x6d, 0xf3, 0xb2, 0x53, 0xd9,
+	0xbc, 0x25, 0x6e, 0xa0, 0x02, 0x82, 0x17, 0x6d, 0x69, 0xbf, 0x55, 0xdb, 0x78, 0x02, 0xf9, 0x1d,
+	0x9c, 0x01, 0xa0, 0xf0, 0xc4, 0x6c, 0x35, 0x37, 0xd7, 0x6b, 0xaf, 0xe9, 0x73, 0x50, 0x69, 0x6d,
+	0xbe, 0x68, 0xed, 0x36, 0xdb, 0x3b, 0xcd, 0xcd, 0xdd, 0x9a, 0xa6, 0x5f, 0x83, 0x39, 0x09, 0x30,
+	0x9b, 0x6b, 0xcd, 0xd6, 0x8b, 0xe6, 0x7a, 0x2d, 0xa3, 0x57, 0xa0, 0xb8, 0xba, 0xb1, 0xb5, 0xf6,
+	0xac, 0xb9, 0x5e, 0xcb, 0x1a, 0x5b, 0x50, 0x94, 0x07, 0x52, 0xff, 0x26, 0x14, 0x0f, 0xc4, 0xa7,
+	0x54, 0x14, 0x3d, 0x2e, 0x07, 0x81, 0x65, 0x2a, 0x14, 0x9e, 0x4a, 0x4a, 0x18, 0x40, 0xd9, 0x32,
+	0xfe, 0x49, 0x83, 0x9a, 0xc0, 0xdd, 0xe9, 0x1f, 0x1e, 0x52, 0x1f, 0x35, 0x7a, 0x32, 0xf9, 0x2e,
+	0xc3, 0xb5, 0x6e, 0x3f, 0xe8, 0x93, 0x4e, 0x5b, 0x4c, 0xd2, 0x16, 0x91, 0x00, 0x1f, 0x3f, 0x6f,
+	0xce, 0x8b, 0x2e, 0x31, 0xf4, 0x1a, 0xef, 0xd0, 0xbf, 0x09, 0xba, 0x7f, 0x44, 0x18, 0xb5, 0xdb,
+	0xe2, 0xec, 0x0b, 0xf4, 0x2c, 0xa2, 0xd7, 0x44, 0x0f, 0x1a, 0x09, 0x81, 0xfd, 0x36, 0xcc, 0x61,
+	0x3a, 0xc1, 0x6e, 0x33, 0x6a, 0x51, 0x37, 0xe8, 0x9c, 0xa1, 0x50, 0x4b, 0xe6, 0xac, 0x00, 0x9b,
+	0x12, 0x6a, 0xbc, 0x80, 0x85, 0xf4, 0x02, 0xd0, 0x5f, 0x7c, 0x04, 0x15, 0x3f, 0x84, 0x28, 0x19,
+	0xdd, 0x1a, 0x94, 0x51, 0x44, 0x66, 0xc6, 0x09, 0x0c, 0x1b, 0xe6, 0x9e, 0xd2, 0x20, 0x71, 0x1d,
+	0x9d, 0xd2, 0xf8, 0xe9, 0x6f, 0xc0, 0xcc, 0x81, 0x0c, 0xdf, 0xf1, 0x40, 0x67, 0x11, 0xa1, 0xa2,
+	0x60, 0xfc, 0xbc, 0xfe, 0x34, 0x0b, 0x79, 0x5c, 0x75, 0x3a, 0x35, 0x85, 0xe1, 0x07, 0xa3, 0x24,
+	0xf0, 0x58, 0x2c, 0xbe, 0x90, 0x90, 0x96, 0x1d, 0x1e, 0xef, 0xec, 0x68, 0xef, 0x93, 0x3b, 0xdf,
+	0xfb, 0xe4, 0x93, 0xde, 0x27, 0xae, 0xbe, 0x85, 0xa4, 0xfa, 0xa6, 0x3c, 0x53, 0x31, 0xed, 0x99,
+	0x96, 0xa5, 0x67, 0x2a, 0x8d, 0xbf, 0x41, 0x70, 0x3c, 0x3e, 0x1c, 0xb5, 0x0f, 0xa9, 0xd4, 0x80,
+	0x32, 0x6a, 0x40, 0x99, 0x43, 0xc4, 0xd6, 0xdf, 0x84, 0x72, 0x97, 0x9c, 0xca, 0x5e, 0xc0, 0xde,
+	0x52, 0x97, 0x9c, 0x8a, 0xce, 0x54, 0x4c, 0x53, 0xb9, 0x4c, 0x4c, 0x33, 0x33, 0xcd, 0xf9, 0x36,
+	0x36, 0xa1, 0x8c, 0x3b, 0x85, 0xda, 0xf5, 0x75, 0x28, 0xa0, 0x16, 0x2b, 0xc5, 0x9a, 0x8f, 0x2b,
+	0x16, 0xa2, 0x99, 0x12, 0x61, 0xe4, 0xd1, 0xfb, 0xc3, 0x0c, 0x54, 0xc3, 0x94, 0x07, 0x0e, 0xba,
+	0x0e, 0x15, 0x71, 0x34, 0xb8, 0x0a, 0xa9, 0x91, 0xdf, 0x1c, 0x18, 0x59, 0xe1, 0x47, 0x2d, 0x13,
+	0x0e, 0xd5, 0xe7, 0xc8, 0xf9, 0x96, 0xfe, 0x4a, 0x93, 0x0b, 0xe0, 0x68, 0xaf, 0xcc, 0x86, 0x1a,
+	0x8f, 0x95, 0xa9, 0x9b, 0x05, 0xd8, 0xd9, 0xdb, 0x6e, 0x9a, 0x8d, 0xf5, 0xe7, 0xad, 0xcd, 0xda,
+	0x6b, 0x7a, 0x19, 0xf2, 0xe2, 0x53, 0xe3, 0x56, 0xf0, 0x79, 0xf3, 0xf9, 0x6a, 0xd3, 0xac, 0x65,
+	0xf4, 0x1a, 0xcc, 0x7c, 0xba, 0xd5, 0xda, 0x6c, 0x9b, 0xcd, 0xcf, 0xf6, 0x9a, 0x3b, 0xbb, 0xb5,
+	0xac, 0xf1, 0x3b, 0x1a, 0xdc, 0x6a, 0x75, 0x7b, 0x1e, 0x0b, 0x2f, 0xbf, 0xa9, 0x20, 0xe4, 0x82,
+	0x17, 0xe7, 0x77, 0x21, 0xcf, 0xa8, 0x2f, 0xb3, 0xe9, 0xe7, 0xeb, 0xa9, 0x40, 0x34, 0xbe, 0x05,
+	0x35, 0x1e, 0x52, 0x4d, 0x1a, 0xbb, 0xfc, 0x2a, 0x5c, 0xe7, 0xe8, 0xbb, 0x5e, 0x1f, 0x0d, 0x80,
+	0x1b, 0x28, 0x9a, 0x37, 0xa1, 0x1a, 0x84, 0xc0, 0x88, 0x70, 0x26, 0x02, 0xb6, 0x6c, 0xe3, 0x39,
+	0x5c, 0x7f, 0xe6, 0x58, 0xc7, 0x57, 0x95, 0x05, 0xfb, 0xdf, 0x2c, 0xcc, 0x0f, 0xc4, 0x50, 0x13,
+	0x06, 0x4f, 0x7c, 0x5c, 0xef, 0xa5, 0x4b, 0x63, 0xa6, 0xa7, 0x88, 0xed, 0x96, 0xad, 0x3f, 0x4a,
+	0x5d, 0xc6, 0xb8, 0x51, 0x4d, 0x0b, 0x72, 0x27, 0x60, 0x8e, 0x7b, 0x28, 0x44, 0x19, 0x62, 0x73,
+	0xdf, 0xee, 0x5b, 0x1e, 0x13, 0xa9, 0xcc, 0xac, 0x29, 0x1a, 0xdc, 0xee, 0xf8, 0xfd, 0x7d, 0xd1,
+	0x91, 0xc7, 0x8e, 0xb0, 0xcd, 0x2d, 0x81, 0xdb, 0xef, 0xb6, 0x45, 0x67, 0x41, 0x58, 0x02, 0xb7,
+	0xdf, 0xdd, 0x51, 0x84, 0xa1, 0xc1, 0x2a, 0xa6, 0x0c, 0x56, 0xca, 0x4a, 0x94, 0x2e, 0x63, 0x25,
+	0xca, 0x53, 0x45, 0x01, 0x1f, 0x40, 0x85, 0x9e, 0xf6, 0x1c, 0x26, 0x6b, 0x26, 0x30, 0x9e, 0x58,
+	0xa0, 0x23, 0xb1, 0x0e, 0x39, 0x46, 0xdc, 0x63, 0xb4, 0x6a, 0x59, 0x13, 0xbf, 0x75, 0x03, 0xaa,
+	0xdc, 0x1a, 0x46, 0x72, 0xe0, 0x56, 0xab, 0x6a, 0x56, 0xba, 0xe4, 0x74, 0x53, 0x8a, 0xc2, 0xf8,
+	0x77, 0x0d, 0xae, 0x0f, 0xec, 0x35, 0x9a, 0x94, 0x87, 0x50, 0x64, 0xd8, 0x52, 0xe6, 0x24, 0x91,
+	0x8a, 0x19, 0xa0, 0x31, 0x15, 0xb6, 0xbe, 0x0a, 0x55, 0xa1, 0x01, 0x8a, 0x3c, 0x33, 0x09, 0xf9,
+	0x0c, 0xd2, 0x98, 0x72, 0x8c, 0xd4, 0xd5, 0x2b, 0x3b, 0xee, 0xea, 0x95, 0x1b, 0xb8, 0x7a, 0x2d,
+	0xa3, 0x0e, 0x9f, 0x4c, 0x7c, 0x7b, 0xf8, 0x4d, 0xb8, 0xb6, 0xe1, 0xb8, 0xc7, 0x57, 0x94, 0x69,
+	0x9b, 0x36, 0x33, 0xf6, 0x8f, 0x1a, 0x2c, 0x71, 0xa9, 0x27, 0xef, 0xa2, 0xe1, 0x39, 0x1e, 0x93,
+	0x50, 0x78, 0x0f, 0xf2, 0x1d, 0xa7, 0xeb, 0x04, 0x13, 0xd9, 0x5a, 0xc4, 0xd4, 0x7f, 0x19, 0x8a,
+	0x07, 0x1e, 0x7b, 0x49, 0x98, 0x5d, 0xcf, 0x8e, 0xe5, 0x51, 0xa1, 0xc6, 0x1c, 0x44, 0x2e, 0xe1,
+	0x90, 0x3e, 0x83, 0x5b, 0x9c, 0xfb, 0x74, 0x58, 0xe4, 0x47, 0xe9, 0x28, 0xc9, 0xa0, 0x36, 0x29,
+	0x83, 0xc6, 0x1f, 0x68, 0xa0, 0x47, 0x63, 0x5e, 0x62, 0xa4, 0x8b, 0x44, 0xf3, 0xd1, 0x3a, 0xb3,
+	0x89, 0x75, 0x32, 0x98, 0xe7, 0x3c, 0xa1, 0x4e, 0xf9, 0xe7, 0xdd, 0xe6, 0x47, 0x78, 0xd2, 0x88,
+	0xfd, 0xec, 0xc4, 0x82, 0xf8, 0xb1, 0x06, 0xb7, 0xc2, 0x49, 0xa7, 0xbc, 0xa6, 0x5f, 0x40, 0x31,
+	0x46, 0x2d, 0x7d, 0x05, 0xae, 0x87, 0x5c, 0x4c, 0xe8, 0x63, 0x78, 0x12, 0xf5, 0x3e, 0x27, 0x1a,
+	0x38, 0xed, 0x7e, 0x83, 0x79, 0x7d, 0xd7, 0xde, 0x12, 0x47, 0x7e, 0x9a, 0xcb, 0xb9, 0xbe, 0x92,
+	0x5c, 0xd2, 0xa0, 0x07, 0xd9, 0x1b, 0x5c, 0x53, 0xdc, 0x27, 0x65, 0x13, 0x3e, 0xc9, 0xf8, 0x3b,
+	0x0d, 0x6e, 0x0f, 0x67, 0x71, 0x4a, 0xbe, 0x6e, 0x42, 0x59, 0xcd, 0xa1, 0x1c, 0x6a, 0x49, 0x4e,
+	0xe2, 0x5f, 0x60, 0xdb, 0x47, 0x1e, 0xb5, 0xff, 0xc9, 0x88, 0x73, 0xf1, 0x9c, 0x04, 0xd6, 0x11,
+	0xbd, 0xcc, 0xb9, 0x78, 0x0c, 0x55, 0x5e, 0x23, 0xf4, 0x98, 0x13, 0x90, 0xc0, 0x39, 0x99, 0x24,
+	0xad, 0x9a, 0x24, 0xc0, 0xbd, 0x20, 0xfb, 0xb4, 0x33, 0x91, 0x37, 0x17, 0xa8, 0x98, 0x8c, 0x73,
+	0xdc, 0xb6, 0xef, 0x7c, 0xa9, 0x0a, 0x93, 0xe7, 0xf2, 0x5a, 0xec, 0x3a, 0xee, 0x8e, 0xf3, 0x25,
+	0x45, 0x3a, 0x72, 0x2a, 0xe8, 0xf2, 0x93, 0xd0, 0x91, 0x53, 0xa4, 0x5b, 0x81, 0xfc, 0x0f, 0xfb,
+	0x94, 0x9d, 0xd5, 0x0b, 0x93, 0xf0, 0x88, 0xa8, 0xc6, 0x01, 0xd4, 0x51, 0xc4, 0xb1, 0x8b, 0x68,
+	0x28, 0xe8, 0xd7, 0xa1, 0x28, 0xe3, 0x26, 0xa9, 0x07, 0x05, 0x11, 0x36, 0x5d, 0xe0, 0xac, 0x19,
+	0xa7, 0x62, 0x9e, 0xa1, 0x69, 0xa6, 0x0b, 0x6c, 0xe8, 0xd7, 0xa1, 0x66, 0x11, 0xeb, 0x88, 0x92,
+	0xfd, 0x0e, 0x4d, 0x26, 0x2d, 0xe7, 0x42, 0xb8, 0xf4, 0x8e, 0x04, 0x16, 0xf8, 0xcc, 0xdb, 0x7d,
+	0x66, 0x1d, 0x11, 0xff, 0x52, 0x6a, 0x34, 0xea, 0x92, 0xf2, 0x67, 0x1a, 0x2c, 0xf2, 0x39, 0x86,
+	0xe7, 0xab, 0x46, 0x8a, 0x31, 0x99, 0x33, 0xcb, 0x0c, 0xe4, 0xcc, 0xae, 0xf0, 0x28, 0xfd, 0x89,
+	0x06, 0x6f, 0x73, 0x0e, 0xe3, 0x51, 0xf7, 0x28, 0xeb, 0x34, 0x49, 0x1c, 0x7e, 0xd5, 0xb6, 0xe9,
+	0x6f, 0xa4, 0xe5, 0x1f, 0xe0, 0x6f, 0x2a, 0xa6, 0xbe, 0x2a, 0xc3, 0xf4, 0x5f, 0x19, 0xb8, 0x91,
+	0xe4, 0x36, 0xe4, 0x73, 0x0d, 0x66, 0x2d, 0x12, 0xd0, 0x43, 0x8f, 0x9d, 0xb5, 0xfd, 0x80, 0x30,
+	0xa5, 0x5e, 0xe7, 0x0b, 0xa8, 0xaa, 0x68, 0x76, 0x38, 0x89, 0xfe, 0x31, 0xcc, 0x84, 0x83, 0x50,
+	0xd7, 0x9e, 0x48, 0xc6, 0x15, 0x45, 0xd1, 0x74, 0xf9, 0x9b, 0x24, 0xc0, 0xc9, 0xe3, 0x19, 0xba,
+	0xf3, 0xc9, 0xcb, 0x88, 0x8f, 0xf1, 0xf5, 0x43, 0x28, 0xf1, 0x44, 0x55, 0xec, 0x3d, 0xc5, 0xf9,
+	0xa4, 0x45, 0xea, 0xda, 0x48, 0x18, 0x4a, 0xb8, 0x70, 0x01, 0x09, 0x97, 0x12, 0x12, 0x7e, 0x57,
+	0xb8, 0x60, 0xee, 0x7d, 0x93, 0x11, 0xc8, 0xa8, 0xc3, 0x64, 0xfc, 0xbe, 0x06, 0x79, 0x74, 0x14,
+	0x5c, 0xcd, 0xba, 0xfc, 0x23, 0xe6, 0xa5, 0xb1, 0xdd, 0xe2, 0x39, 0xd1, 0x21, 0x7e, 0xa0, 0x74,
+	0x15, 0xb6, 0x9e, 0x3f, 0xc1, 0x51, 0x76, 0x3e, 0x6f, 0xe2, 0xb7, 0xf1, 0x08, 0xca, 0xc8, 0x11,
+	0xde, 0x31, 0xde, 0x01, 0xc1, 0x05, 0x1d, 0x9a, 0x0c, 0x41, 0x3c, 0x53, 0x61, 0x18, 0xff, 0xad,
+	0xc1, 0x4c, 0xdc, 0x54, 0x0e, 0xe4, 0xbd, 0xea, 0x50, 0xf4, 0xfb, 0x68, 0x66, 0xd4, 0xcd, 0x53,
+	0x36, 0xe3, 0x85, 0xae, 0x6c, 0xb2, 0xd0, 0xa5, 0xcb, 0x62, 0x9b, 0x64, 0x71, 0xb0, 0x9e, 0x96,
+	0x4f, 0xd5, 0xd3, 0x52, 0xf7, 0xc3, 0xc2, 0x54, 0xf7, 0xc3, 0x3b, 0x89, 0xe2, 0x56, 0x11, 0xe5,
+	0x1c, 0x83, 0x18, 0xbf, 0x05, 0xb5, 0xf8, 0x0a, 0x65, 0x36, 0xb2, 0xea, 0xc6, 0x60, 0x4a, 0x52,
+	0x89, 0x82, 0x69, 0x9c, 0xc8, 0x4c, 0xa2, 0x4f, 0xe3, 0x15, 0xb6, 0xa1, 0xbe, 0xcd, 0xbc, 0xae,
+	0x27, 0x6b, 0x2e, 0x57, 0x90, 0x4a, 0x38, 0x81, 0x19, 0xe5, 0x63, 0x70, 0x31, 0x9b, 0x70, 0xed,
+	0x84, 0x74, 0x1c, 0x9b, 0x04, 0xd4, 0x6e, 0xf7, 0x64, 0xcf, 0xd0, 0x0b, 0xe6, 0x0b, 0x85, 0xa6,
+	0xe8, 0x4d, 0xfd, 0x24, 0x0d, 0x1a, 0x9d, 0x21, 0xfb, 0x3e, 0x5c, 0x33, 0x29, 0xb1, 0x2f, 0x5f,
+	0x90, 0x89, 0x1d, 0xad, 0x6c, 0xe2, 0x68, 0xfd, 0x3a, 0x2c, 0x0e, 0xcc, 0x10, 0x0a, 0xeb, 0xa3,
+	0x21, 0xd5, 0x98, 0xbb, 0xf1, 0xd5, 0x0d, 0x61, 0x2e, 0x5e, 0x8b, 0xf9, 0x04, 0x16, 0x4c, 0xea,
+	0xd3, 0x60, 0x5b, 0xbe, 0x15, 0x54, 0xe3, 0x0e, 0x7d, 0x48, 0x75, 0xee, 0x23, 0xc3, 0x4f, 0x21,
+	0x6b, 0xf6, 0xac, 0x61, 0x47, 0xa5, 0x47, 0xce, 0x3a, 0x1e, 0x51, 0x14, 0xaa, 0xc9, 0x37, 0xf3,
+	0x28, 0x08, 0x7a, 0xfc, 0x09, 0x9c, 0x3a, 0x2b, 0xbc, 0xfd, 0x8c, 0x9e, 0x19, 0xef, 0x42, 0x7d,
+	0x87, 0xba, 0x76, 0xc4, 0x94, 0x4f, 0x83, 0x18, 0x67, 0x83, 0xcf, 0x1f, 0x8d, 0xdf, 0x80, 0xe2,
+	0x0e, 0xf5, 0x79, 0xfd, 0x0a, 0x8f, 0x20, 0x1e, 0x04, 0xc1, 0x46, 0xc9, 0x54, 0xcd, 0xe1, 0x6f,
+	0xc8, 0xf8, 0x21, 0xec, 0xdb, 0xbd, 0xb6, 0xe8, 0x51, 0xa5, 0x7b, 0xbb, 0xb7, 0x8b, 0x9d, 0x6f,
+	0x42, 0x95, 0xd1, 0x03, 0x46, 0xfd, 0x23, 0x89, 0x20, 0x5c, 0xd1, 0x8c, 0x04, 0x22, 0x92, 0xf1,
+	0x19, 0x2c, 0xc8, 0xc9, 0x37, 0xbc, 0x43, 0xaf, 0x1f, 0x9c, 0x2f, 0xc4, 0x81, 0x21, 0x33, 0x43,
+	0x86, 0xfc, 0x16, 0x5c, 0x97, 0x43, 0x9a, 0x02, 0x7c, 0xee, 0x98, 0xc6, 0x7f, 0x66, 0xa0, 0x9a,
+	0xd8, 0xe5, 0x2b, 0x54, 0xc0, 0xa8, 0xde, 0x95, 0x8b, 0xd5, 0xbb, 0xe2, 0x05, 0xc4, 0x7c, 0xa2,
+	0x80, 0x88, 0x65, 0x11, 0xca, 0xba, 0x0e, 0xb2, 0xdf, 0x66, 0x94, 0xd8, 0x32, 0x2f, 0x36, 0x1b,
+	0x81, 0xb9, 0x5a, 0x72, 0x83, 0x11, 0x43, 0x7c, 0xc9, 0x9c, 0x40, 0x3c, 0x00, 0xc8, 0x9b, 0xb1,
+	0x01, 0x3e, 0xe7, 0xe0, 0x9f, 0x5f, 0xb2, 0xcc, 0x78, 0x09, 0xb5, 0x84, 0x64, 0x1b, 0xd6, 0xf1,
+	0x55, 0x96, 0x5b, 0xe3, 0x62, 0xcf, 0x25, 0xce, 0x7d, 0x13, 0xe6, 0xd3, 0x13, 0xfb, 0xfa, 0xbb,
+	0x90, 0x23, 0xd6, 0xf1, 0xd0, 0x52, 0x51, 0x1a, 0xd9, 0x44, 0x4c, 0xa3, 0x09, 0xb3, 0x89, 0x1e,
+	0x9f, 0xbf, 0x29, 0x12, 0x06, 0x40, 0x0d, 0xb3, 0x38, 0x72, 0x18, 0x53, 0x61, 0x1a, 0xdf, 0x4f,
+	0x71, 0x83, 0x46, 0xf6, 0x22, 0x23, 0x8d, 0xb4, 0xa4, 0x7f, 0x99, 0x03, 0x88, 0x42, 0xba, 0x01,
+	0x43, 0xc2, 0x15, 0xdf, 0x09, 0x3a, 0x61, 0xd5, 0x15, 0x1b, 0xe9, 0x72, 0x52, 0x76, 0xb0, 0x9c,
+	0xb4, 0x04, 0x25, 0x15, 0x9b, 0xa1, 0x80, 0xab, 0x66, 0xd8, 0xe6, 0xd9, 0x2e, 0xdf, 0x63, 0x41,
+	0xdb, 0x63, 0x36, 0x65, 0xa8, 0xc6, 0x55, 0xb3, 0xcc, 0x21, 0x5b, 0x1c, 0x10, 0x46, 0x15, 0x05,
+	0xec, 0xc0, 0x6f, 0x7d, 0x31, 0x76, 0x3b, 0x2c, 0x22, 0x3c, 0xbc, 0x00, 0x0e, 0x64, 0x41, 0x4b,
+	0x03, 0x59, 0x50, 0x7c, 0xcb, 0x4e, 0xdc, 0x36, 0xbe, 0x29, 0x43, 0x45, 0x2c, 0x71, 0x76, 0xdc,
+	0x26, 0x6f, 0x73, 0x76, 0x78, 0xe8, 0x47, 0x2c, 0x0c, 0x8e, 0x40, 0xb0, 0x43, 0x5d, 0xbb, 0x81,
+	0x00, 0xde, 0x8d, 0xa9, 0x4a, 0x51, 0x20, 0xa8, 0x88, 0x6e, 0x0e, 0x41, 0xfb, 0x98, 0xc8, 0x35,
+	0xcf, 0x9c, 0x9f, 0x6b, 0xae, 0x4e, 0x75, 0x7c, 0xbe, 0x93, 0x08, 0x67, 0x67, 0xc7, 0xd2, 0xc6,
+	0x82, 0xd9, 0x6f, 0xc7, 0x82, 0xd9, 0xb9, 0xb1, 0x84, 0x61, 0x28, 0xbb, 0x04, 0x25, 0xbb, 0xcf,
+	0x30, 0xac, 0xa8, 0xd7, 0xc4, 0x9e, 0xa9, 0xb6, 0xb1, 0x0f, 0xb3, 0x91, 0x96, 0xa0, 0x16, 0x3e,
+	0x82, 0x4a, 0x74, 0x0f, 0x51, 0x9a, 0x78, 0x23, 0xae, 0x89, 0x11, 0x81, 0x19, 0x47, 0x1d, 0xa9,
+	0x8a, 0xff, 0xa6, 0xc1, 0x42, 0xfa, 0x2e, 0xf4, 0x8b, 0x90, 0xaa, 0xfe, 0xbf, 0x0c, 0x2c, 0xec,
+	0xa1, 0x69, 0x93, 0xf9, 0x64, 0xe5, 0x55, 0xe2, 0x05, 0x13, 0x6d, 0xaa, 0x82, 0xc9, 0xc7, 0x30,
+	0x63, 0x3b, 0x3e, 0xaf, 0x77, 0xb7, 0x91, 0x3a, 0x33, 0x01, 0x75, 0x45, 0x52, 0x6c, 0x12, 0xf1,
+	0x0f, 0x46, 0xac, 0x6e, 0x3b, 0x49, 0xcc, 0x1f, 0xab, 0xea, 0x3e, 0x8c, 0xd5, 0x8a, 0x73, 0x13,
+	0x90, 0x86, 0x95, 0xe4, 0x47, 0x50, 0xea, 0x78, 0x22, 0x70, 0xad, 0xe7, 0x27, 0x20, 0x0c, 0xb1,
+	0x39, 0x25, 0x57, 0xe7, 0x2f, 0x3d, 0x97, 0x4e, 0x94, 0xe9, 0x09, 0xb1, 0x8d, 0x7f, 0xce, 0x80,
+	0x2e, 0xa4, 0x3f, 0x61, 0xa9, 0x80, 0x5b, 0xfb, 0x89, 0x85, 0x8a, 0x98, 0xfc, 0x45, 0x41, 0xda,
+	0x1e, 0x8e, 0xdf, 0x8d, 0x88, 0xe0, 0xe2, 0x02, 0x4d, 0x6e, 0x63, 0x7e, 0xba, 0x6d, 0x54, 0xc5,
+	0xf9, 0xc2, 0x64, 0xc5, 0x79, 0xe3, 0x6f, 0x73, 0x90, 0xc3, 0x0a, 0x71, 0xda, 0x49, 0xc4, 0xdf,
+	0x20, 0x66, 0x52, 0x6f, 0x10, 0xdf, 0x48, 0x69, 0xaa, 0xf2, 0x15, 0x31, 0x5d, 0x1c, 0xf3, 0xba,
+	0xed, 0xfc, 0x97, 0x09, 0xa1, 0x3e, 0xc9, 0x97, 0x09, 0xaa, 0xcd, 0xfb, 0x42, 0x8d, 0x91, 0x45,
+	0x40, 0xd5, 0x4e, 0x18, 0xed, 0x52, 0xca, 0x68, 0xdf, 0x85, 0x4a, 0xec, 0x69, 0x06, 0x7a, 0x8b,
+	0xb2, 0x09, 0xd1, 0xcb, 0x0c, 0xee, 0x4c, 0x84, 0xa4, 0x78, 0x37, 0x08, 0x6a, 0x01, 0x68, 0xd9,
+	0x3c, 0xcc, 0x3c, 0x24, 0x5d, 0x7c, 0x81, 0x22, 0xa2, 0x8b, 0x8a, 0x08, 0x33, 0x23, 0xa0, 0xb8,
+	0x50, 0xf9, 0x01, 0x25, 0xf8, 0x67, 0xd5, 0x8c, 0xbc, 0xc9, 0xf2, 0x76, 0x0b, 0x2b, 0x30, 0x9e,
+	0xcb, 0x5f, 0xed, 0xa1, 0xb7, 0x28, 0x99, 0xb2, 0x95, 0x7a, 0x18, 0x31, 0x9b, 0x7e, 0x18, 0x91,
+	0xf2, 0x34, 0x73, 0x97, 0x09, 0xd4, 0x6a, 0x53, 0x55, 0x35, 0x17, 0xa1, 0x44, 0xf8, 0x3f, 0x11,
+	0x7c, 0x2d, 0xf3, 0x62, 0x2d, 0xd8, 0x6e, 0xd9, 0xc6, 0x6f, 0x67, 0xa0, 0x1a, 0x26, 0x33, 0xd4,
+	0x33, 0x06, 0x8c, 0xba, 0x12, 0x0f, 0x24, 0xde, 0x4c, 0xbf, 0x30, 0x08, 0xf1, 0xa3, 0x96, 0x09,
+	0x7d, 0xf5, 0xe9, 0x2f, 0xfd, 0x4c, 0x83, 0x72, 0xd8, 0xa3, 0xbf, 0x0d, 0x79, 0x1c, 0x4e, 0x5a,
+	0xd0, 0x21, 0xcf, 0x2d, 0x44, 0xff, 0xcf, 0xe7, 0xc5, 0xc2, 0x03, 0xc8, 0x8b, 0xb7, 0x17, 0xbf,
+	0x04, 0xf9, 0xf8, 0xdb, 0x8d, 0xc1, 0x67, 0x15, 0xa2, 0xdb, 0x78, 0x04, 0xb7, 0xd4, 0xd5, 0x58,
+	0x5d, 0x83, 0x13, 0x7f, 0xa9, 0xd4, 0xd1, 0x17, 0x52, 0xa7, 0x17, 0x28, 0xb3, 0x25, 0x9b, 0xc6,
+	0x07, 0x70, 0x3b, 0x4d, 0x99, 0x7c, 0xd5, 0xce, 0xef, 0x91, 0xb2, 0x23, 0xfc, 0xd1, 0x47, 0xb6,
+	0x8d, 0x2f, 0x06, 0x89, 0x3f, 0xe9, 0x93, 0x97, 0xd4, 0x99, 0x80, 0x38, 0xf9, 0xdb, 0x55, 0x26,
+	0xf5, 0xdb, 0x95, 0xf1, 0x03, 0xa8, 0xa7, 0x87, 0x36, 0xa9, 0xdf, 0xf3, 0x5c, 0x9f, 0x5e, 0x75,
+	0xbe, 0xc0, 0xf8, 0x87, 0x1c, 0xcc, 0x0f, 0x60, 0xf2, 0xc3, 0xd3, 0x63, 0x9e, 0xdd, 0xb7, 0x62,
+	0x49, 0xd4, 0xb2, 0x84, 0xb4, 0xf0, 0xe5, 0x43, 0xc0, 0x88, 0xeb, 0x13, 0xbc, 0x46, 0x44, 0x0f,
+	0x1b, 0xaa, 0x31, 0xa8, 0x28, 0x00, 0xf8, 0x81, 0xc7, 0x84, 0x09, 0x1b, 0xaf, 0x3f, 0x1e, 0xe3,
+	0x6e, 0xba, 0xaa, 0x16, 0x35, 0xf1, 0xaf, 0x5a, 0x8a, 0x40, 0x1d, 0xcd, 0xf8, 0xb9, 0xce, 0x5f,
+	0xe6, 0x5c, 0x17, 0xa6, 0x3a, 0xd7, 0xef, 0xc0, 0xbc, 0xfa, 0x0b, 0xac, 0xcd, 0xe4, 0x76, 0x49,
+	0x3b, 0x5a, 0x53, 0x1d, 0xe1, 0x36, 0x7e, 0x08, 0x15, 0xea, 0x9e, 0x38, 0xcc, 0x73, 0x79, 0xe4,
+	0x56, 0x2f, 0x8d, 0x17, 0x50, 0x1c, 0xdf, 0x78, 0xc6, 0x8f, 0x19, 0x97, 0xd7, 0x35, 0x98, 0x6b,
+	0x6c, 0x6f, 0x6f, 0x34, 0xdb, 0x8d, 0xed, 0xed, 0xf6, 0xce, 0xee, 0x96, 0xd9, 0xac, 0xbd, 0xa6,
+	0x5f, 0x87, 0xf9, 0xa7, 0x5b, 0x5b, 0x4f, 0x37, 0x9a, 0xed, 0xed, 0x8d, 0xc6, 0x17, 0x12, 0xac,
+	0xe9, 0x37, 0x40, 0xff, 0x64, 0xaf, 0xf1, 0x79, 0xb3, 0x85, 0xc8, 0x4f, 0x1b, 0x1b, 0x1b, 0x4d,
+	0xf3, 0x8b, 0x5a, 0xc6, 0x78, 0x08, 0x95, 0x66, 0x34, 0x36, 0x7f, 0x24, 0xb9, 0xb7, 0xf9, 0x6c,
+	0x73, 0xeb, 0x73, 0x7e, 0x6c, 0x2b, 0x50, 0xdc, 0x69, 0x6c, 0xae, 0xaf, 0x6e, 0x7d, 0xaf, 0xa6,
+	0xf1, 0x33, 0xbd, 0x6d, 0x6e, 0xad, 0xef, 0xad, 0xed, 0xb6, 0xb6, 0x36, 0x6b, 0x19, 0xe3, 0x1b,
+	0xa0, 0xbf, 0xc0, 0x9f, 0x54, 0x13, 0xbf, 0x0b, 0x0d, 0xbf, 0xf9, 0xff, 0x24, 0x03, 0xb7, 0xf1,
+	0x8a, 0x7c, 0xc9, 0xb7, 0xc8, 0xfa, 0xf7, 0xa0, 0x20, 0x62, 0x53, 0x69, 0x95, 0x1e, 0xc7, 0x75,
+	0xfe, 0xdc, 0x19, 0x06, 0x03, 0x57, 0x44, 0x37, 0xe5, 0x78, 0x4b, 0x07, 0x70, 0x63, 0x38, 0x46,
+	0xf4, 0xda, 0x46, 0x1b, 0xf5, 0xda, 0x26, 0x93, 0x7a, 0x6d, 0x13, 0xf7, 0x97, 0xd9, 0xd4, 0x03,
+	0xd6, 0x1f, 0x67, 0x40, 0xc7, 0x71, 0x2f, 0x9b, 0x09, 0x09, 0x13, 0x1e, 0xd9, 0x11, 0x09, 0x8f,
+	0x5c, 0xf2, 0x0a, 0xbf, 0x3e, 0x98, 0xf0, 0x98, 0xa0, 0x70, 0x98, 0xce, 0x86, 0x3c, 0x19, 0x92,
+	0x0d, 0x99, 0x20, 0x95, 0x9f, 0x4e, 0x95, 0x18, 0x2f, 0x60, 0x69, 0x50, 0x0a, 0x7e, 0x14, 0xe9,
+	0xa7, 0xae, 0xec, 0x77, 0x06, 0xf6, 0x79, 0x44, 0x06, 0xe0, 0x47, 0x19, 0xb8, 0x85, 0xfd, 0xe9,
+	0x9b, 0xd1, 0x54, 0x45, 0xa2, 0x17, 0x29, 0x35, 0xfb, 0x68, 0x60, 0xfa, 0x11, 0xc3, 0x2f, 0xa7,
+	0xe1, 0x49, 0x25, 0xa3, 0x70, 0x7d, 0x28, 0xc2, 0xd5, 0xea, 0xd8, 0xea, 0x87, 0xb0, 0x68, 0x79,
+	0xdd, 0xe5, 0x23, 0xca, 0x3c, 0xc7, 0xea, 0x90, 0x7d, 0x3f, 0xc6, 0xfe, 0x6a, 0x79, 0x13, 0xbf,
+	0x1b, 0x3d, 0x67, 0x5b, 0xfb, 0xb5, 0x2c, 0xe9, 0x39, 0x7f, 0x91, 0xc9, 0x6d, 0x3e, 0xdb, 0x5e,
+	0xfd, 0xeb, 0x4c, 0x41, 0xf4, 0xec, 0x17, 0x70, 0x07, 0xdf, 0xff, 0xff, 0x01, 0x00, 0xae, 0x1b,
+	0xd2, 0x0a, 0xc4, 0x3f, 0x00, 0x00,
 }
@@ -29,132 +29,169 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("apigrpc/apigrpc.proto", fileDescriptor_84e2d31978c605c7) }
 
 var fileDescriptor_84e2d31978c605c7 = []byte{
-	// 1999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x2e, 0xbd, 0x45, 0x3e, 0x46, 0xfe, 0x1c, 0x7f, 0x24, 0x96, 0xed, 0x44, 0xe6, 0x3a, 0xd9,
-	0xac, 0xba, 0x2b, 0x3a, 0x4e, 0xdb, 0xa0, 0xbe, 0x74, 0x65, 0x67, 0xed, 0xec, 0xc6, 0xeb, 0x0d,
-	0x9c, 0x4d, 0x03, 0x04, 0x28, 0xd2, 0xd1, 0x70, 0x2c, 0x31, 0x92, 0x38, 0x0c, 0x39, 0x72, 0x1a,
-	0x18, 0xc1, 0x02, 0x05, 0x8a, 0x1e, 0x7a, 0x59, 0x64, 0x8b, 0x9e, 0x7a, 0xca, 0xb1, 0xc7, 0x5e,
-	0x7a, 0x68, 0xff, 0x45, 0xff, 0x42, 0x7f, 0xc8, 0x62, 0x3e, 0x28, 0xce, 0x88, 0x43, 0xd1, 0x71,
-	0x90, 0x93, 0x6c, 0x3e, 0x2f, 0xdf, 0xe7, 0x11, 0xe7, 0x7d, 0xdf, 0x79, 0x38, 0x02, 0x8b, 0x28,
-	0x0a, 0xda, 0x71, 0x84, 0x3d, 0xf5, 0xd9, 0x88, 0x62, 0xca, 0x28, 0x04, 0x21, 0xea, 0xa2, 0x3e,
-	0x6a, 0xa0, 0x28, 0xa8, 0xae, 0xb6, 0x29, 0x6d, 0xf7, 0x08, 0x8f, 0xf0, 0x50, 0x18, 0x52, 0x86,
-	0x58, 0x40, 0xc3, 0x44, 0x46, 0x56, 0x57, 0x14, 0x2a, 0xfe, 0x6b, 0x0d, 0x8e, 0x3d, 0xd2, 0x8f,
-	0xd8, 0x2b, 0x05, 0x7e, 0x26, 0x3e, 0xf0, 0xe7, 0x6d, 0x12, 0x7e, 0x9e, 0xbc, 0x44, 0xed, 0x36,
-	0x89, 0x3d, 0x1a, 0x89, 0xdb, 0x2d, 0xa9, 0xea, 0xed, 0x80, 0x75, 0x06, 0xad, 0x06, 0xa6, 0x7d,
-	0xaf, 0x43, 0x62, 0x1a, 0xe0, 0x1e, 0x6a, 0x25, 0x9e, 0x94, 0x22, 0xe9, 0xa3, 0x40, 0xc6, 0x6e,
-	0xfd, 0xe7, 0xd7, 0xe0, 0xc2, 0xa1, 0x00, 0xe0, 0x13, 0x00, 0x9a, 0xbe, 0xbf, 0x17, 0x07, 0x24,
-	0xf4, 0x13, 0xb8, 0xd6, 0xc8, 0xa4, 0x37, 0xb2, 0xeb, 0x47, 0xe4, 0xc5, 0x80, 0x24, 0xac, 0xba,
-	0xd4, 0x90, 0x7a, 0x1b, 0xa9, 0xde, 0xc6, 0x97, 0x5c, 0xaf, 0x0b, 0xff, 0xf4, 0xbf, 0xff, 0xff,
-	0x38, 0x31, 0xe9, 0x02, 0xef, 0x64, 0xcb, 0x3b, 0x16, 0xf7, 0xc0, 0x2e, 0x98, 0x6a, 0xfa, 0xfe,
-	0x7e, 0x4c, 0x07, 0xd1, 0xe3, 0x84, 0xc4, 0x09, 0xac, 0x8d, 0xe4, 0xce, 0xa0, 0xb2, 0xf4, 0x35,
-	0x91, 0xbe, 0xea, 0x5e, 0xe5, 0xe9, 0xdb, 0xfc, 0x36, 0xef, 0x54, 0x7c, 0x3c, 0x0b, 0xfc, 0xd7,
-	0x1e, 0xf2, 0x7d, 0xf8, 0x77, 0x07, 0xc0, 0xe6, 0x80, 0x75, 0x48, 0xc8, 0x02, 0x8c, 0x18, 0xd9,
-	0x1d, 0x24, 0x8c, 0xf6, 0xe1, 0x0d, 0x83, 0x32, 0x87, 0xa7, 0xbc, 0xf3, 0x7a, 0xd8, 0x23, 0x92,
-	0x24, 0x01, 0x0d, 0xdd, 0x7b, 0x6f, 0x9a, 0x73, 0xad, 0x19, 0x30, 0x05, 0x2e, 0xef, 0xa0, 0x24,
-	0xc0, 0xfc, 0x6e, 0xf8, 0x33, 0x21, 0xa4, 0xee, 0x5e, 0xe7, 0x42, 0x10, 0xc6, 0x74, 0x10, 0x32,
-	0x0f, 0x69, 0x79, 0x3d, 0x2c, 0x12, 0x6f, 0x5f, 0x54, 0x60, 0x4e, 0xd8, 0x3d, 0x72, 0x12, 0x60,
-	0x52, 0x2c, 0x4c, 0xe2, 0x1f, 0x40, 0x98, 0x2f, 0x12, 0x67, 0xc2, 0x7e, 0x74, 0xc0, 0x9c, 0x4e,
-	0xfc, 0x65, 0x1f, 0x05, 0x3d, 0xb8, 0x51, 0xa4, 0x4b, 0xc0, 0x63, 0x65, 0xed, 0x16, 0xca, 0xfa,
-	0xd4, 0xbd, 0x56, 0x28, 0x8b, 0xf0, 0xbc, 0x99, 0xaa, 0x7f, 0x38, 0x60, 0x41, 0xa7, 0xdd, 0x43,
-	0x98, 0xb4, 0x28, 0xed, 0xc2, 0x4f, 0x8a, 0x84, 0xa5, 0x11, 0x63, 0xb5, 0xed, 0x15, 0x6a, 0xfb,
-	0xcc, 0x5d, 0x2f, 0xd4, 0x76, 0xac, 0x52, 0x67, 0xf2, 0xde, 0x3a, 0x60, 0x49, 0x27, 0xdf, 0x47,
-	0x7d, 0xb2, 0x4b, 0x42, 0x46, 0x62, 0xf8, 0x69, 0x91, 0xc0, 0x2c, 0x66, 0xac, 0xc4, 0xfb, 0x85,
-	0x12, 0x1b, 0xee, 0xc7, 0x85, 0x12, 0xdb, 0xa8, 0x4f, 0xb0, 0x48, 0x5e, 0x5c, 0x72, 0xfb, 0xa2,
-	0xa7, 0x8a, 0x4b, 0x4e, 0xe2, 0x1f, 0xa0, 0xe4, 0x64, 0x33, 0x17, 0x97, 0xdc, 0x23, 0x46, 0x50,
-	0xbf, 0xb8, 0xe4, 0x04, 0xfc, 0x01, 0x4a, 0x2e, 0xe1, 0x79, 0x33, 0x55, 0x08, 0x4c, 0xee, 0xf4,
-	0x28, 0xee, 0xa6, 0x23, 0xf0, 0xba, 0xce, 0xa4, 0x23, 0x65, 0x53, 0xea, 0xaa, 0x60, 0x86, 0xee,
-	0x6c, 0x36, 0x04, 0xbd, 0x16, 0xbf, 0x1f, 0xfe, 0x0e, 0x54, 0x76, 0x63, 0xc2, 0x1f, 0x35, 0x1f,
-	0x5a, 0xf0, 0x9a, 0xce, 0xa0, 0x01, 0x29, 0xc1, 0x9c, 0x8e, 0x0b, 0xc4, 0x5d, 0x10, 0xb9, 0xa7,
-	0xdd, 0xcb, 0xc3, 0x09, 0xb8, 0xed, 0xd4, 0xe1, 0xef, 0xc1, 0xd4, 0x3d, 0xd2, 0x23, 0x8c, 0xa4,
-	0xda, 0x8d, 0x11, 0x6b, 0x40, 0x67, 0x9c, 0xe0, 0x75, 0x7d, 0x82, 0x63, 0x50, 0x91, 0x39, 0x2c,
-	0xb2, 0x35, 0xa0, 0x2c, 0xf5, 0xaa, 0x48, 0xbd, 0x54, 0x5f, 0xb0, 0x4d, 0x6f, 0xf8, 0x17, 0x07,
-	0x5c, 0x91, 0xc9, 0x0e, 0x08, 0xf2, 0x49, 0xdc, 0xa2, 0x28, 0xf6, 0x8f, 0x08, 0xa6, 0xb1, 0x0f,
-	0xeb, 0x79, 0xc6, 0x5c, 0x50, 0x19, 0xfb, 0x2d, 0xc1, 0xee, 0xd6, 0x6b, 0x9c, 0xbd, 0x97, 0xdd,
-	0xed, 0x9d, 0x6a, 0xff, 0x08, 0x25, 0x14, 0xcc, 0x4b, 0x8e, 0x43, 0xca, 0x82, 0x63, 0x5e, 0x2b,
-	0x7c, 0x77, 0x85, 0x37, 0xf3, 0x22, 0x8c, 0x80, 0x33, 0x96, 0x45, 0x5d, 0x94, 0x45, 0xa8, 0xdd,
-	0x09, 0x4f, 0xc0, 0x82, 0xcc, 0xf7, 0x88, 0xd1, 0x18, 0xb5, 0xc9, 0xb7, 0xad, 0xe7, 0x04, 0xb3,
-	0xc4, 0x9c, 0x75, 0xb6, 0x88, 0x32, 0xca, 0x35, 0x41, 0x79, 0xa5, 0x0a, 0x39, 0x65, 0x22, 0x6f,
-	0xf5, 0x7c, 0x91, 0x88, 0x97, 0xcd, 0x21, 0x00, 0xfb, 0x84, 0x35, 0x55, 0xfd, 0x17, 0x24, 0x31,
-	0x3b, 0x4e, 0x05, 0xbb, 0xf3, 0x22, 0xf3, 0x14, 0xac, 0x68, 0xdd, 0x05, 0x0f, 0xc0, 0xa5, 0x7d,
-	0xc2, 0xe4, 0x26, 0xbf, 0x62, 0xd4, 0xae, 0xba, 0x6a, 0x2d, 0x6c, 0x81, 0xb8, 0xb3, 0x22, 0x21,
-	0x80, 0x97, 0x78, 0xc2, 0x41, 0x42, 0x62, 0xf8, 0x08, 0x54, 0xee, 0x13, 0xd4, 0x63, 0x1d, 0xdc,
-	0x21, 0xb8, 0x5b, 0x28, 0xaf, 0xe8, 0xbb, 0xab, 0x4e, 0x81, 0x93, 0x5e, 0x47, 0xcb, 0xf2, 0x3d,
-	0x58, 0xfc, 0xaa, 0x1f, 0xd1, 0x98, 0xa5, 0xdb, 0x45, 0xda, 0x31, 0xb7, 0x74, 0x49, 0xd6, 0x90,
-	0xb2, 0x87, 0xbd, 0x21, 0x08, 0xaf, 0xb9, 0xf3, 0x5a, 0xdb, 0xe7, 0x77, 0x0e, 0x1f, 0x5c, 0xfe,
-	0x9a, 0x06, 0xa1, 0xec, 0xa4, 0x55, 0x9d, 0x74, 0x78, 0xb9, 0x8c, 0x68, 0x5d, 0x10, 0xad, 0xb8,
-	0xcb, 0x56, 0x17, 0xf4, 0x9c, 0x06, 0x21, 0xfc, 0x23, 0x98, 0xe6, 0xe9, 0xbe, 0xa3, 0x83, 0x38,
-	0x44, 0x7d, 0x12, 0x32, 0xb8, 0x3e, 0x4a, 0x95, 0x61, 0x65, 0x7c, 0xbf, 0x10, 0x7c, 0x37, 0xe4,
-	0xee, 0xc3, 0x86, 0xb7, 0x79, 0xa7, 0xd9, 0xdf, 0x19, 0x73, 0x08, 0xa6, 0x1f, 0x04, 0xb8, 0xab,
-	0xd9, 0x3d, 0x83, 0xd9, 0xc4, 0xde, 0xef, 0x9b, 0x76, 0x03, 0xdc, 0x85, 0x6d, 0x00, 0x0e, 0x08,
-	0x3a, 0x51, 0xa3, 0xc9, 0xb0, 0xad, 0xd9, 0xf5, 0x32, 0x1e, 0x57, 0xf0, 0xac, 0xba, 0x55, 0x2b,
-	0x4f, 0x8f, 0xe7, 0x81, 0x18, 0x80, 0x83, 0x20, 0xec, 0x2a, 0x43, 0xb9, 0x6c, 0x69, 0x0a, 0x09,
-	0x95, 0x92, 0x5c, 0xd1, 0x37, 0xa4, 0x5e, 0x10, 0x76, 0x53, 0xaf, 0xe8, 0xd4, 0x53, 0x12, 0x65,
-	0x0e, 0x6d, 0x24, 0x12, 0x3a, 0x07, 0x89, 0xf2, 0x7d, 0x4e, 0x1d, 0xfe, 0x01, 0x5c, 0xe6, 0x24,
-	0xd2, 0xe8, 0x5d, 0xb5, 0x70, 0x08, 0xa4, 0x74, 0x51, 0x96, 0x72, 0x14, 0xd2, 0xc3, 0x39, 0x75,
-	0x98, 0x80, 0x49, 0xce, 0x30, 0x34, 0x6d, 0xc6, 0x56, 0xaa, 0x23, 0x65, 0x0b, 0x53, 0x17, 0x5c,
-	0x1b, 0xb2, 0x00, 0x0c, 0xae, 0x7c, 0x67, 0x51, 0x30, 0xcd, 0x53, 0x6b, 0x56, 0x6c, 0xcd, 0xf2,
-	0xdd, 0x32, 0xb8, 0x90, 0xf4, 0xa6, 0x20, 0xad, 0xb9, 0x2b, 0x39, 0x52, 0xcd, 0x65, 0x65, 0x8b,
-	0xa5, 0x6c, 0x95, 0x6d, 0xb1, 0x24, 0x74, 0x8e, 0xc5, 0x52, 0x8e, 0x29, 0x5b, 0x2c, 0x69, 0x91,
-	0x6c, 0x8b, 0x25, 0x90, 0x73, 0x2c, 0x96, 0x74, 0x3f, 0x4e, 0x1d, 0x7e, 0x0f, 0xe6, 0x0f, 0x82,
-	0x84, 0xed, 0x76, 0x50, 0x18, 0x92, 0xde, 0x37, 0x24, 0x49, 0x50, 0x9b, 0x8c, 0x6c, 0x77, 0x96,
-	0x80, 0x74, 0xe9, 0x4c, 0x13, 0x63, 0xc4, 0xf0, 0xbb, 0xd2, 0x77, 0x36, 0x28, 0xde, 0xd9, 0xb0,
-	0xc4, 0xbd, 0x53, 0xf5, 0x87, 0xd8, 0x6f, 0x0f, 0x41, 0x85, 0x47, 0xa6, 0x93, 0xf8, 0x4c, 0xfb,
-	0x90, 0x0a, 0x4e, 0xed, 0x0a, 0xd4, 0xed, 0xca, 0x63, 0xbe, 0x2e, 0x09, 0x13, 0x9d, 0x3f, 0xf2,
-	0x26, 0x9b, 0x5d, 0x4f, 0xe5, 0x2f, 0xe6, 0x3c, 0x96, 0x50, 0x3d, 0x27, 0xf2, 0x56, 0x60, 0xe6,
-	0xb3, 0xe0, 0x0b, 0x5e, 0x5f, 0xea, 0x76, 0xcb, 0x64, 0x33, 0xb1, 0x34, 0xfd, 0x72, 0x2e, 0x3d,
-	0x87, 0x05, 0x85, 0x5a, 0x1a, 0x68, 0x1f, 0x6e, 0x62, 0x0b, 0xfc, 0xc1, 0x01, 0x4b, 0x3c, 0x36,
-	0x67, 0x76, 0x12, 0xf3, 0x35, 0xc3, 0x1e, 0x93, 0x6a, 0x58, 0x1f, 0x19, 0x8a, 0x66, 0x98, 0xd0,
-	0xa2, 0xcc, 0x11, 0x2c, 0x37, 0x47, 0xff, 0x76, 0xc0, 0xba, 0x9d, 0xae, 0x19, 0xd3, 0x41, 0xe8,
-	0x7f, 0xfb, 0x32, 0x24, 0x31, 0xfc, 0x65, 0xb9, 0x3a, 0x2d, 0xfc, 0x1d, 0x84, 0xfe, 0x46, 0x08,
-	0xbd, 0x03, 0x6f, 0x97, 0x09, 0xf5, 0x28, 0xcf, 0xec, 0x9d, 0x8a, 0x0f, 0xa1, 0xfc, 0x89, 0x2c,
-	0xb3, 0x6f, 0x10, 0xc3, 0x1d, 0x92, 0x98, 0x2e, 0x56, 0x03, 0xac, 0x85, 0x21, 0xb0, 0x7c, 0x61,
-	0xf4, 0xf9, 0x65, 0xf8, 0x02, 0xcc, 0x71, 0xc8, 0x74, 0x8b, 0x1b, 0xa3, 0xe9, 0xad, 0x5e, 0xd1,
-	0x30, 0x00, 0x7a, 0x84, 0xe0, 0x52, 0x8e, 0x11, 0xe6, 0x1d, 0xe3, 0x5b, 0x07, 0x40, 0x1e, 0x32,
-	0x62, 0x18, 0x6f, 0x8c, 0x92, 0xda, 0xed, 0xa2, 0xd1, 0x12, 0x46, 0x88, 0xa0, 0xdd, 0x13, 0xb4,
-	0x5f, 0xc8, 0x8e, 0x4d, 0x5d, 0xe3, 0x29, 0xa6, 0xbd, 0x1e, 0xc1, 0x9c, 0xfd, 0xf5, 0xd3, 0x0d,
-	0xe8, 0x16, 0x61, 0xde, 0x29, 0xaf, 0x5c, 0xf1, 0xc0, 0x03, 0x30, 0xc3, 0xf3, 0x65, 0x46, 0x23,
-	0x81, 0xee, 0xa8, 0x40, 0x0d, 0x4c, 0xd5, 0x55, 0xf5, 0x98, 0x0c, 0x17, 0xd2, 0x96, 0x84, 0xb4,
-	0x59, 0x38, 0x6d, 0x5a, 0x11, 0xf8, 0x57, 0x07, 0x2c, 0x9a, 0xe9, 0xd2, 0x3e, 0xb9, 0x55, 0xcc,
-	0x38, 0xd2, 0x26, 0x35, 0x3b, 0xaf, 0x56, 0x7c, 0x6a, 0x63, 0x80, 0xd7, 0xc6, 0x1b, 0x21, 0xf8,
-	0x2f, 0x07, 0xd4, 0xac, 0x54, 0x7a, 0x8b, 0xdc, 0x29, 0x15, 0x66, 0xe9, 0x90, 0x72, 0x8d, 0x77,
-	0x85, 0xc6, 0xdb, 0xd0, 0x2b, 0x31, 0x6b, 0xb9, 0xf6, 0x88, 0xe4, 0x78, 0xe3, 0xe3, 0x49, 0x4d,
-	0xce, 0xdc, 0x78, 0xcb, 0x30, 0xeb, 0x78, 0x1b, 0xc2, 0xf9, 0xb9, 0xcf, 0x6b, 0x22, 0xab, 0x0c,
-	0x35, 0x50, 0x5f, 0x82, 0xb9, 0x87, 0x31, 0xed, 0x53, 0xf5, 0xfa, 0x28, 0x67, 0xaa, 0xd1, 0x37,
-	0x39, 0xf8, 0xac, 0x1e, 0x7c, 0xd5, 0x3a, 0x53, 0x23, 0x99, 0x0e, 0x52, 0x00, 0x8f, 0x08, 0xf2,
-	0xc7, 0x35, 0x4f, 0x1e, 0xb7, 0x96, 0xa7, 0x19, 0x92, 0x96, 0xa7, 0x5b, 0xd1, 0xba, 0x43, 0x6e,
-	0xb1, 0x17, 0x8f, 0x22, 0xbc, 0x37, 0x08, 0x31, 0x9c, 0x31, 0x58, 0x22, 0x5c, 0x1d, 0xbd, 0xe0,
-	0x1e, 0xbd, 0x69, 0xba, 0xad, 0x1a, 0x98, 0x01, 0x95, 0xfb, 0x8c, 0x45, 0x0f, 0xc8, 0x2b, 0x79,
-	0x9c, 0x21, 0x4e, 0x37, 0x08, 0x8a, 0x49, 0xfc, 0xf5, 0x4b, 0xa6, 0x4e, 0x37, 0x3e, 0x71, 0x27,
-	0x39, 0x53, 0x1c, 0x61, 0xef, 0x34, 0xf0, 0x5f, 0x6f, 0x5f, 0x8c, 0xd0, 0xab, 0x1e, 0x45, 0xfe,
-	0x53, 0xfe, 0xda, 0xa3, 0x01, 0xb0, 0x0d, 0x26, 0x1f, 0x87, 0xbd, 0xf7, 0xb2, 0xaf, 0xe9, 0xa3,
-	0x35, 0xac, 0xd8, 0x20, 0x1c, 0x31, 0xb0, 0x43, 0xa2, 0xf3, 0x5b, 0xd8, 0x71, 0x44, 0x99, 0x89,
-	0xf5, 0x41, 0x45, 0x12, 0x9d, 0xd7, 0xc6, 0x7e, 0x2c, 0x68, 0xd6, 0xe4, 0x59, 0xf2, 0x08, 0xcd,
-	0xd0, 0xc8, 0xf6, 0xc1, 0xb4, 0x64, 0x19, 0x5a, 0xd9, 0x15, 0x0b, 0x51, 0x0a, 0xbe, 0x9b, 0xa3,
-	0x54, 0x5c, 0x43, 0x23, 0x2b, 0x7c, 0xf3, 0xac, 0xa4, 0x7b, 0x7f, 0x13, 0xab, 0x76, 0x74, 0x77,
-	0xcd, 0x42, 0x69, 0xda, 0xd8, 0xe1, 0x92, 0x9d, 0xdf, 0xc8, 0x8e, 0x5b, 0xb2, 0xcc, 0xca, 0x0e,
-	0x97, 0xec, 0xbc, 0x66, 0x76, 0xdc, 0x92, 0x0d, 0xed, 0x2c, 0x02, 0x53, 0x8f, 0x23, 0x1f, 0x31,
-	0x92, 0x9e, 0x6b, 0x18, 0x33, 0xd3, 0x80, 0xca, 0xa6, 0x89, 0x6a, 0xe7, 0xaa, 0x7e, 0xc8, 0xc1,
-	0x29, 0x8e, 0x41, 0x45, 0xe6, 0xb1, 0x9c, 0x87, 0x69, 0x40, 0x59, 0xfa, 0xeb, 0x22, 0xfd, 0x72,
-	0xd5, 0x7a, 0x1e, 0xc6, 0x79, 0xfe, 0xe6, 0x80, 0xa5, 0x27, 0x71, 0x60, 0x3b, 0x11, 0x33, 0xec,
-	0x9f, 0x3d, 0xc6, 0xba, 0xdb, 0xe7, 0xa2, 0xdc, 0x4d, 0x75, 0x7c, 0x5b, 0x6a, 0xfd, 0xb6, 0x2f,
-	0xc4, 0x92, 0x9b, 0x81, 0x79, 0xc1, 0x38, 0x32, 0x3f, 0x6f, 0xe6, 0x24, 0xbd, 0xab, 0xfb, 0x68,
-	0xe2, 0x6e, 0x62, 0x3e, 0x74, 0x6d, 0x86, 0xfe, 0xe0, 0x80, 0x45, 0x91, 0x75, 0x74, 0xdb, 0x33,
-	0xb7, 0x78, 0x6b, 0xc8, 0x19, 0x1f, 0x45, 0x43, 0x50, 0xdf, 0xaa, 0x96, 0xec, 0xef, 0xe9, 0x83,
-	0xd8, 0xf9, 0xf3, 0x47, 0x6f, 0x9a, 0xff, 0x9d, 0x80, 0x03, 0x30, 0x25, 0x7f, 0x43, 0xab, 0x35,
-	0x1f, 0x7e, 0x55, 0x3b, 0xd9, 0x72, 0x9f, 0x81, 0xf5, 0xef, 0x3a, 0xa4, 0x96, 0x5e, 0x1c, 0xb0,
-	0x0e, 0x8d, 0x93, 0xda, 0xcd, 0xda, 0x2e, 0x0d, 0x59, 0x1c, 0xb4, 0x06, 0x8c, 0xf2, 0x8d, 0xae,
-	0xc3, 0x58, 0x94, 0x6c, 0x7b, 0xde, 0xb8, 0x9f, 0xeb, 0xaa, 0x0b, 0x1d, 0xd2, 0xeb, 0xd1, 0x2f,
-	0x32, 0x80, 0xc7, 0x6d, 0x7d, 0xb4, 0xd5, 0xd8, 0xac, 0x4e, 0xdf, 0xde, 0xba, 0xdb, 0xd8, 0x6c,
-	0x6c, 0x36, 0x6e, 0x6f, 0xdf, 0xbd, 0xf3, 0xab, 0xcd, 0xba, 0xe3, 0x6c, 0xcd, 0xa2, 0x28, 0xea,
-	0x29, 0x8f, 0xe8, 0x3d, 0x4f, 0x68, 0xb8, 0x9d, 0xbb, 0xf2, 0xf4, 0xb7, 0x60, 0x46, 0x3f, 0x26,
-	0x9f, 0xb8, 0xe4, 0x8c, 0xec, 0x2c, 0x60, 0xcd, 0xdc, 0x79, 0xa6, 0x2f, 0x4d, 0x54, 0x2f, 0x71,
-	0xb1, 0xcf, 0xba, 0xe4, 0x55, 0x6d, 0x42, 0x9d, 0xb3, 0x67, 0xf1, 0xf1, 0x36, 0x58, 0x51, 0x5f,
-	0x35, 0x21, 0xf1, 0x09, 0x89, 0x6b, 0x3e, 0xc5, 0x03, 0xfe, 0xb0, 0xa4, 0x57, 0x5d, 0x49, 0xbf,
-	0xa8, 0xf9, 0x25, 0x3c, 0x9f, 0xe2, 0x04, 0x2c, 0x63, 0xda, 0x6f, 0x68, 0x40, 0xb6, 0x3e, 0x3b,
-	0xea, 0xa1, 0x36, 0xa3, 0x60, 0x3f, 0x8e, 0xf0, 0x43, 0xe7, 0xe9, 0x45, 0xf5, 0xe3, 0xea, 0xdb,
-	0x89, 0x9f, 0x1f, 0x3e, 0x78, 0xb8, 0xf3, 0xcf, 0x09, 0xf5, 0xd3, 0x65, 0xeb, 0x82, 0x68, 0xac,
-	0x3b, 0x3f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xee, 0x56, 0xbf, 0x04, 0x86, 0x1d, 0x00, 0x00,
+	// 2578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0xce, 0x2a, 0x45, 0x2c, 0x8f, 0xfe, 0x47, 0xa2, 0x2c, 0x51, 0x92, 0x4d, 0x6d, 0x64, 0xc7,
+	0x66, 0x12, 0xae, 0x2d, 0xb7, 0x35, 0xaa, 0x5e, 0x34, 0x94, 0x6c, 0xcb, 0x89, 0x15, 0x5b, 0x90,
+	0x62, 0x1b, 0x30, 0x10, 0xb8, 0xc3, 0xdd, 0x11, 0xb9, 0x26, 0xb9, 0xb3, 0xde, 0x1f, 0x29, 0x82,
+	0xe0, 0x06, 0x0d, 0x1a, 0x14, 0x68, 0xd1, 0xc2, 0x70, 0x8a, 0x5e, 0xf5, 0x2a, 0x97, 0xbd, 0xec,
+	0x4d, 0x2f, 0xda, 0xb7, 0xe8, 0x2b, 0xf4, 0x41, 0x8a, 0xf9, 0x59, 0xee, 0xcc, 0xee, 0x2c, 0x57,
+	0x96, 0xeb, 0x2b, 0x4a, 0xfb, 0x9d, 0x39, 0xdf, 0x37, 0xb3, 0x73, 0x66, 0xce, 0x39, 0x24, 0xa8,
+	0x20, 0xdf, 0x6d, 0x07, 0xbe, 0x6d, 0x89, 0xcf, 0x86, 0x1f, 0x90, 0x88, 0x40, 0xe0, 0xa1, 0x2e,
+	0xea, 0xa3, 0x06, 0xf2, 0xdd, 0xea, 0x72, 0x9b, 0x90, 0x76, 0x0f, 0x53, 0x0b, 0x0b, 0x79, 0x1e,
+	0x89, 0x50, 0xe4, 0x12, 0x2f, 0xe4, 0x96, 0xd5, 0x25, 0x81, 0xb2, 0xff, 0x5a, 0xf1, 0x81, 0x85,
+	0xfb, 0x7e, 0x74, 0x2c, 0xc0, 0x4f, 0xd8, 0x87, 0xfd, 0x69, 0x1b, 0x7b, 0x9f, 0x86, 0x47, 0xa8,
+	0xdd, 0xc6, 0x81, 0x45, 0x7c, 0x36, 0x5c, 0xe3, 0xaa, 0xde, 0x76, 0xa3, 0x4e, 0xdc, 0x6a, 0xd8,
+	0xa4, 0x6f, 0x75, 0x70, 0x40, 0x5c, 0xbb, 0x87, 0x5a, 0xa1, 0xc5, 0xa5, 0x70, 0x7a, 0xdf, 0xe5,
+	0xb6, 0xeb, 0xff, 0xda, 0x01, 0x1f, 0x3c, 0x60, 0x00, 0x7c, 0x02, 0x40, 0xd3, 0x71, 0xee, 0x06,
+	0x2e, 0xf6, 0x9c, 0x10, 0xae, 0x34, 0x52, 0xe9, 0x8d, 0xf4, 0xf9, 0x1e, 0x7e, 0x11, 0xe3, 0x30,
+	0xaa, 0xce, 0x37, 0xb8, 0xde, 0x46, 0xa2, 0xb7, 0x71, 0x87, 0xea, 0x35, 0xe1, 0x77, 0xff, 0xf9,
+	0xef, 0x0f, 0x23, 0xe3, 0x26, 0xb0, 0x0e, 0xd7, 0xad, 0x03, 0x36, 0x06, 0x76, 0xc1, 0x44, 0xd3,
+	0x71, 0xb6, 0x03, 0x12, 0xfb, 0x8f, 0x42, 0x1c, 0x84, 0xb0, 0x96, 0xf1, 0x9d, 0x42, 0x65, 0xee,
+	0x6b, 0xcc, 0x7d, 0xd5, 0x5c, 0xa0, 0xee, 0xdb, 0x74, 0x98, 0x75, 0xc2, 0x3e, 0x9e, 0xb9, 0xce,
+	0x4b, 0x0b, 0x39, 0x0e, 0xfc, 0xc1, 0x00, 0x33, 0xcd, 0x38, 0xea, 0x60, 0x2f, 0x72, 0x6d, 0x14,
+	0xe1, 0xa6, 0xef, 0xf7, 0x30, 0x5c, 0x53, 0x18, 0xb3, 0x70, 0xc2, 0x3a, 0x2b, 0x5b, 0xed, 0xe3,
+	0x30, 0x74, 0x89, 0x67, 0x6e, 0xbd, 0x6e, 0xce, 0xb4, 0xa6, 0xc0, 0x04, 0x38, 0xbf, 0x89, 0x42,
+	0xd7, 0xa6, 0x83, 0xe1, 0x7b, 0x4c, 0xc6, 0x35, 0xf3, 0x22, 0x95, 0x81, 0x6c, 0x9b, 0xc4, 0x5e,
+	0x64, 0x21, 0xc9, 0xad, 0x85, 0xa8, 0xdf, 0x8d, 0x73, 0x02, 0x83, 0x7f, 0x35, 0x00, 0x94, 0x69,
+	0xb7, 0xe2, 0x30, 0x22, 0x7d, 0x78, 0xb9, 0x48, 0x16, 0xc7, 0x87, 0xea, 0xba, 0x5d, 0xa8, 0xab,
+	0x6e, 0x5e, 0x2a, 0xd4, 0x65, 0x33, 0xc7, 0xc5, 0xc2, 0x6e, 0xe3, 0x43, 0xd7, 0xc6, 0xc5, 0xc2,
+	0x38, 0xfe, 0x0e, 0x84, 0x39, 0xcc, 0x71, 0x2a, 0x2c, 0xfb, 0x1e, 0xef, 0xf4, 0x91, 0xdb, 0x2b,
+	0x7e, 0x8f, 0x0c, 0x7e, 0x07, 0xef, 0x11, 0x53, 0xbf, 0xa9, 0xaa, 0xbf, 0x19, 0x60, 0x4e, 0xa6,
+	0xbd, 0x8b, 0x6c, 0xdc, 0x22, 0xa4, 0x0b, 0x3f, 0x2a, 0x12, 0x96, 0x58, 0x0c, 0xd5, 0x76, 0xb7,
+	0x50, 0xdb, 0x27, 0xe6, 0x6a, 0xa1, 0xb6, 0x03, 0xe1, 0x3a, 0x95, 0xf7, 0xa3, 0x01, 0xe6, 0x65,
+	0xf2, 0x6d, 0xd4, 0xc7, 0x5b, 0xd8, 0x8b, 0x70, 0x00, 0xaf, 0x15, 0x09, 0x4c, 0x6d, 0x86, 0x4a,
+	0xbc, 0x57, 0x28, 0xb1, 0x61, 0x7e, 0x58, 0x28, 0xb1, 0x8d, 0xfa, 0xd8, 0x66, 0xce, 0x8b, 0xb7,
+	0xdc, 0x36, 0x8b, 0xf4, 0xe2, 0x2d, 0xc7, 0xf1, 0x77, 0xb0, 0xe5, 0xf8, 0x11, 0x93, 0x0a, 0x7b,
+	0x65, 0x80, 0x69, 0x99, 0xf8, 0xa1, 0xeb, 0xd8, 0xf0, 0xc3, 0x22, 0x59, 0x14, 0x1d, 0x2a, 0x6a,
+	0xb3, 0x50, 0xd4, 0x55, 0x73, 0xa5, 0x50, 0x14, 0x71, 0x1d, 0xbb, 0x38, 0x0a, 0xf6, 0x23, 0x8c,
+	0xfa, 0xc5, 0x51, 0xc0, 0xe0, 0x77, 0x10, 0x05, 0x21, 0xf5, 0x9b, 0xaa, 0x42, 0x60, 0x7c, 0xb3,
+	0x47, 0xec, 0x6e, 0x72, 0x57, 0x5c, 0x92, 0x99, 0x64, 0xa4, 0xec, 0x38, 0x5f, 0x60, 0xcc, 0xd0,
+	0x9c, 0x4e, 0x6f, 0x0b, 0xab, 0x45, 0xc7, 0x43, 0x1b, 0xcc, 0x6d, 0x21, 0xcf, 0xc6, 0xbd, 0x26,
+	0xe7, 0xbc, 0x13, 0xa0, 0x30, 0x0e, 0x30, 0x2c, 0xf0, 0x54, 0xc8, 0xb0, 0xc4, 0x18, 0x2a, 0xf5,
+	0x59, 0x79, 0x6e, 0x58, 0x38, 0x7b, 0x0c, 0xc6, 0xb6, 0x02, 0x4c, 0xb7, 0x18, 0xbd, 0x42, 0xe0,
+	0x45, 0x79, 0x1a, 0x12, 0x90, 0xcc, 0x62, 0x46, 0xc6, 0x19, 0x62, 0xce, 0x31, 0xf7, 0x93, 0xe6,
+	0xf9, 0xc1, 0x7d, 0xb4, 0x61, 0xd4, 0xe1, 0xf7, 0x06, 0x58, 0xb8, 0x8d, 0xed, 0x9e, 0xeb, 0x71,
+	0x07, 0x5f, 0x10, 0xd7, 0x13, 0x4e, 0x42, 0xf8, 0xb1, 0xec, 0xa5, 0xc8, 0xaa, 0x6c, 0xe1, 0xd6,
+	0x18, 0xef, 0x45, 0x73, 0x59, 0x7b, 0x0f, 0x3a, 0xdc, 0x2b, 0xfc, 0x1a, 0x4c, 0xdc, 0xc6, 0x3d,
+	0x1c, 0xe1, 0xe4, 0x45, 0xd5, 0x54, 0x6e, 0x09, 0x3a, 0xe5, 0xbd, 0x5e, 0x97, 0xef, 0x75, 0x1b,
+	0x8c, 0x71, 0x1f, 0x9a, 0xe5, 0x93, 0x80, 0x32, 0xd7, 0xcb, 0xcc, 0xf5, 0x7c, 0x7d, 0x4e, 0x37,
+	0x17, 0xf8, 0x7b, 0x03, 0x5c, 0xe0, 0xce, 0x76, 0x30, 0x72, 0x70, 0xd0, 0x22, 0x28, 0x70, 0xf6,
+	0xb0, 0x4d, 0x02, 0x07, 0xd6, 0xf3, 0x8c, 0x39, 0xa3, 0x32, 0xf6, 0xab, 0x8c, 0xdd, 0xac, 0xd7,
+	0x28, 0x7b, 0x2f, 0x1d, 0x6d, 0x9d, 0x48, 0xff, 0x30, 0x25, 0x04, 0xcc, 0x72, 0x8e, 0x07, 0x24,
+	0x72, 0x0f, 0x5c, 0x9b, 0xe7, 0x5c, 0xf0, 0x4a, 0x5e, 0x84, 0x62, 0x70, 0xca, 0x18, 0xa8, 0xb3,
+	0x18, 0xf0, 0xa4, 0x91, 0xf0, 0x10, 0xcc, 0x71, 0x7f, 0xfb, 0x11, 0x09, 0x50, 0x1b, 0x3f, 0x6c,
+	0x3d, 0xc7, 0x76, 0x14, 0xaa, 0x77, 0x8d, 0xce, 0xa2, 0x8c, 0x72, 0x85, 0x51, 0x5e, 0xa8, 0x42,
+	0x4a, 0x19, 0xf2, 0xa1, 0x96, 0xc3, 0x1c, 0xd1, 0xed, 0x8b, 0xc1, 0xc4, 0x9d, 0x6f, 0x7c, 0x12,
+	0x44, 0x22, 0xf6, 0x0a, 0x83, 0x4e, 0xcd, 0xe3, 0x44, 0xa0, 0xf2, 0x91, 0x81, 0xdd, 0x71, 0x0f,
+	0xb1, 0x59, 0x65, 0x4c, 0x73, 0x10, 0x2a, 0xe1, 0xc7, 0x4c, 0xe0, 0x03, 0x00, 0xb6, 0x71, 0x29,
+	0xc7, 0xac, 0x86, 0xc3, 0x9c, 0x65, 0x6e, 0x27, 0xe0, 0x98, 0xe4, 0x16, 0xee, 0x80, 0xd1, 0x6d,
+	0x1c, 0xf1, 0x0c, 0x73, 0x49, 0x09, 0x55, 0xf1, 0x54, 0x1b, 0xc7, 0x0c, 0x31, 0xa7, 0x99, 0x43,
+	0x00, 0x47, 0xa9, 0xc3, 0x38, 0xc4, 0x01, 0xdc, 0x07, 0x63, 0xf7, 0x30, 0xea, 0x45, 0x1d, 0xbb,
+	0x83, 0xed, 0xee, 0x1b, 0x9f, 0x3b, 0xe2, 0x60, 0x80, 0xe3, 0x56, 0x47, 0xf2, 0xf2, 0x2d, 0xa8,
+	0x7c, 0xde, 0xa7, 0x93, 0x4f, 0xb2, 0x82, 0x24, 0x30, 0xaf, 0xca, 0x92, 0xb4, 0x26, 0xa7, 0x3d,
+	0x11, 0x66, 0xa5, 0xa3, 0x34, 0x9f, 0x20, 0x38, 0xe0, 0x3c, 0x3d, 0x66, 0x78, 0xc0, 0x2e, 0xcb,
+	0xa4, 0x83, 0xc7, 0x65, 0x44, 0xab, 0x8c, 0x68, 0xc9, 0x5c, 0xd4, 0x1e, 0x3d, 0xcf, 0x89, 0xeb,
+	0xc1, 0x6f, 0xc0, 0x24, 0x75, 0xf7, 0x15, 0x89, 0x03, 0x0f, 0xf5, 0xb1, 0x17, 0xc1, 0xd5, 0x2c,
+	0x55, 0x8a, 0x95, 0xf1, 0x7d, 0xcc, 0xf8, 0x2e, 0xf3, 0x24, 0x23, 0x1a, 0x0c, 0xb3, 0x4e, 0xd2,
+	0xbf, 0x53, 0x66, 0x0f, 0x4c, 0xde, 0x77, 0xed, 0xae, 0x54, 0x6b, 0x28, 0xcc, 0x2a, 0xf6, 0x76,
+	0x33, 0xed, 0xba, 0x76, 0x17, 0xb6, 0x01, 0xd8, 0xc1, 0xe8, 0x50, 0x9c, 0x80, 0x4a, 0xcd, 0x94,
+	0x3e, 0x2f, 0xe3, 0x31, 0x19, 0xcf, 0xb2, 0x59, 0xd5, 0xf2, 0xf4, 0xa8, 0x1f, 0xf8, 0x6b, 0x70,
+	0x7e, 0xc7, 0xf5, 0xba, 0xbc, 0x9a, 0x59, 0xd0, 0xc4, 0x04, 0x43, 0x4a, 0xa7, 0x32, 0x2f, 0xc7,
+	0x61, 0xcf, 0xf5, 0xba, 0xa2, 0x50, 0x31, 0xea, 0xd0, 0x06, 0x80, 0x32, 0x88, 0xca, 0x64, 0x51,
+	0x43, 0xc1, 0xa1, 0xd2, 0x69, 0x5c, 0xc8, 0x71, 0x88, 0xa2, 0x23, 0x25, 0x11, 0x55, 0x86, 0x8e,
+	0x84, 0x43, 0x67, 0x20, 0x11, 0x05, 0x84, 0x51, 0x4f, 0xd6, 0x8a, 0x57, 0x0c, 0xba, 0xb5, 0x62,
+	0xc8, 0x19, 0xd6, 0x8a, 0x17, 0x03, 0x46, 0x1d, 0x86, 0x60, 0x9c, 0x32, 0x0c, 0xb2, 0x7f, 0x25,
+	0x01, 0x92, 0x91, 0xb2, 0x57, 0x5f, 0x67, 0x5c, 0x6b, 0xe6, 0x62, 0x8e, 0x2b, 0x1f, 0xbb, 0x04,
+	0x4c, 0x52, 0xd7, 0x52, 0x4e, 0xbf, 0xa2, 0x99, 0x5b, 0x0a, 0x17, 0x92, 0x5e, 0x61, 0xa4, 0x35,
+	0x73, 0x29, 0x47, 0x2a, 0xa5, 0xeb, 0xe9, 0xcb, 0x12, 0xf9, 0xb9, 0xee, 0x65, 0x71, 0xe8, 0x0c,
+	0x2f, 0x4b, 0xa4, 0xde, 0x46, 0x1d, 0x7e, 0x0d, 0x46, 0x29, 0x09, 0xcb, 0xb5, 0x2f, 0x68, 0x28,
+	0x28, 0x50, 0xda, 0x0e, 0xa8, 0xe4, 0x08, 0x58, 0x1a, 0x9d, 0xee, 0x05, 0x9e, 0x37, 0xeb, 0xf6,
+	0x02, 0x43, 0xce, 0xb0, 0x17, 0x78, 0x4a, 0x6c, 0xd4, 0xe1, 0xb7, 0x60, 0x76, 0xc7, 0x0d, 0xa3,
+	0xad, 0x0e, 0xf2, 0x3c, 0xdc, 0xfb, 0x12, 0x87, 0x21, 0x6a, 0xe3, 0x4c, 0x5a, 0xa0, 0x31, 0x48,
+	0x76, 0x86, 0x9a, 0x74, 0x2a, 0x36, 0x74, 0x54, 0x32, 0x45, 0xc8, 0x3a, 0x1e, 0x36, 0xc7, 0xad,
+	0x13, 0xf1, 0x07, 0xcb, 0x4b, 0xbe, 0x33, 0x40, 0x85, 0x9a, 0xf2, 0x8b, 0x62, 0x3f, 0x6e, 0xb7,
+	0x71, 0xc8, 0x53, 0x93, 0xab, 0x59, 0x0d, 0x39, 0x93, 0x44, 0x85, 0x72, 0x93, 0x67, 0xad, 0x98,
+	0x0e, 0x91, 0x33, 0xc0, 0x8a, 0x74, 0xbf, 0x84, 0x03, 0x13, 0x9a, 0x4a, 0xa7, 0x04, 0xa1, 0x9a,
+	0x0b, 0x4a, 0x80, 0xb6, 0x36, 0x11, 0x58, 0x92, 0x63, 0x42, 0x39, 0xc7, 0x4c, 0x26, 0x97, 0xcf,
+	0xa3, 0x73, 0x93, 0x2b, 0x4c, 0xa2, 0x17, 0x73, 0x79, 0x3b, 0x3d, 0xfe, 0xd9, 0xac, 0xc4, 0xad,
+	0x09, 0xf5, 0x79, 0x74, 0xc0, 0x1d, 0xc0, 0x47, 0x00, 0x0c, 0x08, 0x32, 0x9d, 0xb1, 0xf4, 0x79,
+	0xc2, 0x56, 0xc9, 0xb1, 0x31, 0xa6, 0x19, 0xc6, 0x34, 0x06, 0xd3, 0x4a, 0x01, 0xbe, 0x00, 0x93,
+	0x83, 0xe1, 0x9a, 0xcb, 0x4a, 0xc5, 0x4e, 0x31, 0x19, 0xb1, 0x59, 0xa1, 0xfe, 0xbe, 0x62, 0x59,
+	0xcd, 0x2b, 0x03, 0xcc, 0x53, 0xdb, 0x5c, 0x9a, 0x1c, 0xaa, 0x0d, 0x02, 0xbd, 0x4d, 0xa2, 0x61,
+	0x35, 0x73, 0xcf, 0xa9, 0x66, 0x4c, 0x8b, 0x48, 0xab, 0x61, 0x79, 0x5a, 0xfd, 0x4f, 0x03, 0xac,
+	0xea, 0xe9, 0x9a, 0x01, 0x89, 0x3d, 0xe7, 0xe1, 0x91, 0x87, 0x03, 0xf8, 0xd3, 0x72, 0x75, 0x92,
+	0xf9, 0x1b, 0x08, 0xfd, 0x05, 0x13, 0x7a, 0x13, 0xde, 0x28, 0x13, 0x6a, 0x11, 0xea, 0xd9, 0x3a,
+	0x61, 0x1f, 0x4c, 0xf9, 0x13, 0xbe, 0xe7, 0xbf, 0x44, 0x91, 0xdd, 0xc1, 0x9a, 0x3d, 0x2f, 0x00,
+	0xed, 0xc6, 0x60, 0x58, 0x7e, 0x63, 0xf4, 0xe9, 0x63, 0xf8, 0x02, 0xcc, 0xb0, 0xf1, 0x71, 0x14,
+	0xa3, 0x5e, 0x12, 0x52, 0x6b, 0x39, 0xf7, 0x32, 0x3c, 0x24, 0xb7, 0xfd, 0x90, 0x11, 0xac, 0xc0,
+	0x25, 0x29, 0x72, 0x4f, 0xe2, 0x90, 0x4f, 0xc2, 0xea, 0x33, 0x2f, 0x09, 0xa5, 0x5a, 0xda, 0xe4,
+	0x28, 0xb5, 0x85, 0x8d, 0x92, 0x46, 0xca, 0x16, 0x6c, 0x7a, 0xa2, 0xbc, 0x81, 0xf9, 0xf2, 0x06,
+	0x83, 0x09, 0x6a, 0xb1, 0x1b, 0x07, 0x76, 0x07, 0x85, 0x38, 0x53, 0x9d, 0x2a, 0x50, 0x42, 0xa5,
+	0x1c, 0xe0, 0x09, 0x9a, 0xa7, 0x71, 0x91, 0x6f, 0xf9, 0x02, 0xa5, 0x3d, 0x31, 0x48, 0x4d, 0x32,
+	0x45, 0xd4, 0xe5, 0x2c, 0x99, 0xbe, 0x84, 0x52, 0x82, 0x5d, 0x31, 0x61, 0xb4, 0x77, 0x19, 0xed,
+	0x67, 0x70, 0x41, 0xae, 0xa4, 0x4e, 0x6c, 0xd2, 0xeb, 0x61, 0x9b, 0x4e, 0xf2, 0xe5, 0xd3, 0x35,
+	0x68, 0x16, 0x61, 0xe9, 0x5b, 0x80, 0x2e, 0x98, 0xa2, 0xfe, 0xd2, 0xac, 0x38, 0x84, 0x66, 0x56,
+	0xa0, 0x04, 0x26, 0xea, 0xaa, 0xb2, 0x4d, 0x8a, 0x33, 0x69, 0xf3, 0x4c, 0xda, 0x34, 0x9c, 0x54,
+	0xf3, 0x66, 0xf8, 0x47, 0x71, 0xa2, 0xca, 0x19, 0x38, 0x3f, 0x01, 0xae, 0x16, 0x33, 0x66, 0x0e,
+	0x80, 0x9a, 0x9e, 0x57, 0x0a, 0x2b, 0x91, 0x63, 0xc0, 0x8b, 0xc3, 0xb3, 0x76, 0xf8, 0x0f, 0x03,
+	0xd4, 0xb4, 0x54, 0x72, 0xf0, 0xdf, 0x2c, 0x15, 0xa6, 0x89, 0xfd, 0x72, 0x8d, 0xb7, 0x98, 0xc6,
+	0x1b, 0xd0, 0x2a, 0xa9, 0x2c, 0x72, 0x81, 0xef, 0xf3, 0x83, 0x9b, 0x86, 0x97, 0xb8, 0x13, 0x72,
+	0x07, 0x77, 0x8a, 0x69, 0x0f, 0xee, 0x01, 0x9c, 0xbf, 0xe3, 0xe9, 0x9e, 0x90, 0xe2, 0x93, 0x5f,
+	0x15, 0x47, 0x60, 0x66, 0x37, 0x20, 0x7d, 0x22, 0x5a, 0x2a, 0xfc, 0xb6, 0x50, 0xc2, 0x33, 0x07,
+	0xbf, 0x5d, 0x0b, 0xc9, 0xe7, 0xee, 0x20, 0x01, 0x70, 0x0f, 0x23, 0x67, 0x58, 0xf0, 0xe4, 0x71,
+	0xed, 0xf6, 0x54, 0x4d, 0x92, 0xed, 0x69, 0x8e, 0x49, 0xd1, 0x41, 0xd3, 0xa9, 0x0e, 0xa8, 0x88,
+	0xe1, 0xa7, 0xec, 0xfc, 0x55, 0x75, 0x09, 0x3e, 0x1f, 0x93, 0x74, 0xff, 0x4c, 0x6d, 0xf7, 0xef,
+	0xb7, 0x06, 0x98, 0xd8, 0xc3, 0x21, 0x8e, 0x76, 0x51, 0x18, 0x1e, 0xd1, 0x7e, 0x52, 0x4d, 0x9d,
+	0x96, 0x04, 0x95, 0x2d, 0xe6, 0xcf, 0x0b, 0xdb, 0xaa, 0x99, 0xec, 0x97, 0x95, 0x10, 0x56, 0x40,
+	0x7d, 0xf3, 0xe4, 0xf1, 0xdc, 0x9e, 0x6f, 0xdf, 0x8d, 0x3d, 0x1b, 0x4e, 0x29, 0xe4, 0xbe, 0x5d,
+	0xcd, 0x3e, 0x30, 0xf7, 0x5e, 0x37, 0xcd, 0x56, 0x8d, 0x91, 0x60, 0x14, 0xe0, 0xe0, 0x8b, 0xa3,
+	0x08, 0xbe, 0x07, 0xa6, 0xc0, 0xd8, 0xbd, 0x28, 0xf2, 0xef, 0xe3, 0x63, 0x89, 0xf5, 0x23, 0x73,
+	0x9c, 0xb2, 0xd2, 0x2f, 0x24, 0x4f, 0x5c, 0xe7, 0xe5, 0xc6, 0x39, 0x1f, 0x1d, 0xf7, 0x08, 0x72,
+	0x9e, 0x4e, 0x42, 0x05, 0x80, 0x1e, 0xa8, 0xec, 0x63, 0xcf, 0x61, 0x15, 0xd1, 0x63, 0x1c, 0xa4,
+	0xa7, 0xf3, 0x9b, 0x36, 0x3c, 0x2e, 0x33, 0xde, 0x4b, 0xe6, 0x4a, 0x7e, 0xb6, 0x87, 0xd4, 0xef,
+	0xb1, 0x15, 0xd2, 0x7c, 0xee, 0xcf, 0x06, 0x98, 0xa1, 0x84, 0xe9, 0xc2, 0x86, 0x38, 0x52, 0x77,
+	0x72, 0x0e, 0x2e, 0x5b, 0xfc, 0x5f, 0x16, 0x2e, 0xfe, 0xaa, 0xb9, 0x9c, 0x97, 0xc3, 0x16, 0x9f,
+	0xa9, 0xe1, 0xfb, 0x6d, 0x42, 0xf4, 0xc6, 0x77, 0x48, 0x9b, 0xc4, 0x91, 0xba, 0x09, 0x14, 0xe8,
+	0x94, 0x6d, 0x35, 0x93, 0xb7, 0xd5, 0xf8, 0x48, 0xab, 0xc7, 0x86, 0x52, 0xa6, 0xdf, 0x19, 0x60,
+	0x52, 0xf8, 0xdb, 0xc3, 0x07, 0x01, 0x0e, 0x3b, 0xea, 0xb1, 0xa1, 0x62, 0x43, 0xbb, 0xf8, 0x1b,
+	0x85, 0x33, 0xce, 0x54, 0x75, 0x89, 0x8a, 0x80, 0x3b, 0xa5, 0x32, 0x1c, 0x30, 0xf6, 0xc8, 0xeb,
+	0xbd, 0x45, 0x2f, 0x41, 0xe4, 0x13, 0xe6, 0x82, 0x4c, 0x14, 0x7b, 0x6a, 0x37, 0xa1, 0x0d, 0xc6,
+	0x39, 0xcb, 0xd9, 0xfb, 0x09, 0xc9, 0x01, 0xb5, 0xa8, 0xe1, 0x49, 0x3b, 0x0a, 0x03, 0xa2, 0xb3,
+	0xf7, 0x14, 0x86, 0x11, 0xa5, 0x5d, 0x85, 0xc1, 0xba, 0x9d, 0xb5, 0xaf, 0x30, 0x6c, 0xdd, 0x06,
+	0x9d, 0x85, 0x3e, 0x98, 0xe4, 0x2c, 0x83, 0xde, 0xc2, 0x92, 0x86, 0x28, 0x01, 0xdf, 0xac, 0xc4,
+	0x8f, 0x3d, 0xb5, 0xb3, 0xc0, 0x1a, 0x19, 0xd3, 0x9c, 0xee, 0xed, 0xbb, 0x0a, 0x22, 0xe3, 0x37,
+	0x57, 0x34, 0x94, 0x6a, 0x5f, 0x61, 0xf0, 0xca, 0xce, 0xde, 0x59, 0x18, 0xf6, 0xca, 0xd2, 0xde,
+	0x02, 0x02, 0x80, 0x13, 0x9d, 0xad, 0xbb, 0xa0, 0x6d, 0x5f, 0xc4, 0x9e, 0xd2, 0x5f, 0x18, 0xec,
+	0x8a, 0xb3, 0x76, 0x18, 0x86, 0xed, 0x8a, 0x41, 0x8f, 0x01, 0x81, 0x89, 0x47, 0xbe, 0x83, 0x22,
+	0x2c, 0x5c, 0xaa, 0x87, 0x94, 0x02, 0x95, 0x1d, 0x52, 0xe2, 0xde, 0xad, 0xca, 0xad, 0x73, 0x4a,
+	0x71, 0x00, 0xc6, 0xb8, 0x1f, 0xcd, 0x97, 0x39, 0x12, 0x50, 0xe6, 0xfe, 0x12, 0x73, 0xbf, 0x58,
+	0xd5, 0x7e, 0x99, 0x43, 0x79, 0xfe, 0x60, 0x80, 0xca, 0x63, 0xd4, 0x73, 0xa9, 0xc7, 0x24, 0x83,
+	0xe7, 0x27, 0x91, 0x92, 0x7e, 0x6a, 0x4d, 0x12, 0xf2, 0xb5, 0x61, 0x96, 0x7b, 0x38, 0xf4, 0x89,
+	0x17, 0x62, 0xb5, 0x77, 0x23, 0x97, 0x04, 0xe9, 0x29, 0xf5, 0x27, 0x03, 0xcc, 0x67, 0xc7, 0x8b,
+	0x4d, 0x79, 0x6d, 0x18, 0x87, 0xfa, 0x95, 0xf4, 0xe9, 0xe4, 0x28, 0xbb, 0x49, 0x91, 0x93, 0x6e,
+	0x58, 0x9d, 0x9e, 0x7b, 0x31, 0x3a, 0xc2, 0xee, 0x70, 0x3d, 0xdc, 0xe6, 0xff, 0xa5, 0xa7, 0xc3,
+	0xbc, 0x51, 0x3d, 0xbf, 0x01, 0x63, 0x2c, 0x29, 0x38, 0xe6, 0x67, 0x9e, 0xb2, 0x29, 0x24, 0xa0,
+	0x6c, 0x53, 0xdc, 0x2a, 0xbc, 0xae, 0x32, 0xfb, 0x5e, 0xce, 0x17, 0x28, 0xff, 0x5f, 0x0c, 0x30,
+	0xff, 0x24, 0x70, 0x75, 0xdf, 0xfd, 0x29, 0xeb, 0xa1, 0xb7, 0xd1, 0xd6, 0x70, 0x39, 0x2b, 0xf3,
+	0xba, 0xf8, 0xa1, 0x40, 0x69, 0xab, 0x62, 0xe3, 0x83, 0x80, 0x73, 0x47, 0x60, 0x96, 0x31, 0x66,
+	0xb2, 0xe2, 0x2b, 0x39, 0x49, 0x6f, 0x5a, 0x53, 0x36, 0xed, 0x6e, 0xa8, 0x46, 0xa8, 0x94, 0x19,
+	0xbf, 0x32, 0x40, 0x85, 0x79, 0xcd, 0x16, 0x33, 0x6a, 0xe4, 0x68, 0x4d, 0x4e, 0xb9, 0x14, 0x0d,
+	0xfe, 0xf3, 0x84, 0x6a, 0x49, 0xd5, 0x96, 0x2c, 0xc4, 0xe6, 0xf7, 0xef, 0xbf, 0x6e, 0xfe, 0x7b,
+	0x04, 0xc6, 0x60, 0x82, 0xff, 0x86, 0xac, 0xd6, 0xdc, 0xfd, 0xbc, 0x76, 0xb8, 0x6e, 0x3e, 0x03,
+	0xab, 0x5f, 0x75, 0x70, 0x2d, 0x79, 0x18, 0x47, 0x1d, 0x12, 0x84, 0xb5, 0x2b, 0xb5, 0x2d, 0xe2,
+	0x45, 0x81, 0xdb, 0x8a, 0x23, 0x42, 0xcb, 0x97, 0x4e, 0x14, 0xf9, 0xe1, 0x86, 0x65, 0x0d, 0xfb,
+	0xb9, 0x5a, 0x75, 0xae, 0x83, 0x7b, 0x3d, 0xf2, 0x59, 0x0a, 0x50, 0xbb, 0xf5, 0xf7, 0xd7, 0x1b,
+	0xd7, 0xab, 0x93, 0x37, 0xd6, 0x6f, 0x35, 0xae, 0x37, 0xae, 0x37, 0x6e, 0x6c, 0xdc, 0xba, 0xf9,
+	0xb3, 0xeb, 0x75, 0xc3, 0x58, 0x9f, 0xa6, 0xa1, 0x2d, 0x52, 0x58, 0xeb, 0x79, 0x48, 0xbc, 0x8d,
+	0xdc, 0x93, 0xa7, 0xbf, 0x02, 0x53, 0xf2, 0x46, 0x1c, 0x19, 0x35, 0xb2, 0x29, 0xf5, 0x8a, 0x9a,
+	0x52, 0x4f, 0x8e, 0x8e, 0x54, 0x47, 0xa9, 0xd8, 0x67, 0x5d, 0x7c, 0x5c, 0x1b, 0x69, 0x4d, 0x65,
+	0xec, 0x83, 0x0d, 0xb0, 0x24, 0xa6, 0x1a, 0xe2, 0xe0, 0x10, 0x07, 0x35, 0x87, 0xd8, 0x31, 0x5d,
+	0x2c, 0x9e, 0x4a, 0x2f, 0x25, 0x13, 0x55, 0x27, 0x61, 0x39, 0xc4, 0x0e, 0xc1, 0xa2, 0x4d, 0xfa,
+	0x0d, 0x09, 0x48, 0xdf, 0xcf, 0xa6, 0x58, 0xd4, 0xa6, 0xef, 0x6e, 0x07, 0xbe, 0xbd, 0x6b, 0x3c,
+	0x3d, 0x27, 0x7e, 0x5c, 0xf8, 0xe3, 0xc8, 0x4f, 0x1e, 0xdc, 0xdf, 0xdd, 0xfc, 0xfb, 0x88, 0xf8,
+	0xe9, 0x5e, 0xeb, 0x03, 0x16, 0x70, 0x37, 0xff, 0x37, 0x00, 0xdf, 0x75, 0x85, 0x4e, 0x86, 0x28,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -173,6 +210,8 @@ type NakamaClient interface {
 	AddFriends(ctx context.Context, in *api.AddFriendsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Add users to a group.
 	AddGroupUsers(ctx context.Context, in *api.AddGroupUsersRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Authenticate a user with an Apple ID against the server.
+	AuthenticateApple(ctx context.Context, in *api.AuthenticateAppleRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with a custom id against the server.
 	AuthenticateCustom(ctx context.Context, in *api.AuthenticateCustomRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with a device id against the server.
@@ -185,12 +224,18 @@ type NakamaClient interface {
 	AuthenticateGameCenter(ctx context.Context, in *api.AuthenticateGameCenterRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with Google against the server.
 	AuthenticateGoogle(ctx context.Context, in *api.AuthenticateGoogleRequest, opts ...grpc.CallOption) (*api.Session, error)
+	// Authenticate a user with an OpenID Connect provider against the server.
+	AuthenticateOidc(ctx context.Context, in *api.AuthenticateOidcRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with Steam against the server.
 	AuthenticateSteam(ctx context.Context, in *api.AuthenticateSteamRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Block one or more users by ID or username.
 	BlockFriends(ctx context.Context, in *api.BlockFriendsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Cancel a pending request to erase the current user's account.
+	CancelAccountErasure(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Create a new group with the current user as the owner.
 	CreateGroup(ctx context.Context, in *api.CreateGroupRequest, opts ...grpc.CallOption) (*api.Group, error)
+	// Decline pending requests to join a group.
+	DeclineGroupJoinRequests(ctx context.Context, in *api.DeclineGroupJoinRequestsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Delete one or more users by ID or username.
 	DeleteFriends(ctx context.Context, in *api.DeleteFriendsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Delete a group by ID.
@@ -201,6 +246,8 @@ type NakamaClient interface {
 	DeleteNotifications(ctx context.Context, in *api.DeleteNotificationsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Delete one or more objects by ID or username.
 	DeleteStorageObjects(ctx context.Context, in *api.DeleteStorageObjectsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Export all data stored for the current user's account as an archive.
+	ExportAccount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.AccountExportArchive, error)
 	// Fetch the current user's account.
 	GetAccount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.Account, error)
 	// Fetch zero or more users by ID and/or username.
@@ -217,6 +264,8 @@ type NakamaClient interface {
 	KickGroupUsers(ctx context.Context, in *api.KickGroupUsersRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Leave a group the user is a member of.
 	LeaveGroup(ctx context.Context, in *api.LeaveGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Add an Apple ID to the social profiles on the current user's account.
+	LinkApple(ctx context.Context, in *api.AccountApple, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Add a custom ID to the social profiles on the current user's account.
 	LinkCustom(ctx context.Context, in *api.AccountCustom, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Add a device ID to the social profiles on the current user's account.
@@ -229,12 +278,18 @@ type NakamaClient interface {
 	LinkGameCenter(ctx context.Context, in *api.AccountGameCenter, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Add Google to the social profiles on the current user's account.
 	LinkGoogle(ctx context.Context, in *api.AccountGoogle, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Add an OpenID Connect identity to the social profiles on the current user's account.
+	LinkOidc(ctx context.Context, in *api.AccountOidc, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Add Steam to the social profiles on the current user's account.
 	LinkSteam(ctx context.Context, in *api.AccountSteam, opts ...grpc.CallOption) (*empty.Empty, error)
 	// List a channel's message history.
 	ListChannelMessages(ctx context.Context, in *api.ListChannelMessagesRequest, opts ...grpc.CallOption) (*api.ChannelMessageList, error)
-	// List all friends for the current user.
-	ListFriends(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.Friends, error)
+	// List users the current user may want to add as friends.
+	ListFriendSuggestions(ctx context.Context, in *api.ListFriendSuggestionsRequest, opts ...grpc.CallOption) (*api.FriendSuggestionList, error)
+	// List friends, invites and blocked users of the current user.
+	ListFriends(ctx context.Context, in *api.ListFriendsRequest, opts ...grpc.CallOption) (*api.Friends, error)
+	// List pending requests to join a group.
+	ListGroupJoinRequests(ctx context.Context, in *api.ListGroupJoinRequestsRequest, opts ...grpc.CallOption) (*api.GroupUserList, error)
 	// List groups based on given filters.
 	ListGroups(ctx context.Context, in *api.ListGroupsRequest, opts ...grpc.CallOption) (*api.GroupList, error)
 	// List all users that are part of a group.
@@ -245,8 +300,12 @@ type NakamaClient interface {
 	ListLeaderboardRecordsAroundOwner(ctx context.Context, in *api.ListLeaderboardRecordsAroundOwnerRequest, opts ...grpc.CallOption) (*api.LeaderboardRecordList, error)
 	// Fetch list of running matches.
 	ListMatches(ctx context.Context, in *api.ListMatchesRequest, opts ...grpc.CallOption) (*api.MatchList, error)
+	// List the friends the current user shares with another user.
+	ListMutualFriends(ctx context.Context, in *api.ListMutualFriendsRequest, opts ...grpc.CallOption) (*api.Users, error)
 	// Fetch list of notifications.
 	ListNotifications(ctx context.Context, in *api.ListNotificationsRequest, opts ...grpc.CallOption) (*api.NotificationList, error)
+	// List user's validated purchases.
+	ListPurchases(ctx context.Context, in *api.ListPurchasesRequest, opts ...grpc.CallOption) (*api.PurchaseList, error)
 	// List publicly readable storage objects in a given collection.
 	ListStorageObjects(ctx context.Context, in *api.ListStorageObjectsRequest, opts ...grpc.CallOption) (*api.StorageObjectList, error)
 	// List current or upcoming tournaments.
@@ -261,8 +320,22 @@ type NakamaClient interface {
 	PromoteGroupUsers(ctx context.Context, in *api.PromoteGroupUsersRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Get storage objects.
 	ReadStorageObjects(ctx context.Context, in *api.ReadStorageObjectsRequest, opts ...grpc.CallOption) (*api.StorageObjects, error)
+	// Request the current user's account is erased after a grace period.
+	RequestAccountErasure(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.AccountErasure, error)
+	// Reset the password of an account using a token from a password reset email.
+	ResetPassword(ctx context.Context, in *api.ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Execute a Lua function on the server.
 	RpcFunc(ctx context.Context, in *api.Rpc, opts ...grpc.CallOption) (*api.Rpc, error)
+	// Send an email to the current user's email address with a token to verify it.
+	SendEmailVerification(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Send a password reset email with a token to the account with the given email address, if there is one.
+	SendPasswordReset(ctx context.Context, in *api.SendPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Log out a session, invalidate its session and refresh tokens, and disconnect any sockets opened with it.
+	SessionLogout(ctx context.Context, in *api.SessionLogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Refresh a user's session using a refresh token retrieved from a previous authentication request.
+	SessionRefresh(ctx context.Context, in *api.SessionRefreshRequest, opts ...grpc.CallOption) (*api.Session, error)
+	// Remove the Apple ID from the social profiles on the current user's account.
+	UnlinkApple(ctx context.Context, in *api.AccountApple, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Remove the custom ID from the social profiles on the current user's account.
 	UnlinkCustom(ctx context.Context, in *api.AccountCustom, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Remove the device ID from the social profiles on the current user's account.
@@ -275,12 +348,22 @@ type NakamaClient interface {
 	UnlinkGameCenter(ctx context.Context, in *api.AccountGameCenter, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Remove Google from the social profiles on the current user's account.
 	UnlinkGoogle(ctx context.Context, in *api.AccountGoogle, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Remove an OpenID Connect identity from the social profiles on the current user's account.
+	UnlinkOidc(ctx context.Context, in *api.AccountOidc, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Remove Steam from the social profiles on the current user's account.
 	UnlinkSteam(ctx context.Context, in *api.AccountSteam, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Update fields in the current user's account.
 	UpdateAccount(ctx context.Context, in *api.UpdateAccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Update fields in a given group.
 	UpdateGroup(ctx context.Context, in *api.UpdateGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Validate Apple IAP Receipt.
+	ValidatePurchaseApple(ctx context.Context, in *api.ValidatePurchaseAppleRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error)
+	// Validate Google IAP Receipt.
+	ValidatePurchaseGoogle(ctx context.Context, in *api.ValidatePurchaseGoogleRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error)
+	// Validate Huawei IAP Receipt.
+	ValidatePurchaseHuawei(ctx context.Context, in *api.ValidatePurchaseHuaweiRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error)
+	// Verify the email address of an account using a token from a verification email.
+	VerifyEmail(ctx context.Context, in *api.VerifyEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Write a record to a leaderboard.
 	WriteLeaderboardRecord(ctx context.Context, in *api.WriteLeaderboardRecordRequest, opts ...grpc.CallOption) (*api.LeaderboardRecord, error)
 	// Write objects into the storage engine.
@@ -315,6 +398,15 @@ func (c *nakamaClient) AddGroupUsers(ctx context.Context, in *api.AddGroupUsersR
 	return out, nil
 }
 
+func (c *nakamaClient) AuthenticateApple(ctx context.Context, in *api.AuthenticateAppleRequest, opts ...grpc.CallOption) (*api.Session, error) {
+	out := new(api.Session)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/AuthenticateApple", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) AuthenticateCustom(ctx context.Context, in *api.AuthenticateCustomRequest, opts ...grpc.CallOption) (*api.Session, error) {
 	out := new(api.Session)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/AuthenticateCustom", in, out, opts...)
@@ -369,6 +461,15 @@ func (c *nakamaClient) AuthenticateGoogle(ctx context.Context, in *api.Authentic
 	return out, nil
 }
 
+func (c *nakamaClient) AuthenticateOidc(ctx context.Context, in *api.AuthenticateOidcRequest, opts ...grpc.CallOption) (*api.Session, error) {
+	out := new(api.Session)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/AuthenticateOidc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) AuthenticateSteam(ctx context.Context, in *api.AuthenticateSteamRequest, opts ...grpc.CallOption) (*api.Session, error) {
 	out := new(api.Session)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/AuthenticateSteam", in, out, opts...)
@@ -387,6 +488,15 @@ func (c *nakamaClient) BlockFriends(ctx context.Context, in *api.BlockFriendsReq
 	return out, nil
 }
 
+func (c *nakamaClient) CancelAccountErasure(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/CancelAccountErasure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) CreateGroup(ctx context.Context, in *api.CreateGroupRequest, opts ...grpc.CallOption) (*api.Group, error) {
 	out := new(api.Group)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/CreateGroup", in, out, opts...)
@@ -396,6 +506,15 @@ func (c *nakamaClient) CreateGroup(ctx context.Context, in *api.CreateGroupReque
 	return out, nil
 }
 
+func (c *nakamaClient) DeclineGroupJoinRequests(ctx context.Context, in *api.DeclineGroupJoinRequestsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/DeclineGroupJoinRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) DeleteFriends(ctx context.Context, in *api.DeleteFriendsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/DeleteFriends", in, out, opts...)
@@ -441,6 +560,15 @@ func (c *nakamaClient) DeleteStorageObjects(ctx context.Context, in *api.DeleteS
 	return out, nil
 }
 
+func (c *nakamaClient) ExportAccount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.AccountExportArchive, error) {
+	out := new(api.AccountExportArchive)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ExportAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) GetAccount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.Account, error) {
 	out := new(api.Account)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/GetAccount", in, out, opts...)
@@ -513,6 +641,15 @@ func (c *nakamaClient) LeaveGroup(ctx context.Context, in *api.LeaveGroupRequest
 	return out, nil
 }
 
+func (c *nakamaClient) LinkApple(ctx context.Context, in *api.AccountApple, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/LinkApple", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) LinkCustom(ctx context.Context, in *api.AccountCustom, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/LinkCustom", in, out, opts...)
@@ -567,6 +704,15 @@ func (c *nakamaClient) LinkGoogle(ctx context.Context, in *api.AccountGoogle, op
 	return out, nil
 }
 
+func (c *nakamaClient) LinkOidc(ctx context.Context, in *api.AccountOidc, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/LinkOidc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) LinkSteam(ctx context.Context, in *api.AccountSteam, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/LinkSteam", in, out, opts...)
@@ -585,7 +731,16 @@ func (c *nakamaClient) ListChannelMessages(ctx context.Context, in *api.ListChan
 	return out, nil
 }
 
-func (c *nakamaClient) ListFriends(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.Friends, error) {
+func (c *nakamaClient) ListFriendSuggestions(ctx context.Context, in *api.ListFriendSuggestionsRequest, opts ...grpc.CallOption) (*api.FriendSuggestionList, error) {
+	out := new(api.FriendSuggestionList)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ListFriendSuggestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ListFriends(ctx context.Context, in *api.ListFriendsRequest, opts ...grpc.CallOption) (*api.Friends, error) {
 	out := new(api.Friends)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ListFriends", in, out, opts...)
 	if err != nil {
@@ -594,6 +749,15 @@ func (c *nakamaClient) ListFriends(ctx context.Context, in *empty.Empty, opts ..
 	return out, nil
 }
 
+func (c *nakamaClient) ListGroupJoinRequests(ctx context.Context, in *api.ListGroupJoinRequestsRequest, opts ...grpc.CallOption) (*api.GroupUserList, error) {
+	out := new(api.GroupUserList)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ListGroupJoinRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ListGroups(ctx context.Context, in *api.ListGroupsRequest, opts ...grpc.CallOption) (*api.GroupList, error) {
 	out := new(api.GroupList)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ListGroups", in, out, opts...)
@@ -639,6 +803,15 @@ func (c *nakamaClient) ListMatches(ctx context.Context, in *api.ListMatchesReque
 	return out, nil
 }
 
+func (c *nakamaClient) ListMutualFriends(ctx context.Context, in *api.ListMutualFriendsRequest, opts ...grpc.CallOption) (*api.Users, error) {
+	out := new(api.Users)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ListMutualFriends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ListNotifications(ctx context.Context, in *api.ListNotificationsRequest, opts ...grpc.CallOption) (*api.NotificationList, error) {
 	out := new(api.NotificationList)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ListNotifications", in, out, opts...)
@@ -648,6 +821,15 @@ func (c *nakamaClient) ListNotifications(ctx context.Context, in *api.ListNotifi
 	return out, nil
 }
 
+func (c *nakamaClient) ListPurchases(ctx context.Context, in *api.ListPurchasesRequest, opts ...grpc.CallOption) (*api.PurchaseList, error) {
+	out := new(api.PurchaseList)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ListPurchases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) ListStorageObjects(ctx context.Context, in *api.ListStorageObjectsRequest, opts ...grpc.CallOption) (*api.StorageObjectList, error) {
 	out := new(api.StorageObjectList)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ListStorageObjects", in, out, opts...)
@@ -711,6 +893,24 @@ func (c *nakamaClient) ReadStorageObjects(ctx context.Context, in *api.ReadStora
 	return out, nil
 }
 
+func (c *nakamaClient) RequestAccountErasure(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*api.AccountErasure, error) {
+	out := new(api.AccountErasure)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/RequestAccountErasure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ResetPassword(ctx context.Context, in *api.ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) RpcFunc(ctx context.Context, in *api.Rpc, opts ...grpc.CallOption) (*api.Rpc, error) {
 	out := new(api.Rpc)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/RpcFunc", in, out, opts...)
@@ -720,6 +920,51 @@ func (c *nakamaClient) RpcFunc(ctx context.Context, in *api.Rpc, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *nakamaClient) SendEmailVerification(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/SendEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) SendPasswordReset(ctx context.Context, in *api.SendPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/SendPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) SessionLogout(ctx context.Context, in *api.SessionLogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/SessionLogout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) SessionRefresh(ctx context.Context, in *api.SessionRefreshRequest, opts ...grpc.CallOption) (*api.Session, error) {
+	out := new(api.Session)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/SessionRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) UnlinkApple(ctx context.Context, in *api.AccountApple, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/UnlinkApple", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) UnlinkCustom(ctx context.Context, in *api.AccountCustom, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/UnlinkCustom", in, out, opts...)
@@ -774,6 +1019,15 @@ func (c *nakamaClient) UnlinkGoogle(ctx context.Context, in *api.AccountGoogle,
 	return out, nil
 }
 
+func (c *nakamaClient) UnlinkOidc(ctx context.Context, in *api.AccountOidc, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/UnlinkOidc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) UnlinkSteam(ctx context.Context, in *api.AccountSteam, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/UnlinkSteam", in, out, opts...)
@@ -801,6 +1055,42 @@ func (c *nakamaClient) UpdateGroup(ctx context.Context, in *api.UpdateGroupReque
 	return out, nil
 }
 
+func (c *nakamaClient) ValidatePurchaseApple(ctx context.Context, in *api.ValidatePurchaseAppleRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error) {
+	out := new(api.ValidatePurchaseResponse)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ValidatePurchaseApple", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ValidatePurchaseGoogle(ctx context.Context, in *api.ValidatePurchaseGoogleRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error) {
+	out := new(api.ValidatePurchaseResponse)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ValidatePurchaseGoogle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) ValidatePurchaseHuawei(ctx context.Context, in *api.ValidatePurchaseHuaweiRequest, opts ...grpc.CallOption) (*api.ValidatePurchaseResponse, error) {
+	out := new(api.ValidatePurchaseResponse)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/ValidatePurchaseHuawei", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nakamaClient) VerifyEmail(ctx context.Context, in *api.VerifyEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) WriteLeaderboardRecord(ctx context.Context, in *api.WriteLeaderboardRecordRequest, opts ...grpc.CallOption) (*api.LeaderboardRecord, error) {
 	out := new(api.LeaderboardRecord)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/WriteLeaderboardRecord", in, out, opts...)
@@ -834,6 +1124,8 @@ type NakamaServer interface {
 	AddFriends(context.Context, *api.AddFriendsRequest) (*empty.Empty, error)
 	// Add users to a group.
 	AddGroupUsers(context.Context, *api.AddGroupUsersRequest) (*empty.Empty, error)
+	// Authenticate a user with an Apple ID against the server.
+	AuthenticateApple(context.Context, *api.AuthenticateAppleRequest) (*api.Session, error)
 	// Authenticate a user with a custom id against the server.
 	AuthenticateCustom(context.Context, *api.AuthenticateCustomRequest) (*api.Session, error)
 	// Authenticate a user with a device id against the server.
@@ -846,12 +1138,18 @@ type NakamaServer interface {
 	AuthenticateGameCenter(context.Context, *api.AuthenticateGameCenterRequest) (*api.Session, error)
 	// Authenticate a user with Google against the server.
 	AuthenticateGoogle(context.Context, *api.AuthenticateGoogleRequest) (*api.Session, error)
+	// Authenticate a user with an OpenID Connect provider against the server.
+	AuthenticateOidc(context.Context, *api.AuthenticateOidcRequest) (*api.Session, error)
 	// Authenticate a user with Steam against the server.
 	AuthenticateSteam(context.Context, *api.AuthenticateSteamRequest) (*api.Session, error)
 	// Block one or more users by ID or username.
 	BlockFriends(context.Context, *api.BlockFriendsRequest) (*empty.Empty, error)
+	// Cancel a pending request to erase the current user's account.
+	CancelAccountErasure(context.Context, *empty.Empty) (*empty.Empty, error)
 	// Create a new group with the current user as the owner.
 	CreateGroup(context.Context, *api.CreateGroupRequest) (*api.Group, error)
+	// Decline pending requests to join a group.
+	DeclineGroupJoinRequests(context.Context, *api.DeclineGroupJoinRequestsRequest) (*empty.Empty, error)
 	// Delete one or more users by ID or username.
 	DeleteFriends(context.Context, *api.DeleteFriendsRequest) (*empty.Empty, error)
 	// Delete a group by ID.
@@ -862,6 +1160,8 @@ type NakamaServer interface {
 	DeleteNotifications(context.Context, *api.DeleteNotificationsRequest) (*empty.Empty, error)
 	// Delete one or more objects by ID or username.
 	DeleteStorageObjects(context.Context, *api.DeleteStorageObjectsRequest) (*empty.Empty, error)
+	// Export all data stored for the current user's account as an archive.
+	ExportAccount(context.Context, *empty.Empty) (*api.AccountExportArchive, error)
 	// Fetch the current user's account.
 	GetAccount(context.Context, *empty.Empty) (*api.Account, error)
 	// Fetch zero or more users by ID and/or username.
@@ -878,6 +1178,8 @@ type NakamaServer interface {
 	KickGroupUsers(context.Context, *api.KickGroupUsersRequest) (*empty.Empty, error)
 	// Leave a group the user is a member of.
 	LeaveGroup(context.Context, *api.LeaveGroupRequest) (*empty.Empty, error)
+	// Add an Apple ID to the social profiles on the current user's account.
+	LinkApple(context.Context, *api.AccountApple) (*empty.Empty, error)
 	// Add a custom ID to the social profiles on the current user's account.
 	LinkCustom(context.Context, *api.AccountCustom) (*empty.Empty, error)
 	// Add a device ID to the social profiles on the current user's account.
@@ -890,12 +1192,18 @@ type NakamaServer interface {
 	LinkGameCenter(context.Context, *api.AccountGameCenter) (*empty.Empty, error)
 	// Add Google to the social profiles on the current user's account.
 	LinkGoogle(context.Context, *api.AccountGoogle) (*empty.Empty, error)
+	// Add an OpenID Connect identity to the social profiles on the current user's account.
+	LinkOidc(context.Context, *api.AccountOidc) (*empty.Empty, error)
 	// Add Steam to the social profiles on the current user's account.
 	LinkSteam(context.Context, *api.AccountSteam) (*empty.Empty, error)
 	// List a channel's message history.
 	ListChannelMessages(context.Context, *api.ListChannelMessagesRequest) (*api.ChannelMessageList, error)
-	// List all friends for the current user.
-	ListFriends(context.Context, *empty.Empty) (*api.Friends, error)
+	// List users the current user may want to add as friends.
+	ListFriendSuggestions(context.Context, *api.ListFriendSuggestionsRequest) (*api.FriendSuggestionList, error)
+	// List friends, invites and blocked users of the current user.
+	ListFriends(context.Context, *api.ListFriendsRequest) (*api.Friends, error)
+	// List pending requests to join a group.
+	ListGroupJoinRequests(context.Context, *api.ListGroupJoinRequestsRequest) (*api.GroupUserList, error)
 	// List groups based on given filters.
 	ListGroups(context.Context, *api.ListGroupsRequest) (*api.GroupList, error)
 	// List all users that are part of a group.
@@ -906,8 +1214,12 @@ type NakamaServer interface {
 	ListLeaderboardRecordsAroundOwner(context.Context, *api.ListLeaderboardRecordsAroundOwnerRequest) (*api.LeaderboardRecordList, error)
 	// Fetch list of running matches.
 	ListMatches(context.Context, *api.ListMatchesRequest) (*api.MatchList, error)
+	// List the friends the current user shares with another user.
+	ListMutualFriends(context.Context, *api.ListMutualFriendsRequest) (*api.Users, error)
 	// Fetch list of notifications.
 	ListNotifications(context.Context, *api.ListNotificationsRequest) (*api.NotificationList, error)
+	// List user's validated purchases.
+	ListPurchases(context.Context, *api.ListPurchasesRequest) (*api.PurchaseList, error)
 	// List publicly readable storage objects in a given collection.
 	ListStorageObjects(context.Context, *api.ListStorageObjectsRequest) (*api.StorageObjectList, error)
 	// List current or upcoming tournaments.
@@ -922,8 +1234,22 @@ type NakamaServer interface {
 	PromoteGroupUsers(context.Context, *api.PromoteGroupUsersRequest) (*empty.Empty, error)
 	// Get storage objects.
 	ReadStorageObjects(context.Context, *api.ReadStorageObjectsRequest) (*api.StorageObjects, error)
+	// Request the current user's account is erased after a grace period.
+	RequestAccountErasure(context.Context, *empty.Empty) (*api.AccountErasure, error)
+	// Reset the password of an account using a token from a password reset email.
+	ResetPassword(context.Context, *api.ResetPasswordRequest) (*empty.Empty, error)
 	// Execute a Lua function on the server.
 	RpcFunc(context.Context, *api.Rpc) (*api.Rpc, error)
+	// Send an email to the current user's email address with a token to verify it.
+	SendEmailVerification(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Send a password reset email with a token to the account with the given email address, if there is one.
+	SendPasswordReset(context.Context, *api.SendPasswordResetRequest) (*empty.Empty, error)
+	// Log out a session, invalidate its session and refresh tokens, and disconnect any sockets opened with it.
+	SessionLogout(context.Context, *api.SessionLogoutRequest) (*empty.Empty, error)
+	// Refresh a user's session using a refresh token retrieved from a previous authentication request.
+	SessionRefresh(context.Context, *api.SessionRefreshRequest) (*api.Session, error)
+	// Remove the Apple ID from the social profiles on the current user's account.
+	UnlinkApple(context.Context, *api.AccountApple) (*empty.Empty, error)
 	// Remove the custom ID from the social profiles on the current user's account.
 	UnlinkCustom(context.Context, *api.AccountCustom) (*empty.Empty, error)
 	// Remove the device ID from the social profiles on the current user's account.
@@ -936,12 +1262,22 @@ type NakamaServer interface {
 	UnlinkGameCenter(context.Context, *api.AccountGameCenter) (*empty.Empty, error)
 	// Remove Google from the social profiles on the current user's account.
 	UnlinkGoogle(context.Context, *api.AccountGoogle) (*empty.Empty, error)
+	// Remove an OpenID Connect identity from the social profiles on the current user's account.
+	UnlinkOidc(context.Context, *api.AccountOidc) (*empty.Empty, error)
 	// Remove Steam from the social profiles on the current user's account.
 	UnlinkSteam(context.Context, *api.AccountSteam) (*empty.Empty, error)
 	// Update fields in the current user's account.
 	UpdateAccount(context.Context, *api.UpdateAccountRequest) (*empty.Empty, error)
 	// Update fields in a given group.
 	UpdateGroup(context.Context, *api.UpdateGroupRequest) (*empty.Empty, error)
+	// Validate Apple IAP Receipt.
+	ValidatePurchaseApple(context.Context, *api.ValidatePurchaseAppleRequest) (*api.ValidatePurchaseResponse, error)
+	// Validate Google IAP Receipt.
+	ValidatePurchaseGoogle(context.Context, *api.ValidatePurchaseGoogleRequest) (*api.ValidatePurchaseResponse, error)
+	// Validate Huawei IAP Receipt.
+	ValidatePurchaseHuawei(context.Context, *api.ValidatePurchaseHuaweiRequest) (*api.ValidatePurchaseResponse, error)
+	// Verify the email address of an account using a token from a verification email.
+	VerifyEmail(context.Context, *api.VerifyEmailRequest) (*empty.Empty, error)
 	// Write a record to a leaderboard.
 	WriteLeaderboardRecord(context.Context, *api.WriteLeaderboardRecordRequest) (*api.LeaderboardRecord, error)
 	// Write objects into the storage engine.
@@ -990,6 +1326,24 @@ func _Nakama_AddGroupUsers_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_AuthenticateApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.AuthenticateAppleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).AuthenticateApple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/AuthenticateApple",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).AuthenticateApple(ctx, req.(*api.AuthenticateAppleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_AuthenticateCustom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AuthenticateCustomRequest)
 	if err := dec(in); err != nil {
@@ -1098,6 +1452,24 @@ func _Nakama_AuthenticateGoogle_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_AuthenticateOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.AuthenticateOidcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).AuthenticateOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/AuthenticateOidc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).AuthenticateOidc(ctx, req.(*api.AuthenticateOidcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_AuthenticateSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AuthenticateSteamRequest)
 	if err := dec(in); err != nil {
@@ -1134,6 +1506,24 @@ func _Nakama_BlockFriends_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_CancelAccountErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).CancelAccountErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/CancelAccountErasure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).CancelAccountErasure(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.CreateGroupRequest)
 	if err := dec(in); err != nil {
@@ -1152,6 +1542,24 @@ func _Nakama_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_DeclineGroupJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.DeclineGroupJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).DeclineGroupJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/DeclineGroupJoinRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).DeclineGroupJoinRequests(ctx, req.(*api.DeclineGroupJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_DeleteFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.DeleteFriendsRequest)
 	if err := dec(in); err != nil {
@@ -1242,6 +1650,24 @@ func _Nakama_DeleteStorageObjects_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_ExportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ExportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ExportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ExportAccount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -1386,6 +1812,24 @@ func _Nakama_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_LinkApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.AccountApple)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).LinkApple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/LinkApple",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).LinkApple(ctx, req.(*api.AccountApple))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_LinkCustom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AccountCustom)
 	if err := dec(in); err != nil {
@@ -1494,6 +1938,24 @@ func _Nakama_LinkGoogle_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_LinkOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.AccountOidc)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).LinkOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/LinkOidc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).LinkOidc(ctx, req.(*api.AccountOidc))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_LinkSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AccountSteam)
 	if err := dec(in); err != nil {
@@ -1530,8 +1992,26 @@ func _Nakama_ListChannelMessages_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_ListFriendSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListFriendSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListFriendSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ListFriendSuggestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListFriendSuggestions(ctx, req.(*api.ListFriendSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+	in := new(api.ListFriendsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1543,7 +2023,25 @@ func _Nakama_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: "/nakama.api.Nakama/ListFriends",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NakamaServer).ListFriends(ctx, req.(*empty.Empty))
+		return srv.(NakamaServer).ListFriends(ctx, req.(*api.ListFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ListGroupJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListGroupJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListGroupJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ListGroupJoinRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListGroupJoinRequests(ctx, req.(*api.ListGroupJoinRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1638,6 +2136,24 @@ func _Nakama_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_ListMutualFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListMutualFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListMutualFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ListMutualFriends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListMutualFriends(ctx, req.(*api.ListMutualFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.ListNotificationsRequest)
 	if err := dec(in); err != nil {
@@ -1656,6 +2172,24 @@ func _Nakama_ListNotifications_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_ListPurchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ListPurchasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ListPurchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ListPurchases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ListPurchases(ctx, req.(*api.ListPurchasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_ListStorageObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.ListStorageObjectsRequest)
 	if err := dec(in); err != nil {
@@ -1782,6 +2316,42 @@ func _Nakama_ReadStorageObjects_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_RequestAccountErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).RequestAccountErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/RequestAccountErasure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).RequestAccountErasure(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ResetPassword(ctx, req.(*api.ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_RpcFunc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.Rpc)
 	if err := dec(in); err != nil {
@@ -1800,6 +2370,96 @@ func _Nakama_RpcFunc_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_SendEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).SendEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/SendEmailVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).SendEmailVerification(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_SendPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.SendPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).SendPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/SendPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).SendPasswordReset(ctx, req.(*api.SendPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_SessionLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.SessionLogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).SessionLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/SessionLogout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).SessionLogout(ctx, req.(*api.SessionLogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_SessionRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.SessionRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).SessionRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/SessionRefresh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).SessionRefresh(ctx, req.(*api.SessionRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_UnlinkApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.AccountApple)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).UnlinkApple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/UnlinkApple",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).UnlinkApple(ctx, req.(*api.AccountApple))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_UnlinkCustom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AccountCustom)
 	if err := dec(in); err != nil {
@@ -1908,6 +2568,24 @@ func _Nakama_UnlinkGoogle_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_UnlinkOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.AccountOidc)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).UnlinkOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/UnlinkOidc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).UnlinkOidc(ctx, req.(*api.AccountOidc))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_UnlinkSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AccountSteam)
 	if err := dec(in); err != nil {
@@ -1962,6 +2640,78 @@ func _Nakama_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_ValidatePurchaseApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ValidatePurchaseAppleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ValidatePurchaseApple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ValidatePurchaseApple",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ValidatePurchaseApple(ctx, req.(*api.ValidatePurchaseAppleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ValidatePurchaseGoogle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ValidatePurchaseGoogleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ValidatePurchaseGoogle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ValidatePurchaseGoogle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ValidatePurchaseGoogle(ctx, req.(*api.ValidatePurchaseGoogleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_ValidatePurchaseHuawei_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.ValidatePurchaseHuaweiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).ValidatePurchaseHuawei(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/ValidatePurchaseHuawei",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).ValidatePurchaseHuawei(ctx, req.(*api.ValidatePurchaseHuaweiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nakama_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/VerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).VerifyEmail(ctx, req.(*api.VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_WriteLeaderboardRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.WriteLeaderboardRecordRequest)
 	if err := dec(in); err != nil {
@@ -2028,6 +2778,10 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddGroupUsers",
 			Handler:    _Nakama_AddGroupUsers_Handler,
 		},
+		{
+			MethodName: "AuthenticateApple",
+			Handler:    _Nakama_AuthenticateApple_Handler,
+		},
 		{
 			MethodName: "AuthenticateCustom",
 			Handler:    _Nakama_AuthenticateCustom_Handler,
@@ -2052,6 +2806,10 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticateGoogle",
 			Handler:    _Nakama_AuthenticateGoogle_Handler,
 		},
+		{
+			MethodName: "AuthenticateOidc",
+			Handler:    _Nakama_AuthenticateOidc_Handler,
+		},
 		{
 			MethodName: "AuthenticateSteam",
 			Handler:    _Nakama_AuthenticateSteam_Handler,
@@ -2060,10 +2818,18 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockFriends",
 			Handler:    _Nakama_BlockFriends_Handler,
 		},
+		{
+			MethodName: "CancelAccountErasure",
+			Handler:    _Nakama_CancelAccountErasure_Handler,
+		},
 		{
 			MethodName: "CreateGroup",
 			Handler:    _Nakama_CreateGroup_Handler,
 		},
+		{
+			MethodName: "DeclineGroupJoinRequests",
+			Handler:    _Nakama_DeclineGroupJoinRequests_Handler,
+		},
 		{
 			MethodName: "DeleteFriends",
 			Handler:    _Nakama_DeleteFriends_Handler,
@@ -2084,6 +2850,10 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStorageObjects",
 			Handler:    _Nakama_DeleteStorageObjects_Handler,
 		},
+		{
+			MethodName: "ExportAccount",
+			Handler:    _Nakama_ExportAccount_Handler,
+		},
 		{
 			MethodName: "GetAccount",
 			Handler:    _Nakama_GetAccount_Handler,
@@ -2116,6 +2886,10 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Nakama_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "LinkApple",
+			Handler:    _Nakama_LinkApple_Handler,
+		},
 		{
 			MethodName: "LinkCustom",
 			Handler:    _Nakama_LinkCustom_Handler,
@@ -2140,6 +2914,10 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LinkGoogle",
 			Handler:    _Nakama_LinkGoogle_Handler,
 		},
+		{
+			MethodName: "LinkOidc",
+			Handler:    _Nakama_LinkOidc_Handler,
+		},
 		{
 			MethodName: "LinkSteam",
 			Handler:    _Nakama_LinkSteam_Handler,
@@ -2148,10 +2926,18 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListChannelMessages",
 			Handler:    _Nakama_ListChannelMessages_Handler,
 		},
+		{
+			MethodName: "ListFriendSuggestions",
+			Handler:    _Nakama_ListFriendSuggestions_Handler,
+		},
 		{
 			MethodName: "ListFriends",
 			Handler:    _Nakama_ListFriends_Handler,
 		},
+		{
+			MethodName: "ListGroupJoinRequests",
+			Handler:    _Nakama_ListGroupJoinRequests_Handler,
+		},
 		{
 			MethodName: "ListGroups",
 			Handler:    _Nakama_ListGroups_Handler,
@@ -2172,10 +2958,18 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListMatches",
 			Handler:    _Nakama_ListMatches_Handler,
 		},
+		{
+			MethodName: "ListMutualFriends",
+			Handler:    _Nakama_ListMutualFriends_Handler,
+		},
 		{
 			MethodName: "ListNotifications",
 			Handler:    _Nakama_ListNotifications_Handler,
 		},
+		{
+			MethodName: "ListPurchases",
+			Handler:    _Nakama_ListPurchases_Handler,
+		},
 		{
 			MethodName: "ListStorageObjects",
 			Handler:    _Nakama_ListStorageObjects_Handler,
@@ -2204,10 +2998,38 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReadStorageObjects",
 			Handler:    _Nakama_ReadStorageObjects_Handler,
 		},
+		{
+			MethodName: "RequestAccountErasure",
+			Handler:    _Nakama_RequestAccountErasure_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _Nakama_ResetPassword_Handler,
+		},
 		{
 			MethodName: "RpcFunc",
 			Handler:    _Nakama_RpcFunc_Handler,
 		},
+		{
+			MethodName: "SendEmailVerification",
+			Handler:    _Nakama_SendEmailVerification_Handler,
+		},
+		{
+			MethodName: "SendPasswordReset",
+			Handler:    _Nakama_SendPasswordReset_Handler,
+		},
+		{
+			MethodName: "SessionLogout",
+			Handler:    _Nakama_SessionLogout_Handler,
+		},
+		{
+			MethodName: "SessionRefresh",
+			Handler:    _Nakama_SessionRefresh_Handler,
+		},
+		{
+			MethodName: "UnlinkApple",
+			Handler:    _Nakama_UnlinkApple_Handler,
+		},
 		{
 			MethodName: "UnlinkCustom",
 			Handler:    _Nakama_UnlinkCustom_Handler,
@@ -2232,6 +3054,10 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnlinkGoogle",
 			Handler:    _Nakama_UnlinkGoogle_Handler,
 		},
+		{
+			MethodName: "UnlinkOidc",
+			Handler:    _Nakama_UnlinkOidc_Handler,
+		},
 		{
 			MethodName: "UnlinkSteam",
 			Handler:    _Nakama_UnlinkSteam_Handler,
@@ -2244,6 +3070,22 @@ var _Nakama_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroup",
 			Handler:    _Nakama_UpdateGroup_Handler,
 		},
+		{
+			MethodName: "ValidatePurchaseApple",
+			Handler:    _Nakama_ValidatePurchaseApple_Handler,
+		},
+		{
+			MethodName: "ValidatePurchaseGoogle",
+			Handler:    _Nakama_ValidatePurchaseGoogle_Handler,
+		},
+		{
+			MethodName: "ValidatePurchaseHuawei",
+			Handler:    _Nakama_ValidatePurchaseHuawei_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _Nakama_VerifyEmail_Handler,
+		},
 		{
 			MethodName: "WriteLeaderboardRecord",
 			Handler:    _Nakama_WriteLeaderboardRecord_Handler,
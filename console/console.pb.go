@@ -28,6 +28,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Which account's data is kept when both accounts have data in the same place.
+type MergeAccountsRequest_Conflict int32
+
+const (
+	// Keep the target account's data.
+	MergeAccountsRequest_KEEP_TARGET MergeAccountsRequest_Conflict = 0
+	// Keep the source account's data.
+	MergeAccountsRequest_KEEP_SOURCE MergeAccountsRequest_Conflict = 1
+	// Keep whichever was updated most recently, or the target account's if updated at the same time.
+	MergeAccountsRequest_KEEP_NEWEST MergeAccountsRequest_Conflict = 2
+)
+
+var MergeAccountsRequest_Conflict_name = map[int32]string{
+	0: "KEEP_TARGET",
+	1: "KEEP_SOURCE",
+	2: "KEEP_NEWEST",
+}
+
+var MergeAccountsRequest_Conflict_value = map[string]int32{
+	"KEEP_TARGET": 0,
+	"KEEP_SOURCE": 1,
+	"KEEP_NEWEST": 2,
+}
+
+func (x MergeAccountsRequest_Conflict) String() string {
+	return proto.EnumName(MergeAccountsRequest_Conflict_name, int32(x))
+}
+
+func (MergeAccountsRequest_Conflict) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{14, 0}
+}
+
 // Delete a user account.
 type AccountDeleteRequest struct {
 	// The unique identifier of the user account.
@@ -90,15 +122,23 @@ type AccountExport struct {
 	Groups []*api.Group `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
 	// The user's chat messages.
 	Messages []*api.ChannelMessage `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty"`
-	// The user's leaderboard records.
+	// The user's leaderboard and tournament records.
 	LeaderboardRecords []*api.LeaderboardRecord `protobuf:"bytes,6,rep,name=leaderboard_records,json=leaderboardRecords,proto3" json:"leaderboard_records,omitempty"`
 	// The user's notifications.
 	Notifications []*api.Notification `protobuf:"bytes,7,rep,name=notifications,proto3" json:"notifications,omitempty"`
 	// The user's wallet ledger items.
-	WalletLedgers        []*WalletLedger `protobuf:"bytes,8,rep,name=wallet_ledgers,json=walletLedgers,proto3" json:"wallet_ledgers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	WalletLedgers []*WalletLedger `protobuf:"bytes,8,rep,name=wallet_ledgers,json=walletLedgers,proto3" json:"wallet_ledgers,omitempty"`
+	// The user's group memberships and join requests, with their role in each group.
+	GroupMemberships []*api.UserGroupList_UserGroup `protobuf:"bytes,9,rep,name=group_memberships,json=groupMemberships,proto3" json:"group_memberships,omitempty"`
+	// The user's validated in-app purchases.
+	Purchases []*api.ValidatedPurchase `protobuf:"bytes,10,rep,name=purchases,proto3" json:"purchases,omitempty"`
+	// The user's sessions, identified by their refresh tokens.
+	Sessions []*AccountExport_Session `protobuf:"bytes,11,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// The user's pending erasure request, if any.
+	Erasure              *api.AccountErasure `protobuf:"bytes,12,opt,name=erasure,proto3" json:"erasure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *AccountExport) Reset()         { *m = AccountExport{} }
@@ -182,6 +222,93 @@ func (m *AccountExport) GetWalletLedgers() []*WalletLedger {
 	return nil
 }
 
+func (m *AccountExport) GetGroupMemberships() []*api.UserGroupList_UserGroup {
+	if m != nil {
+		return m.GroupMemberships
+	}
+	return nil
+}
+
+func (m *AccountExport) GetPurchases() []*api.ValidatedPurchase {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+func (m *AccountExport) GetSessions() []*AccountExport_Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *AccountExport) GetErasure() *api.AccountErasure {
+	if m != nil {
+		return m.Erasure
+	}
+	return nil
+}
+
+// A session the user has opened, identified by its refresh token.
+type AccountExport_Session struct {
+	// The refresh token ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The UNIX time when the session was created.
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The UNIX time when the refresh token expires.
+	ExpiryTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AccountExport_Session) Reset()         { *m = AccountExport_Session{} }
+func (m *AccountExport_Session) String() string { return proto.CompactTextString(m) }
+func (*AccountExport_Session) ProtoMessage()    {}
+func (*AccountExport_Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{1, 0}
+}
+
+func (m *AccountExport_Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountExport_Session.Unmarshal(m, b)
+}
+func (m *AccountExport_Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountExport_Session.Marshal(b, m, deterministic)
+}
+func (m *AccountExport_Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountExport_Session.Merge(m, src)
+}
+func (m *AccountExport_Session) XXX_Size() int {
+	return xxx_messageInfo_AccountExport_Session.Size(m)
+}
+func (m *AccountExport_Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountExport_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountExport_Session proto.InternalMessageInfo
+
+func (m *AccountExport_Session) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AccountExport_Session) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *AccountExport_Session) GetExpiryTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
 // The identifier for a user account.
 type AccountId struct {
 	// The unique identifier of the user account.
@@ -632,6 +759,47 @@ func (m *DeleteWalletLedgerRequest) GetWalletId() string {
 	return ""
 }
 
+// List pending matchmaker tickets.
+type ListMatchmakerTicketsRequest struct {
+	// Max number of tickets to return, oldest first. All tickets are returned if not set.
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMatchmakerTicketsRequest) Reset()         { *m = ListMatchmakerTicketsRequest{} }
+func (m *ListMatchmakerTicketsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMatchmakerTicketsRequest) ProtoMessage()    {}
+func (*ListMatchmakerTicketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{10}
+}
+
+func (m *ListMatchmakerTicketsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMatchmakerTicketsRequest.Unmarshal(m, b)
+}
+func (m *ListMatchmakerTicketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMatchmakerTicketsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMatchmakerTicketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMatchmakerTicketsRequest.Merge(m, src)
+}
+func (m *ListMatchmakerTicketsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMatchmakerTicketsRequest.Size(m)
+}
+func (m *ListMatchmakerTicketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMatchmakerTicketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMatchmakerTicketsRequest proto.InternalMessageInfo
+
+func (m *ListMatchmakerTicketsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 // List (and optionally filter) storage objects.
 type ListStorageRequest struct {
 	// User ID to filter objects for.
@@ -645,7 +813,7 @@ func (m *ListStorageRequest) Reset()         { *m = ListStorageRequest{} }
 func (m *ListStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*ListStorageRequest) ProtoMessage()    {}
 func (*ListStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{10}
+	return fileDescriptor_9289ac5ba895f2a7, []int{11}
 }
 
 func (m *ListStorageRequest) XXX_Unmarshal(b []byte) error {
@@ -690,7 +858,7 @@ func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
 func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUsersRequest) ProtoMessage()    {}
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{11}
+	return fileDescriptor_9289ac5ba895f2a7, []int{12}
 }
 
 func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -732,6 +900,142 @@ func (m *ListUsersRequest) GetTombstones() bool {
 	return false
 }
 
+// Log out a user's session.
+type LogoutUserRequest struct {
+	// The unique identifier of the user account.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Session token to log out. If neither token is set, all of the user's sessions are logged out.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Refresh token to invalidate.
+	RefreshToken         string   `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutUserRequest) Reset()         { *m = LogoutUserRequest{} }
+func (m *LogoutUserRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutUserRequest) ProtoMessage()    {}
+func (*LogoutUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{13}
+}
+
+func (m *LogoutUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutUserRequest.Unmarshal(m, b)
+}
+func (m *LogoutUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutUserRequest.Marshal(b, m, deterministic)
+}
+func (m *LogoutUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutUserRequest.Merge(m, src)
+}
+func (m *LogoutUserRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutUserRequest.Size(m)
+}
+func (m *LogoutUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutUserRequest proto.InternalMessageInfo
+
+func (m *LogoutUserRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LogoutUserRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *LogoutUserRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+// Merge a user account into another.
+type MergeAccountsRequest struct {
+	// User ID of the account to merge and delete.
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// User ID of the account that receives the merged data.
+	TargetId string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Conflict rule for custom ID, email, social and OpenID Connect identities.
+	Identities MergeAccountsRequest_Conflict `protobuf:"varint,3,opt,name=identities,proto3,enum=nakama.console.MergeAccountsRequest_Conflict" json:"identities,omitempty"`
+	// Conflict rule for storage objects with the same collection and key.
+	Storage MergeAccountsRequest_Conflict `protobuf:"varint,4,opt,name=storage,proto3,enum=nakama.console.MergeAccountsRequest_Conflict" json:"storage,omitempty"`
+	// Conflict rule for records in the same leaderboard or tournament period.
+	Leaderboard          MergeAccountsRequest_Conflict `protobuf:"varint,5,opt,name=leaderboard,proto3,enum=nakama.console.MergeAccountsRequest_Conflict" json:"leaderboard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *MergeAccountsRequest) Reset()         { *m = MergeAccountsRequest{} }
+func (m *MergeAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeAccountsRequest) ProtoMessage()    {}
+func (*MergeAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{14}
+}
+
+func (m *MergeAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeAccountsRequest.Unmarshal(m, b)
+}
+func (m *MergeAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *MergeAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeAccountsRequest.Merge(m, src)
+}
+func (m *MergeAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_MergeAccountsRequest.Size(m)
+}
+func (m *MergeAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeAccountsRequest proto.InternalMessageInfo
+
+func (m *MergeAccountsRequest) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+func (m *MergeAccountsRequest) GetTargetId() string {
+	if m != nil {
+		return m.TargetId
+	}
+	return ""
+}
+
+func (m *MergeAccountsRequest) GetIdentities() MergeAccountsRequest_Conflict {
+	if m != nil {
+		return m.Identities
+	}
+	return MergeAccountsRequest_KEEP_TARGET
+}
+
+func (m *MergeAccountsRequest) GetStorage() MergeAccountsRequest_Conflict {
+	if m != nil {
+		return m.Storage
+	}
+	return MergeAccountsRequest_KEEP_TARGET
+}
+
+func (m *MergeAccountsRequest) GetLeaderboard() MergeAccountsRequest_Conflict {
+	if m != nil {
+		return m.Leaderboard
+	}
+	return MergeAccountsRequest_KEEP_TARGET
+}
+
 // List of storage objects.
 type StorageList struct {
 	// List of storage objects matching list/filter operation.
@@ -747,7 +1051,7 @@ func (m *StorageList) Reset()         { *m = StorageList{} }
 func (m *StorageList) String() string { return proto.CompactTextString(m) }
 func (*StorageList) ProtoMessage()    {}
 func (*StorageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{12}
+	return fileDescriptor_9289ac5ba895f2a7, []int{15}
 }
 
 func (m *StorageList) XXX_Unmarshal(b []byte) error {
@@ -797,7 +1101,7 @@ func (m *UnlinkDeviceRequest) Reset()         { *m = UnlinkDeviceRequest{} }
 func (m *UnlinkDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlinkDeviceRequest) ProtoMessage()    {}
 func (*UnlinkDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{13}
+	return fileDescriptor_9289ac5ba895f2a7, []int{16}
 }
 
 func (m *UnlinkDeviceRequest) XXX_Unmarshal(b []byte) error {
@@ -832,6 +1136,56 @@ func (m *UnlinkDeviceRequest) GetDeviceId() string {
 	return ""
 }
 
+// Unlink an OpenID Connect identity from a user's account.
+type UnlinkOidcRequest struct {
+	// User ID to unlink from.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the OpenID Connect provider to unlink.
+	Provider             string   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlinkOidcRequest) Reset()         { *m = UnlinkOidcRequest{} }
+func (m *UnlinkOidcRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlinkOidcRequest) ProtoMessage()    {}
+func (*UnlinkOidcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{17}
+}
+
+func (m *UnlinkOidcRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlinkOidcRequest.Unmarshal(m, b)
+}
+func (m *UnlinkOidcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlinkOidcRequest.Marshal(b, m, deterministic)
+}
+func (m *UnlinkOidcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlinkOidcRequest.Merge(m, src)
+}
+func (m *UnlinkOidcRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlinkOidcRequest.Size(m)
+}
+func (m *UnlinkOidcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlinkOidcRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlinkOidcRequest proto.InternalMessageInfo
+
+func (m *UnlinkOidcRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UnlinkOidcRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
 // Update user account information.
 type UpdateAccountRequest struct {
 	// User ID to update.
@@ -867,7 +1221,7 @@ func (m *UpdateAccountRequest) Reset()         { *m = UpdateAccountRequest{} }
 func (m *UpdateAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateAccountRequest) ProtoMessage()    {}
 func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{14}
+	return fileDescriptor_9289ac5ba895f2a7, []int{18}
 }
 
 func (m *UpdateAccountRequest) XXX_Unmarshal(b []byte) error {
@@ -987,7 +1341,7 @@ func (m *UserList) Reset()         { *m = UserList{} }
 func (m *UserList) String() string { return proto.CompactTextString(m) }
 func (*UserList) ProtoMessage()    {}
 func (*UserList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{15}
+	return fileDescriptor_9289ac5ba895f2a7, []int{19}
 }
 
 func (m *UserList) XXX_Unmarshal(b []byte) error {
@@ -1022,6 +1376,246 @@ func (m *UserList) GetTotalCount() int32 {
 	return 0
 }
 
+// A pending matchmaker ticket.
+type MatchmakerTicket struct {
+	// The ticket.
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	// The party the ticket was submitted for, if any.
+	PartyId string `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// The users covered by the ticket, the submitting user first.
+	Presences []*MatchmakerTicket_Presence `protobuf:"bytes,3,rep,name=presences,proto3" json:"presences,omitempty"`
+	// The submitted matchmaker query.
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// Minimum total user count to match together.
+	MinCount int32 `protobuf:"varint,5,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// Maximum total user count to match together.
+	MaxCount int32 `protobuf:"varint,6,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	// String properties.
+	StringProperties map[string]string `protobuf:"bytes,7,rep,name=string_properties,json=stringProperties,proto3" json:"string_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Numeric properties.
+	NumericProperties map[string]float64 `protobuf:"bytes,8,rep,name=numeric_properties,json=numericProperties,proto3" json:"numeric_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// The UNIX time when the ticket was submitted.
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Number of seconds the ticket has been waiting.
+	WaitSec              int64    `protobuf:"varint,10,opt,name=wait_sec,json=waitSec,proto3" json:"wait_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatchmakerTicket) Reset()         { *m = MatchmakerTicket{} }
+func (m *MatchmakerTicket) String() string { return proto.CompactTextString(m) }
+func (*MatchmakerTicket) ProtoMessage()    {}
+func (*MatchmakerTicket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{20}
+}
+
+func (m *MatchmakerTicket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchmakerTicket.Unmarshal(m, b)
+}
+func (m *MatchmakerTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchmakerTicket.Marshal(b, m, deterministic)
+}
+func (m *MatchmakerTicket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchmakerTicket.Merge(m, src)
+}
+func (m *MatchmakerTicket) XXX_Size() int {
+	return xxx_messageInfo_MatchmakerTicket.Size(m)
+}
+func (m *MatchmakerTicket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchmakerTicket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchmakerTicket proto.InternalMessageInfo
+
+func (m *MatchmakerTicket) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+func (m *MatchmakerTicket) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *MatchmakerTicket) GetPresences() []*MatchmakerTicket_Presence {
+	if m != nil {
+		return m.Presences
+	}
+	return nil
+}
+
+func (m *MatchmakerTicket) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *MatchmakerTicket) GetMinCount() int32 {
+	if m != nil {
+		return m.MinCount
+	}
+	return 0
+}
+
+func (m *MatchmakerTicket) GetMaxCount() int32 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+func (m *MatchmakerTicket) GetStringProperties() map[string]string {
+	if m != nil {
+		return m.StringProperties
+	}
+	return nil
+}
+
+func (m *MatchmakerTicket) GetNumericProperties() map[string]float64 {
+	if m != nil {
+		return m.NumericProperties
+	}
+	return nil
+}
+
+func (m *MatchmakerTicket) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *MatchmakerTicket) GetWaitSec() int64 {
+	if m != nil {
+		return m.WaitSec
+	}
+	return 0
+}
+
+// A user submitted as part of the ticket.
+type MatchmakerTicket_Presence struct {
+	// User ID.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Session ID.
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Username.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// Node the session is connected to.
+	Node                 string   `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatchmakerTicket_Presence) Reset()         { *m = MatchmakerTicket_Presence{} }
+func (m *MatchmakerTicket_Presence) String() string { return proto.CompactTextString(m) }
+func (*MatchmakerTicket_Presence) ProtoMessage()    {}
+func (*MatchmakerTicket_Presence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{20, 0}
+}
+
+func (m *MatchmakerTicket_Presence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchmakerTicket_Presence.Unmarshal(m, b)
+}
+func (m *MatchmakerTicket_Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchmakerTicket_Presence.Marshal(b, m, deterministic)
+}
+func (m *MatchmakerTicket_Presence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchmakerTicket_Presence.Merge(m, src)
+}
+func (m *MatchmakerTicket_Presence) XXX_Size() int {
+	return xxx_messageInfo_MatchmakerTicket_Presence.Size(m)
+}
+func (m *MatchmakerTicket_Presence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchmakerTicket_Presence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchmakerTicket_Presence proto.InternalMessageInfo
+
+func (m *MatchmakerTicket_Presence) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *MatchmakerTicket_Presence) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *MatchmakerTicket_Presence) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *MatchmakerTicket_Presence) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+// A list of pending matchmaker tickets.
+type MatchmakerTicketList struct {
+	// Pending matchmaker tickets, oldest first.
+	Tickets []*MatchmakerTicket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	// Total number of pending tickets on this node.
+	TotalCount           int32    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatchmakerTicketList) Reset()         { *m = MatchmakerTicketList{} }
+func (m *MatchmakerTicketList) String() string { return proto.CompactTextString(m) }
+func (*MatchmakerTicketList) ProtoMessage()    {}
+func (*MatchmakerTicketList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9289ac5ba895f2a7, []int{21}
+}
+
+func (m *MatchmakerTicketList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchmakerTicketList.Unmarshal(m, b)
+}
+func (m *MatchmakerTicketList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchmakerTicketList.Marshal(b, m, deterministic)
+}
+func (m *MatchmakerTicketList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchmakerTicketList.Merge(m, src)
+}
+func (m *MatchmakerTicketList) XXX_Size() int {
+	return xxx_messageInfo_MatchmakerTicketList.Size(m)
+}
+func (m *MatchmakerTicketList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchmakerTicketList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchmakerTicketList proto.InternalMessageInfo
+
+func (m *MatchmakerTicketList) GetTickets() []*MatchmakerTicket {
+	if m != nil {
+		return m.Tickets
+	}
+	return nil
+}
+
+func (m *MatchmakerTicketList) GetTotalCount() int32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
 // List of nodes and their stats.
 type StatusList struct {
 	// List of nodes and their stats.
@@ -1035,7 +1629,7 @@ func (m *StatusList) Reset()         { *m = StatusList{} }
 func (m *StatusList) String() string { return proto.CompactTextString(m) }
 func (*StatusList) ProtoMessage()    {}
 func (*StatusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{16}
+	return fileDescriptor_9289ac5ba895f2a7, []int{22}
 }
 
 func (m *StatusList) XXX_Unmarshal(b []byte) error {
@@ -1094,7 +1688,7 @@ func (m *StatusList_Status) Reset()         { *m = StatusList_Status{} }
 func (m *StatusList_Status) String() string { return proto.CompactTextString(m) }
 func (*StatusList_Status) ProtoMessage()    {}
 func (*StatusList_Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{16, 0}
+	return fileDescriptor_9289ac5ba895f2a7, []int{22, 0}
 }
 
 func (m *StatusList_Status) XXX_Unmarshal(b []byte) error {
@@ -1208,7 +1802,7 @@ func (m *WalletLedger) Reset()         { *m = WalletLedger{} }
 func (m *WalletLedger) String() string { return proto.CompactTextString(m) }
 func (*WalletLedger) ProtoMessage()    {}
 func (*WalletLedger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{17}
+	return fileDescriptor_9289ac5ba895f2a7, []int{23}
 }
 
 func (m *WalletLedger) XXX_Unmarshal(b []byte) error {
@@ -1284,7 +1878,7 @@ func (m *WalletLedgerList) Reset()         { *m = WalletLedgerList{} }
 func (m *WalletLedgerList) String() string { return proto.CompactTextString(m) }
 func (*WalletLedgerList) ProtoMessage()    {}
 func (*WalletLedgerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{18}
+	return fileDescriptor_9289ac5ba895f2a7, []int{24}
 }
 
 func (m *WalletLedgerList) XXX_Unmarshal(b []byte) error {
@@ -1337,7 +1931,7 @@ func (m *WriteStorageObjectRequest) Reset()         { *m = WriteStorageObjectReq
 func (m *WriteStorageObjectRequest) String() string { return proto.CompactTextString(m) }
 func (*WriteStorageObjectRequest) ProtoMessage()    {}
 func (*WriteStorageObjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9289ac5ba895f2a7, []int{19}
+	return fileDescriptor_9289ac5ba895f2a7, []int{25}
 }
 
 func (m *WriteStorageObjectRequest) XXX_Unmarshal(b []byte) error {
@@ -1408,8 +2002,10 @@ func (m *WriteStorageObjectRequest) GetPermissionWrite() *wrappers.Int32Value {
 }
 
 func init() {
+	proto.RegisterEnum("nakama.console.MergeAccountsRequest_Conflict", MergeAccountsRequest_Conflict_name, MergeAccountsRequest_Conflict_value)
 	proto.RegisterType((*AccountDeleteRequest)(nil), "nakama.console.AccountDeleteRequest")
 	proto.RegisterType((*AccountExport)(nil), "nakama.console.AccountExport")
+	proto.RegisterType((*AccountExport_Session)(nil), "nakama.console.AccountExport.Session")
 	proto.RegisterType((*AccountId)(nil), "nakama.console.AccountId")
 	proto.RegisterType((*AuthenticateRequest)(nil), "nakama.console.AuthenticateRequest")
 	proto.RegisterType((*Config)(nil), "nakama.console.Config")
@@ -1419,13 +2015,22 @@ func init() {
 	proto.RegisterType((*DeleteGroupUserRequest)(nil), "nakama.console.DeleteGroupUserRequest")
 	proto.RegisterType((*DeleteStorageObjectRequest)(nil), "nakama.console.DeleteStorageObjectRequest")
 	proto.RegisterType((*DeleteWalletLedgerRequest)(nil), "nakama.console.DeleteWalletLedgerRequest")
+	proto.RegisterType((*ListMatchmakerTicketsRequest)(nil), "nakama.console.ListMatchmakerTicketsRequest")
 	proto.RegisterType((*ListStorageRequest)(nil), "nakama.console.ListStorageRequest")
 	proto.RegisterType((*ListUsersRequest)(nil), "nakama.console.ListUsersRequest")
+	proto.RegisterType((*LogoutUserRequest)(nil), "nakama.console.LogoutUserRequest")
+	proto.RegisterType((*MergeAccountsRequest)(nil), "nakama.console.MergeAccountsRequest")
 	proto.RegisterType((*StorageList)(nil), "nakama.console.StorageList")
 	proto.RegisterType((*UnlinkDeviceRequest)(nil), "nakama.console.UnlinkDeviceRequest")
+	proto.RegisterType((*UnlinkOidcRequest)(nil), "nakama.console.UnlinkOidcRequest")
 	proto.RegisterType((*UpdateAccountRequest)(nil), "nakama.console.UpdateAccountRequest")
 	proto.RegisterMapType((map[string]string)(nil), "nakama.console.UpdateAccountRequest.DeviceIdsEntry")
 	proto.RegisterType((*UserList)(nil), "nakama.console.UserList")
+	proto.RegisterType((*MatchmakerTicket)(nil), "nakama.console.MatchmakerTicket")
+	proto.RegisterMapType((map[string]float64)(nil), "nakama.console.MatchmakerTicket.NumericPropertiesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "nakama.console.MatchmakerTicket.StringPropertiesEntry")
+	proto.RegisterType((*MatchmakerTicket_Presence)(nil), "nakama.console.MatchmakerTicket.Presence")
+	proto.RegisterType((*MatchmakerTicketList)(nil), "nakama.console.MatchmakerTicketList")
 	proto.RegisterType((*StatusList)(nil), "nakama.console.StatusList")
 	proto.RegisterType((*StatusList_Status)(nil), "nakama.console.StatusList.Status")
 	proto.RegisterType((*WalletLedger)(nil), "nakama.console.WalletLedger")
@@ -1436,155 +2041,200 @@ func init() {
 func init() { proto.RegisterFile("console/console.proto", fileDescriptor_9289ac5ba895f2a7) }
 
 var fileDescriptor_9289ac5ba895f2a7 = []byte{
-	// 2358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
-	0xf1, 0xf7, 0x50, 0xe2, 0xab, 0xa8, 0xd7, 0xb6, 0x65, 0xff, 0x47, 0x94, 0x6c, 0xd3, 0x63, 0xaf,
-	0x6c, 0x73, 0x2d, 0xd2, 0xa6, 0xfe, 0x89, 0xbd, 0xca, 0x22, 0x89, 0x24, 0xdb, 0x8a, 0xb0, 0xb6,
-	0x37, 0x18, 0xd9, 0x31, 0xe0, 0x04, 0x21, 0x9a, 0x33, 0xad, 0xd1, 0xac, 0x86, 0xd3, 0xdc, 0xe9,
-	0x26, 0x15, 0x45, 0x10, 0x90, 0x6c, 0x72, 0xcc, 0x69, 0x73, 0xd8, 0x5b, 0x4e, 0x7b, 0xcb, 0xa7,
-	0x09, 0x72, 0x0a, 0xb0, 0xc7, 0xdc, 0xf2, 0x15, 0x72, 0x08, 0xfa, 0x31, 0xd4, 0xf0, 0x31, 0x12,
-	0xed, 0x85, 0x0f, 0x86, 0xd9, 0xdd, 0xbf, 0xaa, 0x5f, 0x75, 0x4d, 0x55, 0x75, 0x75, 0x0b, 0xae,
-	0x38, 0x34, 0x64, 0x34, 0x20, 0x75, 0xfd, 0x7f, 0xad, 0x13, 0x51, 0x4e, 0xd1, 0x5c, 0x88, 0x0f,
-	0x71, 0x1b, 0xd7, 0xf4, 0x6c, 0xb9, 0xea, 0xf9, 0xfc, 0xa0, 0xdb, 0xaa, 0x39, 0xb4, 0x5d, 0x3f,
-	0x20, 0x11, 0xf5, 0x9d, 0x00, 0xb7, 0x58, 0x5d, 0xa1, 0xea, 0xb8, 0xe3, 0x8b, 0x7f, 0x4a, 0xb6,
-	0xbc, 0xe2, 0x51, 0xea, 0x05, 0x44, 0xcd, 0x86, 0x21, 0xe5, 0x98, 0xfb, 0x34, 0x64, 0x7a, 0x75,
-	0x59, 0xaf, 0xca, 0x51, 0xab, 0xbb, 0x5f, 0x27, 0xed, 0x0e, 0x3f, 0xd6, 0x8b, 0x37, 0x86, 0x17,
-	0xb9, 0xdf, 0x26, 0x8c, 0xe3, 0x76, 0x47, 0x03, 0xae, 0x0f, 0x03, 0x8e, 0x22, 0xdc, 0xe9, 0x90,
-	0x28, 0xd6, 0x7e, 0x5f, 0xfe, 0xe7, 0xac, 0x79, 0x24, 0x5c, 0x63, 0x47, 0xd8, 0xf3, 0x48, 0x54,
-	0xa7, 0x1d, 0xc9, 0x3f, 0x6a, 0x8b, 0x75, 0x08, 0x8b, 0x9b, 0x8e, 0x43, 0xbb, 0x21, 0x7f, 0x42,
-	0x02, 0xc2, 0x89, 0x4d, 0xbe, 0xea, 0x12, 0xc6, 0xd1, 0x1c, 0x64, 0x7c, 0xd7, 0x34, 0x2a, 0xc6,
-	0xdd, 0xa2, 0x9d, 0xf1, 0x5d, 0xb4, 0x0d, 0xf3, 0x11, 0x71, 0x68, 0xe4, 0x36, 0x5d, 0x81, 0xf3,
-	0x69, 0x68, 0x66, 0x2a, 0xc6, 0xdd, 0x52, 0xa3, 0x5c, 0x53, 0xf6, 0xd4, 0x62, 0x7b, 0x6a, 0x5b,
-	0x94, 0x06, 0xbf, 0xc2, 0x41, 0x97, 0xd8, 0x73, 0x4a, 0xe4, 0x89, 0x96, 0xb0, 0xfe, 0x35, 0x05,
-	0xb3, 0x9a, 0xed, 0xe9, 0xef, 0x3a, 0x34, 0xe2, 0x68, 0x0d, 0xf2, 0x58, 0x4d, 0x48, 0xae, 0x52,
-	0xe3, 0x72, 0x4d, 0xbb, 0x5d, 0x38, 0x53, 0x63, 0xed, 0x18, 0x83, 0xd6, 0x21, 0x4f, 0x5b, 0x5f,
-	0x12, 0x87, 0x33, 0x33, 0x53, 0x99, 0xba, 0x5b, 0x6a, 0x2c, 0x25, 0xe1, 0x7b, 0x9c, 0x46, 0xd8,
-	0x23, 0x5f, 0x48, 0x84, 0x1d, 0x23, 0xd1, 0x7d, 0xc8, 0xef, 0x47, 0x3e, 0x09, 0x5d, 0x66, 0x4e,
-	0x49, 0x21, 0x94, 0x14, 0x7a, 0x26, 0x97, 0xec, 0x18, 0x82, 0xee, 0x41, 0xce, 0x8b, 0x68, 0xb7,
-	0xc3, 0xcc, 0x69, 0x09, 0xfe, 0x28, 0x09, 0xde, 0x11, 0x2b, 0xb6, 0x06, 0xa0, 0x1f, 0x43, 0xa1,
-	0x4d, 0x18, 0xc3, 0x1e, 0x61, 0x66, 0x56, 0x82, 0xcb, 0x49, 0xf0, 0xf6, 0x01, 0x0e, 0x43, 0x12,
-	0xbc, 0x50, 0x10, 0xbb, 0x8f, 0x45, 0x2f, 0xe1, 0x72, 0x40, 0xb0, 0x4b, 0xa2, 0x16, 0xc5, 0x91,
-	0xdb, 0x54, 0x4e, 0x62, 0x66, 0x4e, 0xaa, 0xb8, 0x96, 0x54, 0xf1, 0xfc, 0x0c, 0x66, 0x4b, 0x94,
-	0x8d, 0x82, 0xe1, 0x29, 0x86, 0x7e, 0x0a, 0xb3, 0x21, 0xe5, 0xfe, 0xbe, 0xef, 0xa8, 0x4f, 0x6b,
-	0xe6, 0xa5, 0x26, 0x33, 0xa9, 0xe9, 0x65, 0x02, 0x60, 0x0f, 0xc2, 0xd1, 0x36, 0xcc, 0x1d, 0xe1,
-	0x20, 0x20, 0xbc, 0x19, 0x10, 0xd7, 0x23, 0x11, 0x33, 0x0b, 0x52, 0xc1, 0x4a, 0x6d, 0x30, 0x05,
-	0x6a, 0x6f, 0x24, 0xea, 0xb9, 0x04, 0xd9, 0xb3, 0x47, 0x89, 0x11, 0xb3, 0x96, 0xa1, 0xa8, 0x3f,
-	0xd7, 0xae, 0x3b, 0x1c, 0x3d, 0xd6, 0x0b, 0xb8, 0xbc, 0xd9, 0xe5, 0x07, 0x24, 0xe4, 0x82, 0xb4,
-	0x1f, 0x64, 0x65, 0x28, 0x74, 0x19, 0x89, 0x42, 0xdc, 0x26, 0x1a, 0xdc, 0x1f, 0x8b, 0xb5, 0x0e,
-	0x66, 0xec, 0x88, 0x46, 0xae, 0x8c, 0xb4, 0xa2, 0xdd, 0x1f, 0x5b, 0xdf, 0x1a, 0x90, 0xdb, 0xa6,
-	0xe1, 0xbe, 0xef, 0xa1, 0xab, 0x90, 0x73, 0xe4, 0x2f, 0xad, 0x40, 0x8f, 0xd0, 0x06, 0x14, 0x8e,
-	0x70, 0x14, 0xfa, 0xa1, 0x17, 0x87, 0xca, 0xf5, 0xe1, 0xdd, 0x28, 0x0d, 0xb5, 0x37, 0x0a, 0x66,
-	0xf7, 0xf1, 0xe5, 0x4f, 0x21, 0xaf, 0x27, 0xd1, 0x22, 0x64, 0xf7, 0x7d, 0x12, 0xc4, 0x7b, 0x51,
-	0x03, 0x64, 0x42, 0x5e, 0x7f, 0x4c, 0x6d, 0x5a, 0x3c, 0xb4, 0x56, 0x61, 0x6e, 0x5b, 0xa9, 0xdf,
-	0x23, 0x8c, 0xf9, 0x34, 0x14, 0x1a, 0x38, 0x3d, 0x24, 0x61, 0xac, 0x41, 0x0e, 0xac, 0x2d, 0xb8,
-	0xac, 0xf2, 0x4d, 0x87, 0x5f, 0x4a, 0xd6, 0x2d, 0x43, 0x51, 0xc5, 0x65, 0xd3, 0xef, 0x7b, 0x41,
-	0x4d, 0xec, 0xba, 0xd6, 0x36, 0x5c, 0x55, 0x3a, 0x64, 0x54, 0xbe, 0x66, 0x24, 0x4a, 0x53, 0xb3,
-	0x04, 0x05, 0x19, 0xb2, 0x67, 0x5a, 0xf2, 0x72, 0xbc, 0xeb, 0x5a, 0x7f, 0x34, 0xa0, 0xac, 0xb4,
-	0x0c, 0x66, 0x8f, 0xd6, 0x74, 0x1d, 0xc0, 0xa1, 0x41, 0x40, 0x1c, 0x99, 0xf1, 0x4a, 0x63, 0x62,
-	0x06, 0x2d, 0xc0, 0xd4, 0x21, 0x39, 0xd6, 0x4a, 0xc5, 0x4f, 0xf4, 0x7f, 0x90, 0x17, 0xdf, 0x50,
-	0x50, 0x4d, 0xa9, 0x2f, 0x22, 0x86, 0xbb, 0xd2, 0x69, 0x3d, 0x12, 0x09, 0x9f, 0x98, 0xd3, 0xca,
-	0x06, 0x3d, 0xb4, 0x7e, 0x01, 0x4b, 0xca, 0x84, 0x81, 0xf8, 0x4a, 0x77, 0x89, 0x0e, 0xd6, 0x33,
-	0x97, 0xa8, 0x89, 0x5d, 0xd7, 0x5a, 0x03, 0xf4, 0xdc, 0x67, 0x5c, 0x6f, 0x25, 0x56, 0x91, 0x30,
-	0xc9, 0x48, 0x9a, 0x64, 0xb5, 0x60, 0x41, 0xc0, 0x85, 0xeb, 0x58, 0x0c, 0xbe, 0x0a, 0xb9, 0x7d,
-	0x3f, 0xe0, 0x24, 0x8a, 0xb1, 0x6a, 0x24, 0xe6, 0x5b, 0x22, 0x9f, 0x15, 0x69, 0xc1, 0xd6, 0x23,
-	0xe1, 0x21, 0x4e, 0xdb, 0x2d, 0xc6, 0x69, 0x48, 0x98, 0xdc, 0x72, 0xc1, 0x4e, 0xcc, 0x58, 0x0e,
-	0x94, 0xb4, 0x39, 0x82, 0x2a, 0x59, 0xc1, 0x8c, 0x89, 0x2b, 0xd8, 0x0d, 0x28, 0x71, 0xca, 0x71,
-	0xd0, 0x54, 0x95, 0x52, 0x18, 0x90, 0x15, 0x24, 0x1c, 0x07, 0xdb, 0x62, 0x46, 0x84, 0xd3, 0xeb,
-	0x30, 0xf0, 0xc3, 0xc3, 0x27, 0xa4, 0xe7, 0x3b, 0xe4, 0x1c, 0xdf, 0xb9, 0x12, 0x90, 0xf0, 0x9d,
-	0x9a, 0xd8, 0x75, 0xad, 0xff, 0x66, 0x61, 0xf1, 0x75, 0xc7, 0xc5, 0x9c, 0xc4, 0x65, 0x37, 0x45,
-	0xcb, 0xe3, 0x44, 0xd6, 0xaa, 0x33, 0x60, 0x65, 0xe4, 0x0c, 0xd8, 0xe3, 0x91, 0x1f, 0x7a, 0xea,
-	0x14, 0x38, 0xcb, 0xe9, 0x9f, 0xc1, 0x8c, 0xeb, 0xb3, 0x4e, 0x80, 0x8f, 0x9b, 0x52, 0x7a, 0x6a,
-	0x02, 0xe9, 0x92, 0x96, 0x78, 0x29, 0x14, 0x3c, 0x16, 0x15, 0x97, 0x63, 0x17, 0x73, 0x2c, 0x83,
-	0xe8, 0x42, 0xea, 0x18, 0x8d, 0x7e, 0x02, 0x80, 0x7b, 0x98, 0xe3, 0xa8, 0xd9, 0x8d, 0x02, 0x33,
-	0x3b, 0x81, 0x6c, 0x51, 0xe1, 0x5f, 0x47, 0x01, 0x7a, 0x04, 0x85, 0x00, 0x87, 0x5e, 0x93, 0x63,
-	0xcf, 0xcc, 0x4d, 0x20, 0x9a, 0x17, 0xe8, 0x57, 0xd8, 0x13, 0xf6, 0x06, 0x54, 0x95, 0x59, 0x33,
-	0x3f, 0x89, 0xbd, 0x31, 0x5a, 0x48, 0x8a, 0x83, 0xff, 0xf7, 0x34, 0x24, 0x66, 0x61, 0x12, 0xc9,
-	0x18, 0x8d, 0x3e, 0x85, 0xa2, 0xd3, 0x65, 0x9c, 0xb6, 0xc5, 0x47, 0x2e, 0x4e, 0x22, 0xaa, 0xe0,
-	0xbb, 0x2e, 0x6a, 0x40, 0x96, 0xb4, 0xb1, 0x1f, 0x98, 0x30, 0x81, 0x98, 0x82, 0x22, 0x1b, 0xa0,
-	0x1f, 0x53, 0xcc, 0x2c, 0xc9, 0x98, 0x5e, 0x1f, 0x2e, 0xb5, 0xe3, 0xe2, 0xaa, 0xf6, 0x44, 0x47,
-	0x1e, 0x7b, 0x1a, 0xf2, 0xe8, 0xd8, 0x2e, 0xc6, 0x91, 0xc8, 0xd0, 0xff, 0x43, 0x4e, 0xa5, 0xb4,
-	0x39, 0x33, 0x81, 0x21, 0x1a, 0x5b, 0xfe, 0x0c, 0xe6, 0x06, 0x55, 0xc6, 0xd5, 0xc9, 0x38, 0xab,
-	0x4e, 0x8b, 0x90, 0xed, 0x09, 0x21, 0x1d, 0xfd, 0x6a, 0xb0, 0x91, 0x79, 0x6c, 0x58, 0x7b, 0x50,
-	0x10, 0x75, 0x40, 0x26, 0xe9, 0x2a, 0x64, 0x45, 0xcc, 0xc6, 0x29, 0xba, 0x90, 0x4c, 0x51, 0x59,
-	0x67, 0xd5, 0xf2, 0xc5, 0x79, 0xf9, 0xdd, 0x14, 0xc0, 0x1e, 0xc7, 0xbc, 0xcb, 0xa4, 0xde, 0x47,
-	0x90, 0x0d, 0xa9, 0x4b, 0x62, 0xbd, 0x37, 0x87, 0xdd, 0x74, 0x06, 0xd5, 0x3f, 0x6d, 0x85, 0x2f,
-	0x7f, 0x9f, 0x81, 0x9c, 0x9a, 0x41, 0x08, 0xa6, 0x13, 0xe7, 0xa5, 0xfc, 0x2d, 0x6a, 0xd3, 0x01,
-	0xc1, 0x01, 0x3f, 0xd0, 0x26, 0xe8, 0x11, 0xba, 0x05, 0xb3, 0x4c, 0x1d, 0x43, 0xda, 0xc2, 0x29,
-	0xb9, 0x3c, 0xa3, 0x27, 0xa5, 0x8d, 0xe8, 0x63, 0x98, 0xeb, 0x44, 0x84, 0x91, 0xd0, 0x21, 0x1a,
-	0x35, 0x2d, 0x51, 0xb3, 0xf1, 0xac, 0x82, 0xdd, 0x80, 0x52, 0x1b, 0x73, 0xe7, 0x40, 0x63, 0xb2,
-	0x6a, 0xaf, 0x72, 0x4a, 0x01, 0xee, 0xc0, 0xbc, 0x47, 0x23, 0xda, 0xe5, 0x7e, 0x18, 0x2b, 0xca,
-	0x49, 0xd0, 0x5c, 0x7f, 0x5a, 0x01, 0x6f, 0xc3, 0x1c, 0xee, 0x79, 0xcd, 0x00, 0x73, 0x12, 0x3a,
-	0xc7, 0xcd, 0x36, 0x93, 0xa9, 0x61, 0xd8, 0x33, 0xb8, 0xe7, 0x3d, 0x57, 0x93, 0x2f, 0x18, 0xaa,
-	0x80, 0x18, 0x37, 0x23, 0xcc, 0x49, 0x93, 0x11, 0x47, 0x26, 0x81, 0x61, 0x03, 0xee, 0x79, 0x36,
-	0xe6, 0x64, 0x8f, 0x38, 0xc8, 0x82, 0x59, 0x81, 0xf0, 0xc3, 0x4e, 0x97, 0x37, 0x0f, 0x5b, 0x4c,
-	0x06, 0xbb, 0x61, 0x97, 0x70, 0xcf, 0xdb, 0x15, 0x73, 0x9f, 0xb7, 0x58, 0xcc, 0x45, 0xbb, 0x3c,
-	0x06, 0x41, 0x9f, 0xeb, 0x0b, 0x39, 0xf9, 0x79, 0x8b, 0x59, 0xff, 0x31, 0x60, 0x26, 0x79, 0xf6,
-	0x8c, 0x94, 0xbc, 0xc4, 0x09, 0x92, 0x19, 0x38, 0xd4, 0x56, 0xa0, 0xe8, 0x1c, 0xe0, 0xd0, 0x23,
-	0x8c, 0x70, 0x7d, 0xde, 0x9d, 0x4d, 0x88, 0x1e, 0x66, 0xa0, 0x5c, 0x15, 0x07, 0x0a, 0x52, 0xc9,
-	0x89, 0x88, 0xd8, 0x9d, 0xc8, 0x5c, 0x5d, 0x91, 0x46, 0x9b, 0xe9, 0x57, 0x71, 0xf7, 0x6f, 0x83,
-	0x82, 0x8b, 0x09, 0x21, 0xdc, 0x95, 0x29, 0xa5, 0x84, 0x73, 0x17, 0x0b, 0x2b, 0xb8, 0x98, 0xb0,
-	0x9e, 0xc1, 0x42, 0x72, 0xb3, 0x32, 0x32, 0x1b, 0x90, 0xf5, 0x39, 0x69, 0xc7, 0x91, 0x79, 0x7e,
-	0xe7, 0xa7, 0xa0, 0xd6, 0x77, 0x19, 0x58, 0x7a, 0x13, 0xf9, 0x1f, 0xbe, 0x73, 0xe8, 0x27, 0xed,
-	0x74, 0x22, 0x69, 0x93, 0xfd, 0x44, 0x76, 0xa0, 0x9f, 0x40, 0x4f, 0x60, 0xbe, 0x43, 0xa2, 0xb6,
-	0xaf, 0x22, 0x3f, 0x22, 0xd8, 0xd5, 0x1e, 0x5a, 0x1e, 0xf1, 0xd0, 0x6e, 0xc8, 0xd7, 0x1b, 0xfa,
-	0xb2, 0x72, 0x26, 0x63, 0x13, 0xec, 0xa2, 0x67, 0xb0, 0x90, 0xd0, 0x72, 0x24, 0x36, 0xaa, 0x6b,
-	0xf8, 0xb9, 0x6a, 0x12, 0xd4, 0xd2, 0x39, 0x8d, 0xef, 0xcb, 0x90, 0xd7, 0x3d, 0x21, 0xfa, 0x93,
-	0x01, 0x33, 0xc9, 0x46, 0x18, 0xdd, 0x1a, 0x76, 0xf4, 0x98, 0x36, 0xb9, 0x3c, 0xae, 0x73, 0x4d,
-	0xb4, 0x98, 0x56, 0xed, 0x9b, 0xcd, 0x42, 0x2b, 0x07, 0xd3, 0x70, 0x09, 0x5d, 0xfa, 0xfa, 0x9f,
-	0xff, 0xfe, 0x6b, 0xe6, 0x9a, 0x65, 0xd6, 0x7b, 0x8d, 0xf8, 0x3e, 0x5b, 0xc7, 0x09, 0x9d, 0x1b,
-	0x46, 0x15, 0xb5, 0x20, 0xbf, 0x85, 0x43, 0x51, 0xc8, 0xd0, 0xd2, 0x08, 0x7f, 0xdc, 0xc3, 0x97,
-	0xaf, 0x8e, 0xec, 0xf2, 0xa9, 0xb8, 0xa6, 0x5a, 0xb7, 0x25, 0xc5, 0x75, 0x6b, 0x65, 0x80, 0x42,
-	0x89, 0xd5, 0x4f, 0x7c, 0xf7, 0xb4, 0xde, 0xc2, 0x21, 0xa2, 0x30, 0xab, 0x7a, 0x3a, 0xad, 0x10,
-	0xdd, 0x4e, 0x61, 0x1a, 0xb8, 0x76, 0xa6, 0x92, 0x56, 0x24, 0x69, 0xb9, 0x6a, 0xa6, 0x91, 0xa2,
-	0x3f, 0x18, 0x30, 0x93, 0x6c, 0xa9, 0x47, 0x5d, 0x3b, 0xa6, 0xe1, 0x4e, 0xe5, 0x5b, 0x97, 0x7c,
-	0x6b, 0xd5, 0x4f, 0x52, 0x37, 0xa9, 0xda, 0xf0, 0xfa, 0x49, 0xbf, 0x3f, 0x3f, 0x45, 0x7f, 0x36,
-	0x60, 0x7e, 0xa8, 0x23, 0x47, 0xab, 0xe3, 0xad, 0x18, 0x6e, 0xd9, 0x53, 0x0d, 0x79, 0x28, 0x0d,
-	0xf9, 0xa4, 0x7a, 0x2f, 0xd5, 0x10, 0xd9, 0xc9, 0xd7, 0x4f, 0xe2, 0x06, 0xff, 0x14, 0xfd, 0x26,
-	0x76, 0xbd, 0xce, 0x4b, 0x94, 0xa2, 0x3b, 0x95, 0x73, 0x59, 0x72, 0x5e, 0xa9, 0x5e, 0x4e, 0x72,
-	0x32, 0xad, 0xec, 0x1f, 0x46, 0x7c, 0x75, 0x19, 0x48, 0x7b, 0x54, 0x1d, 0xbf, 0xd1, 0x71, 0xb5,
-	0x21, 0x95, 0xb8, 0x27, 0x89, 0x3b, 0xd5, 0x07, 0x63, 0x88, 0xeb, 0x27, 0x67, 0xc5, 0xe3, 0xb4,
-	0x7e, 0x72, 0x48, 0x8e, 0x4f, 0xeb, 0x27, 0xba, 0x5e, 0x9c, 0xbe, 0xfd, 0xac, 0xba, 0xf1, 0xae,
-	0x32, 0xf5, 0x13, 0x5d, 0x2f, 0x4e, 0xd1, 0x1b, 0x28, 0x29, 0x6b, 0xe5, 0x4d, 0xe0, 0x9d, 0xfd,
-	0x65, 0x4a, 0xb3, 0x51, 0x75, 0x21, 0x69, 0x82, 0xa0, 0x41, 0x7f, 0x31, 0x00, 0x8d, 0x5e, 0x6d,
-	0xd0, 0xbd, 0xf1, 0xbe, 0x1a, 0x73, 0xfd, 0xf9, 0x01, 0x01, 0xaa, 0xba, 0xa2, 0xfa, 0x49, 0xff,
-	0xb6, 0x74, 0x8a, 0x22, 0x98, 0x55, 0xef, 0x2e, 0x71, 0x52, 0x9e, 0x93, 0xfe, 0xd7, 0x52, 0x96,
-	0x94, 0x02, 0xeb, 0x8e, 0xe4, 0xbf, 0x89, 0x6e, 0xa4, 0xf2, 0x13, 0xf5, 0xc2, 0xf3, 0x5b, 0x80,
-	0x1d, 0x32, 0x09, 0xe1, 0xb8, 0x97, 0x9f, 0x38, 0xef, 0x51, 0x7a, 0xde, 0xbf, 0x81, 0xe2, 0x0e,
-	0xe1, 0xf1, 0x6b, 0x40, 0xea, 0x97, 0x1b, 0x7b, 0xf7, 0xb7, 0xca, 0x52, 0xfd, 0x22, 0x42, 0x49,
-	0xf5, 0xfa, 0x05, 0x81, 0x48, 0xc3, 0x9f, 0xe9, 0x67, 0xa1, 0x49, 0x0d, 0xd7, 0xf8, 0x09, 0xfc,
-	0xa3, 0x0a, 0x07, 0xf2, 0xa5, 0xfd, 0x3b, 0xea, 0x45, 0xe9, 0x1c, 0x96, 0xa5, 0xe1, 0x26, 0x54,
-	0x8a, 0x88, 0xc3, 0xdb, 0x5a, 0x95, 0x5c, 0x15, 0x74, 0xfd, 0xfc, 0x1a, 0x81, 0x7e, 0x2d, 0xa9,
-	0x74, 0x1f, 0x99, 0xe6, 0xaa, 0x72, 0x7a, 0x53, 0x3a, 0xde, 0x5d, 0x4c, 0xe9, 0xfb, 0xda, 0x90,
-	0xfe, 0x8a, 0x6b, 0xce, 0x8d, 0xa4, 0xb9, 0xe2, 0x3c, 0x1d, 0x28, 0x04, 0xc3, 0xfb, 0x19, 0x58,
-	0xb4, 0x1e, 0x4b, 0x9a, 0x06, 0x7a, 0xe7, 0x32, 0x80, 0x8e, 0x60, 0x7e, 0x87, 0xf0, 0x81, 0x5c,
-	0x3b, 0xc7, 0xa5, 0x95, 0xf3, 0xba, 0x1c, 0xb9, 0xe1, 0x8b, 0xbf, 0xa2, 0xca, 0x2e, 0x74, 0x08,
-	0xa5, 0xc4, 0xc3, 0x03, 0xb2, 0x86, 0x35, 0x8f, 0xbe, 0x4a, 0x94, 0x97, 0x47, 0x1d, 0xdd, 0x7f,
-	0x26, 0x88, 0x4b, 0x30, 0x1a, 0x5b, 0x82, 0x31, 0x14, 0xfb, 0xcf, 0x16, 0xa8, 0x32, 0x8e, 0x2a,
-	0xf9, 0xa2, 0x51, 0x36, 0x47, 0x6e, 0x63, 0xfa, 0x9e, 0x13, 0x17, 0x2e, 0x34, 0x5a, 0xb8, 0xf6,
-	0xa1, 0xf8, 0x3a, 0x6c, 0xbd, 0x7f, 0x93, 0xa0, 0x43, 0xd2, 0x4a, 0x0f, 0xc9, 0xae, 0x50, 0x8f,
-	0xbe, 0x82, 0x19, 0xf5, 0x70, 0xb1, 0x2d, 0xef, 0xa0, 0xef, 0x43, 0x55, 0x93, 0x54, 0x77, 0xad,
-	0xd5, 0x73, 0xa8, 0x04, 0x43, 0x5d, 0x5d, 0x73, 0xd1, 0x49, 0x4c, 0xa9, 0x2e, 0x8b, 0xa3, 0x7d,
-	0xc2, 0x98, 0x97, 0x94, 0x1f, 0x4e, 0xae, 0x2e, 0xb7, 0x88, 0x42, 0x49, 0xa9, 0x7f, 0x2a, 0x2f,
-	0xcf, 0xef, 0xb1, 0xdd, 0x35, 0xc9, 0x78, 0xc7, 0xfa, 0xf8, 0x22, 0x46, 0x75, 0x3d, 0xef, 0xc2,
-	0x9c, 0x22, 0x7c, 0x86, 0x1d, 0xd2, 0xa2, 0xf4, 0xf0, 0x7d, 0x38, 0x1f, 0x48, 0xce, 0xaa, 0x75,
-	0xf7, 0x22, 0xce, 0xfd, 0x98, 0xe4, 0x18, 0x16, 0x14, 0xed, 0x0e, 0x6e, 0x93, 0x6d, 0x12, 0xf2,
-	0xf7, 0x0b, 0xa3, 0x86, 0x24, 0xbe, 0x6f, 0x55, 0x2f, 0x22, 0xf6, 0x70, 0x9b, 0x38, 0x8a, 0xa6,
-	0x1f, 0x52, 0x3b, 0x52, 0xe5, 0x07, 0x0d, 0x29, 0x25, 0x7e, 0xf6, 0x55, 0xf7, 0x38, 0xc1, 0xed,
-	0x0f, 0xfa, 0x55, 0x99, 0x64, 0xa0, 0x30, 0x3b, 0xf0, 0xa4, 0x32, 0xda, 0x5d, 0x8f, 0x7b, 0x71,
-	0xb9, 0xa8, 0xbb, 0xb6, 0xd2, 0x4f, 0xd9, 0x6f, 0x0d, 0x40, 0xa3, 0x77, 0xbd, 0xd1, 0x46, 0x26,
-	0xf5, 0x3e, 0x58, 0x5e, 0x49, 0xad, 0xf7, 0x9b, 0xce, 0x61, 0x5c, 0xf2, 0xad, 0x77, 0x2e, 0xf9,
-	0x5b, 0x7f, 0xcf, 0x7c, 0xb3, 0xf9, 0xb7, 0x0c, 0x3a, 0x85, 0x2b, 0x2f, 0xa5, 0xfe, 0x8a, 0x96,
-	0xae, 0x6c, 0xfe, 0x72, 0xb7, 0xd2, 0x6b, 0x58, 0x4d, 0xb8, 0xf9, 0xea, 0x80, 0x54, 0xf4, 0xa2,
-	0xb8, 0x5e, 0xd1, 0x88, 0x55, 0x56, 0x2b, 0xdb, 0x34, 0xe4, 0x91, 0xdf, 0xea, 0x72, 0x1a, 0x31,
-	0x74, 0xfb, 0x80, 0xf3, 0x0e, 0xdb, 0xa8, 0xd7, 0xcf, 0xfb, 0x1b, 0x5f, 0x79, 0xf1, 0x80, 0x04,
-	0x01, 0xfd, 0xf9, 0xd9, 0x82, 0xc0, 0x35, 0xa6, 0x1a, 0xb5, 0x07, 0xe5, 0xb9, 0x87, 0x8d, 0x47,
-	0xb5, 0x07, 0xb5, 0x07, 0xb5, 0x87, 0x1b, 0x8f, 0xd6, 0x7f, 0xf4, 0xb0, 0x6a, 0x18, 0x8d, 0x05,
-	0xdc, 0xe9, 0x04, 0xfa, 0x6f, 0x2c, 0xf5, 0x2f, 0x19, 0x0d, 0x37, 0x46, 0x66, 0xde, 0x7e, 0x04,
-	0xf3, 0x50, 0xdc, 0xc2, 0xcc, 0x77, 0x84, 0x61, 0x28, 0x53, 0x30, 0x5a, 0xf3, 0x30, 0x9b, 0x9c,
-	0xba, 0x14, 0x6d, 0xc1, 0x2d, 0x6d, 0x3c, 0x23, 0x51, 0x8f, 0x44, 0xfd, 0x0d, 0xba, 0xd4, 0xe9,
-	0xb6, 0x49, 0xa8, 0xfe, 0x9e, 0x87, 0x96, 0xe3, 0x2d, 0x0c, 0x9a, 0x57, 0x77, 0xa9, 0xc3, 0xde,
-	0xe6, 0xb5, 0x4c, 0x2b, 0x27, 0xbf, 0xfc, 0xfa, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xc9,
-	0xb7, 0x78, 0xf4, 0x1c, 0x00, 0x00,
+	// 3087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x73, 0x1b, 0xc7,
+	0xd1, 0x37, 0x40, 0x82, 0x00, 0x1a, 0x7c, 0x69, 0x44, 0xe9, 0x5b, 0x81, 0x7a, 0xc0, 0xab, 0x37,
+	0x2d, 0x01, 0x12, 0xe4, 0xcf, 0x92, 0x69, 0x7f, 0x9f, 0x43, 0x51, 0x14, 0x83, 0xb2, 0x5e, 0xb5,
+	0xa4, 0xac, 0x2a, 0x27, 0x65, 0xd4, 0x60, 0x77, 0x08, 0xac, 0xb9, 0xd8, 0x85, 0x67, 0x06, 0xa0,
+	0x18, 0x16, 0x2b, 0xb1, 0x2b, 0xb7, 0xe4, 0x64, 0x1f, 0x7c, 0xcb, 0xc9, 0xb7, 0xfc, 0x35, 0xa9,
+	0x9c, 0x52, 0x95, 0x63, 0x6e, 0xf9, 0x03, 0x92, 0x43, 0x0e, 0xa9, 0x79, 0xec, 0x62, 0xf1, 0x58,
+	0x02, 0x92, 0x4b, 0x07, 0x95, 0x30, 0x3d, 0xdd, 0xfd, 0xeb, 0xe9, 0x99, 0xee, 0xe9, 0xe9, 0x25,
+	0x9c, 0xb1, 0x03, 0x9f, 0x05, 0x1e, 0xa9, 0xe8, 0xff, 0xcb, 0x1d, 0x1a, 0xf0, 0x00, 0x2d, 0xfa,
+	0x78, 0x1f, 0xb7, 0x71, 0x59, 0x53, 0x8b, 0x6b, 0x4d, 0x97, 0xb7, 0xba, 0x8d, 0xb2, 0x1d, 0xb4,
+	0x2b, 0x2d, 0x42, 0x03, 0xd7, 0xf6, 0x70, 0x83, 0x55, 0x14, 0x57, 0x05, 0x77, 0x5c, 0xf1, 0x4f,
+	0xc9, 0x16, 0xcf, 0x37, 0x83, 0xa0, 0xe9, 0x11, 0x45, 0xf5, 0xfd, 0x80, 0x63, 0xee, 0x06, 0x3e,
+	0xd3, 0xb3, 0xab, 0x7a, 0x56, 0x8e, 0x1a, 0xdd, 0xbd, 0x0a, 0x69, 0x77, 0xf8, 0xa1, 0x9e, 0xbc,
+	0x34, 0x3c, 0xc9, 0xdd, 0x36, 0x61, 0x1c, 0xb7, 0x3b, 0x9a, 0xe1, 0xe2, 0x30, 0xc3, 0x01, 0xc5,
+	0x9d, 0x0e, 0xa1, 0xa1, 0xf6, 0x5b, 0xf2, 0x3f, 0xfb, 0x76, 0x93, 0xf8, 0xb7, 0xd9, 0x01, 0x6e,
+	0x36, 0x09, 0xad, 0x04, 0x1d, 0x89, 0x3f, 0x6a, 0x8b, 0xb9, 0x0f, 0x2b, 0x1b, 0xb6, 0x1d, 0x74,
+	0x7d, 0xfe, 0x88, 0x78, 0x84, 0x13, 0x8b, 0x7c, 0xd3, 0x25, 0x8c, 0xa3, 0x45, 0x48, 0xbb, 0x8e,
+	0x91, 0x2a, 0xa5, 0x6e, 0xe4, 0xad, 0xb4, 0xeb, 0xa0, 0x4d, 0x58, 0xa2, 0xc4, 0x0e, 0xa8, 0x53,
+	0x77, 0x04, 0x9f, 0x1b, 0xf8, 0x46, 0xba, 0x94, 0xba, 0x51, 0xa8, 0x16, 0xcb, 0xca, 0x9e, 0x72,
+	0x68, 0x4f, 0xf9, 0x61, 0x10, 0x78, 0x5f, 0x60, 0xaf, 0x4b, 0xac, 0x45, 0x25, 0xf2, 0x48, 0x4b,
+	0x98, 0xff, 0x9a, 0x83, 0x05, 0x8d, 0xb6, 0xf5, 0xba, 0x13, 0x50, 0x8e, 0x6e, 0x43, 0x16, 0x2b,
+	0x82, 0xc4, 0x2a, 0x54, 0x4f, 0x97, 0xb5, 0xdb, 0x85, 0x33, 0x35, 0xaf, 0x15, 0xf2, 0xa0, 0x7b,
+	0x90, 0x0d, 0x1a, 0x5f, 0x13, 0x9b, 0x33, 0x23, 0x5d, 0x9a, 0xb9, 0x51, 0xa8, 0x9e, 0x8b, 0xb3,
+	0xef, 0xf0, 0x80, 0xe2, 0x26, 0x79, 0x2e, 0x39, 0xac, 0x90, 0x13, 0xdd, 0x82, 0xec, 0x1e, 0x75,
+	0x89, 0xef, 0x30, 0x63, 0x46, 0x0a, 0xa1, 0xb8, 0xd0, 0x63, 0x39, 0x65, 0x85, 0x2c, 0xe8, 0x26,
+	0xcc, 0x35, 0x69, 0xd0, 0xed, 0x30, 0x63, 0x56, 0x32, 0x9f, 0x8a, 0x33, 0x6f, 0x8b, 0x19, 0x4b,
+	0x33, 0xa0, 0x8f, 0x20, 0xd7, 0x26, 0x8c, 0xe1, 0x26, 0x61, 0x46, 0x46, 0x32, 0x17, 0xe3, 0xcc,
+	0x9b, 0x2d, 0xec, 0xfb, 0xc4, 0x7b, 0xaa, 0x58, 0xac, 0x88, 0x17, 0x3d, 0x83, 0xd3, 0x1e, 0xc1,
+	0x0e, 0xa1, 0x8d, 0x00, 0x53, 0xa7, 0xae, 0x9c, 0xc4, 0x8c, 0x39, 0xa9, 0xe2, 0x42, 0x5c, 0xc5,
+	0x93, 0x3e, 0x9b, 0x25, 0xb9, 0x2c, 0xe4, 0x0d, 0x93, 0x18, 0xfa, 0x7f, 0x58, 0xf0, 0x03, 0xee,
+	0xee, 0xb9, 0xb6, 0xda, 0x5a, 0x23, 0x2b, 0x35, 0x19, 0x71, 0x4d, 0xcf, 0x62, 0x0c, 0xd6, 0x20,
+	0x3b, 0xda, 0x84, 0xc5, 0x03, 0xec, 0x79, 0x84, 0xd7, 0x3d, 0xe2, 0x34, 0x09, 0x65, 0x46, 0x4e,
+	0x2a, 0x38, 0x5f, 0x1e, 0x0c, 0x81, 0xf2, 0x2b, 0xc9, 0xf5, 0x44, 0x32, 0x59, 0x0b, 0x07, 0xb1,
+	0x11, 0x43, 0x2f, 0xe0, 0x94, 0x74, 0x4b, 0xbd, 0x4d, 0xda, 0x0d, 0x42, 0x59, 0xcb, 0xed, 0x30,
+	0x23, 0x2f, 0xf5, 0x5c, 0x8e, 0x1b, 0xf2, 0x92, 0x11, 0x2a, 0xdd, 0xf8, 0xc4, 0x65, 0xbc, 0x3f,
+	0xb2, 0x96, 0xa5, 0xf4, 0xd3, 0xbe, 0x30, 0xfa, 0x04, 0xf2, 0x9d, 0x2e, 0xb5, 0x5b, 0x98, 0x11,
+	0x66, 0xc0, 0xa8, 0x73, 0xbe, 0xc0, 0x9e, 0xeb, 0x60, 0x4e, 0x9c, 0x17, 0x9a, 0xcb, 0xea, 0xf3,
+	0xa3, 0x0d, 0xc8, 0x31, 0xc2, 0x98, 0x74, 0x47, 0x41, 0xca, 0x5e, 0x1d, 0x5e, 0xcd, 0xc0, 0x49,
+	0x2c, 0xef, 0x28, 0x6e, 0x2b, 0x12, 0x43, 0x1f, 0x42, 0x96, 0x50, 0xcc, 0xba, 0x94, 0x18, 0xf3,
+	0xfa, 0xa8, 0x8f, 0x9e, 0xcd, 0x2d, 0xc5, 0x61, 0x85, 0xac, 0xc5, 0x1f, 0x52, 0x90, 0xd5, 0xba,
+	0x46, 0x82, 0xe8, 0x13, 0x28, 0xd8, 0x94, 0x60, 0x4e, 0xea, 0x22, 0xa8, 0x13, 0x03, 0x68, 0x37,
+	0x8c, 0x78, 0x0b, 0x14, 0xbb, 0x20, 0x08, 0x61, 0xf2, 0xba, 0xe3, 0xd2, 0x43, 0x25, 0x3c, 0x33,
+	0x59, 0x58, 0xb1, 0x0b, 0x82, 0xb9, 0x0a, 0x79, 0x6d, 0x70, 0xcd, 0x19, 0x36, 0xcb, 0x7c, 0x0a,
+	0xa7, 0x37, 0xba, 0xbc, 0x45, 0x7c, 0x2e, 0x8e, 0x44, 0x94, 0x02, 0x8a, 0x90, 0xeb, 0x32, 0x42,
+	0x7d, 0xdc, 0x26, 0x9a, 0x39, 0x1a, 0x8b, 0xb9, 0x0e, 0x66, 0xec, 0x20, 0xa0, 0x8e, 0x5c, 0x46,
+	0xde, 0x8a, 0xc6, 0xe6, 0x8f, 0x29, 0x98, 0xdb, 0x0c, 0xfc, 0x3d, 0xb7, 0x89, 0xce, 0xc2, 0x9c,
+	0x2d, 0x7f, 0x69, 0x05, 0x7a, 0x84, 0xd6, 0x21, 0x77, 0x80, 0xa9, 0xef, 0xfa, 0xcd, 0x30, 0x90,
+	0x2f, 0x0e, 0xef, 0x8e, 0xd2, 0x50, 0x7e, 0xa5, 0xd8, 0xac, 0x88, 0xbf, 0xf8, 0x31, 0x64, 0x35,
+	0x11, 0xad, 0x40, 0x66, 0xcf, 0x25, 0x5e, 0xb8, 0x16, 0x35, 0x40, 0x06, 0x64, 0x75, 0xa8, 0x69,
+	0xd3, 0xc2, 0xa1, 0x79, 0x0d, 0x16, 0x37, 0x95, 0xfa, 0x70, 0x87, 0x56, 0x20, 0xc3, 0x83, 0x7d,
+	0xe2, 0x87, 0x1a, 0xe4, 0xc0, 0x7c, 0x08, 0xa7, 0x55, 0x36, 0xd4, 0xc9, 0x21, 0x21, 0x27, 0xae,
+	0x42, 0x5e, 0x65, 0x8d, 0xba, 0x1b, 0x79, 0x41, 0x11, 0x6a, 0x8e, 0xb9, 0x09, 0x67, 0x95, 0x0e,
+	0x79, 0xbc, 0xc5, 0x39, 0x4f, 0x52, 0x73, 0x0e, 0x72, 0x2a, 0x72, 0x22, 0x2d, 0x59, 0x39, 0xae,
+	0x39, 0xe6, 0xb7, 0x29, 0x28, 0x2a, 0x2d, 0x83, 0xb9, 0x4d, 0x6b, 0xba, 0x08, 0x60, 0x07, 0x9e,
+	0x47, 0x6c, 0x99, 0x8f, 0x95, 0xc6, 0x18, 0x05, 0x2d, 0xc3, 0xcc, 0x3e, 0x39, 0xd4, 0x4a, 0xc5,
+	0x4f, 0xf4, 0x3f, 0x90, 0x15, 0x7b, 0x28, 0xa0, 0x66, 0xd4, 0x8e, 0x88, 0x61, 0x4d, 0x3a, 0xad,
+	0x47, 0xa8, 0xf0, 0x89, 0x31, 0xab, 0x6c, 0xd0, 0x43, 0xf3, 0x97, 0x70, 0x4e, 0x99, 0x30, 0x10,
+	0xfd, 0xc9, 0x2e, 0xd1, 0xa9, 0xa4, 0xef, 0x12, 0x45, 0xa8, 0x39, 0xe6, 0x87, 0x70, 0x5e, 0x04,
+	0xfd, 0x53, 0xcc, 0xed, 0x56, 0x1b, 0xef, 0x13, 0xba, 0xeb, 0xda, 0xfb, 0x84, 0xb3, 0x50, 0xd9,
+	0x0a, 0x64, 0x3c, 0xb7, 0xed, 0xaa, 0xab, 0x20, 0x63, 0xa9, 0x81, 0x79, 0x1b, 0x90, 0x90, 0xd2,
+	0x0e, 0x08, 0x79, 0x63, 0x0b, 0x49, 0xc5, 0x17, 0x62, 0x36, 0x60, 0x59, 0xb0, 0x0b, 0x87, 0x47,
+	0x8a, 0xcf, 0xc2, 0xdc, 0x9e, 0xeb, 0x71, 0x42, 0x43, 0x5e, 0x35, 0x12, 0xf4, 0x06, 0xf6, 0x7d,
+	0xa2, 0x4c, 0xcd, 0x59, 0x7a, 0x24, 0xfc, 0xca, 0x83, 0x76, 0x83, 0xf1, 0xc0, 0x27, 0x4c, 0x3a,
+	0x2a, 0x67, 0xc5, 0x28, 0xe6, 0x57, 0x70, 0xea, 0x49, 0xd0, 0x0c, 0xba, 0xfc, 0xa4, 0x6d, 0x8d,
+	0x8e, 0x56, 0x3a, 0x76, 0xb4, 0xd0, 0x65, 0x58, 0xa0, 0x64, 0x8f, 0x12, 0xd6, 0xaa, 0xab, 0x59,
+	0xb5, 0x0d, 0xf3, 0x9a, 0xb8, 0x2b, 0xcf, 0xdf, 0xbf, 0xd3, 0xb0, 0xf2, 0x94, 0xd0, 0x26, 0xd1,
+	0x31, 0x1b, 0x2d, 0x64, 0x15, 0xf2, 0x2c, 0xe8, 0x52, 0x9b, 0xf4, 0xd7, 0x9d, 0x53, 0x84, 0x9a,
+	0xf4, 0x3d, 0xc7, 0xb4, 0x39, 0xe0, 0x7b, 0x45, 0xa8, 0x39, 0xe8, 0x29, 0x80, 0xeb, 0x88, 0x08,
+	0xe7, 0xae, 0x5e, 0xd2, 0x62, 0xf5, 0xf6, 0x70, 0xcc, 0x8d, 0xc3, 0x94, 0x81, 0xe8, 0xb9, 0x36,
+	0xb7, 0x62, 0x0a, 0xd0, 0x36, 0x64, 0x99, 0xda, 0x10, 0x63, 0xf6, 0x6d, 0x74, 0x85, 0xd2, 0xe8,
+	0x39, 0x14, 0x62, 0x37, 0x9a, 0x91, 0x79, 0x1b, 0x65, 0x71, 0x0d, 0xe6, 0xff, 0x41, 0x2e, 0x9c,
+	0x40, 0x4b, 0x50, 0xf8, 0x7c, 0x6b, 0xeb, 0x45, 0x7d, 0x77, 0xc3, 0xda, 0xde, 0xda, 0x5d, 0x7e,
+	0x2f, 0x22, 0xec, 0x3c, 0x7f, 0x69, 0x6d, 0x6e, 0x2d, 0xa7, 0x22, 0xc2, 0xb3, 0xad, 0x57, 0x5b,
+	0x3b, 0xbb, 0xcb, 0x69, 0xd3, 0x86, 0x82, 0x3e, 0x69, 0xe2, 0x14, 0xc5, 0x0b, 0x8e, 0xd4, 0xd4,
+	0x05, 0xc7, 0x25, 0x28, 0xf0, 0x80, 0x63, 0xaf, 0xae, 0x0a, 0x9b, 0xb4, 0x3c, 0xcd, 0x20, 0x49,
+	0x9b, 0x82, 0x22, 0xf2, 0xcb, 0x4b, 0xdf, 0x73, 0xfd, 0xfd, 0x47, 0xa4, 0xe7, 0xda, 0xe4, 0x84,
+	0x60, 0x72, 0x24, 0x43, 0x6c, 0x43, 0x15, 0xa1, 0xe6, 0x98, 0x9f, 0xc1, 0x29, 0xa5, 0xe3, 0xb9,
+	0xeb, 0xd8, 0x49, 0x1a, 0x44, 0x9a, 0xa6, 0x41, 0xcf, 0x75, 0x08, 0x8d, 0xd2, 0xb4, 0x1e, 0x9b,
+	0xff, 0xc9, 0xc0, 0xca, 0xcb, 0x8e, 0xb8, 0x40, 0xc3, 0x32, 0x2b, 0x41, 0xc9, 0x83, 0xd8, 0x3d,
+	0xa0, 0xae, 0xac, 0xf3, 0x23, 0xb7, 0xce, 0x0e, 0xa7, 0xae, 0xdf, 0x54, 0x55, 0x5f, 0xc4, 0x8d,
+	0x3e, 0x83, 0x79, 0xc7, 0x65, 0x1d, 0x0f, 0x1f, 0xd6, 0xa5, 0xf4, 0xcc, 0x14, 0xd2, 0x05, 0x2d,
+	0xf1, 0x4c, 0x28, 0x78, 0x20, 0x2a, 0x2c, 0x8e, 0x1d, 0xcc, 0xb1, 0x31, 0x3b, 0x85, 0x70, 0xc4,
+	0x8d, 0x3e, 0x01, 0xc0, 0x3d, 0xcc, 0x31, 0xad, 0x77, 0xa9, 0x67, 0x64, 0xa6, 0x90, 0xcd, 0x2b,
+	0xfe, 0x97, 0xd4, 0x43, 0xf7, 0x21, 0xe7, 0x61, 0xbf, 0x59, 0xe7, 0xb8, 0x69, 0xcc, 0x4d, 0x21,
+	0x9a, 0x15, 0xdc, 0xbb, 0xb8, 0x29, 0xec, 0xf5, 0x02, 0x55, 0x56, 0x19, 0xd9, 0x69, 0xec, 0x0d,
+	0xb9, 0x85, 0xa4, 0xb8, 0xd6, 0x7f, 0x13, 0xf8, 0xc4, 0xc8, 0x4d, 0x23, 0x19, 0x72, 0xa3, 0x8f,
+	0x21, 0x6f, 0x77, 0x19, 0x0f, 0xda, 0xe2, 0x94, 0xe4, 0xa7, 0x11, 0x55, 0xec, 0x35, 0x07, 0x55,
+	0x21, 0x43, 0xda, 0xd8, 0xf5, 0x0c, 0x98, 0x42, 0x4c, 0xb1, 0x22, 0x0b, 0x20, 0x3a, 0x94, 0x61,
+	0x69, 0x75, 0x6f, 0x38, 0x5e, 0xc7, 0x9d, 0xab, 0xf2, 0x23, 0x7d, 0x74, 0xd9, 0x96, 0xcf, 0xe9,
+	0xa1, 0x95, 0x0f, 0x8f, 0xb2, 0xa8, 0xb4, 0xe6, 0xd4, 0x25, 0x61, 0xcc, 0x4f, 0x61, 0x88, 0xe6,
+	0x2d, 0x7e, 0x0a, 0x8b, 0x83, 0x2a, 0xc3, 0xfb, 0x2e, 0xd5, 0xbf, 0xef, 0x56, 0x20, 0xd3, 0x13,
+	0x42, 0x61, 0x12, 0x96, 0x83, 0xf5, 0xf4, 0x83, 0x94, 0xb9, 0x03, 0x39, 0x91, 0xbd, 0x65, 0x94,
+	0x5f, 0x83, 0x8c, 0x38, 0xb3, 0x61, 0x8c, 0x2f, 0x0f, 0xd7, 0xab, 0x96, 0x9a, 0x9e, 0x1c, 0xd8,
+	0xdf, 0xcd, 0xc1, 0xf2, 0xf0, 0xf5, 0x26, 0x6e, 0x19, 0x2e, 0x7f, 0x85, 0xb7, 0x8f, 0x1a, 0x89,
+	0x7b, 0xbf, 0x83, 0x29, 0x3f, 0x8c, 0xdd, 0xfb, 0x72, 0x5c, 0x73, 0xd0, 0x36, 0xe4, 0x3b, 0x94,
+	0x30, 0xe2, 0xdb, 0x24, 0x7c, 0xb4, 0xdc, 0x1c, 0xc9, 0x89, 0x43, 0x38, 0xe5, 0x17, 0x5a, 0xc2,
+	0xea, 0xcb, 0x8a, 0xf5, 0x7f, 0xd3, 0x25, 0xf4, 0x50, 0x5f, 0xea, 0x6a, 0x20, 0x12, 0x4b, 0xdb,
+	0xf5, 0xf5, 0x2a, 0x32, 0x72, 0x15, 0xb9, 0xb6, 0xeb, 0xcb, 0x35, 0xc8, 0x49, 0xfc, 0x5a, 0x4f,
+	0xce, 0xe9, 0x49, 0xfc, 0x5a, 0x4d, 0xda, 0x70, 0x8a, 0xc9, 0xad, 0xa8, 0x77, 0x68, 0xd0, 0x21,
+	0x54, 0xde, 0x26, 0xea, 0xb9, 0xf1, 0xd1, 0x44, 0x03, 0xd5, 0x26, 0xbe, 0x88, 0x04, 0xd5, 0x39,
+	0x58, 0x66, 0x43, 0x64, 0xb4, 0x07, 0xc8, 0xef, 0xb6, 0x09, 0x75, 0xed, 0x38, 0x8a, 0x7a, 0x93,
+	0xdc, 0x9f, 0x88, 0xf2, 0x4c, 0x89, 0x0e, 0xc3, 0x9c, 0xf2, 0x87, 0xe9, 0xc3, 0xe5, 0x78, 0xfe,
+	0x8d, 0xca, 0xf1, 0x73, 0xa2, 0x84, 0x75, 0x79, 0x9d, 0x11, 0x5b, 0x86, 0xcf, 0x8c, 0x95, 0x15,
+	0xe3, 0x1d, 0x62, 0x17, 0x29, 0xe4, 0xc2, 0xbd, 0x48, 0xac, 0x53, 0xd0, 0x05, 0x00, 0xfd, 0xd2,
+	0xe8, 0xef, 0x7f, 0x5e, 0x53, 0x6a, 0xce, 0x40, 0xf1, 0x3d, 0x33, 0x54, 0x7c, 0x23, 0x98, 0xf5,
+	0x03, 0x87, 0xe8, 0x3d, 0x95, 0xbf, 0x8b, 0x9b, 0x70, 0x66, 0xac, 0x7b, 0xdf, 0x24, 0x26, 0x8a,
+	0x8f, 0xe0, 0xec, 0x78, 0xef, 0x4d, 0xd2, 0x92, 0x8a, 0x47, 0x16, 0x83, 0x95, 0xe1, 0x4d, 0x91,
+	0x51, 0xb6, 0x0e, 0x59, 0x75, 0xf2, 0xc3, 0x38, 0x2b, 0x4d, 0xda, 0x4b, 0x2b, 0x14, 0x98, 0x1c,
+	0x79, 0x3f, 0xcd, 0x00, 0xec, 0x70, 0xcc, 0xbb, 0x4c, 0x62, 0xdd, 0x87, 0x8c, 0x70, 0x4b, 0x88,
+	0xf4, 0xfe, 0x30, 0x52, 0x9f, 0x55, 0xff, 0xb4, 0x14, 0x7f, 0xf1, 0xef, 0x69, 0x98, 0x53, 0x14,
+	0xe9, 0xe6, 0xfe, 0xdb, 0x47, 0xfe, 0x16, 0xb1, 0xdc, 0x22, 0xd8, 0xe3, 0x2d, 0x6d, 0x82, 0x1e,
+	0x89, 0xb2, 0x2e, 0xdc, 0x4d, 0x65, 0xe1, 0x8c, 0x9c, 0x9e, 0xd7, 0x44, 0x15, 0x3c, 0x57, 0x61,
+	0x31, 0x8c, 0x4c, 0xcd, 0x35, 0x2b, 0xb9, 0x16, 0x42, 0xaa, 0x62, 0xbb, 0x04, 0x85, 0xb6, 0x70,
+	0xc4, 0x40, 0x7c, 0x82, 0x24, 0x29, 0x86, 0xeb, 0xb0, 0xd4, 0x0c, 0x68, 0xd0, 0xe5, 0xae, 0x4f,
+	0x06, 0xe2, 0x74, 0x31, 0x22, 0x2b, 0xc6, 0x2b, 0xb0, 0x88, 0x7b, 0xcd, 0xba, 0x87, 0x39, 0xf1,
+	0xed, 0xc3, 0x7a, 0x9b, 0xc9, 0x4b, 0x29, 0x65, 0xcd, 0xe3, 0x5e, 0xf3, 0x89, 0x22, 0x3e, 0x65,
+	0xa8, 0x04, 0x62, 0x5c, 0xa7, 0x22, 0x10, 0xc4, 0x69, 0xce, 0x49, 0x1e, 0xc0, 0xbd, 0xa6, 0x85,
+	0x39, 0xd9, 0x21, 0x36, 0x32, 0x61, 0x41, 0x70, 0xb8, 0x7e, 0xa7, 0xcb, 0xeb, 0xfb, 0x0d, 0x26,
+	0x43, 0x25, 0x65, 0x15, 0x70, 0xaf, 0x59, 0x13, 0xb4, 0xcf, 0x1b, 0x2c, 0xc4, 0x0a, 0xba, 0x3c,
+	0x64, 0x82, 0x08, 0xeb, 0xb9, 0x24, 0x7e, 0xde, 0x60, 0xe6, 0x3f, 0x53, 0x30, 0x1f, 0x7f, 0x47,
+	0x8c, 0x14, 0x1b, 0xb1, 0x78, 0x49, 0x0f, 0xc4, 0xcb, 0x79, 0xc8, 0xdb, 0x2d, 0xec, 0x37, 0x09,
+	0x23, 0x5c, 0x47, 0x44, 0x9f, 0x20, 0xc2, 0x65, 0xa0, 0x50, 0xc8, 0x0f, 0x94, 0x02, 0x03, 0x61,
+	0x9e, 0x79, 0xd3, 0x57, 0x77, 0xb7, 0xe3, 0x44, 0xc2, 0x73, 0x93, 0x85, 0x15, 0xbb, 0x20, 0x98,
+	0x8f, 0x61, 0x39, 0xbe, 0x58, 0x79, 0x32, 0xab, 0x90, 0x71, 0x39, 0x69, 0x87, 0x27, 0xf3, 0xe4,
+	0x1e, 0x8b, 0x62, 0x35, 0x7f, 0x4a, 0xc3, 0xb9, 0x57, 0xd4, 0x7d, 0xf7, 0xaf, 0xc0, 0x28, 0xa8,
+	0x67, 0x63, 0xa9, 0x21, 0xfe, 0x36, 0xcc, 0x0c, 0xbc, 0x0d, 0xd1, 0x23, 0x58, 0xea, 0x10, 0xda,
+	0x76, 0xd5, 0xc9, 0xa7, 0x04, 0x3b, 0xda, 0x43, 0xab, 0x23, 0x1e, 0xaa, 0xf9, 0xfc, 0x5e, 0x55,
+	0xb7, 0x05, 0xfb, 0x32, 0x16, 0xc1, 0x0e, 0x7a, 0x0c, 0xcb, 0x31, 0x2d, 0x07, 0x62, 0xa1, 0x46,
+	0x76, 0xb2, 0x9a, 0x18, 0xb4, 0x74, 0x4e, 0xf5, 0x6f, 0x25, 0xc8, 0xea, 0xf7, 0x3d, 0xfa, 0x36,
+	0x05, 0xf3, 0xf1, 0xa6, 0x06, 0xba, 0x3c, 0xd2, 0xfe, 0x19, 0x6d, 0x79, 0x14, 0xc7, 0x75, 0x21,
+	0x62, 0xed, 0x02, 0xf3, 0xd6, 0xf7, 0x1b, 0x73, 0x8d, 0x59, 0x48, 0xc3, 0x7b, 0xdf, 0xfd, 0xf5,
+	0x1f, 0x3f, 0xa4, 0x2f, 0x98, 0x46, 0xa5, 0x57, 0x0d, 0xfb, 0xc6, 0x15, 0x1c, 0xd3, 0xb8, 0x9e,
+	0x5a, 0x43, 0x0d, 0xc8, 0x3e, 0xc4, 0xbe, 0x28, 0x20, 0xd0, 0xb9, 0x84, 0xe6, 0x53, 0xcd, 0x29,
+	0x9e, 0x1d, 0x59, 0xe3, 0x96, 0x68, 0x07, 0x9b, 0x57, 0x24, 0xc4, 0x45, 0xf3, 0xfc, 0x00, 0x84,
+	0x12, 0xab, 0x1c, 0xb9, 0xce, 0x71, 0xa5, 0x81, 0x7d, 0x14, 0xc0, 0x82, 0x7a, 0x9d, 0x6b, 0x85,
+	0xe8, 0x4a, 0x02, 0xd2, 0x40, 0x7b, 0x37, 0x11, 0xb4, 0x24, 0x41, 0x8b, 0x6b, 0x46, 0x12, 0x28,
+	0xfa, 0x5d, 0x0a, 0xe6, 0xe3, 0xcd, 0x91, 0x51, 0xc7, 0x8e, 0x69, 0x9d, 0x24, 0xe2, 0xdd, 0x93,
+	0x78, 0xb7, 0xd7, 0x3e, 0x48, 0x5c, 0xa4, 0x6a, 0xa8, 0x54, 0x8e, 0xa2, 0x4e, 0xcb, 0x31, 0xfa,
+	0x7d, 0x0a, 0x96, 0x86, 0x7a, 0x2b, 0xe8, 0xda, 0x78, 0x2b, 0x86, 0x9b, 0x2f, 0x89, 0x86, 0xdc,
+	0x95, 0x86, 0x7c, 0xb0, 0x76, 0x33, 0xd1, 0x10, 0xd9, 0x93, 0xa9, 0x1c, 0x85, 0xad, 0x9a, 0x63,
+	0xf4, 0xeb, 0xd0, 0xf5, 0x3a, 0x2a, 0x51, 0x82, 0xee, 0x44, 0xcc, 0x55, 0x89, 0x79, 0x66, 0xed,
+	0x74, 0x1c, 0x33, 0x7c, 0x18, 0xff, 0x25, 0x05, 0xa7, 0x07, 0xd4, 0xab, 0xa0, 0x47, 0x6b, 0xe3,
+	0x17, 0x3a, 0x2e, 0x33, 0x24, 0x02, 0xf7, 0x24, 0x70, 0x67, 0xed, 0xce, 0x18, 0xe0, 0xca, 0x51,
+	0x3f, 0x75, 0x1c, 0x57, 0x8e, 0xf6, 0xc9, 0xe1, 0x71, 0xe5, 0x48, 0x67, 0x8b, 0xe3, 0x2f, 0x3f,
+	0x5d, 0x5b, 0x7f, 0x53, 0x99, 0xca, 0x91, 0xce, 0x16, 0xc7, 0xe8, 0x15, 0x14, 0x94, 0xb5, 0xb2,
+	0x3b, 0xf3, 0xc6, 0xfe, 0x32, 0xa4, 0xd9, 0x68, 0x6d, 0x39, 0x6e, 0x82, 0x80, 0x41, 0x7f, 0x4c,
+	0x01, 0x1a, 0x6d, 0x52, 0xa1, 0x9b, 0xe3, 0x7d, 0x35, 0xa6, 0x91, 0xf5, 0x33, 0x0e, 0xa8, 0x7a,
+	0x8d, 0x54, 0x8e, 0xa2, 0xbe, 0xd7, 0x31, 0xa2, 0xb0, 0xa0, 0xba, 0xca, 0x61, 0x50, 0x9e, 0x10,
+	0xfe, 0x17, 0x4e, 0x6c, 0x4b, 0x9b, 0xd7, 0x25, 0xfe, 0xfb, 0xe8, 0x52, 0x22, 0x3e, 0x91, 0x8c,
+	0x22, 0x28, 0x56, 0x06, 0x40, 0x37, 0xa8, 0xdd, 0x72, 0x7b, 0xe4, 0x24, 0xec, 0xd2, 0xb8, 0x86,
+	0xb6, 0xd2, 0xa1, 0x84, 0xcd, 0x8a, 0x84, 0xbf, 0x89, 0xae, 0x4f, 0x80, 0xaf, 0x60, 0x8d, 0xf6,
+	0x15, 0xc0, 0x36, 0x99, 0x66, 0xdd, 0xe3, 0x3e, 0xf4, 0x84, 0xe9, 0x07, 0x25, 0xa7, 0x9f, 0x57,
+	0x90, 0xdf, 0x26, 0x3c, 0x6c, 0x2f, 0x27, 0x1e, 0xa0, 0xb1, 0xcd, 0x64, 0xb3, 0x28, 0xd5, 0xaf,
+	0x20, 0x14, 0x57, 0xaf, 0x5b, 0xd2, 0x44, 0x1a, 0xfe, 0x58, 0x7f, 0x05, 0x9a, 0xd6, 0x70, 0xcd,
+	0x3f, 0xc5, 0x36, 0xa9, 0xfc, 0x85, 0x5c, 0x69, 0xff, 0xb6, 0xfa, 0x80, 0x74, 0x02, 0xca, 0xb9,
+	0xc4, 0x6f, 0x26, 0xe6, 0x35, 0x89, 0x55, 0x42, 0x17, 0x4f, 0x4e, 0x55, 0xe8, 0x57, 0x12, 0x4a,
+	0x17, 0xb3, 0x49, 0xae, 0x2a, 0x26, 0x57, 0xc6, 0xe3, 0xdd, 0xc5, 0x94, 0xbe, 0xef, 0x52, 0xd2,
+	0x5f, 0x61, 0xea, 0xbb, 0x14, 0x37, 0x57, 0x5c, 0xea, 0x03, 0xf9, 0x68, 0x78, 0x3d, 0x03, 0x93,
+	0xe6, 0x03, 0x09, 0x53, 0x45, 0x6f, 0x9c, 0x8d, 0xd0, 0x01, 0x2c, 0x6d, 0x13, 0x3e, 0x10, 0xf2,
+	0xd3, 0x9c, 0xf6, 0x71, 0xa5, 0x96, 0x5c, 0xf0, 0xe4, 0x5d, 0x54, 0x41, 0x8e, 0xfe, 0x90, 0x82,
+	0x33, 0x63, 0x5b, 0xd9, 0xe8, 0xd6, 0x30, 0xc8, 0x49, 0x1d, 0xef, 0xe2, 0x95, 0x49, 0x2f, 0x20,
+	0x69, 0xd6, 0x45, 0x69, 0x96, 0x81, 0xce, 0xc6, 0xcd, 0x6a, 0x47, 0x9c, 0x68, 0x1f, 0x0a, 0xb1,
+	0x0e, 0x39, 0x32, 0xc7, 0x99, 0x30, 0xd8, 0x3e, 0x2f, 0xae, 0x8e, 0x6e, 0x7b, 0xd4, 0xf4, 0x0c,
+	0xef, 0x25, 0x34, 0xf6, 0x5e, 0xc2, 0x90, 0x8f, 0xfa, 0xeb, 0xa8, 0x34, 0x0e, 0x2a, 0xde, 0x7a,
+	0x2f, 0x1a, 0x23, 0xad, 0x21, 0xdd, 0x74, 0x09, 0xb3, 0x39, 0x1a, 0xcd, 0xe6, 0x0c, 0xa0, 0xdf,
+	0x5e, 0x47, 0x23, 0x6f, 0xb7, 0x91, 0xd6, 0x7b, 0x62, 0xf2, 0x5e, 0x93, 0x10, 0x57, 0xcc, 0xe4,
+	0xfd, 0xf4, 0xa4, 0x2e, 0x51, 0xac, 0xfd, 0x16, 0x16, 0x06, 0xba, 0xcc, 0xa3, 0x85, 0xd4, 0xb8,
+	0x26, 0x74, 0x22, 0xf4, 0x1d, 0x09, 0xbd, 0x66, 0x5e, 0x1d, 0x0b, 0x1d, 0xf5, 0xe9, 0x8f, 0x2b,
+	0x6d, 0xa1, 0x55, 0x18, 0xb0, 0x07, 0xf9, 0x97, 0x7e, 0xe3, 0xed, 0xeb, 0x45, 0x9d, 0x16, 0xcc,
+	0xe4, 0xb4, 0xd0, 0xf5, 0x55, 0xc5, 0x58, 0x50, 0x8d, 0xe3, 0x8d, 0x4e, 0xc7, 0x23, 0x6f, 0x83,
+	0x74, 0x5b, 0x22, 0x5d, 0x37, 0xaf, 0x9e, 0x80, 0x24, 0x00, 0x2a, 0x58, 0x22, 0x7c, 0x03, 0xf3,
+	0x0a, 0x70, 0x53, 0xf6, 0x1d, 0xdf, 0x06, 0xb1, 0x2c, 0x11, 0x6f, 0x98, 0xd7, 0x26, 0x21, 0xaa,
+	0xd6, 0x26, 0x3a, 0x0a, 0x21, 0x55, 0x83, 0x70, 0xb4, 0x46, 0x1d, 0xd3, 0x7e, 0xff, 0xf9, 0xe0,
+	0xaa, 0xa1, 0xd9, 0x77, 0xf0, 0x96, 0x6c, 0x98, 0xbe, 0x4b, 0x07, 0xab, 0x96, 0x6c, 0x17, 0x16,
+	0x15, 0xe0, 0x63, 0x6c, 0x93, 0x46, 0x10, 0xec, 0xbf, 0x0d, 0x66, 0x78, 0x60, 0x6f, 0x4c, 0xc2,
+	0xdc, 0x0b, 0x41, 0x0e, 0x61, 0x59, 0xc1, 0x6e, 0xe3, 0x36, 0xd9, 0x24, 0x3e, 0x7f, 0xbb, 0x73,
+	0x5b, 0x95, 0xc0, 0xb7, 0xcc, 0xb5, 0x49, 0xc0, 0x4d, 0xdc, 0x26, 0xb6, 0x82, 0x89, 0x8e, 0xd4,
+	0xb6, 0x54, 0xf9, 0x4e, 0x8f, 0x94, 0x12, 0x17, 0xef, 0x1e, 0xe8, 0x7f, 0x70, 0x19, 0xcd, 0x4a,
+	0x23, 0x1f, 0x63, 0x12, 0x91, 0xef, 0x4b, 0xe4, 0xbb, 0x66, 0x65, 0x12, 0x72, 0xe0, 0x3a, 0x76,
+	0xe5, 0x28, 0xfc, 0x60, 0x73, 0xdc, 0x3f, 0x58, 0x3b, 0x9c, 0xe0, 0xf6, 0x3b, 0x3d, 0x58, 0x4c,
+	0x22, 0x04, 0xb0, 0x30, 0xd0, 0xc9, 0x1f, 0xcd, 0x89, 0xe3, 0x1a, 0xfd, 0x93, 0x1e, 0x97, 0x66,
+	0x72, 0x75, 0xf7, 0x63, 0x0a, 0xd0, 0x68, 0xa3, 0x63, 0xb4, 0x8e, 0x4f, 0x6c, 0x86, 0x14, 0xcf,
+	0x27, 0xd6, 0x19, 0x1b, 0xf6, 0x7e, 0x58, 0x6a, 0x98, 0x6f, 0x5c, 0x6a, 0x3c, 0xfc, 0x73, 0xfa,
+	0xfb, 0x8d, 0x3f, 0xa5, 0xd1, 0x31, 0x9c, 0x79, 0x26, 0xf5, 0x97, 0xb4, 0x74, 0x69, 0xe3, 0x45,
+	0xad, 0xd4, 0xab, 0x9a, 0x75, 0x78, 0x7f, 0xb7, 0x45, 0x4a, 0x7a, 0x52, 0xf4, 0x16, 0x02, 0xca,
+	0x4a, 0xd7, 0x4a, 0x9b, 0x81, 0xcf, 0xa9, 0xdb, 0xe8, 0xf2, 0x80, 0x32, 0x74, 0xa5, 0xc5, 0x79,
+	0x87, 0xad, 0x57, 0x2a, 0x27, 0xfd, 0x29, 0x59, 0x71, 0xa5, 0x45, 0x3c, 0x2f, 0xf8, 0x45, 0x7f,
+	0x42, 0xf0, 0x55, 0x67, 0xaa, 0xe5, 0x3b, 0xc5, 0xc5, 0xbb, 0xd5, 0xfb, 0xe5, 0x3b, 0xe5, 0x3b,
+	0xe5, 0xbb, 0xeb, 0xf7, 0xef, 0xfd, 0xef, 0xdd, 0xb5, 0x54, 0xaa, 0xba, 0x2c, 0x92, 0xac, 0xfe,
+	0x53, 0x9e, 0xca, 0xd7, 0x2c, 0xf0, 0xd7, 0x47, 0x28, 0x5f, 0x9e, 0x82, 0x25, 0xc8, 0x3f, 0xc4,
+	0xcc, 0xb5, 0x85, 0x61, 0x28, 0x9d, 0x4b, 0x35, 0x96, 0x60, 0x21, 0x4e, 0x7a, 0x8f, 0x3e, 0x84,
+	0xcb, 0xda, 0x78, 0x46, 0x68, 0x8f, 0xd0, 0x68, 0x81, 0x4e, 0x60, 0x77, 0xdb, 0xc4, 0x57, 0x7f,
+	0x36, 0x86, 0x56, 0xc3, 0x25, 0x0c, 0x9a, 0x57, 0x71, 0x02, 0x9b, 0x7d, 0x99, 0xd5, 0x32, 0x8d,
+	0x39, 0xb9, 0xf3, 0xf7, 0xfe, 0x3b, 0x00, 0x7d, 0x20, 0x83, 0x5b, 0x5b, 0x27, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1619,6 +2269,8 @@ type ConsoleClient interface {
 	DeleteWalletLedger(ctx context.Context, in *DeleteWalletLedgerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Export all information stored about a user account.
 	ExportAccount(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*AccountExport, error)
+	// Export all information stored about a user account as an archive.
+	ExportAccountArchive(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*api.AccountExportArchive, error)
 	// Get detailed account information for a single user.
 	GetAccount(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*api.Account, error)
 	// Get server config and configuration warnings.
@@ -1633,12 +2285,20 @@ type ConsoleClient interface {
 	GetStorage(ctx context.Context, in *api.ReadStorageObjectId, opts ...grpc.CallOption) (*api.StorageObject, error)
 	// Get a list of the user's wallet transactions.
 	GetWalletLedger(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*WalletLedgerList, error)
+	// List pending matchmaker tickets on this node.
+	ListMatchmakerTickets(ctx context.Context, in *ListMatchmakerTicketsRequest, opts ...grpc.CallOption) (*MatchmakerTicketList, error)
 	// List (and optionally filter) storage data.
 	ListStorage(ctx context.Context, in *ListStorageRequest, opts ...grpc.CallOption) (*StorageList, error)
 	// List (and optionally filter) users.
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*UserList, error)
+	// Log out a user's session, or all of their sessions, and disconnect any sockets opened with them.
+	LogoutUser(ctx context.Context, in *LogoutUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Merge one user account into another, deleting the merged account.
+	MergeAccounts(ctx context.Context, in *MergeAccountsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Unban a user.
 	UnbanUser(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Unlink the Apple ID from a user account.
+	UnlinkApple(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Unlink the custom ID from a user account.
 	UnlinkCustom(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Unlink the device ID from a user account.
@@ -1651,6 +2311,8 @@ type ConsoleClient interface {
 	UnlinkGameCenter(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Unlink the Google ID from a user account.
 	UnlinkGoogle(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Unlink an OpenID Connect identity from a user account.
+	UnlinkOidc(ctx context.Context, in *UnlinkOidcRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Unlink the Steam ID from a user account.
 	UnlinkSteam(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Update one or more fields on a user account.
@@ -1757,6 +2419,15 @@ func (c *consoleClient) ExportAccount(ctx context.Context, in *AccountId, opts .
 	return out, nil
 }
 
+func (c *consoleClient) ExportAccountArchive(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*api.AccountExportArchive, error) {
+	out := new(api.AccountExportArchive)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/ExportAccountArchive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) GetAccount(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*api.Account, error) {
 	out := new(api.Account)
 	err := c.cc.Invoke(ctx, "/nakama.console.Console/GetAccount", in, out, opts...)
@@ -1820,6 +2491,15 @@ func (c *consoleClient) GetWalletLedger(ctx context.Context, in *AccountId, opts
 	return out, nil
 }
 
+func (c *consoleClient) ListMatchmakerTickets(ctx context.Context, in *ListMatchmakerTicketsRequest, opts ...grpc.CallOption) (*MatchmakerTicketList, error) {
+	out := new(MatchmakerTicketList)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/ListMatchmakerTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) ListStorage(ctx context.Context, in *ListStorageRequest, opts ...grpc.CallOption) (*StorageList, error) {
 	out := new(StorageList)
 	err := c.cc.Invoke(ctx, "/nakama.console.Console/ListStorage", in, out, opts...)
@@ -1838,6 +2518,24 @@ func (c *consoleClient) ListUsers(ctx context.Context, in *ListUsersRequest, opt
 	return out, nil
 }
 
+func (c *consoleClient) LogoutUser(ctx context.Context, in *LogoutUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/LogoutUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleClient) MergeAccounts(ctx context.Context, in *MergeAccountsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/MergeAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) UnbanUser(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.console.Console/UnbanUser", in, out, opts...)
@@ -1847,6 +2545,15 @@ func (c *consoleClient) UnbanUser(ctx context.Context, in *AccountId, opts ...gr
 	return out, nil
 }
 
+func (c *consoleClient) UnlinkApple(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/UnlinkApple", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) UnlinkCustom(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.console.Console/UnlinkCustom", in, out, opts...)
@@ -1901,6 +2608,15 @@ func (c *consoleClient) UnlinkGoogle(ctx context.Context, in *AccountId, opts ..
 	return out, nil
 }
 
+func (c *consoleClient) UnlinkOidc(ctx context.Context, in *UnlinkOidcRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/UnlinkOidc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) UnlinkSteam(ctx context.Context, in *AccountId, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.console.Console/UnlinkSteam", in, out, opts...)
@@ -1950,6 +2666,8 @@ type ConsoleServer interface {
 	DeleteWalletLedger(context.Context, *DeleteWalletLedgerRequest) (*empty.Empty, error)
 	// Export all information stored about a user account.
 	ExportAccount(context.Context, *AccountId) (*AccountExport, error)
+	// Export all information stored about a user account as an archive.
+	ExportAccountArchive(context.Context, *AccountId) (*api.AccountExportArchive, error)
 	// Get detailed account information for a single user.
 	GetAccount(context.Context, *AccountId) (*api.Account, error)
 	// Get server config and configuration warnings.
@@ -1964,12 +2682,20 @@ type ConsoleServer interface {
 	GetStorage(context.Context, *api.ReadStorageObjectId) (*api.StorageObject, error)
 	// Get a list of the user's wallet transactions.
 	GetWalletLedger(context.Context, *AccountId) (*WalletLedgerList, error)
+	// List pending matchmaker tickets on this node.
+	ListMatchmakerTickets(context.Context, *ListMatchmakerTicketsRequest) (*MatchmakerTicketList, error)
 	// List (and optionally filter) storage data.
 	ListStorage(context.Context, *ListStorageRequest) (*StorageList, error)
 	// List (and optionally filter) users.
 	ListUsers(context.Context, *ListUsersRequest) (*UserList, error)
+	// Log out a user's session, or all of their sessions, and disconnect any sockets opened with them.
+	LogoutUser(context.Context, *LogoutUserRequest) (*empty.Empty, error)
+	// Merge one user account into another, deleting the merged account.
+	MergeAccounts(context.Context, *MergeAccountsRequest) (*empty.Empty, error)
 	// Unban a user.
 	UnbanUser(context.Context, *AccountId) (*empty.Empty, error)
+	// Unlink the Apple ID from a user account.
+	UnlinkApple(context.Context, *AccountId) (*empty.Empty, error)
 	// Unlink the custom ID from a user account.
 	UnlinkCustom(context.Context, *AccountId) (*empty.Empty, error)
 	// Unlink the device ID from a user account.
@@ -1982,6 +2708,8 @@ type ConsoleServer interface {
 	UnlinkGameCenter(context.Context, *AccountId) (*empty.Empty, error)
 	// Unlink the Google ID from a user account.
 	UnlinkGoogle(context.Context, *AccountId) (*empty.Empty, error)
+	// Unlink an OpenID Connect identity from a user account.
+	UnlinkOidc(context.Context, *UnlinkOidcRequest) (*empty.Empty, error)
 	// Unlink the Steam ID from a user account.
 	UnlinkSteam(context.Context, *AccountId) (*empty.Empty, error)
 	// Update one or more fields on a user account.
@@ -2174,6 +2902,24 @@ func _Console_ExportAccount_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_ExportAccountArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ExportAccountArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/ExportAccountArchive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ExportAccountArchive(ctx, req.(*AccountId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountId)
 	if err := dec(in); err != nil {
@@ -2300,6 +3046,24 @@ func _Console_GetWalletLedger_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_ListMatchmakerTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchmakerTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ListMatchmakerTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/ListMatchmakerTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ListMatchmakerTickets(ctx, req.(*ListMatchmakerTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_ListStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListStorageRequest)
 	if err := dec(in); err != nil {
@@ -2336,6 +3100,42 @@ func _Console_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_LogoutUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).LogoutUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/LogoutUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).LogoutUser(ctx, req.(*LogoutUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Console_MergeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).MergeAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/MergeAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).MergeAccounts(ctx, req.(*MergeAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountId)
 	if err := dec(in); err != nil {
@@ -2354,6 +3154,24 @@ func _Console_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_UnlinkApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).UnlinkApple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/UnlinkApple",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).UnlinkApple(ctx, req.(*AccountId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_UnlinkCustom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountId)
 	if err := dec(in); err != nil {
@@ -2462,6 +3280,24 @@ func _Console_UnlinkGoogle_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_UnlinkOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkOidcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).UnlinkOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/UnlinkOidc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).UnlinkOidc(ctx, req.(*UnlinkOidcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_UnlinkSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountId)
 	if err := dec(in); err != nil {
@@ -2560,6 +3396,10 @@ var _Console_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExportAccount",
 			Handler:    _Console_ExportAccount_Handler,
 		},
+		{
+			MethodName: "ExportAccountArchive",
+			Handler:    _Console_ExportAccountArchive_Handler,
+		},
 		{
 			MethodName: "GetAccount",
 			Handler:    _Console_GetAccount_Handler,
@@ -2588,6 +3428,10 @@ var _Console_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWalletLedger",
 			Handler:    _Console_GetWalletLedger_Handler,
 		},
+		{
+			MethodName: "ListMatchmakerTickets",
+			Handler:    _Console_ListMatchmakerTickets_Handler,
+		},
 		{
 			MethodName: "ListStorage",
 			Handler:    _Console_ListStorage_Handler,
@@ -2596,10 +3440,22 @@ var _Console_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _Console_ListUsers_Handler,
 		},
+		{
+			MethodName: "LogoutUser",
+			Handler:    _Console_LogoutUser_Handler,
+		},
+		{
+			MethodName: "MergeAccounts",
+			Handler:    _Console_MergeAccounts_Handler,
+		},
 		{
 			MethodName: "UnbanUser",
 			Handler:    _Console_UnbanUser_Handler,
 		},
+		{
+			MethodName: "UnlinkApple",
+			Handler:    _Console_UnlinkApple_Handler,
+		},
 		{
 			MethodName: "UnlinkCustom",
 			Handler:    _Console_UnlinkCustom_Handler,
@@ -2624,6 +3480,10 @@ var _Console_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnlinkGoogle",
 			Handler:    _Console_UnlinkGoogle_Handler,
 		},
+		{
+			MethodName: "UnlinkOidc",
+			Handler:    _Console_UnlinkOidc_Handler,
+		},
 		{
 			MethodName: "UnlinkSteam",
 			Handler:    _Console_UnlinkSteam_Handler,
@@ -15,6 +15,7 @@
 --]]
 
 local du = require("debug_utils")
+local nk = require("nakama")
 
 --[[
 Called when a match is created as a result of nk.match_create().
@@ -62,11 +63,13 @@ Context represents information about the match and server, for information purpo
 
 Dispatcher exposes useful functions to the match. Format:
 {
-  broadcast_message = function(op_code, data, presences, sender),
+  broadcast_message = function(op_code, data, presences, sender, reliable, audience),
     -- numeric message op code
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+    -- true to deliver the message reliably, false to allow unreliable delivery where the transport supports it, defaults to true
+    -- "players", "spectators" or "all" to choose who receives a message sent to the whole match, defaults to "all"
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -105,6 +108,24 @@ local function match_join_attempt(context, dispatcher, tick, state, presence, me
   return state, true
 end
 
+--[[
+Called when a user attempts to join the match as a spectator. Optional, if not defined all spectators are rejected.
+Spectators receive messages broadcast to the whole match, possibly after the delay set in match.spectator_delay_ms,
+but cannot send match data. Accepted spectators are not passed to match_join or match_leave.
+
+Context, dispatcher, tick, state, presence and metadata are as described in match_join_attempt.
+
+Expected return these values (all required) in order:
+1. An (optionally) updated state. May be any non-nil Lua term, or nil to end the match.
+2. Boolean true if the spectator should be allowed, false otherwise.
+--]]
+local function match_spectator_join_attempt(context, dispatcher, tick, state, presence, metadata)
+  if state.debug then
+    print("match spectator join attempt:\n" .. du.print_r(presence))
+  end
+  return state, true
+end
+
 --[[
 Called when one or more users have successfully completed the match join process after their match_join_attempt returns
 `true`. When their presences are sent to this function the users are ready to receive match data messages and can be
@@ -122,11 +143,13 @@ Context represents information about the match and server, for information purpo
 
 Dispatcher exposes useful functions to the match. Format:
 {
-  broadcast_message = function(op_code, data, presences, sender),
+  broadcast_message = function(op_code, data, presences, sender, reliable, audience),
     -- numeric message op code
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+    -- true to deliver the message reliably, false to allow unreliable delivery where the transport supports it, defaults to true
+    -- "players", "spectators" or "all" to choose who receives a message sent to the whole match, defaults to "all"
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -174,11 +197,13 @@ Context represents information about the match and server, for information purpo
 
 Dispatcher exposes useful functions to the match. Format:
 {
-  broadcast_message = function(op_code, data, presences, sender),
+  broadcast_message = function(op_code, data, presences, sender, reliable, audience),
     -- numeric message op code
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+    -- true to deliver the message reliably, false to allow unreliable delivery where the transport supports it, defaults to true
+    -- "players", "spectators" or "all" to choose who receives a message sent to the whole match, defaults to "all"
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -226,11 +251,13 @@ Context represents information about the match and server, for information purpo
 
 Dispatcher exposes useful functions to the match. Format:
 {
-  broadcast_message = function(op_code, data, presences, sender),
+  broadcast_message = function(op_code, data, presences, sender, reliable, audience),
     -- numeric message op code
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+    -- true to deliver the message reliably, false to allow unreliable delivery where the transport supports it, defaults to true
+    -- "players", "spectators" or "all" to choose who receives a message sent to the whole match, defaults to "all"
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -252,7 +279,9 @@ Messages is a list of data messages received from users between the previous and
       node: "name of the Nakama node the user is connected to"
     },
     op_code = 1, -- numeric op code set by the sender.
-    data = "any string data set by the sender" -- may be nil.
+    data = "any string data set by the sender", -- may be nil.
+    receive_time_ms = 1234567890, -- time the message was received by the server, in milliseconds.
+    reliable = true -- true if the sender delivered the message reliably.
   },
   ...
 }
@@ -285,11 +314,13 @@ Context represents information about the match and server, for information purpo
 
 Dispatcher exposes useful functions to the match. Format:
 {
-  broadcast_message = function(op_code, data, presences, sender),
+  broadcast_message = function(op_code, data, presences, sender, reliable, audience),
     -- numeric message op code
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+    -- true to deliver the message reliably, false to allow unreliable delivery where the transport supports it, defaults to true
+    -- "players", "spectators" or "all" to choose who receives a message sent to the whole match, defaults to "all"
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -314,12 +345,143 @@ local function match_terminate(context, dispatcher, tick, state, grace_seconds)
   return state
 end
 
--- Match modules must return a table with these functions defined. All functions are required.
+--[[
+Called when the match receives a signal from server runtime code through nk.match_signal(match_id, data). Optional, if
+not defined signals to the match are ignored.
+
+Context represents information about the match and server, for information purposes. Format:
+{
+  env = {}, -- key-value data set in the runtime.env server configuration.
+  executionMode = "Match",
+  match_id = "client-friendly match ID, can be shared with clients and used in match join operations",
+  match_node = "name of the Nakama node hosting this match",
+  match_label = "the label string returned from match_init",
+  match_tick_rate = 1 -- the tick rate returned by match_init
+}
+
+Dispatcher exposes useful functions to the match, as described in match_terminate.
+
+Tick is the current match tick number, starts at 0 and increments after every match_loop call. Does not increment with
+calls to match_signal.
+
+State is the current in-memory match state, may be any Lua term except nil.
+
+Data is the arbitrary string payload sent with the signal.
+
+Expected return these values in order:
+1. An (optionally) updated state. May be any non-nil Lua term, or nil to end the match.
+2. Optional result string returned to the caller of nk.match_signal.
+--]]
+local function match_signal(context, dispatcher, tick, state, data)
+  if state.debug then
+    print("match " .. context.match_id .. " tick " .. tick)
+    print("match " .. context.match_id .. " data " .. data)
+  end
+  return state, "signal received: " .. data
+end
+
+--[[
+Called instead of match_leave when one or more players disconnect rather than leave the match. Optional, but must be
+defined together with match_reconnect. Their places are held for the returned number of seconds, during which each of
+them can join again from a new session using the reconnect token they received when they first joined. Players who do
+not reconnect in time are passed to match_leave as usual.
+
+Context, dispatcher and tick are as described in match_leave.
+
+State is the current in-memory match state, may be any Lua term except nil.
+
+Presences is a list of players that disconnected, as described in match_leave.
+
+Expected return these values (all required) in order:
+1. An (optionally) updated state. May be any non-nil Lua term, or nil to end the match.
+2. Number of seconds to hold their places for, or 0 to have them leave the match immediately.
+--]]
+local function match_disconnect(context, dispatcher, tick, state, presences)
+  if state.debug then
+    print("match disconnect:\n" .. du.print_r(presences))
+  end
+  return state, 30
+end
+
+--[[
+Called when one or more disconnected players reclaim their place in the match from a new session. Optional, but must
+be defined together with match_disconnect.
+
+Context, dispatcher and tick are as described in match_leave.
+
+State is the current in-memory match state, may be any Lua term except nil.
+
+Presences is a list of the reconnected players with their new session details, as described in match_join.
+
+Expected return these values (all required) in order:
+1. An (optionally) updated state. May be any non-nil Lua term, or nil to end the match.
+--]]
+local function match_reconnect(context, dispatcher, tick, state, presences)
+  if state.debug then
+    print("match reconnect:\n" .. du.print_r(presences))
+  end
+  return state
+end
+
+--[[
+Called after match_terminate when the server begins a graceful shutdown process. Optional, but must be defined together
+with match_restore. Returning a snapshot string stops the match immediately and stores the snapshot, so the match can be
+restored with the same match ID by the next server to start. Users in the match are sent a notification inviting them
+to rejoin once it's restored.
+
+Context, dispatcher and tick are as described in match_terminate.
+
+State is the current in-memory match state, may be any Lua term except nil.
+
+Expected return these values (all required) in order:
+1. A string serialization of the state, or nil to let the match end as usual.
+--]]
+local function match_snapshot(context, dispatcher, tick, state)
+  if state.debug then
+    print("match " .. context.match_id .. " tick " .. tick)
+  end
+  return nk.json_encode(state)
+end
+
+--[[
+Called instead of match_init when a match is recreated from a snapshot. Optional, but must be defined together with
+match_snapshot.
+
+Context represents information about the match and server, as described in match_init.
+
+Tick is the match tick at the time the snapshot was taken, the match resumes from this tick.
+
+Snapshot is the string returned from match_snapshot.
+
+Expected return these values (all required) in order:
+1. The restored state. May be any non-nil Lua term.
+2. Tick rate representing the desired number of match_loop calls per second. Must be between 1 and 30, inclusive.
+3. A string label that can be used to filter matches in listing operations. Must be between 0 and 256 characters long.
+--]]
+local function match_restore(context, tick, snapshot)
+  local state = nk.json_decode(snapshot)
+  if state.debug then
+    print("match restore context:\n" .. du.print_r(context) .. "match restore tick " .. tick)
+  end
+  local tick_rate = 1
+  local label = "skill=100-150"
+
+  return state, tick_rate, label
+end
+
+-- Match modules must return a table with these functions defined. All functions are required except
+-- match_spectator_join_attempt, match_signal, match_disconnect, match_reconnect, match_snapshot and match_restore.
 return {
   match_init = match_init,
   match_join_attempt = match_join_attempt,
+  match_spectator_join_attempt = match_spectator_join_attempt,
   match_join = match_join,
   match_leave = match_leave,
   match_loop = match_loop,
-  match_terminate = match_terminate
+  match_terminate = match_terminate,
+  match_signal = match_signal,
+  match_disconnect = match_disconnect,
+  match_reconnect = match_reconnect,
+  match_snapshot = match_snapshot,
+  match_restore = match_restore
 }
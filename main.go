@@ -97,31 +97,71 @@ func main() {
 	socialClient := social.NewClient(5 * time.Second)
 
 	// Start up server components.
-	matchmaker := server.NewLocalMatchmaker(startupLogger, config.GetName())
-	sessionRegistry := server.NewLocalSessionRegistry()
-	tracker := server.StartLocalTracker(logger, config, sessionRegistry, jsonpbMarshaler)
-	router := server.NewLocalMessageRouter(sessionRegistry, tracker, jsonpbMarshaler)
+	var cluster *server.Cluster
+	var sessionRegistry server.SessionRegistry
+	var sessionCache server.SessionCache
+	var tracker server.Tracker
+	var router server.MessageRouter
+	if config.GetCluster().GossipPort != 0 {
+		// Running as part of a cluster, share presences and route messages between nodes.
+		cluster = server.NewCluster(logger, config)
+		sessionRegistry = server.NewClusterSessionRegistry(cluster)
+		tracker = server.StartClusterTracker(logger, config, sessionRegistry, jsonpbMarshaler, cluster)
+		router = server.NewClusterMessageRouter(logger, sessionRegistry, tracker, jsonpbMarshaler, cluster)
+		sessionCache = server.NewClusterSessionCache(logger, startupLogger, config, db, sessionRegistry, tracker, cluster)
+	} else {
+		sessionRegistry = server.NewLocalSessionRegistry()
+		tracker = server.StartLocalTracker(logger, config, sessionRegistry, jsonpbMarshaler)
+		router = server.NewLocalMessageRouter(sessionRegistry, tracker, jsonpbMarshaler)
+		sessionCache = server.NewLocalSessionCache(logger, startupLogger, config, db, sessionRegistry, tracker)
+	}
+	matchmaker := server.NewLocalMatchmaker(logger, startupLogger, config, router)
 	leaderboardCache := server.NewLocalLeaderboardCache(logger, startupLogger, db)
 	leaderboardRankCache := server.NewLocalLeaderboardRankCache(startupLogger, db, config.GetLeaderboard(), leaderboardCache)
 	leaderboardScheduler := server.NewLocalLeaderboardScheduler(logger, db, leaderboardCache, leaderboardRankCache)
-	matchRegistry := server.NewLocalMatchRegistry(logger, startupLogger, config, tracker, router, config.GetName())
+	var matchRegistry server.MatchRegistry
+	if cluster != nil {
+		matchRegistry = server.NewClusterMatchRegistry(logger, startupLogger, config, db, tracker, router, cluster)
+	} else {
+		matchRegistry = server.NewLocalMatchRegistry(logger, startupLogger, config, db, tracker, router, config.GetName())
+	}
 	tracker.SetMatchJoinListener(matchRegistry.Join)
 	tracker.SetMatchLeaveListener(matchRegistry.Leave)
+	partyRegistry := server.NewLocalPartyRegistry(logger, matchmaker, tracker, router, config.GetName())
+	tracker.SetPartyLeaveListener(partyRegistry.Leave)
 	streamManager := server.NewLocalStreamManager(config, sessionRegistry, tracker)
-	runtime, err := server.NewRuntime(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, matchRegistry, tracker, streamManager, router)
+	runtime, err := server.NewRuntime(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, matchRegistry, matchmaker, tracker, streamManager, router)
 	if err != nil {
 		startupLogger.Fatal("Failed initializing runtime modules", zap.Error(err))
 	}
 
 	leaderboardScheduler.Start(runtime)
+	matchmaker.Start(runtime)
+	accountErasureScheduler := server.StartAccountErasureScheduler(logger, db, config, sessionCache)
+
+	if cluster != nil {
+		if err := cluster.Start(); err != nil {
+			startupLogger.Fatal("Failed starting cluster", zap.Error(err))
+		}
+		startupLogger.Info("Cluster started", zap.Int("gossip_port", config.GetCluster().GossipPort), zap.Strings("join", config.GetCluster().Join))
+	}
+
+	// Recreate any matches stored when a previous server shut down, they resume on this node with the same match ID.
+	if err := matchRegistry.RestoreMatches(context.Background(), runtime.MatchCreateFunction()); err != nil {
+		startupLogger.Error("Failed restoring matches", zap.Error(err))
+	}
 
-	pipeline := server.NewPipeline(logger, config, db, jsonpbMarshaler, jsonpbUnmarshaler, sessionRegistry, matchRegistry, matchmaker, tracker, router, runtime)
+	pipeline := server.NewPipeline(logger, config, db, jsonpbMarshaler, jsonpbUnmarshaler, sessionRegistry, matchRegistry, matchmaker, partyRegistry, tracker, router, runtime)
 	metricsExporter := server.NewMetricsExporter(logger)
 	metrics := server.NewMetrics(logger, startupLogger, config, metricsExporter)
 	statusHandler := server.NewLocalStatusHandler(logger, sessionRegistry, matchRegistry, tracker, metricsExporter, config.GetName())
 
-	consoleServer := server.StartConsoleServer(logger, startupLogger, db, config, tracker, statusHandler, configWarnings)
-	apiServer := server.StartApiServer(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, sessionRegistry, matchRegistry, matchmaker, tracker, router, pipeline, runtime)
+	consoleServer := server.StartConsoleServer(logger, startupLogger, db, config, sessionCache, leaderboardCache, leaderboardRankCache, tracker, router, matchmaker, statusHandler, configWarnings)
+	var udpAcceptor *server.SocketUdpAcceptor
+	if config.GetSocket().UdpPort != 0 {
+		udpAcceptor = server.StartSocketUdpAcceptor(logger, startupLogger, config, sessionRegistry, sessionCache, matchmaker, tracker, runtime, jsonpbMarshaler, jsonpbUnmarshaler, pipeline)
+	}
+	apiServer := server.StartApiServer(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, sessionRegistry, sessionCache, matchRegistry, matchmaker, tracker, router, pipeline, runtime)
 
 	gaenabled := len(os.Getenv("NAKAMA_TELEMETRY")) < 1
 	cookie := newOrLoadCookie(config)
@@ -176,9 +216,18 @@ func main() {
 
 	// Gracefully stop remaining server components.
 	apiServer.Stop()
+	if udpAcceptor != nil {
+		udpAcceptor.Stop()
+	}
 	consoleServer.Stop()
 	metrics.Stop(logger)
 	leaderboardScheduler.Stop()
+	accountErasureScheduler.Stop()
+	matchmaker.Stop()
+	if cluster != nil {
+		cluster.Stop()
+	}
+	sessionCache.Stop()
 	tracker.Stop()
 	sessionRegistry.Stop()
 
@@ -9,4 +9,16 @@ import "github.com/gobuffalo/packr"
 func init() {
 	packr.PackJSONBytes("./sql", "20180103142001_initial_schema.sql", "\"H4sIAAAAAAAA/7xaX5PaOBJ/51N0zcMN5JgZZpLZ7CXZrfKAJ+FCIIdhN7kXl7B7QBnb8koyDHd13/1Ksg2SAUNmU8tuJRi3Wq3WT7/+o1y9aMAL6LJ0zel8IeGmc/0zTBYIQ/JIYgJOJheMiwZouQENMBEYQpaEyEEuEJyUBAss37ThN+SCsgRuLjvQVAJnxauz1lulYs0yiMkaEiYhEwhyQQU80AgBnwJMJdAEAhanESVJgLCicqHnKbRcKh1fCx1sJglNgEDA0jWwB1MQiCyMXkiZvrm6Wq1Wl0Qbe8n4/CrKxcTVoN91h557cXPZKQZMkwiFAI5/ZJRjCLM1kDSNaEBmEUJEVsA4kDlHDEEyZfCKU0mTeRsEe5ArwlGpCamQnM4yafmrNI8KS4AlQBI4czzoe2dw53h9r62U/N6ffBhNJ/C7Mx47w0nf9WA0hu5o2OtP+qOhB6N7cIZf4WN/2GsDUrlADviUcrUCxoEqT2Ko3eYhWiY8sNwkkWJAH2gAEUnmGZkjzNkSeUKTOaTIYyrUjgogSajURDSmkkj908661ERXjcbFBfw9pnNOJMI0bXTHrjNxYeLcDVzo38NwNAH3S9+beAoDXECzAQDwedz/5Iy/wkf3KzRp2Go39M80BOMznfZ72yelaTgdDNpaUilLSIz5u9+ccfeDM25e3/zcAuUzbzJ2+sNJPqdfCvuPuIbpsP+vqVtRF1KRRmTt5ypLdTe3t638PVkSSbif8cic7vb6pnh/caHBJ95cXUnGInFJUT5o9C1kHF3NgvTVay2oHO9LMq/YrcyGnnvvTAcTOMfkPFcbsUC735bWZqkp8XJ+CWceSeCekySgImBt6DpneqykMf6HJVg79jPJ8TChMUJz6sHfoEsSEpJWriRGSUIiSa7kn95oeLfZkI25//3fecWdKxJFKEvBk4dhTGi0ETRNhmLbcrmUCLFivADL3deJ62xGdT+43Y/QjDCZy0WzlGzBO3h50+l0iv16IAHOGHv0NeJs+JgzzRmbR+gXuKyRIzEGmEjkSvawnJBI4lJdjVyQCcni4/NiOEc/YFmina0QDzuO7pQ+MYR//QU6rYr3A45Eoq9wAwCT/ifXmzifPk/+behK2KpZHZel4bPGLZHTh3X9uPPrf7zuXHSuLzrX0Om80f/DdNKtIiekQlF2oey5uhqtt5rQPJRZCt5aSIw1hVw2+kPPHU+Uh0clkdGwvaGhViPfp8HU9aB53ik+F3v+KD/nbTg/z8eNhoqz7gf97kSRIfRGyqQP/eH7t41jjOqHuKQBHuRV9ev9aOz23w/zX/UgNcvYvXfH7rDretsVtZQtPXfgTlzoOl7X6bkVarawuMvJCrIWb29FtEzBvqUVbaBhS7v92DIVePctUrCMB6hVCUkktiFlgirS3Lf4jXQrt+5kH+xoClFImmh2foY3N3bsRrrisG4l3v0KpwGquh+lIwrFd/33G4LYCKpQMFV5nkoRBOMSGFeBniXA2Upc7jnh1vGqO+C2i/YtsiLx7JXqnd8yn/fJGQyKtW5Z0FrzA6eYhM1Oqw00WVKJvsBENq+3zxwDpEsMmzetNswiFjxi2HzZakOIEUoMm69abSA8WGih25aNbwOWFaQotBdg7w977pdDYPdJJplPkxCf/IdH39bic3zwc5yNhuYBqUxWf7ASJlX0z1OMZpnI3Pe/fHLfQMCCR85IsDhX+SCJ1gK5ymVVOh9EuFTZZ8Ky+QJWC0ys6LEgAnqu14WYhaigpNJGvZLL3QO8YQJDg6aFH89d1aNmZoqmL5SDD6aKJcvtKKtAMpt9w0DuZjKVoMsSiXn0tvOkmjQpUF7dA3Ub4UOcE0mXCEsSZSiAcASRhzSOAvlS1wrKUlSpfb6kmgWZG2wF2P0UcIzUhWSc7Kf0gEURBmoj2sCRhG14xHW7dPwPxMV2otq4pqBQfk4If0eAobejeHPqji+LUtu04OVNq7LjcXirjt5C1cf5LExjMN9m5cm9qCnnvS5ZWUvuyxBV8Ysnqcglj2WZJ8BoN8U8aZDFxSaeLCQdoeLtOF+5xC+GKWrwafikYLVB8S5mN7z2iOv6eQolFcIvZ7OYfjNfuYT6QxajEOUhs7MmyVUhovhZ5U36oWCszXOIIuA0lYxvforIDKP9TG2nWSWlfN+JtMv/nXO0h44vLiBYEKnjuPri50jRcVw/c4zZEnUMn3OWpf43RhMdxvNHEuaBPH+KkCyxebt5fqTBY/OnzWPKWcxU6H+t8naThmvORMcy2CTbPUs0Re0WRy35GNtZGxe2okZ4OmjADg6Oimp8HLHVjHjfw4J2eQrVkvEwfVSy11MHNoxkrty0k8qVCEmIfMYID3cPXtnrqkD9oLeI7srqLtwSAe5Go4HrDG1X3TsDT5coKn/38/y9hqKtmEFEoA+P2uTmtYI1S5ETtdmnYVopmaHIj6DAMoUOOMYqob7Rusunl2oClX1IXwQLDLMIN0v/6ZXRmAo4S1RJEhP5Bs5egPHfWaPalnoegH5ADmPstCoX2L4NN2UUgPAppXxdcKcIGFd/ZbPiG1sl2yTHolRbj8WrFuAOs6utodJUMh1VGrGPoOoYytSo4ajWtB1p1p97ulO59G7KUDrnRC2l9K6iJIt9Q9P+Ztkmg9lK72qqg9/z8Hc6fZ06zMDZ4WGntNcMHiyB0YYaVB/lx7w57EeqYOW7B6a2HvzzWf+hWnBPcnEoobc2dUGSuSK0Cg4OweWQzKG0+DRcHB9xUo9NsngmJEv2JIzWZmwrsYqXjvu05hA8z3CdmYldg82OcHtz7dI2OuYFqKrB2Ka7Q0tg+wpmm27M1M2+yzDaDrn5ft3tVJl/WWVqeTVlXUxV76VOvJWy7qTqr6Qs5juR96zmnJVO7KFvLVthXLUOlmKSZ/kRExjmqYp1+/Fdlx/X4Ax75vh3v0BMnvKHCtmXP9cEjc5mmq20mqV1GPvPov9TB1nXIX/mNqSmat06zzdsVBVrURuXJ9M+ieb5M8bpw1jfGDUmMeY+Np81R+Xwn8As23uH028dGn9Jf/8Hdfef1dv/qzr739/XF5kqX8KY5myRf1MlSYzxDLmuR74xmvgc/8hU2fLS7OK/alllX20P3/qXDz22Shq98ejzFkk7KHpbIyAOvLTD8gEhK6E6ILNbqhwXPCBRNJUOvC06VAfeml32uiXX+Mu49KyREG8b/w8AAP//CVYGBfEkAAA=\"")
 	packr.PackJSONBytes("./sql", "20180805174141-tournaments.sql", "\"H4sIAAAAAAAA/7RVzW7bOBfd6ykuvGncz38JUHwzNaaAYisTobJUWHLazsagqRuLE4lUSaq25+kHlG39pJGbLkbwhtY5h+fee0iN31rwFmYiP0i2TTTcTK5/gyhB8MkTyQjYhU6EVBaUOI9R5ApjKHiMEnSCYOeEJnh+M4AHlIoJDjejCVwZQO/0qtefGomDKCAjB+BCQ6EQdMIUPLIUAfcUcw2MAxVZnjLCKcKO6aTc56QyMhpfTxpiownjQICK/ADisQkEok+mE63z9+PxbrcbkdLsSMjtOD3C1NhzZ44fOsOb0eREWPEUlQKJ3womMYbNAUiep4ySTYqQkh0ICWQrEWPQwhjeSaYZ3w5AiUe9IxKNTMyUlmxT6Fa/zvaYagEEB8KhZ4fghj24tUM3HBiRz250H6wi+Gwvl7YfuU4IwRJmgT93IzfwQwjuwPa/wkfXnw8AmU5QAu5zaSoQEpjpJMZl20LEloVHcbSkcqTskVFICd8WZIuwFd9Rcsa3kKPMmDITVUB4bGRSljFNdPnXD3WZjcaWNRyCH0TOe4jMeDO2lSUBMsILkqYHM+KMaWWapzAnkmgELQlXhB6VtQDkqpBotEqXNMGMQJHHRKMCIhEUfiuQUzMipMSkiQr6JAWhSbyBWKAqY6aKPBdSGyESx6aq2b0z+whUcKUlYVwr+M4I9GwvcpYQ2beeA6PRCOz5HGaBt1r4PVCaaMyQazUqy/vfsSiEVW42qa1bt86frj+1mmIpkhjlRhAZW9CQBUo0boU8QPmEC9vzXD8qF3Pnzl55EUxMJ8Ffed6gzY1RUcnysq8AD/Zydm8vr27evetX3DdvOsnFaSLlc96za2MYDo+TooLHatSWQh6vNcvwyI7chRNG9uJT9Fct9eb69/9PhpPr4eQaJpP35Q9W0azT3t+C8XV1AG+DwHNsv23vzvZCp4ufkf1asX/wQnnXk9NzSYMX2VpRIfGiRrtRGdkDSVOxwxiOXKI1Zrl+3jjNdIqV5i8OsK7uNQN8xtVE6mpmL06Mi91Vv+JPra44ryVS8TzVP3auq2FTaxYsFm40tV57bPwwWtpGkiZIn9bVAToe6atq/eEPmPQHXbQq/idatb5Mq2J1olXrDz9j1e1oUOs/z/wH23PnduR0F3oZda7jMups++eoyuHUsmZLx+Bcf+58AfeunKHzxQ2jsLpN1nWy1udroXK+ZvEeAr851brxg0YoB/WVMnfC2aC6JfuXXYgdR7lm8Rr3OZOH4+7NrLL4BQ+nBMPVmT6ABn8AbYH+64/Cf5GCZzOpDk/zgzQXO97y6N6dO9Q+UPNl8Ol8ZGtMM2gvIxofngugRhJfRpyH3I1ofQW6Yc04dyOqtnXDygu5+/XlPer8Ti2rhFQZ/bV8PkvYi9Ors/aKgqfWvwEAAP//B0qo3OYLAAA=\"")
+	packr.PackJSONBytes("./sql", "20190312103000-refresh-tokens.sql", "\"H4sIAAAAAAAA/4RSwXLqRhC86yu6OMELBse3hJMeWhKVseSSRGxyoRZpkKYsdpXdJYK/T0lAbGKnHidqtrunp1vTbx6+Ya6bk+Gycni4//kXZBUhkm9yL+EfXKWN9dDjlpyTslTgoAoycBXBb2Re0fVljD/IWNYKD5N7DDvA4PI0GM06iZM+YC9PUNrhYAmuYosd1wQ65tQ4sEKu903NUuWEll0F975g0mmsLxp66yQrSOS6OUHvPgIh3cV05Vzz63Tatu1E9mYn2pTT+gyz02U4F1Eq7h4m9xfCStVkLQz9dWBDBbYnyKapOZfbmlDLFtpAloaogNOd4dawY1WOYfXOtdJQ57Jg6wxvD+4mr6s9tjcArSAVBn6KMB3gu5+G6bgTeQmz3+NVhhc/SfwoC0WKOME8joIwC+MoRbyAH63xGEbBGMSuIgM6Nqa7QBtwlyQVfWwp0Y2FnT5XaBvKecc5aqnKgywJpf6bjGJVoiGzZ9s1aiFV0cnUvGcnXT/6dFe3aOp5d3f4ac+lkY6warx5IvxMIPO/LwXCBaI4g3gN0yztvgGzMbQzZKuN02+kMPQA4DkJn/xkjUexxpCL0bifLuJEhL9F52nP5WKERCxEIqK5OOvZnoA4QiCWIhOY++ncD8TY6zW4wL+/1SoMrv87V9FquTxvuoj/AJUbko42jveELHwSaeY/PWd/IhALf7XMoHQ7HP2HQ8eGzekz54ryRjPvGlkYBeL1q8i42HzQ2XBx7O79Ks0LfIwP+NHstqNAt8oLkvj5vaP/7Wfm/TMAfWPUFzUEAAA=\"")
+	packr.PackJSONBytes("./sql", "20190401120000-purchases.sql", "\"H4sIAAAAAAAA/5STTZObRheF9/yKU7Ox5Bd9jKreRTIrRrQ8xBqYAmR7slG14Aq6jLpJd2NGlcp/T4GEJ9hJKtZKxT333Od+9OKtg7dYq/qsRVFarJa3PyEtCSH/zE8cXmNLpY2DXrcVGUlDORqZk4YtCV7Ns5KGiIsPpI1QEqv5EpNOcHMN3UzvOouzanDiZ0hl0RiCLYXBUVQEesmothASmTrVleAyI7TClrCvBeadx/PVQx0sFxIcmarPUMe/CsHtFbq0tv55sWjbds572LnSxaK6yMxiG6xZmLDZar68JuxkRcZA02+N0JTjcAav60pk/FARKt5CafBCE+WwqgNutbBCFi6MOtqWa+ooc2GsFofGjuY14AkzEigJLnHjJQiSG9x7SZC4ncnHIH2Idik+enHshWnAEkQx1lHoB2kQhQmiDbzwGe+D0HdBwpakQS+17jpQGqKbJOX92BKiEcJRXVZoasrEUWSouCwaXhAK9YW0FLJATfokTLdRAy7zzqYSJ2G57T9911dXaOE4zmyG/51Eobkl7GpnHTMvZUi9+y1DsEEYpWCfgiRNUDc6K7khTBwAeIqDRy9+xnv2jInVXBqedbX2Ip+6vWITxSx4F14UjSHdhRCzDYtZuGZJd1baYNJ9jUL4bMtShrWXrD2fuU7vMTbGBy9eP3jx5P+3q2nPFu6220u1awFcf7td4A//v1EaqzQNISB59LbbIExHSvhs4+22KZYuZjN4dV3RZDl18U6poqLJ7dTFQ8NbEpPVtHettcqbzA4I/0JK8ovQSp5I2v9afyc/S9XKniDhMj+olx7h6VJUKDlgaN7uNZladYcD/JJE4f3Q6Xfmb37/481lesNy91acCEiDR5ak3uNT+uvfpEnVTq47zjRxO2T9QF5T5z+Y50zvnOE8g9Bnn/7hPPfXQ9iPWtqPD2kv8hdE4dekr/fpvvr0bD5L1i7GydO78bPxVSsdP46eXp/NN0x3zp8DAJ4Kbwa/BQAA\"")
+	packr.PackJSONBytes("./sql", "20190415100000-apple-id.sql", "\"H4sIAAAAAAAA/2yRQXPTMBCF7/4Vb3JqS5q0OQE9qYk79RBssJ2WnhjF3tg72JKQZNz8e0ZpOjTAVfv07dv35hcRLrDUZm+5aT0WV9cfULaEVP6QvYQYfKuti3DQrbki5ajGoGqy8C1BGFm19DqZ4oGsY62wmF3hLAgmx9Hk/CYg9npAL/dQ2mNwBN+yw447Aj1XZDxYodK96ViqijCyb+H/LJgFxtORobdesoJEpc0eevdWCOmPplvvzcf5fBzHmTyYnWnbzLsXmZuvk2WcFvHlYnZ1/LBRHTkHSz8HtlRju4c0puNKbjtCJ0doC9lYohpeB8OjZc+qmcLpnR+lpeCyZuctbwd/kterPXYnAq0gFSaiQFJMcCuKpJgGyGNS3mebEo8iz0VaJnGBLMcyS1dJmWRpgewOIn3CpyRdTUHsW7KgZ2PDBdqCQ5JUH2IriE4s7PRLhc5QxTuu0EnVDLIhNPoXWcWqgSHbswuNOkhVB0zHPXvpD0//3BUWzaMourzEu54bKz1hYyKxLuMcpbhdx6F16yBWKyyz9eZziuQOaVYi/pYUZXGImr5zjQeRL+9Ffna9eH+OTZp83cQ3p+CVHtV/0Ks8+/KG/Rf3Jvo9AN3aAxn0AgAA\"")
+	packr.PackJSONBytes("./sql", "20190422110000-email-tokens.sql", "\"H4sIAAAAAAAA/4RTTW/jNhS861cMcrK3/sgG2EObE2PRXWEVKZDk3XUvBiM9S8TKpErSVfTvC8oKUrdp65PBmTcz70PrDwE+YKO7wci6cbi7/fgzioaQiB/iJMDOrtHGBhh5sSxJWapwVhUZuIbAOlE29Ios8JWMlVrhbnWLmSfcTNDN/N5LDPqMkxigtMPZElwjLY6yJdBLSZ2DVCj1qWulUCWhl66BezNYeY39pKGfnZAKAqXuBujjX4kQbgrdONf9sl73fb8SY9iVNvW6vdDsOo42PMn58m51OxXsVEvWwtDvZ2mowvMA0XWtLMVzS2hFD20gakNUwWkfuDfSSVUvYPXR9cKQT1lJ64x8Prureb3Gk/aKoBWEwg3LEeU3eGB5lC+8yLeo+JzuCnxjWcaSIuI50gybNAmjIkqTHOkWLNnjS5SEC5B0DRnQS2d8B9pA+klSNY4tJ7qKcNSXFdqOSnmUJVqh6rOoCbX+g4ySqkZH5iSt36iFUJWXaeVJOuHGp3/05Y3WQRAsl/jpJGsjHGHXBZuMs4KjYA8xR7RFkhbg36O8yP0RmAOdhGwPTv8ghVkAAE9Z9MiyPb7wPWYjcGiEbeaLEd2mGY9+TS7oqCCrOTK+5RlPNvyiajHzr2mCkMe84NiwfMNCvghGjTdR4GFfcIbp58Mluzi+WE3q/i92uyjE+zQ3dPSK5I8sjqOkuKZhufRfhzwOs9v5AhlZcrOP87F67H+q/sqyzWeWze4+fZr/zaQ0JBwdnDwRiuiR5wV7fCp+ezNByLdsFxdQup9Ns6KXTprhP4qC+X3wuqEoCfn3/9nQYRrJwfd8kNUL0uSdNU6sBTxtfn99E6HuVRBm6dPbTfyL233w5wA8bkSapAQAAA==\"")
+	packr.PackJSONBytes("./sql", "20190429090000-identity-activity.sql", "\"H4sIAAAAAAAA/3xST3ObRhS/8yl+45OUIslVJ4fWJyKtGyYyeAAldS+eNTzBm6BduruY6Nt3FqHGSmfCcIB9v3/73lu9C/AOG92dDNeNw/r2199RNIREfpVHiah3jTY2wIjbcUnKUoVeVWTgGkLUybKhSyXEZzKWtcJ6eYuZB9xMpZv5nZc46R5HeYLSDr0luIYtDtwS6FtJnQMrlPrYtSxVSRjYNXDfDZZe42nS0C9OsoJEqbsT9OEtENJNoRvnuj9Wq2EYlnIMu9SmXrVnmF3t4o1IcrFYL28nwl61ZC0M/dOzoQovJ8iua7mULy2hlQO0gawNUQWnfeDBsGNVh7D64AZpyKes2DrDL7276tclHtsrgFaQCjdRjji/wYcoj/PQi3yJi4/pvsCXKMuipIhFjjTDJk22cRGnSY70HlHyhE9xsg1B7BoyoG+d8TfQBuw7SdXYtpzoKsJBn0doOyr5wCVaqepe1oRav5JRrGp0ZI5s/UQtpKq8TMtHdtKNR/+7lzdaBUGwWOCXI9dGOsK+CzaZiAqBIvqwE4jvkaQFxF9xXuR+CcwzV6Qcu9OzLB2/sjthFgDAYxY/RNkTPoknzCZkiM7oV67IhPiPx9U8HBn3aSbiP5MrxhyZuBeZSDbi7Gcx42qONMFW7EQhsInyTbQVYTBqTDRMz34fby/fY/Jkv9ud3S5JpuLnKNt8jLLZb+v5j8g3Sd8i1+/fz39Atqy+Pjs+kscBRfwg8iJ6eCz+Dieb8V0sRg74vPYXfQzSjhJ+cemgDY1/8IIWAxmCoVKbalwKbyete5a9a86eb+yC+d31ILd6UME2Sx+/D/KnQ7wL/h0AwI/nv18EAAA=\"")
+	packr.PackJSONBytes("./sql", "20190506090000-user-identity.sql", "\"H4sIAAAAAAAA/2ySTXejNhiF9/yKe7wyU2Kn7plFm5UG5A5nHEj5mKm7yZHhNajFEpVEiP99D/5o4nZYAXp0732vtPzg4QNC3R+NbFqH1f2PP6NoCYn4SxwE2OBabayHE7eRFSlLNQZVk4FrCawXVUvXlQBfyVipFVaLe8wnYHZZmvkPk8RRDziII5R2GCzBtdJiLzsCvVbUO0iFSh/6TgpVEUbpWrg3g8Wksb1o6J0TUkGg0v0Rev8ehHCX0K1z/S/L5TiOC3EKu9CmWXZnzC43cciTnN+tFveXDaXqyFoY+nuQhmrsjhB938lK7DpCJ0ZoA9EYohpOT4FHI51UTQCr924UhqaUtbTOyN3gbvq6xpP2BtAKQmHGcsT5DJ9YHufBJPItLj6nZYFvLMtYUsQ8R5ohTJMoLuI0yZGuwZItvsRJFICka8mAXnszTaAN5NQk1afacqKbCHt9PkLbUyX3skInVDOIhtDoFzJKqgY9mYO004laCFVPMp08SCfc6df/5pqMlp7n3d3hh4NsjHCEsvfCjLOCo2CfNhzxGklagP8e50U+XQLzLGtSTroj5h4APGXxI8u2+MK3mPdGv8iaTAA77P6kyvnBCVqnGY9/Tc7QRcVHxtc840nIz8oWc1n7SBNEfMMLjpDlIYv4WaJM4t9K/u/uAFcvP/BOwPV7ev/KsvAzy+Y/rXycBkjKzeascwmG99jq40f/P9jFZqJQlnGE63OLVYaEo2cnD4QifuR5wR6fij/eMER8zcpNAaXHue/5D7d9R3pUXpSlT299f6/rB++fAQAh7B2J/QMAAA==\"")
+	packr.PackJSONBytes("./sql", "20190513090000-user-erasure.sql", "\"H4sIAAAAAAAA/2xSTXPqNhTd+1ecYQUpgTS7lpWDRasJsTO2aUI3GWFfbE2N5Eryc/j3b2RgeOS9ncbn4557rud3Ae6w1O3RyKp2eHz4/Q/kNSEW/4mDQNi5WhsbYOCtZUHKUolOlWTgakLYiqKmCzLFP2Ss1AqPsweMPWF0hkaThbc46g4HcYTSDp0luFpa7GVDoM+CWgepUOhD20ihCkIvXQ13HTDzHtuzh945IRUECt0eofc/EiHcOXTtXPvnfN73/UwMYWfaVPPmRLPzNV+yOGP3j7OHs2CjGrIWhv7vpKESuyNE2zayELuG0Ige2kBUhqiE0z5wb6STqprC6r3rhSGfspTWGbnr3E1fl3jS3hC0glAYhRl4NsJTmPFs6k3eeP53ssnxFqZpGOecZUhSLJM44jlP4gzJCmG8xTOPoylIupoM6LM1fgNtIH2TVA61ZUQ3Efb6dELbUiH3skAjVNWJilDpb2SUVBVaMgdp/UUthCq9TSMP0gk3fPppLz9oHgTB/T1+O8jKCEfYtMEyZWHOkIdPawa+QpzkYO88yzP/E5gPMsJ2hjAOAOA15S9husUz22I84LKcTAdolaSM/xXfQkjZiqUsXrKTncVYlhMkMSK2ZjnDMsyWYcSmweBxlvknsNnwCKfnkCrerNenUf7+ZN2HkwdCzl9Ylocvr/m/iNgq3KxzKN2PJ19EfhE6SXAjutCCySK41MHjiL1/qeNq8CHLT7/DbUFXfLK4rTnSvQqiNHm91vyLihfB9wEAa7CVZ/MDAAA=\"")
+	packr.PackJSONBytes("./sql", "20190520090000-friend-metadata.sql", "\"H4sIAAAAAAAA/3SRQW/TQBCF7/4VT7kUSpqU3qAnN3aFwdgodig9oYk9sUfYu2Z3jRsh/jvaNBUNiOvO2/e+mbc8D3COlR72RprW4ery9RuULSOjb9QTwtG12tgAB10qFSvLNUZVs4FrGeFAVctPkzk+s7GiFa4Wl3jhBbPjaPby2lvs9Yie9lDaYbQM14rFTjoGP1Q8OIhCpfuhE1IVYxLXwv0JWHiP+6OH3joSBUKlhz307rkQ5I7QrXPD2+VymqYFHWAX2jTL7lFml2myirMivrhaXB4/bFTH1sLw91EM19juQcPQSUXbjtHRBG1AjWGu4bQHnow4Uc0cVu/cRIY9ZS3WGdmO7uReT3hiTwRagRRmYYGkmOEmLJJi7k3ukvJdvilxF67XYVYmcYF8jVWeRUmZ5FmB/BZhdo8PSRbNweJaNuCHwfgNtIH4S3J9OFvBfIKw048V2oEr2UmFjlQzUsNo9A82SlSDgU0v1jdqQar2Np304sgdnv7ZywctgyC4uMCrXhpDjrEZgjAt4zXK8CaNfevmK9cNI4wirPJ08zFDcossLxF/SYqyQM+OanKE90We3SCKb8NNWuLs56+zgyzbpOn1aUikJ/WfmGidf3qW81fGdfB7ANTrlzgEAwAA\"")
+	packr.PackJSONBytes("./sql", "20190527090000-user-coplay.sql", "\"H4sIAAAAAAAA/4ySzXKbSBSF9zzFKa+kjCw5Xs2MVkS0JlRkcAFK4tlQbbiCW0HdTHczWG8/1bL8I3sqFZbcr88592fxIcAHrHR/MNy0DtdXH/9A0RIS+UPuJcLBtdrYAEduwxUpSzUGVZOBawlhL6uWniozfCVjWStcz68w8cDFqXQxXXqJgx6wlwco7TBYgmvZYscdgR4q6h1YodL7vmOpKsLIroV7MZh7jbuThr53khUkKt0foHevQUh3Ct061/+5WIzjOJfHsHNtmkX3iNnFJl6JJBeX1/Or04Ot6shaGPpnYEM17g+Qfd9xJe87QidHaAPZGKIaTvvAo2HHqpnB6p0bpSGfsmbrDN8P7mxeT/HYngFaQSpchDni/AKfwjzOZ17kW1x8TrcFvoVZFiZFLHKkGVZpEsVFnCY50jXC5A5f4iSagdi1ZEAPvfEdaAP2k6T6OLac6CzCTj+u0PZU8Y4rdFI1g2wIjf6XjGLVoCezZ+s3aiFV7WU63rOT7vjrXV/eaBEEweUlfttzY6QjbPtglYmwECjCTxuBeI0kLSC+x3mR+yMwZaX7Th4wCQDgNotvwuwOX8QdJlYPpqKS6xlqso7V0bnkejo7wus0E/FfyRt46ktAJtYiE8lKPNpYTLieIk0QiY0oBFZhvgoj8T9Kb7x+Xeko9ZwDxxzbbRzh9PnOk+1m8+h5bvMTcC9d1T4L4muYrT6H2eTj9e/TN+TQ19JR6XhPniziG5EX4c1t8TcisQ63mwJKj5OXZ8F0GTwtKE4i8f3Ngp6bKV9Jl1w/+PbPtvdMzvAKnS5/pv5KoJSD0yWrmh7K3Y/yfDaloV3pYfvO9hycLs+vL9KjCqIsvX25vvfWy+C/AQBzmoGZCQUAAA==\"")
+	packr.PackJSONBytes("./sql", "20190603090000-match-snapshot.sql", "\"H4sIAAAAAAAA/4RR0W7iSBB891eUeAnkCBCe7i66kww4F1+IHdlDctxqFQ12Y49iz3hnxuvw9ys7EBZ2pfXTuLuqurprfOngEnNV7bTIcovp5PoPsJwQ8Fdecri1zZU2DjrcUiQkDaWoZUoaNie4FU9yOnSGeCJthJKYjibot4DevtUb3LQSO1Wj5DtIZVEbgs2FwVYUBHpLqLIQEokqq0JwmRAaYXPY44BRq7Hea6iN5UKCI1HVDmr7PRDc7k3n1lZ/jsdN04x4Z3akdDYu3mFmvPTnXhB7V9PRZE9YyYKMgaYvtdCUYrMDr6pCJHxTEAreQGnwTBOlsKo13GhhhcyGMGprG66pdZkKY7XY1PbkXgd7wpwAlASX6Lkx/LiHmRv78bAVefbZXbhieHajyA2Y78UII8zDYOEzPwxihLdwgzXu/WAxBAmbkwa9VbrdQGmI9pKUdmeLiU4sbNV7hKaiRGxFgoLLrOYZIVNfSUshM1SkS2HaRA24TFuZQpTCctuVftirHTR2HOfqCr+VItPcElaVM488l3lg7mzpwb9FEDJ4//kxi1Fym+QvRvLK5Mqi7wDAY+Q/uNEa994afZEOhk5XFik+vtXKXxzenV6wWi6HHUyqlA6dJzea37lR/3r6++AMVqq0LuiXMCuS14PazP/HD9j+Z+Hduqslw+SDgPmdN79Hv6P8/Rcmg3cJY9s77CXWzHP377NJtSH9IlLTdv6Nw2CGs0kXnz5fnHESTdzSixUlgfkPXszch0f2/5EjVdM/ruQMbk7DWahGOosofDyG89NgbpxvAwB4c0MbKwQAAA==\"")
+	packr.PackJSONBytes("./sql", "20190610090000-session-revocation.sql", "\"H4sIAAAAAAACA3VTXW+bMBR951dc5aVpR5KukyZtfXITqqKlUPHRj71EDnHAKtjMNqX8+10DUdN280t84+Pjc+85LM4cOIOlrDvF88LAxfnXH5AUDAL6TCsKpDGFVBpBFrfmGROa7aARO6bAII7UNMOf8cSFe6Y0lwIu5ucwtYDJeDQ5vbQUnWygoh0IaaDRDDm4hj0vGbDXjNUGuIBMVnXJqcgYtNwU/Tsjy9xyPI0ccmsowileqLHaHwOBmlF0YUz9c7Fo23ZOe7FzqfJFOcD0Yu0vvSD2Zih4vJCKkmkNiv1puMJmtx3QGgVldIsyS9qCVEBzxfDMSCu4Vdxwkbug5d60VDFLs+PaKL5tzLt5HeRh18cAnBgVMCEx+PEErkjsx64lefCTmzBN4IFEEQkS34shjGAZBis/8cMAq2sgwRP88oOVCwynhe+w11rZDlAmt5Nku35sMWPvJOzlIEnXLON7nmFrIm9oziCXL0wJ7AhqpiquraMaBe4sTckrbqjp//rUl31o4TjObAZfKp4rahiktbOMPJJ4kJCrtQf+NQRhAt6jHycxoAeWfqPYi8x6Wpg6gOsu8m9JhK15TzDFqKgN37k48GcmcHfqOj1qPLBbSFN/BeOyLwTpeu32KJTjVbXpgO+BYW/dwIMu6GZw0fZguaCpD6VV9Iwj41Vv11DaUeI6yLD7exItb0g0/fb9FFbeNUnXCZycfFAw3N70ZIl/68UJub1Lfn/WGYqyw6GY3h6NHmDmBrFvE9IutAXPCsCo4ZcEpRQ5SheYyD5L2WD0cK2g2ibCRnnQ3hfdJykOfqAHozBN3uMHo46uYeOvEAb/9O4IZgmPg7CSrXBWUXj3FoT/huDS+Qt3V4LXmwQAAA==\"")
+	packr.PackJSONBytes("./sql", "20190617090000-user-erasure-retry.sql", "\"H4sIAAAAAAAC/51SwW7bMAy95yuInLYuTbretpzc2sWMuU4RK+u6S8E4tCPMljyJnpu/H5W6QIpih+0kSHx8fO+Ji7MJnMG17Q5O13uGy4uPn0DtCXL8iS1C1PPeOi+ggMt0ScbTDnqzIwcsuKjDUo6xMoNv5Ly2Bi7nF/AuAKZjafp+GSgOtocWD2AsQ+9JOLSHSjcE9FRSx6ANlLbtGo2mJBg0749zRpZ54HgYOeyWUeAoDZ3cqlMgII+i98zd58ViGIY5HsXOrasXzTPML7L0OsmL5FwEjw0b05D34OhXr52Y3R4AOxFU4lZkNjiAdYC1I6mxDYIHp1mbegbeVjygo0Cz056d3vb8Kq8XeeL6FCCJoYFpVEBaTOEqKtJiFkjuU/VltVFwH63XUa7SpIDVGq5XeZyqdJXL7Qai/AG+pnk8A5K0ZA49dS44EJk6JEm7Y2wF0SsJlX2W5DsqdaVLsWbqHmuC2v4mZ8QRdORa7cOPehG4CzSNbjUjH5/e+AqDFpPJ5PwcPrS6dsgEm24SZSpZg4qusiT8unskh753sj5xLG6yzW0O6Q3kKwXJ97RQhXwfU9uxhzRXECc30SZTcHFE5JssW4YJBTFgxaIAoULZoR288I7ts6M2LEvbGw6Zh7WT0AWJddgdedZNAJn5f4h0xO7wyLolUOltUqjo9k79WL62H9vB/J07Xq/uTsjfEC//tfUluOXkDybbB8fcAwAA\"")
 }
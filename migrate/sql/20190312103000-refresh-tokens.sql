@@ -0,0 +1,31 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_refresh_token (
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    id          UUID        NOT NULL,
+    user_id     UUID        NOT NULL,
+    create_time TIMESTAMPTZ DEFAULT now() NOT NULL,
+    expiry_time TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS user_id_expiry_time_idx ON user_refresh_token (user_id, expiry_time);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_refresh_token;
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS purchase (
+    PRIMARY KEY (transaction_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    transaction_id VARCHAR(512) NOT NULL,
+    user_id        UUID         NOT NULL,
+    store          SMALLINT     NOT NULL DEFAULT 0, -- Apple(0), Google(1), Huawei(2)
+    product_id     VARCHAR(512) NOT NULL,
+    environment    SMALLINT     NOT NULL DEFAULT 0, -- Unknown(0), Sandbox(1), Production(2)
+    raw_response   JSONB        NOT NULL DEFAULT '{}',
+    purchase_time  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    create_time    TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    update_time    TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS purchase_user_id_purchase_time_transaction_id_idx ON purchase (user_id, purchase_time DESC, transaction_id DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS purchase;
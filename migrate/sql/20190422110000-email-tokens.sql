@@ -0,0 +1,34 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_email_token (
+    PRIMARY KEY (token_hash),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    token_hash  BYTEA        NOT NULL,
+    user_id     UUID         NOT NULL,
+    type        SMALLINT     NOT NULL, -- Verify(0), Reset(1)
+    email       VARCHAR(255) NOT NULL,
+    create_time TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    expiry_time TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS user_email_token_user_id_type_idx ON user_email_token (user_id, type);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_email_token;
@@ -0,0 +1,31 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_identity_activity (
+    PRIMARY KEY (user_id, provider, identity_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id        UUID         NOT NULL,
+    provider       VARCHAR(32)  NOT NULL,
+    identity_id    VARCHAR(255) NOT NULL,
+    link_time      TIMESTAMPTZ,            -- NULL if the identity was linked before link times were recorded.
+    last_auth_time TIMESTAMPTZ
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_identity_activity;
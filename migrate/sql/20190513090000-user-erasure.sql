@@ -0,0 +1,31 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_erasure (
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    user_id      UUID        NOT NULL,
+    request_time TIMESTAMPTZ DEFAULT now() NOT NULL,
+    erase_time   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS erase_time_idx ON user_erasure (erase_time);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_erasure;
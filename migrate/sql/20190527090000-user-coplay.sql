@@ -0,0 +1,34 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_coplay (
+    PRIMARY KEY (source_id, destination_id),
+    FOREIGN KEY (source_id)      REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (destination_id) REFERENCES users (id) ON DELETE CASCADE,
+
+    source_id      UUID         NOT NULL,
+    destination_id UUID         NOT NULL,
+    match_id       VARCHAR(128) NOT NULL,
+    update_time    TIMESTAMPTZ  DEFAULT now() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS source_id_update_time_idx ON user_coplay (source_id, update_time);
+CREATE INDEX IF NOT EXISTS user_coplay_auto_index_fk_destination_id_ref_users ON user_coplay (destination_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_coplay;
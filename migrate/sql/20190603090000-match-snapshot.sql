@@ -0,0 +1,32 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS match_snapshot (
+    PRIMARY KEY (id),
+
+    id          UUID         NOT NULL,
+    node        VARCHAR(128) NOT NULL,
+    module      VARCHAR(128) NOT NULL,
+    tick        BIGINT       DEFAULT 0 NOT NULL CHECK (tick >= 0),
+    state       BYTEA        NOT NULL,
+    user_ids    JSONB        DEFAULT '[]' NOT NULL,
+    create_time TIMESTAMPTZ  DEFAULT now() NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS match_snapshot;
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS session_revocation (
+    PRIMARY KEY (user_id, token_id),
+
+    user_id     UUID        NOT NULL,
+    -- Empty if every token issued to the user up to the revoke time is revoked.
+    token_id    VARCHAR(36) DEFAULT '' NOT NULL,
+    revoke_time TIMESTAMPTZ NOT NULL,
+    -- Only set for single token revocations, which are no longer needed once the token has expired.
+    expiry_time TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS expiry_time_idx ON session_revocation (expiry_time);
+
+-- +migrate Down
+DROP TABLE IF EXISTS session_revocation;
@@ -0,0 +1,25 @@
+/*
+ * Copyright 2019 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+-- +migrate Up
+ALTER TABLE user_erasure ADD COLUMN IF NOT EXISTS attempts INT DEFAULT 0 NOT NULL;
+-- Set after a failed erasure attempt, the account is not tried again until then.
+ALTER TABLE user_erasure ADD COLUMN IF NOT EXISTS retry_time TIMESTAMPTZ;
+
+-- +migrate Down
+ALTER TABLE user_erasure DROP COLUMN IF EXISTS retry_time;
+ALTER TABLE user_erasure DROP COLUMN IF EXISTS attempts;
@@ -56,7 +56,7 @@ func (x ChannelJoin_Type) String() string {
 }
 
 func (ChannelJoin_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{2, 0}
+	return fileDescriptor_0163624496220f8c, []int{3, 0}
 }
 
 // The selection of possible error codes.
@@ -79,6 +79,10 @@ const (
 	Error_RUNTIME_FUNCTION_NOT_FOUND Error_Code = 6
 	// The runtime function executed with an error.
 	Error_RUNTIME_FUNCTION_EXCEPTION Error_Code = 7
+	// The party id was not found.
+	Error_PARTY_NOT_FOUND Error_Code = 8
+	// The party join was rejected.
+	Error_PARTY_JOIN_REJECTED Error_Code = 9
 )
 
 var Error_Code_name = map[int32]string{
@@ -90,6 +94,8 @@ var Error_Code_name = map[int32]string{
 	5: "MATCH_JOIN_REJECTED",
 	6: "RUNTIME_FUNCTION_NOT_FOUND",
 	7: "RUNTIME_FUNCTION_EXCEPTION",
+	8: "PARTY_NOT_FOUND",
+	9: "PARTY_JOIN_REJECTED",
 }
 
 var Error_Code_value = map[string]int32{
@@ -101,6 +107,8 @@ var Error_Code_value = map[string]int32{
 	"MATCH_JOIN_REJECTED":        5,
 	"RUNTIME_FUNCTION_NOT_FOUND": 6,
 	"RUNTIME_FUNCTION_EXCEPTION": 7,
+	"PARTY_NOT_FOUND":            8,
+	"PARTY_JOIN_REJECTED":        9,
 }
 
 func (x Error_Code) String() string {
@@ -108,7 +116,7 @@ func (x Error_Code) String() string {
 }
 
 func (Error_Code) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{9, 0}
+	return fileDescriptor_0163624496220f8c, []int{10, 0}
 }
 
 // An envelope for a realtime message.
@@ -145,6 +153,16 @@ type Envelope struct {
 	//	*Envelope_StatusUpdate
 	//	*Envelope_StreamData
 	//	*Envelope_StreamPresenceEvent
+	//	*Envelope_Party
+	//	*Envelope_PartyCreate
+	//	*Envelope_PartyData
+	//	*Envelope_PartyDataSend
+	//	*Envelope_PartyJoin
+	//	*Envelope_PartyLeader
+	//	*Envelope_PartyLeave
+	//	*Envelope_PartyPresenceEvent
+	//	*Envelope_PartyPromote
+	//	*Envelope_Batch
 	Message              isEnvelope_Message `protobuf_oneof:"message"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -307,6 +325,46 @@ type Envelope_StreamPresenceEvent struct {
 	StreamPresenceEvent *StreamPresenceEvent `protobuf:"bytes,31,opt,name=stream_presence_event,json=streamPresenceEvent,proto3,oneof"`
 }
 
+type Envelope_Party struct {
+	Party *Party `protobuf:"bytes,32,opt,name=party,proto3,oneof"`
+}
+
+type Envelope_PartyCreate struct {
+	PartyCreate *PartyCreate `protobuf:"bytes,33,opt,name=party_create,json=partyCreate,proto3,oneof"`
+}
+
+type Envelope_PartyData struct {
+	PartyData *PartyData `protobuf:"bytes,34,opt,name=party_data,json=partyData,proto3,oneof"`
+}
+
+type Envelope_PartyDataSend struct {
+	PartyDataSend *PartyDataSend `protobuf:"bytes,35,opt,name=party_data_send,json=partyDataSend,proto3,oneof"`
+}
+
+type Envelope_PartyJoin struct {
+	PartyJoin *PartyJoin `protobuf:"bytes,36,opt,name=party_join,json=partyJoin,proto3,oneof"`
+}
+
+type Envelope_PartyLeader struct {
+	PartyLeader *PartyLeader `protobuf:"bytes,37,opt,name=party_leader,json=partyLeader,proto3,oneof"`
+}
+
+type Envelope_PartyLeave struct {
+	PartyLeave *PartyLeave `protobuf:"bytes,38,opt,name=party_leave,json=partyLeave,proto3,oneof"`
+}
+
+type Envelope_PartyPresenceEvent struct {
+	PartyPresenceEvent *PartyPresenceEvent `protobuf:"bytes,39,opt,name=party_presence_event,json=partyPresenceEvent,proto3,oneof"`
+}
+
+type Envelope_PartyPromote struct {
+	PartyPromote *PartyPromote `protobuf:"bytes,40,opt,name=party_promote,json=partyPromote,proto3,oneof"`
+}
+
+type Envelope_Batch struct {
+	Batch *EnvelopeBatch `protobuf:"bytes,41,opt,name=batch,proto3,oneof"`
+}
+
 func (*Envelope_Channel) isEnvelope_Message() {}
 
 func (*Envelope_ChannelJoin) isEnvelope_Message() {}
@@ -367,6 +425,26 @@ func (*Envelope_StreamData) isEnvelope_Message() {}
 
 func (*Envelope_StreamPresenceEvent) isEnvelope_Message() {}
 
+func (*Envelope_Party) isEnvelope_Message() {}
+
+func (*Envelope_PartyCreate) isEnvelope_Message() {}
+
+func (*Envelope_PartyData) isEnvelope_Message() {}
+
+func (*Envelope_PartyDataSend) isEnvelope_Message() {}
+
+func (*Envelope_PartyJoin) isEnvelope_Message() {}
+
+func (*Envelope_PartyLeader) isEnvelope_Message() {}
+
+func (*Envelope_PartyLeave) isEnvelope_Message() {}
+
+func (*Envelope_PartyPresenceEvent) isEnvelope_Message() {}
+
+func (*Envelope_PartyPromote) isEnvelope_Message() {}
+
+func (*Envelope_Batch) isEnvelope_Message() {}
+
 func (m *Envelope) GetMessage() isEnvelope_Message {
 	if m != nil {
 		return m.Message
@@ -584,6 +662,76 @@ func (m *Envelope) GetStreamPresenceEvent() *StreamPresenceEvent {
 	return nil
 }
 
+func (m *Envelope) GetParty() *Party {
+	if x, ok := m.GetMessage().(*Envelope_Party); ok {
+		return x.Party
+	}
+	return nil
+}
+
+func (m *Envelope) GetPartyCreate() *PartyCreate {
+	if x, ok := m.GetMessage().(*Envelope_PartyCreate); ok {
+		return x.PartyCreate
+	}
+	return nil
+}
+
+func (m *Envelope) GetPartyData() *PartyData {
+	if x, ok := m.GetMessage().(*Envelope_PartyData); ok {
+		return x.PartyData
+	}
+	return nil
+}
+
+func (m *Envelope) GetPartyDataSend() *PartyDataSend {
+	if x, ok := m.GetMessage().(*Envelope_PartyDataSend); ok {
+		return x.PartyDataSend
+	}
+	return nil
+}
+
+func (m *Envelope) GetPartyJoin() *PartyJoin {
+	if x, ok := m.GetMessage().(*Envelope_PartyJoin); ok {
+		return x.PartyJoin
+	}
+	return nil
+}
+
+func (m *Envelope) GetPartyLeader() *PartyLeader {
+	if x, ok := m.GetMessage().(*Envelope_PartyLeader); ok {
+		return x.PartyLeader
+	}
+	return nil
+}
+
+func (m *Envelope) GetPartyLeave() *PartyLeave {
+	if x, ok := m.GetMessage().(*Envelope_PartyLeave); ok {
+		return x.PartyLeave
+	}
+	return nil
+}
+
+func (m *Envelope) GetPartyPresenceEvent() *PartyPresenceEvent {
+	if x, ok := m.GetMessage().(*Envelope_PartyPresenceEvent); ok {
+		return x.PartyPresenceEvent
+	}
+	return nil
+}
+
+func (m *Envelope) GetPartyPromote() *PartyPromote {
+	if x, ok := m.GetMessage().(*Envelope_PartyPromote); ok {
+		return x.PartyPromote
+	}
+	return nil
+}
+
+func (m *Envelope) GetBatch() *EnvelopeBatch {
+	if x, ok := m.GetMessage().(*Envelope_Batch); ok {
+		return x.Batch
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Envelope) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -617,7 +765,58 @@ func (*Envelope) XXX_OneofWrappers() []interface{} {
 		(*Envelope_StatusUpdate)(nil),
 		(*Envelope_StreamData)(nil),
 		(*Envelope_StreamPresenceEvent)(nil),
+		(*Envelope_Party)(nil),
+		(*Envelope_PartyCreate)(nil),
+		(*Envelope_PartyData)(nil),
+		(*Envelope_PartyDataSend)(nil),
+		(*Envelope_PartyJoin)(nil),
+		(*Envelope_PartyLeader)(nil),
+		(*Envelope_PartyLeave)(nil),
+		(*Envelope_PartyPresenceEvent)(nil),
+		(*Envelope_PartyPromote)(nil),
+		(*Envelope_Batch)(nil),
+	}
+}
+
+// A batch of realtime messages, processed in order as if each was received separately.
+type EnvelopeBatch struct {
+	// The messages in this batch. Batches may not be nested.
+	Envelopes            []*Envelope `protobuf:"bytes,1,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *EnvelopeBatch) Reset()         { *m = EnvelopeBatch{} }
+func (m *EnvelopeBatch) String() string { return proto.CompactTextString(m) }
+func (*EnvelopeBatch) ProtoMessage()    {}
+func (*EnvelopeBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{1}
+}
+
+func (m *EnvelopeBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnvelopeBatch.Unmarshal(m, b)
+}
+func (m *EnvelopeBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnvelopeBatch.Marshal(b, m, deterministic)
+}
+func (m *EnvelopeBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnvelopeBatch.Merge(m, src)
+}
+func (m *EnvelopeBatch) XXX_Size() int {
+	return xxx_messageInfo_EnvelopeBatch.Size(m)
+}
+func (m *EnvelopeBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnvelopeBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnvelopeBatch proto.InternalMessageInfo
+
+func (m *EnvelopeBatch) GetEnvelopes() []*Envelope {
+	if m != nil {
+		return m.Envelopes
 	}
+	return nil
 }
 
 // A realtime chat channel.
@@ -637,7 +836,7 @@ func (m *Channel) Reset()         { *m = Channel{} }
 func (m *Channel) String() string { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()    {}
 func (*Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{1}
+	return fileDescriptor_0163624496220f8c, []int{2}
 }
 
 func (m *Channel) XXX_Unmarshal(b []byte) error {
@@ -698,7 +897,7 @@ func (m *ChannelJoin) Reset()         { *m = ChannelJoin{} }
 func (m *ChannelJoin) String() string { return proto.CompactTextString(m) }
 func (*ChannelJoin) ProtoMessage()    {}
 func (*ChannelJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{2}
+	return fileDescriptor_0163624496220f8c, []int{3}
 }
 
 func (m *ChannelJoin) XXX_Unmarshal(b []byte) error {
@@ -760,7 +959,7 @@ func (m *ChannelLeave) Reset()         { *m = ChannelLeave{} }
 func (m *ChannelLeave) String() string { return proto.CompactTextString(m) }
 func (*ChannelLeave) ProtoMessage()    {}
 func (*ChannelLeave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{3}
+	return fileDescriptor_0163624496220f8c, []int{4}
 }
 
 func (m *ChannelLeave) XXX_Unmarshal(b []byte) error {
@@ -813,7 +1012,7 @@ func (m *ChannelMessageAck) Reset()         { *m = ChannelMessageAck{} }
 func (m *ChannelMessageAck) String() string { return proto.CompactTextString(m) }
 func (*ChannelMessageAck) ProtoMessage()    {}
 func (*ChannelMessageAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{4}
+	return fileDescriptor_0163624496220f8c, []int{5}
 }
 
 func (m *ChannelMessageAck) XXX_Unmarshal(b []byte) error {
@@ -898,7 +1097,7 @@ func (m *ChannelMessageSend) Reset()         { *m = ChannelMessageSend{} }
 func (m *ChannelMessageSend) String() string { return proto.CompactTextString(m) }
 func (*ChannelMessageSend) ProtoMessage()    {}
 func (*ChannelMessageSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{5}
+	return fileDescriptor_0163624496220f8c, []int{6}
 }
 
 func (m *ChannelMessageSend) XXX_Unmarshal(b []byte) error {
@@ -950,7 +1149,7 @@ func (m *ChannelMessageUpdate) Reset()         { *m = ChannelMessageUpdate{} }
 func (m *ChannelMessageUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelMessageUpdate) ProtoMessage()    {}
 func (*ChannelMessageUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{6}
+	return fileDescriptor_0163624496220f8c, []int{7}
 }
 
 func (m *ChannelMessageUpdate) XXX_Unmarshal(b []byte) error {
@@ -1007,7 +1206,7 @@ func (m *ChannelMessageRemove) Reset()         { *m = ChannelMessageRemove{} }
 func (m *ChannelMessageRemove) String() string { return proto.CompactTextString(m) }
 func (*ChannelMessageRemove) ProtoMessage()    {}
 func (*ChannelMessageRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{7}
+	return fileDescriptor_0163624496220f8c, []int{8}
 }
 
 func (m *ChannelMessageRemove) XXX_Unmarshal(b []byte) error {
@@ -1059,7 +1258,7 @@ func (m *ChannelPresenceEvent) Reset()         { *m = ChannelPresenceEvent{} }
 func (m *ChannelPresenceEvent) String() string { return proto.CompactTextString(m) }
 func (*ChannelPresenceEvent) ProtoMessage()    {}
 func (*ChannelPresenceEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{8}
+	return fileDescriptor_0163624496220f8c, []int{9}
 }
 
 func (m *ChannelPresenceEvent) XXX_Unmarshal(b []byte) error {
@@ -1118,7 +1317,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{9}
+	return fileDescriptor_0163624496220f8c, []int{10}
 }
 
 func (m *Error) XXX_Unmarshal(b []byte) error {
@@ -1173,17 +1372,19 @@ type Match struct {
 	// The users currently in the match.
 	Presences []*UserPresence `protobuf:"bytes,5,rep,name=presences,proto3" json:"presences,omitempty"`
 	// A reference to the current user's presence in the match.
-	Self                 *UserPresence `protobuf:"bytes,6,opt,name=self,proto3" json:"self,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Self *UserPresence `protobuf:"bytes,6,opt,name=self,proto3" json:"self,omitempty"`
+	// A token the user can join with from a new session to reclaim their place in an authoritative match after a disconnect.
+	ReconnectToken       string   `protobuf:"bytes,7,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Match) Reset()         { *m = Match{} }
 func (m *Match) String() string { return proto.CompactTextString(m) }
 func (*Match) ProtoMessage()    {}
 func (*Match) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{10}
+	return fileDescriptor_0163624496220f8c, []int{11}
 }
 
 func (m *Match) XXX_Unmarshal(b []byte) error {
@@ -1246,6 +1447,13 @@ func (m *Match) GetSelf() *UserPresence {
 	return nil
 }
 
+func (m *Match) GetReconnectToken() string {
+	if m != nil {
+		return m.ReconnectToken
+	}
+	return ""
+}
+
 // Create a new realtime match.
 type MatchCreate struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1257,7 +1465,7 @@ func (m *MatchCreate) Reset()         { *m = MatchCreate{} }
 func (m *MatchCreate) String() string { return proto.CompactTextString(m) }
 func (*MatchCreate) ProtoMessage()    {}
 func (*MatchCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{11}
+	return fileDescriptor_0163624496220f8c, []int{12}
 }
 
 func (m *MatchCreate) XXX_Unmarshal(b []byte) error {
@@ -1287,7 +1495,9 @@ type MatchData struct {
 	// Op code value.
 	OpCode int64 `protobuf:"varint,3,opt,name=op_code,json=opCode,proto3" json:"op_code,omitempty"`
 	// Data payload, if any.
-	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// True if this data was delivered reliably, false otherwise.
+	Reliable             bool     `protobuf:"varint,5,opt,name=reliable,proto3" json:"reliable,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1297,7 +1507,7 @@ func (m *MatchData) Reset()         { *m = MatchData{} }
 func (m *MatchData) String() string { return proto.CompactTextString(m) }
 func (*MatchData) ProtoMessage()    {}
 func (*MatchData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{12}
+	return fileDescriptor_0163624496220f8c, []int{13}
 }
 
 func (m *MatchData) XXX_Unmarshal(b []byte) error {
@@ -1346,6 +1556,13 @@ func (m *MatchData) GetData() []byte {
 	return nil
 }
 
+func (m *MatchData) GetReliable() bool {
+	if m != nil {
+		return m.Reliable
+	}
+	return false
+}
+
 // Send realtime match data to the server.
 type MatchDataSend struct {
 	// The match unique ID.
@@ -1355,17 +1572,19 @@ type MatchDataSend struct {
 	// Data payload, if any.
 	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	// List of presences in the match to deliver to, if filtering is required. Otherwise deliver to everyone in the match.
-	Presences            []*UserPresence `protobuf:"bytes,4,rep,name=presences,proto3" json:"presences,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Presences []*UserPresence `protobuf:"bytes,4,rep,name=presences,proto3" json:"presences,omitempty"`
+	// True if the data should be sent reliably, false otherwise. Only affects transports that support unreliable delivery.
+	Reliable             bool     `protobuf:"varint,5,opt,name=reliable,proto3" json:"reliable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MatchDataSend) Reset()         { *m = MatchDataSend{} }
 func (m *MatchDataSend) String() string { return proto.CompactTextString(m) }
 func (*MatchDataSend) ProtoMessage()    {}
 func (*MatchDataSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{13}
+	return fileDescriptor_0163624496220f8c, []int{14}
 }
 
 func (m *MatchDataSend) XXX_Unmarshal(b []byte) error {
@@ -1414,24 +1633,34 @@ func (m *MatchDataSend) GetPresences() []*UserPresence {
 	return nil
 }
 
+func (m *MatchDataSend) GetReliable() bool {
+	if m != nil {
+		return m.Reliable
+	}
+	return false
+}
+
 // Join an existing realtime match.
 type MatchJoin struct {
 	// Types that are valid to be assigned to Id:
 	//	*MatchJoin_MatchId
 	//	*MatchJoin_Token
+	//	*MatchJoin_ReconnectToken
 	Id isMatchJoin_Id `protobuf_oneof:"id"`
 	// An optional set of key-value metadata pairs to be passed to the match handler, if any.
-	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Join as a spectator, receiving match broadcasts without taking part. Only valid for authoritative matches.
+	Spectator            bool     `protobuf:"varint,4,opt,name=spectator,proto3" json:"spectator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MatchJoin) Reset()         { *m = MatchJoin{} }
 func (m *MatchJoin) String() string { return proto.CompactTextString(m) }
 func (*MatchJoin) ProtoMessage()    {}
 func (*MatchJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{14}
+	return fileDescriptor_0163624496220f8c, []int{15}
 }
 
 func (m *MatchJoin) XXX_Unmarshal(b []byte) error {
@@ -1464,10 +1693,16 @@ type MatchJoin_Token struct {
 	Token string `protobuf:"bytes,2,opt,name=token,proto3,oneof"`
 }
 
+type MatchJoin_ReconnectToken struct {
+	ReconnectToken string `protobuf:"bytes,5,opt,name=reconnect_token,json=reconnectToken,proto3,oneof"`
+}
+
 func (*MatchJoin_MatchId) isMatchJoin_Id() {}
 
 func (*MatchJoin_Token) isMatchJoin_Id() {}
 
+func (*MatchJoin_ReconnectToken) isMatchJoin_Id() {}
+
 func (m *MatchJoin) GetId() isMatchJoin_Id {
 	if m != nil {
 		return m.Id
@@ -1489,6 +1724,13 @@ func (m *MatchJoin) GetToken() string {
 	return ""
 }
 
+func (m *MatchJoin) GetReconnectToken() string {
+	if x, ok := m.GetId().(*MatchJoin_ReconnectToken); ok {
+		return x.ReconnectToken
+	}
+	return ""
+}
+
 func (m *MatchJoin) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
@@ -1496,11 +1738,19 @@ func (m *MatchJoin) GetMetadata() map[string]string {
 	return nil
 }
 
+func (m *MatchJoin) GetSpectator() bool {
+	if m != nil {
+		return m.Spectator
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MatchJoin) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MatchJoin_MatchId)(nil),
 		(*MatchJoin_Token)(nil),
+		(*MatchJoin_ReconnectToken)(nil),
 	}
 }
 
@@ -1517,7 +1767,7 @@ func (m *MatchLeave) Reset()         { *m = MatchLeave{} }
 func (m *MatchLeave) String() string { return proto.CompactTextString(m) }
 func (*MatchLeave) ProtoMessage()    {}
 func (*MatchLeave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{15}
+	return fileDescriptor_0163624496220f8c, []int{16}
 }
 
 func (m *MatchLeave) XXX_Unmarshal(b []byte) error {
@@ -1562,7 +1812,7 @@ func (m *MatchPresenceEvent) Reset()         { *m = MatchPresenceEvent{} }
 func (m *MatchPresenceEvent) String() string { return proto.CompactTextString(m) }
 func (*MatchPresenceEvent) ProtoMessage()    {}
 func (*MatchPresenceEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{16}
+	return fileDescriptor_0163624496220f8c, []int{17}
 }
 
 func (m *MatchPresenceEvent) XXX_Unmarshal(b []byte) error {
@@ -1615,17 +1865,23 @@ type MatchmakerAdd struct {
 	// String properties.
 	StringProperties map[string]string `protobuf:"bytes,4,rep,name=string_properties,json=stringProperties,proto3" json:"string_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Numeric properties.
-	NumericProperties    map[string]float64 `protobuf:"bytes,5,rep,name=numeric_properties,json=numericProperties,proto3" json:"numeric_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	NumericProperties map[string]float64 `protobuf:"bytes,5,rep,name=numeric_properties,json=numericProperties,proto3" json:"numeric_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Numeric property ranges that other users must fall within, keyed by property name. Applied in addition to the query.
+	NumericRanges map[string]*MatchmakerRange `protobuf:"bytes,6,rep,name=numeric_ranges,json=numericRanges,proto3" json:"numeric_ranges,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Rules to progressively widen this ticket's criteria the longer it waits, in any order.
+	Relaxations []*MatchmakerRelaxation `protobuf:"bytes,7,rep,name=relaxations,proto3" json:"relaxations,omitempty"`
+	// Optional party to matchmake together, only the party leader may submit a ticket for a party.
+	PartyId              string   `protobuf:"bytes,8,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MatchmakerAdd) Reset()         { *m = MatchmakerAdd{} }
 func (m *MatchmakerAdd) String() string { return proto.CompactTextString(m) }
 func (*MatchmakerAdd) ProtoMessage()    {}
 func (*MatchmakerAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{17}
+	return fileDescriptor_0163624496220f8c, []int{18}
 }
 
 func (m *MatchmakerAdd) XXX_Unmarshal(b []byte) error {
@@ -1681,6 +1937,27 @@ func (m *MatchmakerAdd) GetNumericProperties() map[string]float64 {
 	return nil
 }
 
+func (m *MatchmakerAdd) GetNumericRanges() map[string]*MatchmakerRange {
+	if m != nil {
+		return m.NumericRanges
+	}
+	return nil
+}
+
+func (m *MatchmakerAdd) GetRelaxations() []*MatchmakerRelaxation {
+	if m != nil {
+		return m.Relaxations
+	}
+	return nil
+}
+
+func (m *MatchmakerAdd) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
 // A successful matchmaking result.
 type MatchmakerMatched struct {
 	// The matchmaking ticket that has completed.
@@ -1704,7 +1981,7 @@ func (m *MatchmakerMatched) Reset()         { *m = MatchmakerMatched{} }
 func (m *MatchmakerMatched) String() string { return proto.CompactTextString(m) }
 func (*MatchmakerMatched) ProtoMessage()    {}
 func (*MatchmakerMatched) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{18}
+	return fileDescriptor_0163624496220f8c, []int{19}
 }
 
 func (m *MatchmakerMatched) XXX_Unmarshal(b []byte) error {
@@ -1797,17 +2074,19 @@ type MatchmakerMatched_MatchmakerUser struct {
 	// String properties.
 	StringProperties map[string]string `protobuf:"bytes,5,rep,name=string_properties,json=stringProperties,proto3" json:"string_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Numeric properties.
-	NumericProperties    map[string]float64 `protobuf:"bytes,6,rep,name=numeric_properties,json=numericProperties,proto3" json:"numeric_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	NumericProperties map[string]float64 `protobuf:"bytes,6,rep,name=numeric_properties,json=numericProperties,proto3" json:"numeric_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Party the user matchmade together with, if any.
+	PartyId              string   `protobuf:"bytes,7,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MatchmakerMatched_MatchmakerUser) Reset()         { *m = MatchmakerMatched_MatchmakerUser{} }
 func (m *MatchmakerMatched_MatchmakerUser) String() string { return proto.CompactTextString(m) }
 func (*MatchmakerMatched_MatchmakerUser) ProtoMessage()    {}
 func (*MatchmakerMatched_MatchmakerUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{18, 0}
+	return fileDescriptor_0163624496220f8c, []int{19, 0}
 }
 
 func (m *MatchmakerMatched_MatchmakerUser) XXX_Unmarshal(b []byte) error {
@@ -1849,86 +2128,211 @@ func (m *MatchmakerMatched_MatchmakerUser) GetNumericProperties() map[string]flo
 	return nil
 }
 
-// Cancel an existing ongoing matchmaking process.
-type MatchmakerRemove struct {
-	// The ticket to cancel.
-	Ticket               string   `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+func (m *MatchmakerMatched_MatchmakerUser) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+// An inclusive numeric range used to filter matchmaking candidates.
+type MatchmakerRange struct {
+	// Minimum value, inclusive.
+	Min float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Maximum value, inclusive.
+	Max                  float64  `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MatchmakerRemove) Reset()         { *m = MatchmakerRemove{} }
-func (m *MatchmakerRemove) String() string { return proto.CompactTextString(m) }
-func (*MatchmakerRemove) ProtoMessage()    {}
-func (*MatchmakerRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{19}
+func (m *MatchmakerRange) Reset()         { *m = MatchmakerRange{} }
+func (m *MatchmakerRange) String() string { return proto.CompactTextString(m) }
+func (*MatchmakerRange) ProtoMessage()    {}
+func (*MatchmakerRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{20}
 }
 
-func (m *MatchmakerRemove) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MatchmakerRemove.Unmarshal(m, b)
+func (m *MatchmakerRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchmakerRange.Unmarshal(m, b)
 }
-func (m *MatchmakerRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MatchmakerRemove.Marshal(b, m, deterministic)
+func (m *MatchmakerRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchmakerRange.Marshal(b, m, deterministic)
 }
-func (m *MatchmakerRemove) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MatchmakerRemove.Merge(m, src)
+func (m *MatchmakerRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchmakerRange.Merge(m, src)
 }
-func (m *MatchmakerRemove) XXX_Size() int {
-	return xxx_messageInfo_MatchmakerRemove.Size(m)
+func (m *MatchmakerRange) XXX_Size() int {
+	return xxx_messageInfo_MatchmakerRange.Size(m)
 }
-func (m *MatchmakerRemove) XXX_DiscardUnknown() {
-	xxx_messageInfo_MatchmakerRemove.DiscardUnknown(m)
+func (m *MatchmakerRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchmakerRange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MatchmakerRemove proto.InternalMessageInfo
+var xxx_messageInfo_MatchmakerRange proto.InternalMessageInfo
 
-func (m *MatchmakerRemove) GetTicket() string {
+func (m *MatchmakerRange) GetMin() float64 {
 	if m != nil {
-		return m.Ticket
+		return m.Min
 	}
-	return ""
+	return 0
 }
 
-// A ticket representing a new matchmaking process.
-type MatchmakerTicket struct {
-	// The ticket that can be used to cancel matchmaking.
-	Ticket               string   `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MatchmakerRange) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
 }
 
-func (m *MatchmakerTicket) Reset()         { *m = MatchmakerTicket{} }
-func (m *MatchmakerTicket) String() string { return proto.CompactTextString(m) }
-func (*MatchmakerTicket) ProtoMessage()    {}
-func (*MatchmakerTicket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{20}
+// A step used to relax matchmaking criteria once a ticket has waited long enough.
+type MatchmakerRelaxation struct {
+	// Time in seconds the ticket must have waited before this step applies.
+	WaitSec int32 `protobuf:"varint,1,opt,name=wait_sec,json=waitSec,proto3" json:"wait_sec,omitempty"`
+	// A lower minimum count to use from this step onwards, if set.
+	MinCount *wrappers.Int32Value `protobuf:"bytes,2,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// A replacement query to use from this step onwards, if set.
+	Query *wrappers.StringValue `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// Amount to widen numeric ranges by in both directions, keyed by property name. Widening accumulates across steps.
+	WidenRanges          map[string]float64 `protobuf:"bytes,4,rep,name=widen_ranges,json=widenRanges,proto3" json:"widen_ranges,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *MatchmakerTicket) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MatchmakerTicket.Unmarshal(m, b)
+func (m *MatchmakerRelaxation) Reset()         { *m = MatchmakerRelaxation{} }
+func (m *MatchmakerRelaxation) String() string { return proto.CompactTextString(m) }
+func (*MatchmakerRelaxation) ProtoMessage()    {}
+func (*MatchmakerRelaxation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{21}
 }
-func (m *MatchmakerTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MatchmakerTicket.Marshal(b, m, deterministic)
+
+func (m *MatchmakerRelaxation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchmakerRelaxation.Unmarshal(m, b)
 }
-func (m *MatchmakerTicket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MatchmakerTicket.Merge(m, src)
+func (m *MatchmakerRelaxation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchmakerRelaxation.Marshal(b, m, deterministic)
 }
-func (m *MatchmakerTicket) XXX_Size() int {
-	return xxx_messageInfo_MatchmakerTicket.Size(m)
+func (m *MatchmakerRelaxation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchmakerRelaxation.Merge(m, src)
 }
-func (m *MatchmakerTicket) XXX_DiscardUnknown() {
-	xxx_messageInfo_MatchmakerTicket.DiscardUnknown(m)
+func (m *MatchmakerRelaxation) XXX_Size() int {
+	return xxx_messageInfo_MatchmakerRelaxation.Size(m)
+}
+func (m *MatchmakerRelaxation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchmakerRelaxation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MatchmakerTicket proto.InternalMessageInfo
+var xxx_messageInfo_MatchmakerRelaxation proto.InternalMessageInfo
 
-func (m *MatchmakerTicket) GetTicket() string {
+func (m *MatchmakerRelaxation) GetWaitSec() int32 {
 	if m != nil {
-		return m.Ticket
+		return m.WaitSec
 	}
-	return ""
+	return 0
+}
+
+func (m *MatchmakerRelaxation) GetMinCount() *wrappers.Int32Value {
+	if m != nil {
+		return m.MinCount
+	}
+	return nil
+}
+
+func (m *MatchmakerRelaxation) GetQuery() *wrappers.StringValue {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *MatchmakerRelaxation) GetWidenRanges() map[string]float64 {
+	if m != nil {
+		return m.WidenRanges
+	}
+	return nil
+}
+
+// Cancel an existing ongoing matchmaking process.
+type MatchmakerRemove struct {
+	// The ticket to cancel.
+	Ticket               string   `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatchmakerRemove) Reset()         { *m = MatchmakerRemove{} }
+func (m *MatchmakerRemove) String() string { return proto.CompactTextString(m) }
+func (*MatchmakerRemove) ProtoMessage()    {}
+func (*MatchmakerRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{22}
+}
+
+func (m *MatchmakerRemove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchmakerRemove.Unmarshal(m, b)
+}
+func (m *MatchmakerRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchmakerRemove.Marshal(b, m, deterministic)
+}
+func (m *MatchmakerRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchmakerRemove.Merge(m, src)
+}
+func (m *MatchmakerRemove) XXX_Size() int {
+	return xxx_messageInfo_MatchmakerRemove.Size(m)
+}
+func (m *MatchmakerRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchmakerRemove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchmakerRemove proto.InternalMessageInfo
+
+func (m *MatchmakerRemove) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+// A ticket representing a new matchmaking process.
+type MatchmakerTicket struct {
+	// The ticket that can be used to cancel matchmaking.
+	Ticket               string   `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatchmakerTicket) Reset()         { *m = MatchmakerTicket{} }
+func (m *MatchmakerTicket) String() string { return proto.CompactTextString(m) }
+func (*MatchmakerTicket) ProtoMessage()    {}
+func (*MatchmakerTicket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{23}
+}
+
+func (m *MatchmakerTicket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchmakerTicket.Unmarshal(m, b)
+}
+func (m *MatchmakerTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchmakerTicket.Marshal(b, m, deterministic)
+}
+func (m *MatchmakerTicket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchmakerTicket.Merge(m, src)
+}
+func (m *MatchmakerTicket) XXX_Size() int {
+	return xxx_messageInfo_MatchmakerTicket.Size(m)
+}
+func (m *MatchmakerTicket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchmakerTicket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchmakerTicket proto.InternalMessageInfo
+
+func (m *MatchmakerTicket) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
 }
 
 // A collection of zero or more notifications.
@@ -1944,7 +2348,7 @@ func (m *Notifications) Reset()         { *m = Notifications{} }
 func (m *Notifications) String() string { return proto.CompactTextString(m) }
 func (*Notifications) ProtoMessage()    {}
 func (*Notifications) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{21}
+	return fileDescriptor_0163624496220f8c, []int{24}
 }
 
 func (m *Notifications) XXX_Unmarshal(b []byte) error {
@@ -1972,6 +2376,492 @@ func (m *Notifications) GetNotifications() []*api.Notification {
 	return nil
 }
 
+// Incoming information about a party.
+type Party struct {
+	// The party unique ID.
+	PartyId string `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// Maximum number of party members, or 0 if unlimited.
+	MaxSize int32 `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// The current party leader.
+	Leader *UserPresence `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	// All current party members.
+	Presences []*UserPresence `protobuf:"bytes,4,rep,name=presences,proto3" json:"presences,omitempty"`
+	// A reference to the current user's presence in the party.
+	Self                 *UserPresence `protobuf:"bytes,5,opt,name=self,proto3" json:"self,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Party) Reset()         { *m = Party{} }
+func (m *Party) String() string { return proto.CompactTextString(m) }
+func (*Party) ProtoMessage()    {}
+func (*Party) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{25}
+}
+
+func (m *Party) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Party.Unmarshal(m, b)
+}
+func (m *Party) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Party.Marshal(b, m, deterministic)
+}
+func (m *Party) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Party.Merge(m, src)
+}
+func (m *Party) XXX_Size() int {
+	return xxx_messageInfo_Party.Size(m)
+}
+func (m *Party) XXX_DiscardUnknown() {
+	xxx_messageInfo_Party.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Party proto.InternalMessageInfo
+
+func (m *Party) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *Party) GetMaxSize() int32 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *Party) GetLeader() *UserPresence {
+	if m != nil {
+		return m.Leader
+	}
+	return nil
+}
+
+func (m *Party) GetPresences() []*UserPresence {
+	if m != nil {
+		return m.Presences
+	}
+	return nil
+}
+
+func (m *Party) GetSelf() *UserPresence {
+	if m != nil {
+		return m.Self
+	}
+	return nil
+}
+
+// Create a party.
+type PartyCreate struct {
+	// Maximum number of party members, or 0 if unlimited.
+	MaxSize              int32    `protobuf:"varint,1,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartyCreate) Reset()         { *m = PartyCreate{} }
+func (m *PartyCreate) String() string { return proto.CompactTextString(m) }
+func (*PartyCreate) ProtoMessage()    {}
+func (*PartyCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{26}
+}
+
+func (m *PartyCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartyCreate.Unmarshal(m, b)
+}
+func (m *PartyCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartyCreate.Marshal(b, m, deterministic)
+}
+func (m *PartyCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartyCreate.Merge(m, src)
+}
+func (m *PartyCreate) XXX_Size() int {
+	return xxx_messageInfo_PartyCreate.Size(m)
+}
+func (m *PartyCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartyCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartyCreate proto.InternalMessageInfo
+
+func (m *PartyCreate) GetMaxSize() int32 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+// Incoming party data delivered from the server.
+type PartyData struct {
+	// The party unique ID.
+	PartyId string `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// A reference to the user presence that sent this data.
+	Presence *UserPresence `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	// Op code value.
+	OpCode int64 `protobuf:"varint,3,opt,name=op_code,json=opCode,proto3" json:"op_code,omitempty"`
+	// Data payload, if any.
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartyData) Reset()         { *m = PartyData{} }
+func (m *PartyData) String() string { return proto.CompactTextString(m) }
+func (*PartyData) ProtoMessage()    {}
+func (*PartyData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{27}
+}
+
+func (m *PartyData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartyData.Unmarshal(m, b)
+}
+func (m *PartyData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartyData.Marshal(b, m, deterministic)
+}
+func (m *PartyData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartyData.Merge(m, src)
+}
+func (m *PartyData) XXX_Size() int {
+	return xxx_messageInfo_PartyData.Size(m)
+}
+func (m *PartyData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartyData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartyData proto.InternalMessageInfo
+
+func (m *PartyData) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *PartyData) GetPresence() *UserPresence {
+	if m != nil {
+		return m.Presence
+	}
+	return nil
+}
+
+func (m *PartyData) GetOpCode() int64 {
+	if m != nil {
+		return m.OpCode
+	}
+	return 0
+}
+
+func (m *PartyData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Send data to a party.
+type PartyDataSend struct {
+	// The party unique ID.
+	PartyId string `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// Op code value.
+	OpCode int64 `protobuf:"varint,2,opt,name=op_code,json=opCode,proto3" json:"op_code,omitempty"`
+	// Data payload, if any.
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartyDataSend) Reset()         { *m = PartyDataSend{} }
+func (m *PartyDataSend) String() string { return proto.CompactTextString(m) }
+func (*PartyDataSend) ProtoMessage()    {}
+func (*PartyDataSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{28}
+}
+
+func (m *PartyDataSend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartyDataSend.Unmarshal(m, b)
+}
+func (m *PartyDataSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartyDataSend.Marshal(b, m, deterministic)
+}
+func (m *PartyDataSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartyDataSend.Merge(m, src)
+}
+func (m *PartyDataSend) XXX_Size() int {
+	return xxx_messageInfo_PartyDataSend.Size(m)
+}
+func (m *PartyDataSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartyDataSend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartyDataSend proto.InternalMessageInfo
+
+func (m *PartyDataSend) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *PartyDataSend) GetOpCode() int64 {
+	if m != nil {
+		return m.OpCode
+	}
+	return 0
+}
+
+func (m *PartyDataSend) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Join a party.
+type PartyJoin struct {
+	// The party unique ID.
+	PartyId              string   `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartyJoin) Reset()         { *m = PartyJoin{} }
+func (m *PartyJoin) String() string { return proto.CompactTextString(m) }
+func (*PartyJoin) ProtoMessage()    {}
+func (*PartyJoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{29}
+}
+
+func (m *PartyJoin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartyJoin.Unmarshal(m, b)
+}
+func (m *PartyJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartyJoin.Marshal(b, m, deterministic)
+}
+func (m *PartyJoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartyJoin.Merge(m, src)
+}
+func (m *PartyJoin) XXX_Size() int {
+	return xxx_messageInfo_PartyJoin.Size(m)
+}
+func (m *PartyJoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartyJoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartyJoin proto.InternalMessageInfo
+
+func (m *PartyJoin) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+// Announcement of a new party leader.
+type PartyLeader struct {
+	// The party unique ID.
+	PartyId string `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// The presence of the new party leader.
+	Presence             *UserPresence `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PartyLeader) Reset()         { *m = PartyLeader{} }
+func (m *PartyLeader) String() string { return proto.CompactTextString(m) }
+func (*PartyLeader) ProtoMessage()    {}
+func (*PartyLeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{30}
+}
+
+func (m *PartyLeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartyLeader.Unmarshal(m, b)
+}
+func (m *PartyLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartyLeader.Marshal(b, m, deterministic)
+}
+func (m *PartyLeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartyLeader.Merge(m, src)
+}
+func (m *PartyLeader) XXX_Size() int {
+	return xxx_messageInfo_PartyLeader.Size(m)
+}
+func (m *PartyLeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartyLeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartyLeader proto.InternalMessageInfo
+
+func (m *PartyLeader) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *PartyLeader) GetPresence() *UserPresence {
+	if m != nil {
+		return m.Presence
+	}
+	return nil
+}
+
+// Leave a party.
+type PartyLeave struct {
+	// The party unique ID.
+	PartyId              string   `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartyLeave) Reset()         { *m = PartyLeave{} }
+func (m *PartyLeave) String() string { return proto.CompactTextString(m) }
+func (*PartyLeave) ProtoMessage()    {}
+func (*PartyLeave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{31}
+}
+
+func (m *PartyLeave) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartyLeave.Unmarshal(m, b)
+}
+func (m *PartyLeave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartyLeave.Marshal(b, m, deterministic)
+}
+func (m *PartyLeave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartyLeave.Merge(m, src)
+}
+func (m *PartyLeave) XXX_Size() int {
+	return xxx_messageInfo_PartyLeave.Size(m)
+}
+func (m *PartyLeave) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartyLeave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartyLeave proto.InternalMessageInfo
+
+func (m *PartyLeave) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+// A set of joins and leaves on a particular party.
+type PartyPresenceEvent struct {
+	// The party unique ID.
+	PartyId string `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// User presences that have just joined the party.
+	Joins []*UserPresence `protobuf:"bytes,2,rep,name=joins,proto3" json:"joins,omitempty"`
+	// User presences that have just left the party.
+	Leaves               []*UserPresence `protobuf:"bytes,3,rep,name=leaves,proto3" json:"leaves,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PartyPresenceEvent) Reset()         { *m = PartyPresenceEvent{} }
+func (m *PartyPresenceEvent) String() string { return proto.CompactTextString(m) }
+func (*PartyPresenceEvent) ProtoMessage()    {}
+func (*PartyPresenceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{32}
+}
+
+func (m *PartyPresenceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartyPresenceEvent.Unmarshal(m, b)
+}
+func (m *PartyPresenceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartyPresenceEvent.Marshal(b, m, deterministic)
+}
+func (m *PartyPresenceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartyPresenceEvent.Merge(m, src)
+}
+func (m *PartyPresenceEvent) XXX_Size() int {
+	return xxx_messageInfo_PartyPresenceEvent.Size(m)
+}
+func (m *PartyPresenceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartyPresenceEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartyPresenceEvent proto.InternalMessageInfo
+
+func (m *PartyPresenceEvent) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *PartyPresenceEvent) GetJoins() []*UserPresence {
+	if m != nil {
+		return m.Joins
+	}
+	return nil
+}
+
+func (m *PartyPresenceEvent) GetLeaves() []*UserPresence {
+	if m != nil {
+		return m.Leaves
+	}
+	return nil
+}
+
+// Promote a party member to be the new party leader.
+type PartyPromote struct {
+	// The party unique ID.
+	PartyId string `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// The presence of the member to promote, must be a current party member.
+	Presence             *UserPresence `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PartyPromote) Reset()         { *m = PartyPromote{} }
+func (m *PartyPromote) String() string { return proto.CompactTextString(m) }
+func (*PartyPromote) ProtoMessage()    {}
+func (*PartyPromote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0163624496220f8c, []int{33}
+}
+
+func (m *PartyPromote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartyPromote.Unmarshal(m, b)
+}
+func (m *PartyPromote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartyPromote.Marshal(b, m, deterministic)
+}
+func (m *PartyPromote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartyPromote.Merge(m, src)
+}
+func (m *PartyPromote) XXX_Size() int {
+	return xxx_messageInfo_PartyPromote.Size(m)
+}
+func (m *PartyPromote) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartyPromote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartyPromote proto.InternalMessageInfo
+
+func (m *PartyPromote) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *PartyPromote) GetPresence() *UserPresence {
+	if m != nil {
+		return m.Presence
+	}
+	return nil
+}
+
 // A snapshot of statuses for some set of users.
 type Status struct {
 	// User statuses.
@@ -1985,7 +2875,7 @@ func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{22}
+	return fileDescriptor_0163624496220f8c, []int{34}
 }
 
 func (m *Status) XXX_Unmarshal(b []byte) error {
@@ -2026,7 +2916,7 @@ func (m *StatusFollow) Reset()         { *m = StatusFollow{} }
 func (m *StatusFollow) String() string { return proto.CompactTextString(m) }
 func (*StatusFollow) ProtoMessage()    {}
 func (*StatusFollow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{23}
+	return fileDescriptor_0163624496220f8c, []int{35}
 }
 
 func (m *StatusFollow) XXX_Unmarshal(b []byte) error {
@@ -2069,7 +2959,7 @@ func (m *StatusPresenceEvent) Reset()         { *m = StatusPresenceEvent{} }
 func (m *StatusPresenceEvent) String() string { return proto.CompactTextString(m) }
 func (*StatusPresenceEvent) ProtoMessage()    {}
 func (*StatusPresenceEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{24}
+	return fileDescriptor_0163624496220f8c, []int{36}
 }
 
 func (m *StatusPresenceEvent) XXX_Unmarshal(b []byte) error {
@@ -2117,7 +3007,7 @@ func (m *StatusUnfollow) Reset()         { *m = StatusUnfollow{} }
 func (m *StatusUnfollow) String() string { return proto.CompactTextString(m) }
 func (*StatusUnfollow) ProtoMessage()    {}
 func (*StatusUnfollow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{25}
+	return fileDescriptor_0163624496220f8c, []int{37}
 }
 
 func (m *StatusUnfollow) XXX_Unmarshal(b []byte) error {
@@ -2158,7 +3048,7 @@ func (m *StatusUpdate) Reset()         { *m = StatusUpdate{} }
 func (m *StatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*StatusUpdate) ProtoMessage()    {}
 func (*StatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{26}
+	return fileDescriptor_0163624496220f8c, []int{38}
 }
 
 func (m *StatusUpdate) XXX_Unmarshal(b []byte) error {
@@ -2205,7 +3095,7 @@ func (m *Stream) Reset()         { *m = Stream{} }
 func (m *Stream) String() string { return proto.CompactTextString(m) }
 func (*Stream) ProtoMessage()    {}
 func (*Stream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{27}
+	return fileDescriptor_0163624496220f8c, []int{39}
 }
 
 func (m *Stream) XXX_Unmarshal(b []byte) error {
@@ -2271,7 +3161,7 @@ func (m *StreamData) Reset()         { *m = StreamData{} }
 func (m *StreamData) String() string { return proto.CompactTextString(m) }
 func (*StreamData) ProtoMessage()    {}
 func (*StreamData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{28}
+	return fileDescriptor_0163624496220f8c, []int{40}
 }
 
 func (m *StreamData) XXX_Unmarshal(b []byte) error {
@@ -2330,7 +3220,7 @@ func (m *StreamPresenceEvent) Reset()         { *m = StreamPresenceEvent{} }
 func (m *StreamPresenceEvent) String() string { return proto.CompactTextString(m) }
 func (*StreamPresenceEvent) ProtoMessage()    {}
 func (*StreamPresenceEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{29}
+	return fileDescriptor_0163624496220f8c, []int{41}
 }
 
 func (m *StreamPresenceEvent) XXX_Unmarshal(b []byte) error {
@@ -2393,7 +3283,7 @@ func (m *UserPresence) Reset()         { *m = UserPresence{} }
 func (m *UserPresence) String() string { return proto.CompactTextString(m) }
 func (*UserPresence) ProtoMessage()    {}
 func (*UserPresence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0163624496220f8c, []int{30}
+	return fileDescriptor_0163624496220f8c, []int{42}
 }
 
 func (m *UserPresence) XXX_Unmarshal(b []byte) error {
@@ -2453,6 +3343,7 @@ func init() {
 	proto.RegisterEnum("nakama.realtime.ChannelJoin_Type", ChannelJoin_Type_name, ChannelJoin_Type_value)
 	proto.RegisterEnum("nakama.realtime.Error_Code", Error_Code_name, Error_Code_value)
 	proto.RegisterType((*Envelope)(nil), "nakama.realtime.Envelope")
+	proto.RegisterType((*EnvelopeBatch)(nil), "nakama.realtime.EnvelopeBatch")
 	proto.RegisterType((*Channel)(nil), "nakama.realtime.Channel")
 	proto.RegisterType((*ChannelJoin)(nil), "nakama.realtime.ChannelJoin")
 	proto.RegisterType((*ChannelLeave)(nil), "nakama.realtime.ChannelLeave")
@@ -2473,14 +3364,27 @@ func init() {
 	proto.RegisterType((*MatchPresenceEvent)(nil), "nakama.realtime.MatchPresenceEvent")
 	proto.RegisterType((*MatchmakerAdd)(nil), "nakama.realtime.MatchmakerAdd")
 	proto.RegisterMapType((map[string]float64)(nil), "nakama.realtime.MatchmakerAdd.NumericPropertiesEntry")
+	proto.RegisterMapType((map[string]*MatchmakerRange)(nil), "nakama.realtime.MatchmakerAdd.NumericRangesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "nakama.realtime.MatchmakerAdd.StringPropertiesEntry")
 	proto.RegisterType((*MatchmakerMatched)(nil), "nakama.realtime.MatchmakerMatched")
 	proto.RegisterType((*MatchmakerMatched_MatchmakerUser)(nil), "nakama.realtime.MatchmakerMatched.MatchmakerUser")
 	proto.RegisterMapType((map[string]float64)(nil), "nakama.realtime.MatchmakerMatched.MatchmakerUser.NumericPropertiesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "nakama.realtime.MatchmakerMatched.MatchmakerUser.StringPropertiesEntry")
+	proto.RegisterType((*MatchmakerRange)(nil), "nakama.realtime.MatchmakerRange")
+	proto.RegisterType((*MatchmakerRelaxation)(nil), "nakama.realtime.MatchmakerRelaxation")
+	proto.RegisterMapType((map[string]float64)(nil), "nakama.realtime.MatchmakerRelaxation.WidenRangesEntry")
 	proto.RegisterType((*MatchmakerRemove)(nil), "nakama.realtime.MatchmakerRemove")
 	proto.RegisterType((*MatchmakerTicket)(nil), "nakama.realtime.MatchmakerTicket")
 	proto.RegisterType((*Notifications)(nil), "nakama.realtime.Notifications")
+	proto.RegisterType((*Party)(nil), "nakama.realtime.Party")
+	proto.RegisterType((*PartyCreate)(nil), "nakama.realtime.PartyCreate")
+	proto.RegisterType((*PartyData)(nil), "nakama.realtime.PartyData")
+	proto.RegisterType((*PartyDataSend)(nil), "nakama.realtime.PartyDataSend")
+	proto.RegisterType((*PartyJoin)(nil), "nakama.realtime.PartyJoin")
+	proto.RegisterType((*PartyLeader)(nil), "nakama.realtime.PartyLeader")
+	proto.RegisterType((*PartyLeave)(nil), "nakama.realtime.PartyLeave")
+	proto.RegisterType((*PartyPresenceEvent)(nil), "nakama.realtime.PartyPresenceEvent")
+	proto.RegisterType((*PartyPromote)(nil), "nakama.realtime.PartyPromote")
 	proto.RegisterType((*Status)(nil), "nakama.realtime.Status")
 	proto.RegisterType((*StatusFollow)(nil), "nakama.realtime.StatusFollow")
 	proto.RegisterType((*StatusPresenceEvent)(nil), "nakama.realtime.StatusPresenceEvent")
@@ -2495,140 +3399,176 @@ func init() {
 func init() { proto.RegisterFile("rtapi/realtime.proto", fileDescriptor_0163624496220f8c) }
 
 var fileDescriptor_0163624496220f8c = []byte{
-	// 2153 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x73, 0xe3, 0x48,
-	0x15, 0xb7, 0xfc, 0x95, 0xf8, 0x25, 0x4e, 0x9c, 0x8e, 0x27, 0xd1, 0x3a, 0x3b, 0x99, 0x41, 0xb3,
-	0x14, 0xc3, 0x52, 0x38, 0x35, 0x1f, 0x5b, 0x05, 0x33, 0x30, 0x55, 0x89, 0xad, 0xc4, 0x1e, 0xd6,
-	0x1f, 0x25, 0xdb, 0x2c, 0x3b, 0x55, 0x94, 0x4a, 0x91, 0x7a, 0x12, 0x6d, 0x2c, 0xc9, 0x48, 0x72,
-	0x66, 0xc2, 0x09, 0x6e, 0x70, 0xe1, 0xc4, 0x8d, 0x13, 0x1c, 0xe1, 0x06, 0x37, 0x0e, 0xdc, 0x38,
-	0x70, 0xe7, 0x2f, 0xe1, 0xcc, 0x81, 0xea, 0x0f, 0xc9, 0x92, 0x6c, 0xd9, 0xc9, 0x6e, 0xd5, 0x54,
-	0x71, 0x73, 0xbf, 0xfe, 0xbd, 0x5f, 0xb7, 0x5e, 0xbf, 0xf7, 0xfa, 0xbd, 0x36, 0x54, 0x5d, 0x5f,
-	0x9b, 0x98, 0x47, 0x2e, 0xd6, 0xc6, 0xbe, 0x69, 0xe1, 0xfa, 0xc4, 0x75, 0x7c, 0x07, 0x6d, 0xdb,
-	0xda, 0x95, 0x66, 0x69, 0xf5, 0x40, 0x5c, 0x7b, 0x70, 0xe1, 0x38, 0x17, 0x63, 0x7c, 0x44, 0xa7,
-	0xcf, 0xa7, 0x6f, 0x8f, 0x88, 0xd4, 0xf3, 0x35, 0x6b, 0xc2, 0x34, 0x6a, 0x87, 0x49, 0xc0, 0x3b,
-	0x57, 0x9b, 0x4c, 0xb0, 0xeb, 0xf1, 0xf9, 0x4f, 0x2f, 0x4c, 0xff, 0x72, 0x7a, 0x5e, 0xd7, 0x1d,
-	0xeb, 0xe8, 0x12, 0xbb, 0x8e, 0xa9, 0x8f, 0xb5, 0x73, 0xef, 0x88, 0xad, 0x73, 0x44, 0xb6, 0xa0,
-	0x4d, 0x4c, 0x86, 0x95, 0xfe, 0xb2, 0x03, 0xeb, 0xb2, 0x7d, 0x8d, 0xc7, 0xce, 0x04, 0xa3, 0x0a,
-	0xe4, 0x74, 0xd3, 0x10, 0x85, 0x87, 0xc2, 0xe3, 0x92, 0x42, 0x7e, 0xa2, 0xe7, 0xb0, 0xa6, 0x5f,
-	0x6a, 0xb6, 0x8d, 0xc7, 0x62, 0xf6, 0xa1, 0xf0, 0x78, 0xe3, 0xa9, 0x58, 0x4f, 0x6c, 0xb7, 0xde,
-	0x60, 0xf3, 0xad, 0x8c, 0x12, 0x40, 0xd1, 0x31, 0x6c, 0xf2, 0x9f, 0xea, 0x57, 0x8e, 0x69, 0x8b,
-	0x39, 0xaa, 0xfa, 0x71, 0x9a, 0xea, 0x6b, 0xc7, 0xb4, 0x5b, 0x19, 0x65, 0x43, 0x9f, 0x0d, 0x51,
-	0x13, 0xca, 0x01, 0xc5, 0x18, 0x6b, 0xd7, 0x58, 0xcc, 0x53, 0x8e, 0xfb, 0x69, 0x1c, 0x9f, 0x13,
-	0x50, 0x2b, 0xa3, 0x04, 0x0b, 0xd3, 0x31, 0x92, 0x61, 0x3b, 0x60, 0xb1, 0xb0, 0xe7, 0x69, 0x17,
-	0x58, 0x2c, 0x50, 0x9e, 0x5a, 0xc0, 0x43, 0x2c, 0xc1, 0x29, 0x3a, 0x0c, 0xd1, 0xca, 0x28, 0x5b,
-	0x7a, 0x4c, 0x82, 0x86, 0xb0, 0x9b, 0xa0, 0x51, 0x35, 0xfd, 0x4a, 0x2c, 0x52, 0x2a, 0x29, 0x6d,
-	0x4b, 0x5c, 0xfb, 0x58, 0xbf, 0x6a, 0x65, 0x94, 0x1d, 0x3d, 0x29, 0x44, 0x5f, 0x40, 0x35, 0xc9,
-	0xea, 0x61, 0xdb, 0x10, 0xd7, 0x28, 0xed, 0xa3, 0x15, 0xb4, 0x03, 0x6c, 0x1b, 0xad, 0x8c, 0x82,
-	0xf4, 0x39, 0x29, 0xfa, 0x39, 0xec, 0x25, 0x89, 0xa7, 0x13, 0x43, 0xf3, 0xb1, 0xb8, 0x4e, 0xa9,
-	0xbf, 0xbd, 0x82, 0x7a, 0x44, 0xc1, 0xad, 0x8c, 0x52, 0xd5, 0x17, 0xc8, 0x17, 0xd1, 0xbb, 0xd8,
-	0x72, 0xae, 0xb1, 0x58, 0xba, 0x15, 0xbd, 0x42, 0xc1, 0xf3, 0xf4, 0x4c, 0x1e, 0xa5, 0x9f, 0xb8,
-	0xd8, 0xc3, 0xb6, 0x8e, 0x55, 0x7c, 0x8d, 0x6d, 0x5f, 0x84, 0xe5, 0xf4, 0x7d, 0x8e, 0x96, 0x09,
-	0x38, 0x42, 0x1f, 0x93, 0xa3, 0x3a, 0x14, 0xb0, 0xeb, 0x3a, 0xae, 0xb8, 0x41, 0xd9, 0xf6, 0xe6,
-	0xd8, 0x64, 0x32, 0xdb, 0xca, 0x28, 0x0c, 0x46, 0xf0, 0x96, 0xe6, 0xeb, 0x97, 0xe2, 0x66, 0x0a,
-	0xbe, 0x43, 0x66, 0x09, 0x9e, 0xc2, 0x88, 0xef, 0xd3, 0x1f, 0xaa, 0xee, 0x62, 0x62, 0xf2, 0x72,
-	0x8a, 0xef, 0x53, 0xb5, 0x06, 0xc5, 0x10, 0xdf, 0xb7, 0x66, 0x43, 0xf4, 0x12, 0x80, 0x51, 0x18,
-	0x9a, 0xaf, 0x89, 0x5b, 0x71, 0x87, 0x8d, 0x13, 0x34, 0x35, 0x5f, 0x6b, 0x65, 0x94, 0x92, 0x15,
-	0x0c, 0x50, 0x0b, 0xb6, 0x67, 0xca, 0xcc, 0xa1, 0xb6, 0x29, 0xc3, 0x61, 0x3a, 0x03, 0xf7, 0xa5,
-	0xb2, 0x15, 0x15, 0xcc, 0xb6, 0x41, 0x63, 0xb8, 0xb2, 0x6c, 0x1b, 0x3c, 0x82, 0xd9, 0x36, 0x68,
-	0xfc, 0xbe, 0x02, 0xf6, 0x49, 0x3c, 0x7a, 0x77, 0xa8, 0xf6, 0xc1, 0x62, 0xed, 0x20, 0x76, 0xd9,
-	0x72, 0x2c, 0x72, 0xbf, 0x80, 0x2a, 0xd3, 0x4f, 0xf8, 0x00, 0x4a, 0x09, 0x0e, 0x4a, 0x94, 0xf4,
-	0x00, 0x64, 0xcd, 0x49, 0xd1, 0x19, 0x6c, 0x51, 0xa9, 0xa5, 0x5d, 0x61, 0x57, 0xd5, 0x0c, 0x43,
-	0xdc, 0x5d, 0x66, 0x1e, 0x0a, 0x3b, 0x36, 0x66, 0xe6, 0x09, 0x04, 0x68, 0x00, 0x28, 0x42, 0x44,
-	0x7f, 0x62, 0x43, 0xac, 0xa6, 0xe4, 0x84, 0x19, 0x59, 0x87, 0x21, 0x49, 0x4e, 0xb0, 0x92, 0x42,
-	0xd4, 0x87, 0x88, 0x30, 0x08, 0xab, 0x7b, 0x94, 0xf3, 0x5b, 0x4b, 0x38, 0xc3, 0x90, 0xaa, 0x58,
-	0x09, 0x59, 0x82, 0xd1, 0x37, 0xf5, 0x2b, 0xec, 0x8b, 0x7b, 0x2b, 0x19, 0x87, 0x14, 0x18, 0x67,
-	0x64, 0x32, 0x74, 0x0a, 0x65, 0xdb, 0xf1, 0xcd, 0xb7, 0xa6, 0xae, 0xf9, 0xa6, 0x63, 0x7b, 0xe2,
-	0x7e, 0x8a, 0x01, 0xbb, 0x51, 0x14, 0x31, 0x60, 0x4c, 0x0d, 0x3d, 0x82, 0x9c, 0x3b, 0xd1, 0x45,
-	0x91, 0x6a, 0x6f, 0x47, 0x13, 0xb2, 0x32, 0xd1, 0x5b, 0x19, 0x85, 0xcc, 0xa2, 0x27, 0x50, 0xf4,
-	0x7c, 0xcd, 0x9f, 0x7a, 0xe2, 0x47, 0x14, 0xb7, 0x3f, 0xb7, 0xca, 0x80, 0x4e, 0xb7, 0x32, 0x0a,
-	0x07, 0x92, 0xab, 0x83, 0xfd, 0x52, 0xdf, 0x3a, 0xe3, 0xb1, 0xf3, 0x4e, 0xac, 0xa5, 0x5c, 0x1d,
-	0x4c, 0xf3, 0x94, 0x82, 0xc8, 0xd5, 0xe1, 0x45, 0xc6, 0xe8, 0x0d, 0xdc, 0xe3, 0x2c, 0x09, 0x0f,
-	0x3c, 0xa0, 0x6c, 0x9f, 0xa4, 0xb0, 0x25, 0x5d, 0x70, 0xd7, 0x9b, 0x17, 0xa3, 0xd7, 0xb0, 0xcd,
-	0xb9, 0xa7, 0x36, 0xdf, 0xe3, 0xc7, 0x94, 0xf5, 0x41, 0x0a, 0xeb, 0x88, 0xc3, 0xc8, 0xdd, 0xe4,
-	0xc5, 0x24, 0x91, 0xaf, 0xe5, 0x39, 0xfe, 0xfe, 0xd2, 0xaf, 0x0d, 0x73, 0x3b, 0xff, 0x5a, 0x9e,
-	0xd3, 0x5f, 0xc1, 0x86, 0xe7, 0xbb, 0x58, 0xb3, 0x58, 0xce, 0x39, 0x4c, 0x09, 0xd7, 0x01, 0xc5,
-	0xf0, 0xa4, 0x03, 0x5e, 0x38, 0x62, 0xd6, 0xa2, 0xfa, 0x09, 0x6b, 0x3d, 0x48, 0xb5, 0x16, 0x41,
-	0x2f, 0xb0, 0xd6, 0x9c, 0xf8, 0xa4, 0x04, 0x6b, 0xfc, 0x9e, 0x91, 0x7e, 0x2b, 0xc0, 0x1a, 0xcf,
-	0xf6, 0x68, 0x0b, 0xb2, 0x61, 0xad, 0x92, 0x35, 0x49, 0xba, 0x2a, 0x05, 0x6b, 0x7b, 0x62, 0xf6,
-	0x61, 0x6e, 0xa1, 0x11, 0x46, 0x1e, 0x76, 0x03, 0x76, 0x65, 0x86, 0x47, 0x4f, 0x20, 0xef, 0xe1,
-	0xf1, 0x5b, 0x5e, 0xa9, 0xac, 0xd0, 0xa3, 0x50, 0xe9, 0x3f, 0x02, 0x6c, 0x44, 0x0a, 0x18, 0xb4,
-	0x07, 0x45, 0x5f, 0x73, 0x2f, 0xb0, 0xcf, 0xf7, 0xc4, 0x47, 0x08, 0x41, 0xde, 0xbf, 0x99, 0x60,
-	0x5a, 0x3f, 0x15, 0x14, 0xfa, 0x1b, 0xfd, 0x08, 0x36, 0x48, 0xbd, 0x66, 0x7a, 0x3e, 0x21, 0xe4,
-	0xab, 0xd6, 0xea, 0xac, 0xae, 0xab, 0x07, 0x75, 0x5d, 0xfd, 0xc4, 0x71, 0xc6, 0x3f, 0xd5, 0xc6,
-	0x53, 0xac, 0x44, 0xe1, 0xe8, 0x29, 0x14, 0x2f, 0x4d, 0xc3, 0xc0, 0x36, 0x2f, 0x8a, 0x96, 0x29,
-	0x72, 0xa4, 0x24, 0x43, 0x7e, 0x48, 0x56, 0xae, 0x42, 0x65, 0xf8, 0x65, 0x5f, 0x56, 0x47, 0xdd,
-	0x41, 0x5f, 0x6e, 0xb4, 0x4f, 0xdb, 0x72, 0xb3, 0x92, 0x41, 0xeb, 0x90, 0x57, 0x7a, 0xbd, 0x4e,
-	0x45, 0x40, 0x08, 0xb6, 0x9a, 0x6d, 0x45, 0x6e, 0x0c, 0xd5, 0x8e, 0x3c, 0x18, 0x1c, 0x9f, 0xc9,
-	0x95, 0x2c, 0x2a, 0x41, 0xe1, 0x4c, 0xe9, 0x8d, 0xfa, 0x95, 0x9c, 0xf4, 0x7d, 0xd8, 0x8c, 0x16,
-	0x5c, 0xe8, 0x3e, 0x40, 0x70, 0x59, 0x87, 0x87, 0x51, 0xe2, 0x92, 0xb6, 0x21, 0xfd, 0x3b, 0x0b,
-	0x3b, 0x73, 0xd5, 0xd0, 0x0a, 0x25, 0x32, 0x1d, 0xd4, 0x15, 0xa6, 0x41, 0xcd, 0x56, 0x52, 0x4a,
-	0x5c, 0xd2, 0x36, 0xd0, 0x11, 0xe4, 0x75, 0xc7, 0x08, 0x8c, 0x76, 0x30, 0xf7, 0xed, 0x6d, 0xdb,
-	0x7f, 0xf6, 0x94, 0x7d, 0x3c, 0x05, 0xa2, 0x1a, 0xac, 0x4f, 0x3d, 0xec, 0xda, 0x9a, 0xc5, 0xaa,
-	0xc8, 0x92, 0x12, 0x8e, 0xd1, 0x4b, 0xd8, 0x60, 0xf7, 0xb4, 0x4a, 0x8e, 0x39, 0x2c, 0x0e, 0x93,
-	0x9c, 0xc3, 0xa0, 0x02, 0x57, 0x80, 0xc1, 0x89, 0x80, 0x28, 0xb3, 0x98, 0x63, 0xca, 0xc5, 0xd5,
-	0xca, 0x0c, 0x4e, 0x95, 0x5f, 0x00, 0x84, 0x67, 0xea, 0xf3, 0x9a, 0x6f, 0xd9, 0x41, 0x46, 0xd0,
-	0x52, 0x07, 0xd0, 0x7c, 0x31, 0xb8, 0xca, 0xac, 0x22, 0xac, 0xe9, 0x8e, 0x4d, 0x57, 0x63, 0x36,
-	0x0d, 0x86, 0x92, 0x0d, 0xd5, 0x45, 0x05, 0xe0, 0x37, 0x3c, 0xa7, 0xc8, 0x7a, 0xb9, 0xf8, 0x7a,
-	0xc3, 0xe4, 0x7a, 0xfc, 0xaa, 0xfa, 0x46, 0xeb, 0x49, 0x7f, 0x14, 0x42, 0xda, 0x78, 0xb6, 0x5d,
-	0x41, 0xfb, 0x0c, 0x0a, 0xa4, 0xc0, 0xb9, 0x65, 0xce, 0x60, 0x58, 0xf4, 0x19, 0x14, 0x69, 0x61,
-	0xe3, 0x89, 0xb9, 0xdb, 0x68, 0x71, 0xb0, 0xf4, 0xdf, 0x2c, 0x14, 0x68, 0x7d, 0x49, 0xb2, 0x02,
-	0xf5, 0x62, 0x81, 0x65, 0x05, 0xea, 0xa8, 0x62, 0x98, 0xe8, 0x82, 0x13, 0xe2, 0x43, 0xf4, 0x63,
-	0x6e, 0xcb, 0xf7, 0x3e, 0x5f, 0xef, 0xd1, 0xe2, 0xb2, 0xb5, 0xde, 0x60, 0x28, 0xd9, 0xf6, 0xdd,
-	0x1b, 0x25, 0xd0, 0xa9, 0xbd, 0x80, 0xcd, 0xe8, 0x04, 0xe9, 0xf3, 0xae, 0xf0, 0x4d, 0xd0, 0xe7,
-	0x5d, 0xe1, 0x1b, 0x54, 0x85, 0xc2, 0x35, 0x71, 0x33, 0xbe, 0x30, 0x1b, 0xbc, 0xc8, 0xfe, 0x40,
-	0x90, 0xfe, 0x25, 0x40, 0xbe, 0x41, 0x76, 0x77, 0x0f, 0x76, 0x94, 0x51, 0x77, 0xd8, 0xee, 0xc8,
-	0xaa, 0xfc, 0xb3, 0x86, 0xdc, 0x1f, 0xb6, 0x7b, 0xdd, 0x4a, 0x06, 0x89, 0x50, 0x1d, 0x75, 0x15,
-	0xb9, 0xd1, 0x3b, 0xeb, 0xb6, 0xdf, 0xc8, 0x4d, 0xb5, 0x7f, 0xfc, 0xe5, 0xe7, 0xbd, 0xe3, 0x66,
-	0x45, 0x40, 0xbb, 0xb0, 0xdd, 0x69, 0x0f, 0x06, 0xed, 0xee, 0x59, 0x28, 0xcc, 0xa2, 0x32, 0x94,
-	0x4e, 0x8e, 0x9b, 0x6a, 0xbb, 0xdb, 0x1f, 0x0d, 0x2b, 0x39, 0x8a, 0x39, 0x1e, 0x36, 0x5a, 0x6a,
-	0xb7, 0x37, 0x54, 0x4f, 0x7b, 0xa3, 0x6e, 0xb3, 0x92, 0x47, 0xfb, 0xb0, 0xcb, 0x84, 0xaf, 0x7b,
-	0xed, 0xae, 0xaa, 0xc8, 0xaf, 0xe5, 0xc6, 0x50, 0x6e, 0x56, 0x0a, 0xe8, 0x10, 0x6a, 0xc1, 0x16,
-	0x4e, 0x47, 0xdd, 0x06, 0xd9, 0x41, 0x44, 0xb1, 0xb8, 0x70, 0x7e, 0xb6, 0xd7, 0x35, 0xe9, 0x57,
-	0x59, 0x28, 0xd0, 0x12, 0x07, 0x7d, 0x04, 0xeb, 0xac, 0xbc, 0x0c, 0x3d, 0x62, 0x8d, 0x8e, 0xdb,
-	0x06, 0xfa, 0x04, 0xca, 0xda, 0xd4, 0xbf, 0x74, 0x5c, 0xd3, 0xd7, 0x7c, 0xf3, 0x9a, 0x99, 0x64,
-	0x5d, 0x89, 0x0b, 0xd1, 0x53, 0x28, 0x8c, 0xb5, 0x73, 0x3c, 0x0e, 0x7b, 0xdb, 0x64, 0xe4, 0x0e,
-	0x7c, 0xd7, 0xb4, 0x2f, 0x58, 0xec, 0x32, 0x28, 0x39, 0x73, 0xcf, 0xfc, 0x25, 0x4b, 0x42, 0x05,
-	0x85, 0xfe, 0x8e, 0xdf, 0x5a, 0x85, 0xaf, 0x79, 0x6b, 0x15, 0x6f, 0x7f, 0x6b, 0x95, 0x61, 0x23,
-	0xd2, 0x79, 0x48, 0xbf, 0x13, 0xa0, 0x14, 0xb6, 0x01, 0xcb, 0xac, 0xf2, 0x43, 0x58, 0x0f, 0xd6,
-	0xe5, 0x2f, 0x01, 0x2b, 0x96, 0x0b, 0xe1, 0x68, 0x1f, 0xd6, 0x9c, 0x89, 0x1a, 0xe6, 0xec, 0x9c,
-	0x52, 0x74, 0x26, 0xd4, 0xa3, 0x10, 0xe4, 0x69, 0xb5, 0x41, 0xec, 0xb1, 0xa9, 0xd0, 0xdf, 0xd2,
-	0xef, 0x05, 0x28, 0xc7, 0xfa, 0x92, 0x65, 0x9b, 0x8a, 0x30, 0x67, 0x17, 0x32, 0xe7, 0x66, 0xcc,
-	0x71, 0x4b, 0xe7, 0xef, 0x66, 0x69, 0x12, 0x05, 0xa5, 0xb0, 0xd3, 0x41, 0x07, 0xc9, 0x2d, 0xb5,
-	0x32, 0xb3, 0x4d, 0xed, 0x41, 0xc1, 0x77, 0xae, 0xb0, 0xcd, 0x42, 0x89, 0x34, 0x86, 0x74, 0x88,
-	0x9a, 0xb0, 0x6e, 0x61, 0x5f, 0xe3, 0xfb, 0x22, 0xcb, 0x3f, 0x4e, 0x6f, 0xa6, 0xea, 0x1d, 0x0e,
-	0x65, 0x91, 0x1c, 0x6a, 0xd6, 0x5e, 0x42, 0x39, 0x36, 0x75, 0x97, 0x58, 0x3e, 0xc9, 0x93, 0x92,
-	0x49, 0xfa, 0x0e, 0xc0, 0xac, 0xed, 0x5a, 0x62, 0x5e, 0xe9, 0x0f, 0x02, 0xa0, 0xf9, 0xbe, 0x6a,
-	0xd9, 0x81, 0x7c, 0xc8, 0x5c, 0xfa, 0xb7, 0x1c, 0xf7, 0x94, 0xb0, 0x23, 0x3b, 0x80, 0x92, 0x65,
-	0xda, 0xaa, 0xee, 0x4c, 0x6d, 0x9f, 0x27, 0xd6, 0x75, 0xcb, 0xb4, 0x1b, 0x64, 0x4c, 0x27, 0xb5,
-	0xf7, 0x7c, 0x32, 0xcb, 0x27, 0xb5, 0xf7, 0x6c, 0xb2, 0x0a, 0x85, 0x5f, 0x4c, 0xb1, 0x7b, 0xc3,
-	0x6f, 0x2a, 0x36, 0x40, 0x1a, 0xec, 0x78, 0x34, 0x8a, 0xd5, 0x89, 0xeb, 0x4c, 0xb0, 0xeb, 0x9b,
-	0xa1, 0xe7, 0x3c, 0x5f, 0xde, 0x2d, 0xf2, 0xe8, 0xef, 0x87, 0x6a, 0xec, 0x18, 0x2b, 0x5e, 0x42,
-	0x8c, 0x0c, 0x40, 0xf6, 0xd4, 0xc2, 0xae, 0xa9, 0x47, 0xd7, 0x60, 0x79, 0xe0, 0xb3, 0x15, 0x6b,
-	0x74, 0x99, 0x62, 0x72, 0x91, 0x1d, 0x3b, 0x29, 0xaf, 0x35, 0xe0, 0xde, 0xc2, 0x0d, 0xdd, 0xc5,
-	0x79, 0x6a, 0x4d, 0xd8, 0x5b, 0xbc, 0xe2, 0x2a, 0x16, 0x21, 0x7a, 0x9d, 0xfc, 0xa3, 0x00, 0x3b,
-	0x73, 0xbd, 0x30, 0xad, 0x9d, 0x59, 0x67, 0x1a, 0xd4, 0xce, 0xac, 0xd5, 0x8c, 0x06, 0x5a, 0x36,
-	0x35, 0xd0, 0x72, 0xf1, 0x40, 0x3b, 0x83, 0x02, 0xa9, 0xef, 0x82, 0xa3, 0x7a, 0xb2, 0xba, 0x17,
-	0x8f, 0x48, 0x88, 0xab, 0x29, 0x4c, 0x1f, 0xc9, 0x3c, 0xbd, 0xb2, 0xaa, 0xf0, 0x6b, 0xf0, 0x50,
-	0xf5, 0xda, 0x3f, 0x73, 0xb0, 0x15, 0x9f, 0x88, 0x65, 0x53, 0xe1, 0x6e, 0xd9, 0xd4, 0x5f, 0xe4,
-	0x94, 0xcc, 0x61, 0xce, 0xee, 0xbc, 0xc3, 0x5b, 0xfb, 0xe9, 0xbb, 0x85, 0x7e, 0x5a, 0xa4, 0xcb,
-	0xb6, 0xee, 0xbe, 0xec, 0xff, 0xa3, 0xeb, 0xf2, 0xec, 0xf9, 0x29, 0x54, 0x92, 0xef, 0x2e, 0x69,
-	0xee, 0x1b, 0xc7, 0xf2, 0xd7, 0x93, 0x34, 0x6c, 0x0f, 0xca, 0xb1, 0xf7, 0x12, 0xf4, 0x2a, 0xf9,
-	0xcc, 0x22, 0x50, 0x6b, 0x8b, 0xd1, 0x87, 0x92, 0xa8, 0x46, 0xe2, 0x79, 0x45, 0x92, 0xa1, 0xc8,
-	0x5a, 0xfe, 0xf8, 0xcd, 0x27, 0xdc, 0xf1, 0xe6, 0xfb, 0x2e, 0x6c, 0x46, 0xdf, 0x49, 0x48, 0xf6,
-	0x27, 0xd1, 0xa1, 0x9a, 0x06, 0xe3, 0x2a, 0x29, 0x6b, 0x64, 0xdc, 0x36, 0x3c, 0xe9, 0xd7, 0x02,
-	0xec, 0x2e, 0x78, 0x05, 0xf9, 0xa0, 0xb7, 0xc2, 0xf7, 0x60, 0x2b, 0xfe, 0x64, 0xb2, 0x6c, 0xc3,
-	0xcd, 0xe0, 0xdb, 0x78, 0xc3, 0xf3, 0x3c, 0x7c, 0x6c, 0x12, 0x6e, 0x51, 0xd5, 0x71, 0xac, 0x34,
-	0x26, 0x86, 0x76, 0xb1, 0x66, 0x91, 0xb2, 0xc3, 0x8a, 0x14, 0xf5, 0x16, 0x2f, 0xea, 0xbd, 0xe9,
-	0xf9, 0x57, 0x58, 0x0f, 0xdb, 0x2e, 0x3e, 0x44, 0x87, 0x00, 0xde, 0xf4, 0x7c, 0x56, 0xd7, 0x93,
-	0xc9, 0x88, 0x84, 0x78, 0x22, 0x2b, 0x31, 0x59, 0xd3, 0xca, 0x06, 0xd2, 0x6f, 0x04, 0x80, 0xd9,
-	0x33, 0x0c, 0x3a, 0x22, 0x5b, 0x26, 0x23, 0xbe, 0xe5, 0xfd, 0x94, 0x97, 0x16, 0x85, 0xc3, 0x88,
-	0x5d, 0x3d, 0x6c, 0x1b, 0xd8, 0xbd, 0x5d, 0x19, 0xc7, 0xc1, 0xb1, 0x8a, 0xaa, 0xc4, 0x6b, 0xb5,
-	0xbf, 0xd2, 0xf3, 0x9e, 0x7b, 0xb0, 0xb9, 0xfb, 0x9e, 0x3e, 0xa4, 0x83, 0xfc, 0x5d, 0x80, 0xcd,
-	0xe8, 0x04, 0x29, 0x22, 0xb9, 0x7f, 0x04, 0x11, 0xc9, 0xdc, 0x83, 0xf4, 0x8d, 0x1e, 0xf6, 0x3c,
-	0xd3, 0xb1, 0x23, 0xfd, 0x26, 0x97, 0xb4, 0x8d, 0xd8, 0xb3, 0x42, 0x2e, 0xf1, 0xac, 0xf0, 0x30,
-	0xfe, 0xbe, 0x93, 0xa7, 0x1d, 0x44, 0xec, 0x0d, 0x67, 0xe6, 0x6a, 0x85, 0xdb, 0xbb, 0xda, 0xc9,
-	0x29, 0x1c, 0xe8, 0x8e, 0x55, 0x9f, 0xfd, 0xa9, 0x17, 0x7e, 0xb3, 0xaf, 0x4d, 0xcc, 0x93, 0xad,
-	0x2e, 0x1d, 0x29, 0xdc, 0x00, 0x7d, 0xe1, 0x4d, 0x81, 0x4e, 0xfc, 0x29, 0x9b, 0xef, 0xfe, 0xa4,
-	0x7f, 0xf2, 0xe7, 0x6c, 0x91, 0x01, 0xce, 0x8b, 0x74, 0x95, 0x67, 0xff, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0x49, 0xe0, 0x91, 0x17, 0x92, 0x1c, 0x00, 0x00,
+	// 2734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x73, 0xe3, 0xc6,
+	0x11, 0x26, 0xf8, 0x66, 0x53, 0x94, 0xa8, 0x91, 0x56, 0x0b, 0x53, 0xf6, 0x5a, 0xc6, 0xfa, 0x21,
+	0x3b, 0x15, 0xaa, 0x56, 0x7e, 0xc4, 0xb1, 0x13, 0x57, 0x49, 0x24, 0x25, 0x72, 0x63, 0x51, 0x2c,
+	0x90, 0x8a, 0xbd, 0xae, 0x4a, 0xb1, 0x20, 0x60, 0x56, 0x82, 0x45, 0x3c, 0x02, 0x80, 0x7a, 0xe4,
+	0x96, 0x5b, 0x72, 0xc9, 0x21, 0xd7, 0x9c, 0x92, 0x5b, 0x7c, 0x4a, 0xe5, 0xe8, 0xca, 0xcf, 0x48,
+	0x0e, 0xb9, 0xe5, 0x90, 0x5f, 0x90, 0x4b, 0xae, 0xa9, 0x79, 0x00, 0x04, 0x40, 0x82, 0xa4, 0xbc,
+	0xe5, 0xad, 0xdc, 0xd0, 0x3d, 0x5f, 0x7f, 0x33, 0xd3, 0xd3, 0xd3, 0x33, 0xd3, 0x24, 0x6c, 0x3a,
+	0x9e, 0x62, 0xeb, 0x7b, 0x0e, 0x56, 0x46, 0x9e, 0x6e, 0xe0, 0xba, 0xed, 0x58, 0x9e, 0x85, 0xd6,
+	0x4c, 0xe5, 0x4a, 0x31, 0x94, 0xba, 0xaf, 0xae, 0xbd, 0x7e, 0x61, 0x59, 0x17, 0x23, 0xbc, 0x47,
+	0x9b, 0xcf, 0xc7, 0xcf, 0xf7, 0x88, 0xd6, 0xf5, 0x14, 0xc3, 0x66, 0x16, 0xb5, 0x47, 0x71, 0xc0,
+	0x8d, 0xa3, 0xd8, 0x36, 0x76, 0x5c, 0xde, 0xfe, 0xde, 0x85, 0xee, 0x5d, 0x8e, 0xcf, 0xeb, 0xaa,
+	0x65, 0xec, 0x5d, 0x62, 0xc7, 0xd2, 0xd5, 0x91, 0x72, 0xee, 0xee, 0xb1, 0x7e, 0xf6, 0xc8, 0x10,
+	0x14, 0x5b, 0x67, 0x58, 0xe9, 0x6f, 0x5b, 0x50, 0x6c, 0x99, 0xd7, 0x78, 0x64, 0xd9, 0x18, 0x55,
+	0x21, 0xa3, 0xea, 0x9a, 0x28, 0xec, 0x08, 0xbb, 0x25, 0x99, 0x7c, 0xa2, 0x0f, 0xa0, 0xa0, 0x5e,
+	0x2a, 0xa6, 0x89, 0x47, 0x62, 0x7a, 0x47, 0xd8, 0x2d, 0xef, 0x8b, 0xf5, 0xd8, 0x70, 0xeb, 0x0d,
+	0xd6, 0xde, 0x4e, 0xc9, 0x3e, 0x14, 0x1d, 0xc0, 0x0a, 0xff, 0x1c, 0x7e, 0x6d, 0xe9, 0xa6, 0x98,
+	0xa1, 0xa6, 0xaf, 0x26, 0x99, 0x3e, 0xb5, 0x74, 0xb3, 0x9d, 0x92, 0xcb, 0xea, 0x44, 0x44, 0x4d,
+	0xa8, 0xf8, 0x14, 0x23, 0xac, 0x5c, 0x63, 0x31, 0x4b, 0x39, 0x5e, 0x4b, 0xe2, 0xf8, 0x9c, 0x80,
+	0xda, 0x29, 0x79, 0x45, 0x0d, 0xc9, 0xa8, 0x05, 0x6b, 0x3e, 0x8b, 0x81, 0x5d, 0x57, 0xb9, 0xc0,
+	0x62, 0x8e, 0xf2, 0xd4, 0x7c, 0x1e, 0xe2, 0x09, 0x4e, 0x71, 0xc2, 0x10, 0xed, 0x94, 0xbc, 0xaa,
+	0x46, 0x34, 0x68, 0x00, 0x1b, 0x31, 0x9a, 0xa1, 0xa2, 0x5e, 0x89, 0x79, 0x4a, 0x25, 0x25, 0x0d,
+	0x89, 0x5b, 0x1f, 0xa8, 0x57, 0xed, 0x94, 0xbc, 0xae, 0xc6, 0x95, 0xe8, 0x0b, 0xd8, 0x8c, 0xb3,
+	0xba, 0xd8, 0xd4, 0xc4, 0x02, 0xa5, 0x7d, 0xbc, 0x80, 0xb6, 0x8f, 0x4d, 0xad, 0x9d, 0x92, 0x91,
+	0x3a, 0xa5, 0x45, 0xbf, 0x80, 0xad, 0x38, 0xf1, 0xd8, 0xd6, 0x14, 0x0f, 0x8b, 0x45, 0x4a, 0xfd,
+	0xd6, 0x02, 0xea, 0x33, 0x0a, 0x6e, 0xa7, 0xe4, 0x4d, 0x75, 0x86, 0x7e, 0x16, 0xbd, 0x83, 0x0d,
+	0xeb, 0x1a, 0x8b, 0xa5, 0xa5, 0xe8, 0x65, 0x0a, 0x9e, 0xa6, 0x67, 0xfa, 0x30, 0xbd, 0xed, 0x60,
+	0x17, 0x9b, 0x2a, 0x1e, 0xe2, 0x6b, 0x6c, 0x7a, 0x22, 0xcc, 0xa7, 0xef, 0x71, 0x74, 0x8b, 0x80,
+	0x43, 0xf4, 0x11, 0x3d, 0xaa, 0x43, 0x0e, 0x3b, 0x8e, 0xe5, 0x88, 0x65, 0xca, 0xb6, 0x35, 0xc5,
+	0xd6, 0x22, 0xad, 0xed, 0x94, 0xcc, 0x60, 0x04, 0x6f, 0x28, 0x9e, 0x7a, 0x29, 0xae, 0x24, 0xe0,
+	0x4f, 0x48, 0x2b, 0xc1, 0x53, 0x18, 0x89, 0x7d, 0xfa, 0x31, 0x54, 0x1d, 0x4c, 0x5c, 0x5e, 0x49,
+	0x88, 0x7d, 0x6a, 0xd6, 0xa0, 0x18, 0x12, 0xfb, 0xc6, 0x44, 0x44, 0x9f, 0x02, 0x30, 0x0a, 0x4d,
+	0xf1, 0x14, 0x71, 0x35, 0x1a, 0xb0, 0x51, 0x82, 0xa6, 0xe2, 0x29, 0xed, 0x94, 0x5c, 0x32, 0x7c,
+	0x01, 0xb5, 0x61, 0x6d, 0x62, 0xcc, 0x02, 0x6a, 0x8d, 0x32, 0x3c, 0x4a, 0x66, 0xe0, 0xb1, 0x54,
+	0x31, 0xc2, 0x8a, 0xc9, 0x30, 0xe8, 0x1e, 0xae, 0xce, 0x1b, 0x06, 0xdf, 0xc1, 0x25, 0xc3, 0x17,
+	0xd0, 0x67, 0xc0, 0xa6, 0xc4, 0x77, 0xef, 0x3a, 0xb5, 0xde, 0x9e, 0x6d, 0xed, 0xef, 0x5d, 0x30,
+	0x02, 0x89, 0x6c, 0x0e, 0x66, 0x1f, 0x8b, 0x01, 0x94, 0xb0, 0x39, 0x28, 0x51, 0x3c, 0x02, 0x90,
+	0x31, 0xa5, 0x45, 0xc7, 0xb0, 0x4a, 0xb5, 0x86, 0x72, 0x85, 0x9d, 0xa1, 0xa2, 0x69, 0xe2, 0xc6,
+	0x3c, 0xf7, 0x50, 0xd8, 0x81, 0x36, 0x71, 0x8f, 0xaf, 0x40, 0x7d, 0x40, 0x21, 0x22, 0xfa, 0x89,
+	0x35, 0x71, 0x33, 0x21, 0x27, 0x4c, 0xc8, 0x4e, 0x18, 0x92, 0xe4, 0x04, 0x23, 0xae, 0x44, 0x3d,
+	0x08, 0x29, 0xfd, 0x6d, 0xf5, 0x80, 0x72, 0xbe, 0x31, 0x87, 0x33, 0xd8, 0x52, 0x55, 0x23, 0xa6,
+	0x8b, 0x31, 0x7a, 0xba, 0x7a, 0x85, 0x3d, 0x71, 0x6b, 0x21, 0xe3, 0x80, 0x02, 0xa3, 0x8c, 0x4c,
+	0x87, 0x8e, 0xa0, 0x62, 0x5a, 0x9e, 0xfe, 0x5c, 0x57, 0x15, 0x4f, 0xb7, 0x4c, 0x57, 0x7c, 0x98,
+	0xe0, 0xc0, 0x6e, 0x18, 0x45, 0x1c, 0x18, 0x31, 0x43, 0x8f, 0x21, 0xe3, 0xd8, 0xaa, 0x28, 0x52,
+	0xeb, 0xb5, 0x70, 0x42, 0x96, 0x6d, 0xb5, 0x9d, 0x92, 0x49, 0x2b, 0x7a, 0x02, 0x79, 0xd7, 0x53,
+	0xbc, 0xb1, 0x2b, 0xbe, 0x42, 0x71, 0x0f, 0xa7, 0x7a, 0xe9, 0xd3, 0xe6, 0x76, 0x4a, 0xe6, 0x40,
+	0x72, 0x74, 0xb0, 0xaf, 0xe1, 0x73, 0x6b, 0x34, 0xb2, 0x6e, 0xc4, 0x5a, 0xc2, 0xd1, 0xc1, 0x2c,
+	0x8f, 0x28, 0x88, 0x1c, 0x1d, 0x6e, 0x48, 0x46, 0x5f, 0xc1, 0x03, 0xce, 0x12, 0x8b, 0xc0, 0x6d,
+	0xca, 0xf6, 0x66, 0x02, 0x5b, 0x3c, 0x04, 0x37, 0xdc, 0x69, 0x35, 0x7a, 0x0a, 0x6b, 0x9c, 0x7b,
+	0x6c, 0xf2, 0x31, 0xbe, 0x4a, 0x59, 0x5f, 0x4f, 0x60, 0x3d, 0xe3, 0x30, 0x72, 0x36, 0xb9, 0x11,
+	0x4d, 0x68, 0xb6, 0x3c, 0xc7, 0xbf, 0x36, 0x77, 0xb6, 0x41, 0x6e, 0x5f, 0x71, 0x43, 0x32, 0xd9,
+	0xae, 0xae, 0xe7, 0x60, 0xc5, 0x60, 0x39, 0xe7, 0x51, 0xc2, 0x76, 0xed, 0x53, 0x0c, 0x4f, 0x3a,
+	0xe0, 0x06, 0x12, 0xf3, 0x16, 0xb5, 0x8f, 0x79, 0xeb, 0xf5, 0x44, 0x6f, 0x11, 0xf4, 0x0c, 0x6f,
+	0x4d, 0xa9, 0x49, 0x06, 0xb6, 0x15, 0xc7, 0xbb, 0x13, 0x77, 0x12, 0x32, 0x70, 0x8f, 0xb4, 0x92,
+	0x0c, 0x4c, 0x61, 0x24, 0x03, 0xd3, 0x0f, 0x3f, 0x03, 0xbf, 0x91, 0x90, 0x81, 0xa9, 0xd9, 0x24,
+	0x03, 0xdb, 0x13, 0x91, 0xa4, 0x3e, 0x46, 0x41, 0xbd, 0x21, 0x25, 0xa4, 0x3e, 0x4a, 0xe0, 0x67,
+	0x60, 0xdb, 0x17, 0x48, 0x06, 0x9e, 0x18, 0xb3, 0x0c, 0xfc, 0x38, 0x61, 0x87, 0x04, 0x0c, 0x7e,
+	0x06, 0xb6, 0xc3, 0x8a, 0xc9, 0x30, 0x68, 0x06, 0x7e, 0x73, 0xde, 0x30, 0xfc, 0x0c, 0x6c, 0xfb,
+	0xc2, 0xc4, 0x0d, 0x23, 0xac, 0x68, 0xd8, 0x11, 0xdf, 0x9a, 0xe7, 0x86, 0xcf, 0x29, 0x26, 0x70,
+	0x03, 0x13, 0x49, 0x54, 0x04, 0x14, 0xd7, 0x58, 0x7c, 0x3b, 0x21, 0x2a, 0x7c, 0x06, 0x96, 0xc4,
+	0xed, 0x40, 0x22, 0x49, 0x9c, 0xd9, 0xc7, 0x82, 0xe2, 0x9d, 0x84, 0x24, 0x4e, 0x89, 0xa6, 0x92,
+	0xb8, 0x3d, 0xa5, 0x25, 0x41, 0xef, 0x13, 0x5b, 0x86, 0xe5, 0x61, 0x71, 0x37, 0x21, 0xe8, 0x39,
+	0x23, 0x05, 0x91, 0xa0, 0xb7, 0x43, 0x32, 0xfa, 0x08, 0x72, 0xe7, 0xf4, 0x68, 0x7f, 0x37, 0x61,
+	0x79, 0xfc, 0x8b, 0xf1, 0xa1, 0x7f, 0xc4, 0x53, 0xf8, 0x61, 0x09, 0x0a, 0xfc, 0xe2, 0x23, 0xb5,
+	0xa1, 0x12, 0x01, 0xa1, 0x1f, 0x41, 0x09, 0x73, 0x85, 0x2b, 0x0a, 0x3b, 0x99, 0xdd, 0xf2, 0xfe,
+	0x2b, 0x89, 0xbc, 0xf2, 0x04, 0x2b, 0xfd, 0x56, 0x80, 0x02, 0xbf, 0xc8, 0xa0, 0x55, 0x48, 0x07,
+	0xd7, 0xf0, 0xb4, 0x4e, 0xe2, 0xa0, 0xe4, 0x7b, 0xd0, 0x15, 0xd3, 0x3b, 0x99, 0x99, 0x53, 0x3d,
+	0x73, 0xb1, 0xe3, 0x7b, 0x49, 0x9e, 0xe0, 0xd1, 0x13, 0xc8, 0xba, 0x78, 0xf4, 0x9c, 0x5f, 0xc2,
+	0x17, 0xd8, 0x51, 0xa8, 0xf4, 0x1f, 0x01, 0xca, 0xa1, 0xbb, 0x39, 0xda, 0x82, 0xbc, 0xa7, 0x38,
+	0x17, 0xd8, 0xe3, 0x63, 0xe2, 0x12, 0x42, 0x90, 0xf5, 0xee, 0x6c, 0x4c, 0x9f, 0x06, 0x39, 0x99,
+	0x7e, 0xa3, 0x9f, 0x40, 0x99, 0x3c, 0x45, 0x74, 0xd7, 0x23, 0x84, 0xbc, 0xd7, 0x5a, 0x9d, 0x3d,
+	0x59, 0xea, 0xfe, 0x93, 0xa5, 0x7e, 0x68, 0x59, 0xa3, 0x9f, 0x2b, 0xa3, 0x31, 0x96, 0xc3, 0x70,
+	0xb4, 0x0f, 0xf9, 0x4b, 0x5d, 0xd3, 0xb0, 0x29, 0x66, 0x17, 0x1a, 0x72, 0xa4, 0xd4, 0x82, 0xec,
+	0x80, 0xf4, 0xbc, 0x09, 0xd5, 0xc1, 0xb3, 0x5e, 0x6b, 0x78, 0xd6, 0xed, 0xf7, 0x5a, 0x8d, 0xce,
+	0x51, 0xa7, 0xd5, 0xac, 0xa6, 0x50, 0x11, 0xb2, 0xf2, 0xe9, 0xe9, 0x49, 0x55, 0x40, 0x08, 0x56,
+	0x9b, 0x1d, 0xb9, 0xd5, 0x18, 0x0c, 0x4f, 0x5a, 0xfd, 0xfe, 0xc1, 0x71, 0xab, 0x9a, 0x46, 0x25,
+	0xc8, 0x1d, 0xcb, 0xa7, 0x67, 0xbd, 0x6a, 0x46, 0xfa, 0x21, 0xac, 0x84, 0xdf, 0x12, 0xe8, 0x35,
+	0x00, 0xff, 0x1e, 0x1a, 0x2c, 0x46, 0x89, 0x6b, 0x3a, 0x9a, 0xf4, 0xf7, 0x34, 0xac, 0x4f, 0x5d,
+	0xf4, 0x17, 0x18, 0x91, 0x66, 0xff, 0xca, 0xac, 0x6b, 0xd4, 0x6d, 0x25, 0xb9, 0xc4, 0x35, 0x1d,
+	0x0d, 0xed, 0x41, 0x56, 0xb5, 0x34, 0xdf, 0x69, 0xdb, 0x53, 0x73, 0xef, 0x98, 0xde, 0xfb, 0xfb,
+	0x6c, 0xf2, 0x14, 0x88, 0x6a, 0x50, 0x1c, 0xbb, 0xd8, 0x31, 0x15, 0x83, 0x3d, 0x90, 0x4a, 0x72,
+	0x20, 0xa3, 0x4f, 0xa1, 0xcc, 0x12, 0xe0, 0x90, 0x2c, 0x73, 0xf0, 0xee, 0x89, 0x73, 0x0e, 0xfc,
+	0xc7, 0xa5, 0x0c, 0x0c, 0x3e, 0xd0, 0x99, 0x31, 0x3b, 0x4e, 0x98, 0x71, 0x7e, 0xb1, 0x31, 0x83,
+	0x53, 0xe3, 0x4f, 0x00, 0x82, 0x35, 0xf5, 0xc4, 0x42, 0x82, 0xed, 0x64, 0x21, 0x43, 0x68, 0xe9,
+	0x04, 0xd0, 0xf4, 0x3b, 0x67, 0x91, 0x5b, 0x45, 0x28, 0xa8, 0x96, 0x49, 0x7b, 0x63, 0x3e, 0xf5,
+	0x45, 0xc9, 0x84, 0xcd, 0x59, 0x6f, 0x9b, 0x17, 0x5c, 0xa7, 0x50, 0x7f, 0x99, 0x68, 0x7f, 0x83,
+	0x78, 0x7f, 0xfc, 0x16, 0xf6, 0x42, 0xfd, 0x49, 0x7f, 0x14, 0x02, 0xda, 0x68, 0x1e, 0x5c, 0x40,
+	0xfb, 0x3e, 0xe4, 0xc8, 0xc9, 0xb1, 0x64, 0xce, 0x60, 0x58, 0xf4, 0x21, 0xe4, 0x69, 0xba, 0x77,
+	0xc5, 0xcc, 0x32, 0x56, 0x1c, 0x2c, 0x7d, 0x93, 0x81, 0x1c, 0x7d, 0x3a, 0x91, 0xac, 0x40, 0xa3,
+	0x58, 0x60, 0x59, 0x81, 0x7c, 0x13, 0x8f, 0xf9, 0x0f, 0x70, 0xbe, 0x42, 0x5c, 0x44, 0x3f, 0xe5,
+	0xbe, 0xbc, 0xf5, 0x78, 0x7f, 0x8f, 0x67, 0xbf, 0xc8, 0xea, 0x0d, 0x86, 0x6a, 0x99, 0x9e, 0x73,
+	0x27, 0xfb, 0x36, 0xb5, 0x4f, 0x60, 0x25, 0xdc, 0x40, 0x4a, 0x18, 0x57, 0xf8, 0xce, 0x2f, 0x61,
+	0x5c, 0xe1, 0x3b, 0xb4, 0x09, 0xb9, 0x6b, 0x12, 0x66, 0xbc, 0x63, 0x26, 0x7c, 0x92, 0xfe, 0x58,
+	0x90, 0xfe, 0x2b, 0x40, 0xb6, 0x41, 0x46, 0xf7, 0x00, 0xd6, 0xe5, 0xb3, 0xee, 0xa0, 0x73, 0xd2,
+	0x1a, 0xb6, 0xbe, 0x6c, 0xb4, 0x7a, 0x83, 0xce, 0x69, 0xb7, 0x9a, 0x42, 0x22, 0x6c, 0x9e, 0x75,
+	0xe5, 0x56, 0xe3, 0xf4, 0xb8, 0xdb, 0xf9, 0xaa, 0xd5, 0x1c, 0xf6, 0x0e, 0x9e, 0x7d, 0x7e, 0x7a,
+	0xd0, 0xac, 0x0a, 0x68, 0x03, 0xd6, 0x4e, 0x3a, 0xfd, 0x7e, 0xa7, 0x7b, 0x1c, 0x28, 0xd3, 0xa8,
+	0x02, 0xa5, 0xc3, 0x83, 0xe6, 0xb0, 0xd3, 0xed, 0x9d, 0x0d, 0xaa, 0x19, 0x8a, 0x39, 0x18, 0x34,
+	0xda, 0xc3, 0xee, 0xe9, 0x60, 0x78, 0x74, 0x7a, 0xd6, 0x6d, 0x56, 0xb3, 0xe8, 0x21, 0x6c, 0x30,
+	0xe5, 0xd3, 0xd3, 0x4e, 0x77, 0x28, 0xb7, 0x9e, 0xb6, 0x1a, 0x83, 0x56, 0xb3, 0x9a, 0x43, 0x8f,
+	0xa0, 0xe6, 0x0f, 0xe1, 0xe8, 0xac, 0xdb, 0x20, 0x23, 0x08, 0x19, 0xe6, 0x67, 0xb6, 0x4f, 0xc6,
+	0x5a, 0x20, 0xbd, 0xf5, 0x0e, 0xe4, 0xc1, 0xb3, 0x90, 0x51, 0x91, 0xf4, 0xc6, 0x94, 0xd1, 0xde,
+	0x4a, 0xd2, 0x9f, 0xd3, 0x90, 0xa3, 0x77, 0x7d, 0xf4, 0x0a, 0x14, 0xd9, 0x3b, 0x2b, 0x88, 0x9f,
+	0x02, 0x95, 0x3b, 0x1a, 0x7a, 0x13, 0x2a, 0xca, 0xd8, 0xbb, 0xb4, 0x1c, 0xdd, 0x53, 0x3c, 0xfd,
+	0x9a, 0x39, 0xb0, 0x28, 0x47, 0x95, 0x68, 0x1f, 0x72, 0x23, 0xe5, 0x1c, 0x8f, 0x82, 0x22, 0x4f,
+	0x7c, 0x9f, 0xf7, 0x3d, 0x47, 0x37, 0x2f, 0xd8, 0x4e, 0x67, 0x50, 0x12, 0x21, 0xae, 0xfe, 0x2b,
+	0x96, 0xb2, 0x72, 0x32, 0xfd, 0x8e, 0x9e, 0x71, 0xb9, 0xef, 0x78, 0xc6, 0xe5, 0x97, 0x3e, 0xe3,
+	0xd0, 0x3b, 0xb0, 0xe6, 0x60, 0xd5, 0x32, 0x4d, 0xac, 0x7a, 0x43, 0xcf, 0xba, 0xc2, 0x26, 0xcd,
+	0x54, 0x25, 0x79, 0x35, 0x50, 0x0f, 0x88, 0x56, 0xaa, 0x40, 0x39, 0xf4, 0x56, 0x97, 0xbe, 0x11,
+	0xa0, 0x14, 0x3c, 0x9c, 0xe7, 0xb9, 0xef, 0xc7, 0x50, 0xf4, 0x07, 0x28, 0xa6, 0x97, 0x19, 0x57,
+	0x00, 0x47, 0x0f, 0xa1, 0x60, 0xd9, 0xc3, 0xe0, 0x28, 0xc8, 0xc8, 0x79, 0xcb, 0xa6, 0x81, 0x8a,
+	0x20, 0x4b, 0x6f, 0xa4, 0xc4, 0x71, 0x2b, 0x32, 0xfd, 0x26, 0x67, 0x80, 0x83, 0x47, 0xba, 0x72,
+	0x3e, 0x62, 0x49, 0xbe, 0x28, 0x07, 0xb2, 0xf4, 0x17, 0x01, 0x2a, 0x91, 0x57, 0xfe, 0xbc, 0x01,
+	0x87, 0x7a, 0x4d, 0xcf, 0xec, 0x35, 0x13, 0xea, 0x35, 0xb2, 0x5c, 0xd9, 0x7b, 0x2e, 0xd7, 0xbc,
+	0x21, 0xff, 0x3e, 0xcd, 0xfd, 0x4b, 0x6f, 0x1e, 0xdb, 0xf1, 0xe1, 0x92, 0x32, 0xa3, 0x3f, 0xe0,
+	0x2d, 0xc8, 0xb1, 0x85, 0x4b, 0xf3, 0x16, 0x26, 0xa2, 0x77, 0xa7, 0x97, 0x36, 0xc7, 0x11, 0xb1,
+	0xc5, 0x45, 0x4d, 0x28, 0x1a, 0xd8, 0x53, 0xf8, 0xf4, 0xc8, 0x2c, 0x76, 0x93, 0x2b, 0x1c, 0xf5,
+	0x13, 0x0e, 0x65, 0x39, 0x28, 0xb0, 0x44, 0xaf, 0x42, 0xc9, 0xb5, 0xb1, 0xea, 0x29, 0x9e, 0xe5,
+	0xd0, 0xb5, 0x29, 0xca, 0x13, 0x45, 0xed, 0x53, 0xa8, 0x44, 0x0c, 0xef, 0x93, 0xa3, 0x0e, 0xb3,
+	0xe4, 0x2a, 0x28, 0xbd, 0x03, 0x30, 0xa9, 0x94, 0xcc, 0x59, 0x43, 0xe9, 0x0f, 0x02, 0xa0, 0xe9,
+	0x52, 0xc8, 0xbc, 0x55, 0x7f, 0x99, 0x67, 0xc4, 0x3f, 0x72, 0x3c, 0x1c, 0x83, 0x22, 0xca, 0x36,
+	0x94, 0x0c, 0xdd, 0x1c, 0xaa, 0xd6, 0xd8, 0xf4, 0xf8, 0x81, 0x51, 0x34, 0x74, 0xb3, 0x41, 0x64,
+	0xda, 0xa8, 0xdc, 0xf2, 0xc6, 0x34, 0x6f, 0x54, 0x6e, 0x59, 0xe3, 0x26, 0xe4, 0x7e, 0x39, 0xc6,
+	0xce, 0x1d, 0x3f, 0x81, 0x99, 0x80, 0x14, 0x58, 0x77, 0x69, 0xbe, 0x21, 0x2f, 0x03, 0x1b, 0x3b,
+	0x9e, 0x1e, 0x84, 0xe7, 0x07, 0xf3, 0x0b, 0x3c, 0x3c, 0x4f, 0xf5, 0x02, 0x33, 0xb6, 0xc8, 0x55,
+	0x37, 0xa6, 0x46, 0x1a, 0x20, 0x73, 0x6c, 0x60, 0x47, 0x57, 0xc3, 0x7d, 0xb0, 0x8c, 0xf5, 0xe1,
+	0x82, 0x3e, 0xba, 0xcc, 0x30, 0xde, 0xc9, 0xba, 0x19, 0xd7, 0xa3, 0x2f, 0x61, 0xd5, 0xef, 0xc5,
+	0x51, 0xcc, 0x0b, 0xec, 0x8a, 0x79, 0xda, 0xc3, 0x93, 0xe5, 0x7a, 0x90, 0xa9, 0x0d, 0x63, 0xaf,
+	0x98, 0x61, 0x1d, 0x3a, 0x86, 0xb2, 0x83, 0x47, 0xca, 0x2d, 0x2f, 0xde, 0x14, 0x76, 0x32, 0x33,
+	0x8b, 0xaa, 0xe1, 0xe2, 0x92, 0x8f, 0x96, 0xc3, 0x96, 0x24, 0xa8, 0xd8, 0x23, 0x4c, 0xd7, 0x68,
+	0x61, 0xb9, 0x24, 0x17, 0xa8, 0xdc, 0xd1, 0x6a, 0x0d, 0x78, 0x30, 0xd3, 0x9d, 0xf7, 0x09, 0xfd,
+	0x5a, 0x13, 0xb6, 0x66, 0xfb, 0x6b, 0x11, 0x8b, 0x10, 0x66, 0x39, 0x07, 0x34, 0xed, 0x93, 0x19,
+	0x0c, 0x1f, 0x85, 0x19, 0xca, 0xfb, 0x3b, 0xf3, 0x1c, 0x42, 0x88, 0xc2, 0x17, 0x89, 0x7f, 0xe6,
+	0x60, 0x7d, 0xaa, 0xc0, 0x47, 0x5f, 0x4d, 0xac, 0xdc, 0xe6, 0xbf, 0x9a, 0xa8, 0x14, 0xc9, 0x69,
+	0xe9, 0xc4, 0x9c, 0x96, 0x89, 0xe6, 0xb4, 0x63, 0xc8, 0x91, 0x9b, 0xbd, 0x1f, 0xcc, 0x4f, 0x16,
+	0x17, 0x18, 0x43, 0x1a, 0xb2, 0x19, 0x65, 0x66, 0x8f, 0x5a, 0xfc, 0xa8, 0x64, 0xef, 0x81, 0xef,
+	0xc0, 0x43, 0xcd, 0x6b, 0xff, 0xca, 0xc0, 0x6a, 0xb4, 0x21, 0x72, 0xe0, 0x09, 0xf7, 0x3b, 0xf0,
+	0xbc, 0x59, 0xdb, 0x96, 0x6d, 0xa9, 0xe3, 0x7b, 0x8f, 0x70, 0xe9, 0x9d, 0x7c, 0x33, 0x73, 0x27,
+	0xb3, 0x7d, 0xd6, 0xbe, 0x7f, 0xb7, 0xcb, 0x6f, 0xee, 0xf0, 0xce, 0x29, 0xfc, 0xbf, 0xee, 0x1c,
+	0x7e, 0xf4, 0x7c, 0x08, 0x6b, 0xb1, 0xc8, 0x27, 0x24, 0x86, 0x6e, 0x52, 0x12, 0x41, 0x26, 0x9f,
+	0x54, 0xa3, 0xdc, 0x72, 0x0a, 0xf2, 0x29, 0x7d, 0x9b, 0x86, 0xcd, 0x59, 0x29, 0x84, 0xcc, 0xfd,
+	0x46, 0xd1, 0xbd, 0xa1, 0x8b, 0x55, 0x9e, 0xf0, 0x0b, 0x44, 0xee, 0x63, 0x15, 0x7d, 0x1c, 0x3e,
+	0x0c, 0xd2, 0x8b, 0xdf, 0xc0, 0x93, 0x93, 0x62, 0x3f, 0x7c, 0x18, 0x2c, 0xbc, 0x84, 0xb2, 0xa3,
+	0xe2, 0x19, 0xac, 0xdc, 0xe8, 0x1a, 0x36, 0xfd, 0xfc, 0xca, 0x36, 0xd6, 0x47, 0x4b, 0x25, 0xc2,
+	0xfa, 0x17, 0xc4, 0x32, 0x9c, 0x64, 0xcb, 0x37, 0x13, 0x4d, 0xed, 0x33, 0xa8, 0xc6, 0x01, 0xf7,
+	0xf1, 0xbc, 0xf4, 0x1e, 0x54, 0xe3, 0xb5, 0xfd, 0xa4, 0x6c, 0x12, 0xc5, 0xf2, 0x0a, 0x7d, 0x12,
+	0xf6, 0x14, 0x2a, 0x91, 0x9a, 0x3c, 0xfa, 0x2c, 0x5e, 0xca, 0x67, 0x15, 0x2b, 0x31, 0x5c, 0x8c,
+	0x0f, 0x5b, 0xc4, 0x4a, 0xf8, 0xd2, 0xbf, 0x05, 0xc8, 0xd1, 0x12, 0x5b, 0x24, 0xa4, 0x85, 0x48,
+	0x48, 0xb3, 0xcb, 0xc7, 0xed, 0x90, 0xde, 0xf8, 0xd9, 0x29, 0x5e, 0x30, 0x94, 0xdb, 0x3e, 0xb9,
+	0xf4, 0xb3, 0x7b, 0x04, 0xa9, 0x4e, 0x2e, 0x55, 0x9d, 0xe2, 0xe0, 0x17, 0xbb, 0x7c, 0x3e, 0x89,
+	0x24, 0xc0, 0xa5, 0xea, 0x61, 0xbb, 0x50, 0x0e, 0x15, 0x8b, 0x23, 0x13, 0x12, 0x22, 0x13, 0x92,
+	0x7e, 0x27, 0x40, 0x29, 0x28, 0xea, 0xce, 0x73, 0xca, 0x4b, 0x7a, 0x1d, 0x48, 0x5f, 0x40, 0x25,
+	0x52, 0x64, 0x9e, 0x37, 0xa6, 0xfb, 0x3c, 0x00, 0xa4, 0xb7, 0xf9, 0x44, 0xe9, 0x35, 0x3d, 0x99,
+	0x54, 0x52, 0xb9, 0xef, 0x78, 0x49, 0xf9, 0x7b, 0x71, 0x09, 0xb9, 0x1f, 0x4f, 0x8a, 0xd0, 0xf3,
+	0x46, 0x43, 0xee, 0xc7, 0xd3, 0x55, 0xe6, 0x79, 0xa3, 0x7a, 0x99, 0xf7, 0x63, 0x0d, 0x56, 0xc2,
+	0x05, 0xeb, 0xef, 0xc9, 0x59, 0x2d, 0xc8, 0xb3, 0xdf, 0x82, 0xa2, 0xfb, 0x48, 0xb8, 0xdf, 0x3e,
+	0x92, 0xde, 0x85, 0x95, 0xf0, 0x0f, 0x68, 0x64, 0xb0, 0xe4, 0x86, 0x31, 0xd4, 0x35, 0xc6, 0x55,
+	0x92, 0x0b, 0x44, 0xee, 0x68, 0xae, 0xf4, 0x6b, 0x01, 0x36, 0x66, 0xfc, 0x3c, 0xf6, 0x52, 0x7d,
+	0xfb, 0x03, 0x58, 0x8d, 0xfe, 0x96, 0x36, 0x6f, 0xc0, 0x4d, 0x7f, 0x6e, 0xbc, 0x5c, 0xf8, 0x41,
+	0xf0, 0x2b, 0xa4, 0xb0, 0xc4, 0x01, 0xc3, 0xb1, 0xd2, 0x88, 0x38, 0xda, 0xc1, 0x8a, 0x41, 0x36,
+	0x90, 0x11, 0x2a, 0x89, 0x19, 0xbc, 0x24, 0xe6, 0x8e, 0xcf, 0xbf, 0xc6, 0x6a, 0x50, 0xb4, 0xe4,
+	0x22, 0x7a, 0x04, 0xe0, 0x8e, 0xcf, 0x27, 0x55, 0x31, 0xd2, 0x18, 0xd2, 0x90, 0x83, 0x83, 0x95,
+	0x5c, 0x58, 0xc9, 0x97, 0x09, 0xd2, 0x6f, 0x04, 0x80, 0xc9, 0xef, 0x73, 0x68, 0x8f, 0x0c, 0x99,
+	0x48, 0xa2, 0x90, 0xf8, 0xc3, 0x29, 0x69, 0x96, 0x39, 0x8c, 0xf8, 0x95, 0xfc, 0x56, 0x85, 0x9d,
+	0xe5, 0xe2, 0x89, 0x83, 0x23, 0xb9, 0xa1, 0xc4, 0x73, 0xc3, 0x5f, 0xe9, 0x7a, 0x4f, 0xff, 0x92,
+	0x77, 0xef, 0x31, 0xbd, 0xcc, 0x00, 0xf9, 0x56, 0x80, 0x95, 0x70, 0x03, 0x49, 0x87, 0x3c, 0x3e,
+	0xfc, 0x63, 0x94, 0x85, 0x07, 0xa9, 0xba, 0xba, 0xd8, 0x75, 0x75, 0xcb, 0x0c, 0x55, 0x6b, 0xb9,
+	0xa6, 0xa3, 0x45, 0x8a, 0xf2, 0x99, 0x58, 0x51, 0x7e, 0x27, 0xfa, 0xeb, 0x08, 0xab, 0x15, 0x84,
+	0x55, 0xa1, 0x50, 0xcb, 0x2d, 0x1f, 0x6a, 0x87, 0x47, 0xb0, 0xad, 0x5a, 0x46, 0x7d, 0xf2, 0x6f,
+	0xaf, 0x60, 0xce, 0x9e, 0x62, 0xeb, 0x87, 0xab, 0x5d, 0x2a, 0xc9, 0xdc, 0x01, 0x3d, 0xe1, 0xab,
+	0x1c, 0x6d, 0xf8, 0x53, 0x3a, 0xdb, 0xfd, 0x59, 0xef, 0xf0, 0x9b, 0x74, 0x9e, 0x01, 0xce, 0xf3,
+	0xb4, 0x97, 0xf7, 0xff, 0x37, 0x00, 0xd5, 0x9f, 0xa3, 0x3f, 0xab, 0x26, 0x00, 0x00,
 }
@@ -292,6 +292,13 @@ type Initializer interface {
 	// RegisterMatchmakerMatched
 	RegisterMatchmakerMatched(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, entries []MatchmakerEntry) (string, error)) error
 
+	/*
+		RegisterMatchmakerOverride registers a function to decide which matchmaker tickets are grouped into matches, in place of the default matching.
+		The function receives all pending candidates and returns the groupings to form. Party tickets are represented once for each member.
+		Any ticket not included in a returned grouping remains in the matchmaker pool for the next interval.
+	*/
+	RegisterMatchmakerOverride(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, candidates []MatchmakerEntry) ([][]MatchmakerEntry, error)) error
+
 	// RegisterMatch
 	RegisterMatch(name string, fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) (Match, error)) error
 
@@ -304,12 +311,42 @@ type Initializer interface {
 	// RegisterLeaderboardReset
 	RegisterLeaderboardReset(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, leaderboard Leaderboard, reset int64) error) error
 
+	/*
+		RegisterEmailSend registers a function to deliver email verification and password reset messages, in place of the configured SMTP server.
+		The message includes the token the user needs to complete the flow. If the function returns an error the token is discarded.
+	*/
+	RegisterEmailSend(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, message EmailMessage) error) error
+
 	// RegisterBeforeGetAccount is used to register a function invoked when the server receives the relevant request.
 	RegisterBeforeGetAccount(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) error) error
 
 	// RegisterAfterGetAccount is used to register a function invoked after the server processes the relevant request.
 	RegisterAfterGetAccount(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.Account) error) error
 
+	// RegisterBeforeExportAccount is used to register a function invoked when the server receives the relevant request.
+	RegisterBeforeExportAccount(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) error) error
+
+	// RegisterAfterExportAccount is used to register a function invoked after the server processes the relevant request.
+	RegisterAfterExportAccount(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.AccountExportArchive) error) error
+
+	// RegisterBeforeRequestAccountErasure is used to register a function invoked when the server receives the relevant request.
+	RegisterBeforeRequestAccountErasure(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) error) error
+
+	// RegisterAfterRequestAccountErasure is used to register a function invoked after the server processes the relevant request.
+	RegisterAfterRequestAccountErasure(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.AccountErasure) error) error
+
+	// RegisterBeforeSendEmailVerification is used to register a function invoked when the server receives the relevant request.
+	RegisterBeforeSendEmailVerification(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) error) error
+
+	// RegisterAfterSendEmailVerification is used to register a function invoked after the server processes the relevant request.
+	RegisterAfterSendEmailVerification(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) error) error
+
+	// RegisterBeforeCancelAccountErasure is used to register a function invoked when the server receives the relevant request.
+	RegisterBeforeCancelAccountErasure(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) error) error
+
+	// RegisterAfterCancelAccountErasure is used to register a function invoked after the server processes the relevant request.
+	RegisterAfterCancelAccountErasure(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) error) error
+
 	// RegisterBeforeUpdateAccount is used to register a function invoked when the server receives the relevant request.
 	RegisterBeforeUpdateAccount(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.UpdateAccountRequest) (*api.UpdateAccountRequest, error)) error
 
@@ -354,12 +391,54 @@ type Initializer interface {
 	// RegisterAfterAuthenticateGoogle can be used to perform after successful authentication checks.
 	RegisterAfterAuthenticateGoogle(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.Session, in *api.AuthenticateGoogleRequest) error) error
 
+	// RegisterBeforeAuthenticateOidc can be used to perform pre-authentication checks.
+	RegisterBeforeAuthenticateOidc(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AuthenticateOidcRequest) (*api.AuthenticateOidcRequest, error)) error
+
+	// RegisterAfterAuthenticateOidc can be used to perform after successful authentication checks.
+	RegisterAfterAuthenticateOidc(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.Session, in *api.AuthenticateOidcRequest) error) error
+
 	// RegisterBeforeAuthenticateSteam can be used to perform pre-authentication checks.
 	RegisterBeforeAuthenticateSteam(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AuthenticateSteamRequest) (*api.AuthenticateSteamRequest, error)) error
 
 	// RegisterAfterAuthenticateSteam can be used to perform after successful authentication checks.
 	RegisterAfterAuthenticateSteam(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.Session, in *api.AuthenticateSteamRequest) error) error
 
+	// RegisterBeforeAuthenticateApple can be used to perform pre-authentication checks.
+	RegisterBeforeAuthenticateApple(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AuthenticateAppleRequest) (*api.AuthenticateAppleRequest, error)) error
+
+	// RegisterAfterAuthenticateApple can be used to perform after successful authentication checks.
+	RegisterAfterAuthenticateApple(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.Session, in *api.AuthenticateAppleRequest) error) error
+
+	// RegisterBeforeSessionRefresh can be used to perform additional logic before a session is refreshed.
+	RegisterBeforeSessionRefresh(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.SessionRefreshRequest) (*api.SessionRefreshRequest, error)) error
+
+	// RegisterAfterSessionRefresh can be used to perform additional logic after a session is refreshed.
+	RegisterAfterSessionRefresh(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.Session, in *api.SessionRefreshRequest) error) error
+
+	// RegisterBeforeSessionLogout can be used to perform additional logic before a session is logged out.
+	RegisterBeforeSessionLogout(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.SessionLogoutRequest) (*api.SessionLogoutRequest, error)) error
+
+	// RegisterAfterSessionLogout can be used to perform additional logic after a session is logged out.
+	RegisterAfterSessionLogout(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.SessionLogoutRequest) error) error
+
+	// RegisterBeforeResetPassword can be used to perform additional logic before an account password is reset.
+	RegisterBeforeResetPassword(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ResetPasswordRequest) (*api.ResetPasswordRequest, error)) error
+
+	// RegisterAfterResetPassword can be used to perform additional logic after an account password is reset.
+	RegisterAfterResetPassword(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ResetPasswordRequest) error) error
+
+	// RegisterBeforeSendPasswordReset can be used to perform additional logic before a password reset email is sent.
+	RegisterBeforeSendPasswordReset(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.SendPasswordResetRequest) (*api.SendPasswordResetRequest, error)) error
+
+	// RegisterAfterSendPasswordReset can be used to perform additional logic after a password reset email is sent.
+	RegisterAfterSendPasswordReset(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.SendPasswordResetRequest) error) error
+
+	// RegisterBeforeVerifyEmail can be used to perform additional logic before an email address is verified.
+	RegisterBeforeVerifyEmail(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.VerifyEmailRequest) (*api.VerifyEmailRequest, error)) error
+
+	// RegisterAfterVerifyEmail can be used to perform additional logic after an email address is verified.
+	RegisterAfterVerifyEmail(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.VerifyEmailRequest) error) error
+
 	// RegisterBeforeListChannelMessages can be used to perform additional logic before listing messages on a channel.
 	RegisterBeforeListChannelMessages(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ListChannelMessagesRequest) (*api.ListChannelMessagesRequest, error)) error
 
@@ -367,7 +446,7 @@ type Initializer interface {
 	RegisterAfterListChannelMessages(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.ChannelMessageList, in *api.ListChannelMessagesRequest) error) error
 
 	// RegisterBeforeListChannelMessages can be used to perform additional logic before listing friends.
-	RegisterBeforeListFriends(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule) error) error
+	RegisterBeforeListFriends(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ListFriendsRequest) (*api.ListFriendsRequest, error)) error
 
 	// RegisterAfterListFriends can be used to perform additional logic after friends are listed.
 	RegisterAfterListFriends(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.Friends) error) error
@@ -396,6 +475,18 @@ type Initializer interface {
 	// RegisterAfterImportFacebookFriends can be used to perform additional logic after Facebook friends are imported.
 	RegisterAfterImportFacebookFriends(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ImportFacebookFriendsRequest) error) error
 
+	// RegisterBeforeListFriendSuggestions can be used to perform additional logic before listing friend suggestions.
+	RegisterBeforeListFriendSuggestions(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ListFriendSuggestionsRequest) (*api.ListFriendSuggestionsRequest, error)) error
+
+	// RegisterAfterListFriendSuggestions can be used to perform additional logic after friend suggestions are listed.
+	RegisterAfterListFriendSuggestions(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.FriendSuggestionList) error) error
+
+	// RegisterBeforeListMutualFriends can be used to perform additional logic before listing mutual friends.
+	RegisterBeforeListMutualFriends(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ListMutualFriendsRequest) (*api.ListMutualFriendsRequest, error)) error
+
+	// RegisterAfterListMutualFriends can be used to perform additional logic after mutual friends are listed.
+	RegisterAfterListMutualFriends(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.Users) error) error
+
 	// RegisterBeforeCreateGroup can be used to perform additional logic before a group is created.
 	RegisterBeforeCreateGroup(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.CreateGroupRequest) (*api.CreateGroupRequest, error)) error
 
@@ -408,6 +499,24 @@ type Initializer interface {
 	// RegisterAfterUpdateGroup can be used to perform additional logic after a group is updated.
 	RegisterAfterUpdateGroup(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.UpdateGroupRequest) error) error
 
+	// RegisterBeforeValidatePurchaseApple can be used to perform additional logic before validating Apple purchases.
+	RegisterBeforeValidatePurchaseApple(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ValidatePurchaseAppleRequest) (*api.ValidatePurchaseAppleRequest, error)) error
+
+	// RegisterAfterValidatePurchaseApple can be used to perform additional logic after validating Apple purchases.
+	RegisterAfterValidatePurchaseApple(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.ValidatePurchaseResponse, in *api.ValidatePurchaseAppleRequest) error) error
+
+	// RegisterBeforeValidatePurchaseGoogle can be used to perform additional logic before validating Google purchases.
+	RegisterBeforeValidatePurchaseGoogle(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ValidatePurchaseGoogleRequest) (*api.ValidatePurchaseGoogleRequest, error)) error
+
+	// RegisterAfterValidatePurchaseGoogle can be used to perform additional logic after validating Google purchases.
+	RegisterAfterValidatePurchaseGoogle(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.ValidatePurchaseResponse, in *api.ValidatePurchaseGoogleRequest) error) error
+
+	// RegisterBeforeValidatePurchaseHuawei can be used to perform additional logic before validating Huawei purchases.
+	RegisterBeforeValidatePurchaseHuawei(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ValidatePurchaseHuaweiRequest) (*api.ValidatePurchaseHuaweiRequest, error)) error
+
+	// RegisterAfterValidatePurchaseHuawei can be used to perform additional logic after validating Huawei purchases.
+	RegisterAfterValidatePurchaseHuawei(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.ValidatePurchaseResponse, in *api.ValidatePurchaseHuaweiRequest) error) error
+
 	// RegisterBeforeDeleteGroup can be used to perform additional logic before a group is deleted.
 	RegisterBeforeDeleteGroup(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.DeleteGroupRequest) (*api.DeleteGroupRequest, error)) error
 
@@ -438,6 +547,12 @@ type Initializer interface {
 	// RegisterAfterKickGroupUsers can be used to perform additional logic after user is kicked from a group.
 	RegisterAfterKickGroupUsers(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.KickGroupUsersRequest) error) error
 
+	// RegisterBeforeDeclineGroupJoinRequests can be used to perform additional logic before group join requests are declined.
+	RegisterBeforeDeclineGroupJoinRequests(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.DeclineGroupJoinRequestsRequest) (*api.DeclineGroupJoinRequestsRequest, error)) error
+
+	// RegisterAfterDeclineGroupJoinRequests can be used to perform additional logic after group join requests are declined.
+	RegisterAfterDeclineGroupJoinRequests(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.DeclineGroupJoinRequestsRequest) error) error
+
 	// RegisterBeforePromoteGroupUsers can be used to perform additional logic before user is promoted.
 	RegisterBeforePromoteGroupUsers(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.PromoteGroupUsersRequest) (*api.PromoteGroupUsersRequest, error)) error
 
@@ -450,6 +565,12 @@ type Initializer interface {
 	// RegisterAfterListGroupUsers can be used to perform additional logic after users in a group is listed.
 	RegisterAfterListGroupUsers(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.GroupUserList, in *api.ListGroupUsersRequest) error) error
 
+	// RegisterBeforeListGroupJoinRequests can be used to perform additional logic before join requests for a group are listed.
+	RegisterBeforeListGroupJoinRequests(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ListGroupJoinRequestsRequest) (*api.ListGroupJoinRequestsRequest, error)) error
+
+	// RegisterAfterListGroupJoinRequests can be used to perform additional logic after join requests for a group are listed.
+	RegisterAfterListGroupJoinRequests(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.GroupUserList, in *api.ListGroupJoinRequestsRequest) error) error
+
 	// RegisterBeforeListUserGroups can be used to perform additional logic before groups for a user is listed.
 	RegisterBeforeListUserGroups(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ListUserGroupsRequest) (*api.ListUserGroupsRequest, error)) error
 
@@ -522,12 +643,24 @@ type Initializer interface {
 	// RegisterAfterLinkGoogle can be used to perform additional logic after linking Google to an account.
 	RegisterAfterLinkGoogle(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountGoogle) error) error
 
+	// RegisterBeforeLinkOidc can be used to perform additional logic before linking an OpenID Connect identity to an account.
+	RegisterBeforeLinkOidc(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountOidc) (*api.AccountOidc, error)) error
+
+	// RegisterAfterLinkOidc can be used to perform additional logic after linking an OpenID Connect identity to an account.
+	RegisterAfterLinkOidc(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountOidc) error) error
+
 	// RegisterBeforeLinkSteam can be used to perform additional logic before linking Steam to an account.
 	RegisterBeforeLinkSteam(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountSteam) (*api.AccountSteam, error)) error
 
 	// RegisterAfterLinkSteam can be used to perform additional logic after linking Steam to an account.
 	RegisterAfterLinkSteam(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountSteam) error) error
 
+	// RegisterBeforeLinkApple can be used to perform additional logic before linking Apple Sign In to an account.
+	RegisterBeforeLinkApple(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountApple) (*api.AccountApple, error)) error
+
+	// RegisterAfterLinkApple can be used to perform additional logic after linking Apple Sign In to an account.
+	RegisterAfterLinkApple(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountApple) error) error
+
 	// RegisterBeforeListMatches can be used to perform additional logic before listing matches.
 	RegisterBeforeListMatches(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ListMatchesRequest) (*api.ListMatchesRequest, error)) error
 
@@ -540,6 +673,12 @@ type Initializer interface {
 	// RegisterAfterListNotifications can be used to perform additional logic after listing notifications for a user.
 	RegisterAfterListNotifications(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.NotificationList, in *api.ListNotificationsRequest) error) error
 
+	// RegisterBeforeListPurchases can be used to perform additional logic before listing purchases for a user.
+	RegisterBeforeListPurchases(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.ListPurchasesRequest) (*api.ListPurchasesRequest, error)) error
+
+	// RegisterAfterListPurchases can be used to perform additional logic after listing purchases for a user.
+	RegisterAfterListPurchases(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, out *api.PurchaseList, in *api.ListPurchasesRequest) error) error
+
 	// RegisterBeforeDeleteNotification can be used to perform additional logic before deleting notifications.
 	RegisterBeforeDeleteNotification(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.DeleteNotificationsRequest) (*api.DeleteNotificationsRequest, error)) error
 
@@ -636,12 +775,24 @@ type Initializer interface {
 	// RegisterAfterUnlinkGoogle can be used to perform additional logic after Google is unlinked from an account.
 	RegisterAfterUnlinkGoogle(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountGoogle) error) error
 
+	// RegisterBeforeUnlinkOidc can be used to perform additional logic before an OpenID Connect identity is unlinked from an account.
+	RegisterBeforeUnlinkOidc(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountOidc) (*api.AccountOidc, error)) error
+
+	// RegisterAfterUnlinkOidc can be used to perform additional logic after an OpenID Connect identity is unlinked from an account.
+	RegisterAfterUnlinkOidc(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountOidc) error) error
+
 	// RegisterBeforeUnlinkSteam can be used to perform additional logic before Steam is unlinked from an account.
 	RegisterBeforeUnlinkSteam(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountSteam) (*api.AccountSteam, error)) error
 
 	// RegisterAfterUnlinkSteam can be used to perform additional logic after Steam is unlinked from an account.
 	RegisterAfterUnlinkSteam(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountSteam) error) error
 
+	// RegisterBeforeUnlinkApple can be used to perform additional logic before Apple Sign In is unlinked from an account.
+	RegisterBeforeUnlinkApple(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountApple) (*api.AccountApple, error)) error
+
+	// RegisterAfterUnlinkApple can be used to perform additional logic after Apple Sign In is unlinked from an account.
+	RegisterAfterUnlinkApple(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.AccountApple) error) error
+
 	// RegisterBeforeGetUsers can be used to perform additional logic before retrieving users.
 	RegisterBeforeGetUsers(fn func(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, in *api.GetUsersRequest) (*api.GetUsersRequest, error)) error
 
@@ -665,6 +816,16 @@ type Leaderboard interface {
 	GetCreateTime() int64
 }
 
+type EmailMessage interface {
+	// GetType is either "verify" for email verification or "reset" for password reset messages.
+	GetType() string
+	GetUserId() string
+	GetUsername() string
+	GetEmail() string
+	GetToken() string
+	GetExpiry() int64
+}
+
 type PresenceMeta interface {
 	GetHidden() bool
 	GetPersistence() bool
@@ -683,6 +844,18 @@ type MatchmakerEntry interface {
 	GetPresence() Presence
 	GetTicket() string
 	GetProperties() map[string]interface{}
+	GetPartyId() string
+}
+
+type MatchmakerTicket interface {
+	MatchmakerEntry
+	GetPresences() []Presence
+	GetQuery() string
+	GetMinCount() int
+	GetMaxCount() int
+	// GetCreateTime is the time the ticket was submitted, in seconds since the Unix epoch.
+	GetCreateTime() int64
+	GetWaitSec() int64
 }
 
 type MatchData interface {
@@ -690,11 +863,30 @@ type MatchData interface {
 	GetOpCode() int64
 	GetData() []byte
 	GetReceiveTime() int64
+	GetReliable() bool
 }
 
+// MatchAudience selects who receives a match broadcast that is not addressed to specific presences.
+type MatchAudience int
+
+const (
+	// Both match players and spectators.
+	MatchAudienceAll MatchAudience = iota
+	// Only match players.
+	MatchAudiencePlayers
+	// Only match spectators.
+	MatchAudienceSpectators
+)
+
 type MatchDispatcher interface {
 	BroadcastMessage(opCode int64, data []byte, presences []Presence, sender Presence) error
 	BroadcastMessageDeferred(opCode int64, data []byte, presences []Presence, sender Presence) error
+	BroadcastMessageUnreliable(opCode int64, data []byte, presences []Presence, sender Presence) error
+	BroadcastMessageUnreliableDeferred(opCode int64, data []byte, presences []Presence, sender Presence) error
+	BroadcastMessageToAudience(audience MatchAudience, opCode int64, data []byte, sender Presence) error
+	BroadcastMessageToAudienceDeferred(audience MatchAudience, opCode int64, data []byte, sender Presence) error
+	BroadcastMessageToAudienceUnreliable(audience MatchAudience, opCode int64, data []byte, sender Presence) error
+	BroadcastMessageToAudienceUnreliableDeferred(audience MatchAudience, opCode int64, data []byte, sender Presence) error
 	MatchKick(presences []Presence) error
 	MatchLabelUpdate(label string) error
 }
@@ -708,6 +900,67 @@ type Match interface {
 	MatchTerminate(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{}
 }
 
+// MatchSignaler may optionally be implemented by a Match to receive signals sent through the runtime MatchSignal
+// function. MatchSignal returns the state and a result string passed back to the caller. Signals sent to matches that
+// do not implement it are ignored.
+type MatchSignaler interface {
+	MatchSignal(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string)
+}
+
+// MatchSnapshotter may optionally be implemented by a Match to keep its state across a graceful server shutdown.
+// MatchSnapshot is called after MatchTerminate and returns the serialized state, or an empty string to let the match end.
+// Matches are only terminated, and so only snapshotted, when the server is configured with a "shutdown_grace_sec"
+// above 0. With the default of 0 matches are closed immediately on shutdown and their state is lost.
+// MatchRestore is called instead of MatchInit when the match is recreated from a snapshot on the next server to start,
+// and returns the state, tick rate and label in the same way as MatchInit.
+type MatchSnapshotter interface {
+	MatchSnapshot(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}) string
+	MatchRestore(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, tick int64, snapshot string) (interface{}, int, string)
+}
+
+// MatchSpectator may optionally be implemented by a Match to allow spectators. Spectators receive broadcasts sent to
+// all presences or to the spectator audience, but cannot send match data and do not trigger MatchJoin or MatchLeave.
+// Spectator joins are rejected for matches that do not implement it.
+type MatchSpectator interface {
+	MatchSpectatorJoinAttempt(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, presence Presence, metadata map[string]string) (interface{}, bool, string)
+}
+
+// MatchReconnector may optionally be implemented by a Match to hold the places of players who disconnect, so they can
+// reclaim them from a new session using the reconnect token they received when joining. MatchDisconnect is called
+// instead of MatchLeave when players disconnect, and returns the state and how many seconds to hold their places for.
+// Players who do not reconnect in time are passed to MatchLeave as usual, and those that do are passed to
+// MatchReconnect with their new session details. Returning 0 seconds, or not implementing it, makes them leave at once.
+type MatchReconnector interface {
+	MatchDisconnect(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, presences []Presence) (interface{}, int)
+	MatchReconnect(ctx context.Context, logger Logger, db *sql.DB, nk NakamaModule, dispatcher MatchDispatcher, tick int64, state interface{}, presences []Presence) interface{}
+}
+
+// MatchRecording describes a stored recording of an authoritative match, taken when match replay recording is enabled.
+type MatchRecording struct {
+	ID         string
+	MatchID    string
+	Module     string
+	CreateTime int64
+}
+
+// MatchReplayResult summarises a recording being replayed into a fresh instance of the same match.
+type MatchReplayResult struct {
+	Events             int
+	Ticks              int64
+	RecordedBroadcasts int
+	ReplayedBroadcasts int
+	// Tick of the first replayed broadcast that differed from the recording, or -1 if the replay matched it exactly.
+	DivergedTick int64
+}
+
+// AccountMergeOptions choose whose data is kept when both accounts being merged have data in the same place. Each is
+// one of "keep_target" (used when empty), "keep_source" or "keep_newest".
+type AccountMergeOptions struct {
+	Identities  string
+	Storage     string
+	Leaderboard string
+}
+
 type NotificationSend struct {
 	UserID     string
 	Subject    string
@@ -756,12 +1009,14 @@ type StorageDelete struct {
 }
 
 type NakamaModule interface {
+	AuthenticateApple(ctx context.Context, token, username string, create bool) (string, string, bool, error)
 	AuthenticateCustom(ctx context.Context, id, username string, create bool) (string, string, bool, error)
 	AuthenticateDevice(ctx context.Context, id, username string, create bool) (string, string, bool, error)
 	AuthenticateEmail(ctx context.Context, email, password, username string, create bool) (string, string, bool, error)
 	AuthenticateFacebook(ctx context.Context, token string, importFriends bool, username string, create bool) (string, string, bool, error)
 	AuthenticateGameCenter(ctx context.Context, playerID, bundleID string, timestamp int64, salt, signature, publicKeyUrl, username string, create bool) (string, string, bool, error)
 	AuthenticateGoogle(ctx context.Context, token, username string, create bool) (string, string, bool, error)
+	AuthenticateOidc(ctx context.Context, provider, token, username string, create bool) (string, string, bool, error)
 	AuthenticateSteam(ctx context.Context, token, username string, create bool) (string, string, bool, error)
 
 	AuthenticateTokenGenerate(userID, username string, exp int64) (string, int64, error)
@@ -771,6 +1026,7 @@ type NakamaModule interface {
 	AccountUpdateId(ctx context.Context, userID, username string, metadata map[string]interface{}, displayName, timezone, location, langTag, avatarUrl string) error
 
 	AccountDeleteId(ctx context.Context, userID string, recorded bool) error
+	AccountMergeId(ctx context.Context, sourceUserID, targetUserID string, options *AccountMergeOptions) error
 
 	UsersGetId(ctx context.Context, userIDs []string) ([]*api.User, error)
 	UsersGetUsername(ctx context.Context, usernames []string) ([]*api.User, error)
@@ -789,9 +1045,15 @@ type NakamaModule interface {
 	StreamSendRaw(mode uint8, subject, subcontext, label string, msg *rtapi.Envelope, presences []Presence) error
 
 	SessionDisconnect(ctx context.Context, sessionID, node string) error
+	SessionLogout(ctx context.Context, userID, token, refreshToken string) error
 
 	MatchCreate(ctx context.Context, module string, params map[string]interface{}) (string, error)
 	MatchList(ctx context.Context, limit int, authoritative bool, label string, minSize, maxSize int, query string) ([]*api.Match, error)
+	MatchSignal(ctx context.Context, id string, data string) (string, error)
+	MatchRecordingsList(ctx context.Context) ([]*MatchRecording, error)
+	MatchReplay(ctx context.Context, id string) (*MatchReplayResult, error)
+
+	MatchmakerTicketsList(ctx context.Context, limit int) ([]MatchmakerTicket, error)
 
 	NotificationSend(ctx context.Context, userID, subject string, content map[string]interface{}, code int, sender string, persistent bool) error
 	NotificationsSend(ctx context.Context, notifications []*NotificationSend) error
@@ -801,6 +1063,11 @@ type NakamaModule interface {
 	WalletLedgerUpdate(ctx context.Context, itemID string, metadata map[string]interface{}) (WalletLedgerItem, error)
 	WalletLedgerList(ctx context.Context, userID string) ([]WalletLedgerItem, error)
 
+	PurchaseValidateApple(ctx context.Context, userID, receipt string) (*api.ValidatePurchaseResponse, error)
+	PurchaseValidateGoogle(ctx context.Context, userID, purchase string) (*api.ValidatePurchaseResponse, error)
+	PurchaseValidateHuawei(ctx context.Context, userID, purchase, signature string) (*api.ValidatePurchaseResponse, error)
+	PurchasesList(ctx context.Context, userID string, limit int, cursor string) (*api.PurchaseList, error)
+
 	StorageList(ctx context.Context, userID, collection string, limit int, cursor string) ([]*api.StorageObject, string, error)
 	StorageRead(ctx context.Context, reads []*StorageRead) ([]*api.StorageObject, error)
 	StorageWrite(ctx context.Context, writes []*StorageWrite) ([]*api.StorageObjectAck, error)
@@ -827,4 +1094,9 @@ type NakamaModule interface {
 	GroupUsersKick(ctx context.Context, groupID string, userIDs []string) error
 	GroupUsersList(ctx context.Context, id string) ([]*api.GroupUserList_GroupUser, error)
 	UserGroupsList(ctx context.Context, userID string) ([]*api.UserGroupList_UserGroup, error)
+
+	FriendsList(ctx context.Context, userID string, limit int, state *int, cursor string) ([]*api.Friend, string, error)
+	FriendsMutualList(ctx context.Context, userID, otherUserID string, limit int) ([]*api.User, error)
+	FriendSuggestionsList(ctx context.Context, userID string, limit int) ([]*api.FriendSuggestion, error)
+	FriendMetadataUpdate(ctx context.Context, userID, friendUserID string, metadata map[string]interface{}) error
 }
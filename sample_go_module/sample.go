@@ -95,6 +95,14 @@ func (m *Match) MatchJoinAttempt(ctx context.Context, logger runtime.Logger, db
 	return state, true, ""
 }
 
+func (m *Match) MatchSpectatorJoinAttempt(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presence runtime.Presence, metadata map[string]string) (interface{}, bool, string) {
+	if state.(*MatchState).debug {
+		logger.Printf("match spectator join attempt username %v user_id %v session_id %v node %v with metadata %v", presence.GetUsername(), presence.GetUserId(), presence.GetSessionId(), presence.GetNodeId(), metadata)
+	}
+
+	return state, true, ""
+}
+
 func (m *Match) MatchJoin(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
 	if state.(*MatchState).debug {
 		for _, presence := range presences {
@@ -136,6 +144,36 @@ func (m *Match) MatchTerminate(ctx context.Context, logger runtime.Logger, db *s
 	return state
 }
 
+func (m *Match) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	if state.(*MatchState).debug {
+		logger.Printf("match signal match_id %v tick %v", ctx.Value(runtime.RUNTIME_CTX_MATCH_ID), tick)
+		logger.Printf("match signal match_id %v data %v", ctx.Value(runtime.RUNTIME_CTX_MATCH_ID), data)
+	}
+
+	return state, "signal received: " + data
+}
+
+func (m *Match) MatchDisconnect(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) (interface{}, int) {
+	if state.(*MatchState).debug {
+		for _, presence := range presences {
+			logger.Printf("match disconnect username %v user_id %v session_id %v node %v", presence.GetUsername(), presence.GetUserId(), presence.GetSessionId(), presence.GetNodeId())
+		}
+	}
+
+	// Hold places for 30 seconds.
+	return state, 30
+}
+
+func (m *Match) MatchReconnect(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	if state.(*MatchState).debug {
+		for _, presence := range presences {
+			logger.Printf("match reconnect username %v user_id %v session_id %v node %v", presence.GetUsername(), presence.GetUserId(), presence.GetSessionId(), presence.GetNodeId())
+		}
+	}
+
+	return state
+}
+
 func eventSessionStart(ctx context.Context, logger runtime.Logger, evt *api.Event) {
 	logger.Printf("session start %v %v", ctx, evt)
 }
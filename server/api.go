@@ -22,6 +22,7 @@ import (
 	"go.opencensus.io/stats"
 	"go.opencensus.io/tag"
 	"go.opencensus.io/trace"
+	"math"
 	"net"
 	"net/http"
 	"strings"
@@ -71,15 +72,17 @@ type ApiServer struct {
 	socialClient         *social.Client
 	leaderboardCache     LeaderboardCache
 	leaderboardRankCache LeaderboardRankCache
+	sessionCache         SessionCache
 	matchRegistry        MatchRegistry
 	tracker              Tracker
 	router               MessageRouter
 	runtime              *Runtime
+	emailSender          EmailSender
 	grpcServer           *grpc.Server
 	grpcGatewayServer    *http.Server
 }
 
-func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, pipeline *Pipeline, runtime *Runtime) *ApiServer {
+func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, sessionRegistry SessionRegistry, sessionCache SessionCache, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, pipeline *Pipeline, runtime *Runtime) *ApiServer {
 	var gatewayContextTimeoutMs string
 	if config.GetSocket().IdleTimeoutMs > 500 {
 		// Ensure the GRPC Gateway timeout is just under the idle timeout (if possible) to ensure it has priority.
@@ -94,7 +97,7 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, j
 		grpc.StatsHandler(&ocgrpc.ServerHandler{IsPublicEndpoint: true}),
 		grpc.MaxRecvMsgSize(int(config.GetSocket().MaxMessageSizeBytes)),
 		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-			ctx, err := securityInterceptorFunc(logger, config, ctx, req, info)
+			ctx, err := securityInterceptorFunc(logger, config, sessionCache, ctx, req, info)
 			if err != nil {
 				return nil, err
 			}
@@ -113,10 +116,12 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, j
 		socialClient:         socialClient,
 		leaderboardCache:     leaderboardCache,
 		leaderboardRankCache: leaderboardRankCache,
+		sessionCache:         sessionCache,
 		matchRegistry:        matchRegistry,
 		tracker:              tracker,
 		router:               router,
 		runtime:              runtime,
+		emailSender:          NewEmailSender(logger, config, runtime),
 		grpcServer:           grpcServer,
 	}
 
@@ -177,7 +182,7 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, j
 	grpcGatewayRouter := mux.NewRouter()
 	// Special case routes. Do NOT enable compression on WebSocket route, it results in "http: response.Write on hijacked connection" errors.
 	grpcGatewayRouter.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(200) }).Methods("GET")
-	grpcGatewayRouter.HandleFunc("/ws", NewSocketWsAcceptor(logger, config, sessionRegistry, matchmaker, tracker, runtime, jsonpbMarshaler, jsonpbUnmarshaler, pipeline)).Methods("GET")
+	grpcGatewayRouter.HandleFunc("/ws", NewSocketWsAcceptor(logger, config, sessionRegistry, sessionCache, matchmaker, tracker, runtime, jsonpbMarshaler, jsonpbUnmarshaler, pipeline)).Methods("GET")
 
 	// Enable stats recording on all request paths except:
 	// "/" is not tracked at all.
@@ -260,11 +265,13 @@ func (s *ApiServer) Healthcheck(ctx context.Context, in *empty.Empty) (*empty.Em
 	return &empty.Empty{}, nil
 }
 
-func securityInterceptorFunc(logger *zap.Logger, config Config, ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) (context.Context, error) {
+func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache SessionCache, ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) (context.Context, error) {
 	switch info.FullMethod {
 	case "/nakama.api.Nakama/Healthcheck":
 		// Healthcheck has no security.
 		return ctx, nil
+	case "/nakama.api.Nakama/AuthenticateApple":
+		fallthrough
 	case "/nakama.api.Nakama/AuthenticateCustom":
 		fallthrough
 	case "/nakama.api.Nakama/AuthenticateDevice":
@@ -277,7 +284,17 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, ctx context.Cont
 		fallthrough
 	case "/nakama.api.Nakama/AuthenticateGoogle":
 		fallthrough
+	case "/nakama.api.Nakama/AuthenticateOidc":
+		fallthrough
 	case "/nakama.api.Nakama/AuthenticateSteam":
+		fallthrough
+	case "/nakama.api.Nakama/SessionRefresh":
+		fallthrough
+	case "/nakama.api.Nakama/SendPasswordReset":
+		fallthrough
+	case "/nakama.api.Nakama/ResetPassword":
+		fallthrough
+	case "/nakama.api.Nakama/VerifyEmail":
 		// Authentication functions require Server key.
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
@@ -337,11 +354,15 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, ctx context.Cont
 			// Value of "authorization" or "grpc-authorization" was empty or repeated.
 			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
 		}
-		userID, username, exp, ok := parseBearerAuth([]byte(config.GetSession().EncryptionKey), auth[0])
+		userID, username, tokenID, exp, issuedAt, ok := parseBearerAuth([]byte(config.GetSession().EncryptionKey), auth[0])
 		if !ok {
 			// Value of "authorization" or "grpc-authorization" was malformed or expired.
 			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
 		}
+		if sessionCache.IsRevoked(ctx, userID, tokenID, issuedAt, exp) {
+			// Token has been revoked before its expiry.
+			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
+		}
 		ctx = context.WithValue(context.WithValue(context.WithValue(ctx, ctxUserIDKey{}, userID), ctxUsernameKey{}, username), ctxExpiryKey{}, exp)
 	default:
 		// Unless explicitly defined above, handlers require full user authentication.
@@ -362,11 +383,15 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, ctx context.Cont
 			// Value of "authorization" or "grpc-authorization" was empty or repeated.
 			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
 		}
-		userID, username, exp, ok := parseBearerAuth([]byte(config.GetSession().EncryptionKey), auth[0])
+		userID, username, tokenID, exp, issuedAt, ok := parseBearerAuth([]byte(config.GetSession().EncryptionKey), auth[0])
 		if !ok {
 			// Value of "authorization" or "grpc-authorization" was malformed or expired.
 			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
 		}
+		if sessionCache.IsRevoked(ctx, userID, tokenID, issuedAt, exp) {
+			// Token has been revoked before its expiry.
+			return nil, status.Error(codes.Unauthenticated, "Auth token invalid")
+		}
 		ctx = context.WithValue(context.WithValue(context.WithValue(ctx, ctxUserIDKey{}, userID), ctxUsernameKey{}, username), ctxExpiryKey{}, exp)
 	}
 	return context.WithValue(ctx, ctxFullMethodKey{}, info.FullMethod), nil
@@ -392,7 +417,7 @@ func parseBasicAuth(auth string) (username, password string, ok bool) {
 	return cs[:s], cs[s+1:], true
 }
 
-func parseBearerAuth(hmacSecretByte []byte, auth string) (userID uuid.UUID, username string, exp int64, ok bool) {
+func parseBearerAuth(hmacSecretByte []byte, auth string) (userID uuid.UUID, username, tokenID string, exp, issuedAt int64, ok bool) {
 	if auth == "" {
 		return
 	}
@@ -403,7 +428,7 @@ func parseBearerAuth(hmacSecretByte []byte, auth string) (userID uuid.UUID, user
 	return parseToken(hmacSecretByte, string(auth[len(prefix):]))
 }
 
-func parseToken(hmacSecretByte []byte, tokenString string) (userID uuid.UUID, username string, exp int64, ok bool) {
+func parseToken(hmacSecretByte []byte, tokenString string) (userID uuid.UUID, username, tokenID string, exp, issuedAt int64, ok bool) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if s, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || s.Hash != crypto.SHA256 {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -417,11 +442,29 @@ func parseToken(hmacSecretByte []byte, tokenString string) (userID uuid.UUID, us
 	if !ok || !token.Valid {
 		return
 	}
-	userID, err = uuid.FromString(claims["uid"].(string))
+	// Session tokens carry no type, other tokens signed with the same key such as match reconnect tokens are not
+	// accepted in their place.
+	if _, typed := claims["typ"]; typed {
+		return
+	}
+	userIDString, uidOk := claims["uid"].(string)
+	usn, usnOk := claims["usn"].(string)
+	expFloat, expOk := claims["exp"].(float64)
+	if !uidOk || !usnOk || !expOk {
+		return
+	}
+	userID, err = uuid.FromString(userIDString)
 	if err != nil {
 		return
 	}
-	return userID, claims["usn"].(string), int64(claims["exp"].(float64)), true
+	// Tokens issued before revocation was introduced have no ID or issue time, and can only be revoked along with
+	// all other tokens belonging to the same user.
+	tokenID, _ = claims["tid"].(string)
+	if iat, iatOk := claims["iat"].(float64); iatOk {
+		// Issue times are in seconds with millisecond precision.
+		issuedAt = int64(math.Round(iat * 1000))
+	}
+	return userID, usn, tokenID, int64(expFloat), issuedAt, true
 }
 
 func decompressHandler(logger *zap.Logger, h http.Handler) http.HandlerFunc {
@@ -15,6 +15,8 @@
 package server
 
 import (
+	"strings"
+
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/heroiclabs/nakama/api"
@@ -67,6 +69,88 @@ func (s *ApiServer) GetAccount(ctx context.Context, in *empty.Empty) (*api.Accou
 	return user, nil
 }
 
+func (s *ApiServer) ExportAccount(ctx context.Context, in *empty.Empty) (*api.AccountExportArchive, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	// Before hook.
+	if fn := s.runtime.BeforeExportAccount(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			// Empty input never overridden.
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	export, err := ExportAccount(ctx, s.logger, s.db, userID)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := ExportAccountArchive(s.logger, export)
+	if err != nil {
+		return nil, err
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterExportAccount(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, archive)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return archive, nil
+}
+
+func (s *ApiServer) RequestAccountErasure(ctx context.Context, in *empty.Empty) (*api.AccountErasure, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	// Before hook.
+	if fn := s.runtime.BeforeRequestAccountErasure(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			// Empty input never overridden.
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	erasure, err := RequestAccountErasure(ctx, s.logger, s.db, s.config, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterRequestAccountErasure(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, erasure)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return erasure, nil
+}
+
 func (s *ApiServer) UpdateAccount(ctx context.Context, in *api.UpdateAccountRequest) (*empty.Empty, error) {
 	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
 
@@ -120,3 +204,223 @@ func (s *ApiServer) UpdateAccount(ctx context.Context, in *api.UpdateAccountRequ
 
 	return &empty.Empty{}, nil
 }
+
+func (s *ApiServer) SendEmailVerification(ctx context.Context, in *empty.Empty) (*empty.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	// Before hook.
+	if fn := s.runtime.BeforeSendEmailVerification(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			// Empty input never overridden.
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := SendEmailVerification(ctx, s.logger, s.db, s.config, s.emailSender, userID); err != nil {
+		return nil, err
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterSendEmailVerification(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *ApiServer) CancelAccountErasure(ctx context.Context, in *empty.Empty) (*empty.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	// Before hook.
+	if fn := s.runtime.BeforeCancelAccountErasure(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			// Empty input never overridden.
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := CancelAccountErasure(ctx, s.logger, s.db, userID); err != nil {
+		return nil, err
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterCancelAccountErasure(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *ApiServer) VerifyEmail(ctx context.Context, in *api.VerifyEmailRequest) (*empty.Empty, error) {
+	// Before hook.
+	if fn := s.runtime.BeforeVerifyEmail(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, "", "", 0, clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "Verification token is required.")
+	}
+
+	if err := VerifyEmail(ctx, s.logger, s.db, in.Token); err != nil {
+		return nil, err
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterVerifyEmail(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, "", "", 0, clientIP, clientPort, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *ApiServer) SendPasswordReset(ctx context.Context, in *api.SendPasswordResetRequest) (*empty.Empty, error) {
+	// Before hook.
+	if fn := s.runtime.BeforeSendPasswordReset(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, "", "", 0, clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if in.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "Email address is required.")
+	} else if invalidCharsRegex.MatchString(in.Email) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid email address, no spaces or control characters allowed.")
+	} else if !emailRegex.MatchString(in.Email) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid email address format.")
+	} else if len(in.Email) < 10 || len(in.Email) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid email address, must be 10-255 bytes.")
+	}
+
+	if err := SendPasswordReset(ctx, s.logger, s.db, s.config, s.emailSender, strings.ToLower(in.Email)); err != nil {
+		return nil, err
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterSendPasswordReset(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, "", "", 0, clientIP, clientPort, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *ApiServer) ResetPassword(ctx context.Context, in *api.ResetPasswordRequest) (*empty.Empty, error) {
+	// Before hook.
+	if fn := s.runtime.BeforeResetPassword(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, "", "", 0, clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "Password reset token is required.")
+	}
+	if len(in.Password) < 8 {
+		return nil, status.Error(codes.InvalidArgument, "Password must be longer than 8 characters.")
+	}
+
+	if err := ResetPassword(ctx, s.logger, s.db, s.sessionCache, in.Token, in.Password); err != nil {
+		return nil, err
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterResetPassword(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, "", "", 0, clientIP, clientPort, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &empty.Empty{}, nil
+}
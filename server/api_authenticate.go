@@ -34,6 +34,74 @@ var (
 	emailRegex        = regexp.MustCompile("^.+@.+\\..+$")
 )
 
+func (s *ApiServer) AuthenticateApple(ctx context.Context, in *api.AuthenticateAppleRequest) (*api.Session, error) {
+	// Before hook.
+	if fn := s.runtime.BeforeAuthenticateApple(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, "", "", 0, clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.config.GetSocial().Apple.BundleID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "Apple authentication is not configured.")
+	}
+
+	if in.Account == nil || in.Account.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "Apple ID token is required.")
+	}
+
+	username := in.Username
+	if username == "" {
+		username = generateUsername()
+	} else if invalidCharsRegex.MatchString(username) {
+		return nil, status.Error(codes.InvalidArgument, "Username invalid, no spaces or control characters allowed.")
+	} else if len(username) > 128 {
+		return nil, status.Error(codes.InvalidArgument, "Username invalid, must be 1-128 bytes.")
+	}
+
+	create := in.Create == nil || in.Create.Value
+
+	dbUserID, dbUsername, created, err := AuthenticateApple(ctx, s.logger, s.db, s.socialClient, s.config.GetSocial().Apple.BundleID, in.Account.Token, username, create)
+	if err != nil {
+		return nil, err
+	}
+
+	token, exp := generateToken(s.config, dbUserID, dbUsername)
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, dbUsername)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
+
+	// After hook.
+	if fn := s.runtime.AfterAuthenticateApple(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, dbUserID, dbUsername, exp, clientIP, clientPort, session, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return session, nil
+}
+
 func (s *ApiServer) AuthenticateCustom(ctx context.Context, in *api.AuthenticateCustomRequest) (*api.Session, error) {
 	// Before hook.
 	if fn := s.runtime.BeforeAuthenticateCustom(); fn != nil {
@@ -83,7 +151,11 @@ func (s *ApiServer) AuthenticateCustom(ctx context.Context, in *api.Authenticate
 	}
 
 	token, exp := generateToken(s.config, dbUserID, dbUsername)
-	session := &api.Session{Created: created, Token: token}
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, dbUsername)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
 
 	// After hook.
 	if fn := s.runtime.AfterAuthenticateCustom(); fn != nil {
@@ -147,7 +219,11 @@ func (s *ApiServer) AuthenticateDevice(ctx context.Context, in *api.Authenticate
 	}
 
 	token, exp := generateToken(s.config, dbUserID, dbUsername)
-	session := &api.Session{Created: created, Token: token}
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, dbUsername)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
 
 	// After hook.
 	if fn := s.runtime.AfterAuthenticateDevice(); fn != nil {
@@ -241,7 +317,11 @@ func (s *ApiServer) AuthenticateEmail(ctx context.Context, in *api.AuthenticateE
 	}
 
 	token, exp := generateToken(s.config, dbUserID, username)
-	session := &api.Session{Created: created, Token: token}
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, username)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
 
 	// After hook.
 	if fn := s.runtime.AfterAuthenticateEmail(); fn != nil {
@@ -306,7 +386,11 @@ func (s *ApiServer) AuthenticateFacebook(ctx context.Context, in *api.Authentica
 	}
 
 	token, exp := generateToken(s.config, dbUserID, dbUsername)
-	session := &api.Session{Created: created, Token: token}
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, dbUsername)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
 
 	// After hook.
 	if fn := s.runtime.AfterAuthenticateFacebook(); fn != nil {
@@ -378,7 +462,11 @@ func (s *ApiServer) AuthenticateGameCenter(ctx context.Context, in *api.Authenti
 	}
 
 	token, exp := generateToken(s.config, dbUserID, dbUsername)
-	session := &api.Session{Created: created, Token: token}
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, dbUsername)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
 
 	// After hook.
 	if fn := s.runtime.AfterAuthenticateGameCenter(); fn != nil {
@@ -438,7 +526,11 @@ func (s *ApiServer) AuthenticateGoogle(ctx context.Context, in *api.Authenticate
 	}
 
 	token, exp := generateToken(s.config, dbUserID, dbUsername)
-	session := &api.Session{Created: created, Token: token}
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, dbUsername)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
 
 	// After hook.
 	if fn := s.runtime.AfterAuthenticateGoogle(); fn != nil {
@@ -453,6 +545,70 @@ func (s *ApiServer) AuthenticateGoogle(ctx context.Context, in *api.Authenticate
 	return session, nil
 }
 
+func (s *ApiServer) AuthenticateOidc(ctx context.Context, in *api.AuthenticateOidcRequest) (*api.Session, error) {
+	// Before hook.
+	if fn := s.runtime.BeforeAuthenticateOidc(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, "", "", 0, clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if in.Account == nil {
+		return nil, status.Error(codes.InvalidArgument, "OpenID Connect account details are required.")
+	}
+
+	username := in.Username
+	if username == "" {
+		username = generateUsername()
+	} else if invalidCharsRegex.MatchString(username) {
+		return nil, status.Error(codes.InvalidArgument, "Username invalid, no spaces or control characters allowed.")
+	} else if len(username) > 128 {
+		return nil, status.Error(codes.InvalidArgument, "Username invalid, must be 1-128 bytes.")
+	}
+
+	create := in.Create == nil || in.Create.Value
+
+	dbUserID, dbUsername, created, err := AuthenticateOidc(ctx, s.logger, s.db, s.socialClient, s.config, in.Account.Provider, in.Account.Token, username, create)
+	if err != nil {
+		return nil, err
+	}
+
+	token, exp := generateToken(s.config, dbUserID, dbUsername)
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, dbUsername)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
+
+	// After hook.
+	if fn := s.runtime.AfterAuthenticateOidc(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, dbUserID, dbUsername, exp, clientIP, clientPort, session, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return session, nil
+}
+
 func (s *ApiServer) AuthenticateSteam(ctx context.Context, in *api.AuthenticateSteamRequest) (*api.Session, error) {
 	// Before hook.
 	if fn := s.runtime.BeforeAuthenticateSteam(); fn != nil {
@@ -502,7 +658,11 @@ func (s *ApiServer) AuthenticateSteam(ctx context.Context, in *api.AuthenticateS
 	}
 
 	token, exp := generateToken(s.config, dbUserID, dbUsername)
-	session := &api.Session{Created: created, Token: token}
+	refreshToken, err := SessionRefreshTokenCreate(ctx, s.logger, s.db, s.config, dbUserID, dbUsername)
+	if err != nil {
+		return nil, err
+	}
+	session := &api.Session{Created: created, Token: token, RefreshToken: refreshToken}
 
 	// After hook.
 	if fn := s.runtime.AfterAuthenticateSteam(); fn != nil {
@@ -524,8 +684,10 @@ func generateToken(config Config, userID, username string) (string, int64) {
 
 func generateTokenWithExpiry(config Config, userID, username string, exp int64) (string, int64) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"tid": uuid.Must(uuid.NewV4()).String(),
 		"uid": userID,
 		"exp": exp,
+		"iat": float64(time.Now().UTC().UnixNano()/int64(time.Millisecond)) / 1000,
 		"usn": username,
 	})
 	signedToken, _ := token.SignedString([]byte(config.GetSession().EncryptionKey))
@@ -24,17 +24,22 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func (s *ApiServer) ListFriends(ctx context.Context, in *empty.Empty) (*api.Friends, error) {
+func (s *ApiServer) ListFriends(ctx context.Context, in *api.ListFriendsRequest) (*api.Friends, error) {
 	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
 
 	// Before hook.
 	if fn := s.runtime.BeforeListFriends(); fn != nil {
 		beforeFn := func(clientIP, clientPort string) error {
-			err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort)
+			result, err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
 			if err != nil {
 				return status.Error(code, err.Error())
 			}
-			// Empty input never overridden.
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)), zap.String("uid", userID.String()))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
 			return nil
 		}
 
@@ -45,8 +50,25 @@ func (s *ApiServer) ListFriends(ctx context.Context, in *empty.Empty) (*api.Frie
 		}
 	}
 
-	friends, err := GetFriends(ctx, s.logger, s.db, s.tracker, userID)
+	limit := 100
+	if in.GetLimit() != nil {
+		if in.GetLimit().Value < 1 || in.GetLimit().Value > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.GetLimit().Value)
+	}
+
+	if in.GetState() != nil {
+		if state := in.GetState().Value; state < 0 || state > 3 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid state - state must be between 0 and 3.")
+		}
+	}
+
+	friends, err := ListFriends(ctx, s.logger, s.db, s.tracker, userID, limit, in.GetState(), in.GetCursor())
 	if err != nil {
+		if err == ErrFriendInvalidCursor {
+			return nil, status.Error(codes.InvalidArgument, "Cursor is invalid.")
+		}
 		return nil, status.Error(codes.Internal, "Error while trying to list friends.")
 	}
 
@@ -351,3 +373,121 @@ func (s *ApiServer) ImportFacebookFriends(ctx context.Context, in *api.ImportFac
 
 	return &empty.Empty{}, nil
 }
+
+func (s *ApiServer) ListFriendSuggestions(ctx context.Context, in *api.ListFriendSuggestionsRequest) (*api.FriendSuggestionList, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	// Before hook.
+	if fn := s.runtime.BeforeListFriendSuggestions(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)), zap.String("uid", userID.String()))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limit := 100
+	if in.GetLimit() != nil {
+		if in.GetLimit().Value < 1 || in.GetLimit().Value > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.GetLimit().Value)
+	}
+
+	suggestions, err := ListFriendSuggestions(ctx, s.logger, s.db, s.tracker, userID, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while trying to list friend suggestions.")
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterListFriendSuggestions(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, suggestions)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return suggestions, nil
+}
+
+func (s *ApiServer) ListMutualFriends(ctx context.Context, in *api.ListMutualFriendsRequest) (*api.Users, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	// Before hook.
+	if fn := s.runtime.BeforeListMutualFriends(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)), zap.String("uid", userID.String()))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID must be set.")
+	}
+	otherUserID, err := uuid.FromString(in.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "User ID must be a valid ID.")
+	}
+	if otherUserID == userID {
+		return nil, status.Error(codes.InvalidArgument, "Cannot list mutual friends with self.")
+	}
+
+	limit := 100
+	if in.GetLimit() != nil {
+		if in.GetLimit().Value < 1 || in.GetLimit().Value > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid limit - limit must be between 1 and 100.")
+		}
+		limit = int(in.GetLimit().Value)
+	}
+
+	users, err := ListMutualFriends(ctx, s.logger, s.db, s.tracker, userID, otherUserID, limit)
+	if err != nil {
+		if err == ErrAccountNotFound {
+			return nil, status.Error(codes.NotFound, "User not found.")
+		}
+		return nil, status.Error(codes.Internal, "Error while trying to list mutual friends.")
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterListMutualFriends(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, users)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return users, nil
+}
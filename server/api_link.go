@@ -15,6 +15,7 @@
 package server
 
 import (
+	"database/sql"
 	"strconv"
 	"strings"
 
@@ -30,6 +31,79 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+func (s *ApiServer) LinkApple(ctx context.Context, in *api.AccountApple) (*empty.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{})
+
+	// Before hook.
+	if fn := s.runtime.BeforeLinkApple(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, userID.(uuid.UUID).String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)), zap.String("uid", userID.(uuid.UUID).String()))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.config.GetSocial().Apple.BundleID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "Apple authentication is not configured.")
+	}
+
+	if in.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "Apple ID token is required.")
+	}
+
+	appleProfile, err := s.socialClient.CheckAppleToken(ctx, s.config.GetSocial().Apple.BundleID, in.Token)
+	if err != nil {
+		s.logger.Info("Could not authenticate Apple profile.", zap.Error(err))
+		return nil, status.Error(codes.Unauthenticated, "Could not authenticate Apple profile.")
+	}
+
+	res, err := s.db.ExecContext(ctx, `
+UPDATE users
+SET apple_id = $2, update_time = now()
+WHERE (id = $1)
+AND (NOT EXISTS
+    (SELECT id
+     FROM users
+     WHERE apple_id = $2 AND NOT id = $1))`,
+		userID,
+		appleProfile.ID)
+
+	if err != nil {
+		s.logger.Error("Could not link Apple ID.", zap.Error(err), zap.Any("input", in))
+		return nil, status.Error(codes.Internal, "Error while trying to link Apple ID.")
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, status.Error(codes.AlreadyExists, "Apple ID is already in use.")
+	}
+
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), IdentityProviderApple, appleProfile.ID)
+
+	// After hook.
+	if fn := s.runtime.AfterLinkApple(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, userID.(uuid.UUID).String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *ApiServer) LinkCustom(ctx context.Context, in *api.AccountCustom) (*empty.Empty, error) {
 	userID := ctx.Value(ctxUserIDKey{})
 
@@ -83,6 +157,8 @@ AND (NOT EXISTS
 		return nil, status.Error(codes.AlreadyExists, "Custom ID is already in use.")
 	}
 
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), IdentityProviderCustom, customID)
+
 	// After hook.
 	if fn := s.runtime.AfterLinkCustom(); fn != nil {
 		afterFn := func(clientIP, clientPort string) {
@@ -172,6 +248,8 @@ func (s *ApiServer) LinkDevice(ctx context.Context, in *api.AccountDevice) (*emp
 		return nil, status.Error(codes.Internal, "Error linking Device ID.")
 	}
 
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), IdentityProviderDevice, deviceID)
+
 	// After hook.
 	if fn := s.runtime.AfterLinkDevice(); fn != nil {
 		afterFn := func(clientIP, clientPort string) {
@@ -228,7 +306,7 @@ func (s *ApiServer) LinkEmail(ctx context.Context, in *api.AccountEmail) (*empty
 
 	res, err := s.db.ExecContext(ctx, `
 UPDATE users
-SET email = $2, password = $3, update_time = now()
+SET email = $2, password = $3, verify_time = CASE WHEN email = $2 THEN verify_time ELSE '1970-01-01 00:00:00 UTC' END, update_time = now()
 WHERE (id = $1)
 AND (NOT EXISTS
     (SELECT id
@@ -245,6 +323,8 @@ AND (NOT EXISTS
 		return nil, status.Error(codes.AlreadyExists, "Email is already in use.")
 	}
 
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), IdentityProviderEmail, cleanEmail)
+
 	// After hook.
 	if fn := s.runtime.AfterLinkEmail(); fn != nil {
 		afterFn := func(clientIP, clientPort string) {
@@ -317,6 +397,8 @@ AND (NOT EXISTS
 		importFacebookFriends(ctx, s.logger, s.db, s.router, s.socialClient, userID.(uuid.UUID), ctx.Value(ctxUsernameKey{}).(string), in.Account.Token, false)
 	}
 
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), IdentityProviderFacebook, facebookProfile.ID)
+
 	// After hook.
 	if fn := s.runtime.AfterLinkFacebook(); fn != nil {
 		afterFn := func(clientIP, clientPort string) {
@@ -394,6 +476,8 @@ AND (NOT EXISTS
 		return nil, status.Error(codes.AlreadyExists, "GameCenter ID is already in use.")
 	}
 
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), IdentityProviderGameCenter, in.PlayerId)
+
 	// After hook.
 	if fn := s.runtime.AfterLinkGameCenter(); fn != nil {
 		afterFn := func(clientIP, clientPort string) {
@@ -475,6 +559,8 @@ AND (NOT EXISTS
 		return nil, status.Error(codes.AlreadyExists, "Google ID is already in use.")
 	}
 
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), IdentityProviderGoogle, googleProfile.Sub)
+
 	// After hook.
 	if fn := s.runtime.AfterLinkGoogle(); fn != nil {
 		afterFn := func(clientIP, clientPort string) {
@@ -488,6 +574,104 @@ AND (NOT EXISTS
 	return &empty.Empty{}, nil
 }
 
+func (s *ApiServer) LinkOidc(ctx context.Context, in *api.AccountOidc) (*empty.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{})
+
+	// Before hook.
+	if fn := s.runtime.BeforeLinkOidc(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, userID.(uuid.UUID).String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)), zap.String("uid", userID.(uuid.UUID).String()))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	oidcProfile, err := checkOidcToken(ctx, s.logger, s.socialClient, s.config, in.Provider, in.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		s.logger.Error("Could not begin database transaction.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error while trying to link OpenID Connect identity.")
+	}
+
+	err = crdb.ExecuteInTx(ctx, tx, func() error {
+		var dbUserID string
+		err := tx.QueryRowContext(ctx, "SELECT user_id FROM user_identity WHERE provider = $1 AND subject = $2", in.Provider, oidcProfile.Subject).Scan(&dbUserID)
+		if err == nil {
+			if dbUserID != userID.(uuid.UUID).String() {
+				return StatusError(codes.AlreadyExists, "OpenID Connect identity is already in use.", ErrRowsAffectedCount)
+			}
+			// Already linked to this account.
+			return nil
+		} else if err != sql.ErrNoRows {
+			s.logger.Debug("Cannot link OpenID Connect identity.", zap.Error(err), zap.Any("input", in))
+			return err
+		}
+
+		// Each account has at most one identity per provider, linking a new one replaces it.
+		_, err = tx.ExecContext(ctx, "DELETE FROM user_identity WHERE user_id = $1 AND provider = $2", userID, in.Provider)
+		if err != nil {
+			s.logger.Debug("Cannot link OpenID Connect identity.", zap.Error(err), zap.Any("input", in))
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "INSERT INTO user_identity (provider, subject, user_id) VALUES ($1, $2, $3)", in.Provider, oidcProfile.Subject, userID)
+		if err != nil {
+			if e, ok := err.(*pq.Error); ok && e.Code == dbErrorUniqueViolation {
+				return StatusError(codes.AlreadyExists, "OpenID Connect identity is already in use.", err)
+			}
+			s.logger.Debug("Cannot link OpenID Connect identity.", zap.Error(err), zap.Any("input", in))
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE users SET update_time = now() WHERE id = $1", userID)
+		if err != nil {
+			s.logger.Debug("Cannot update users table while linking.", zap.Error(err), zap.Any("input", in))
+			return err
+		}
+		return nil
+	})
+
+	if err != nil {
+		if e, ok := err.(*statusError); ok {
+			return nil, e.Status()
+		}
+		s.logger.Error("Error in database transaction.", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Error while trying to link OpenID Connect identity.")
+	}
+
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), in.Provider, oidcProfile.Subject)
+
+	// After hook.
+	if fn := s.runtime.AfterLinkOidc(); fn != nil {
+		afterFn := func(clientIP, clientPort string) {
+			fn(ctx, s.logger, userID.(uuid.UUID).String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *ApiServer) LinkSteam(ctx context.Context, in *api.AccountSteam) (*empty.Empty, error) {
 	userID := ctx.Value(ctxUserIDKey{})
 
@@ -546,6 +730,8 @@ AND (NOT EXISTS
 		return nil, status.Error(codes.AlreadyExists, "Steam ID is already in use.")
 	}
 
+	identityLinked(ctx, s.logger, s.db, userID.(uuid.UUID).String(), IdentityProviderSteam, strconv.FormatUint(steamProfile.SteamID, 10))
+
 	// After hook.
 	if fn := s.runtime.AfterLinkSteam(); fn != nil {
 		afterFn := func(clientIP, clientPort string) {
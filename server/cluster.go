@@ -0,0 +1,882 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+const (
+	clusterDialTimeout    = 2 * time.Second
+	clusterWriteTimeout   = 5 * time.Second
+	clusterRequestTimeout = 15 * time.Second
+	clusterStopTimeout    = time.Second
+)
+
+var (
+	ErrClusterNodeNotFound  = errors.New("cluster node not found")
+	ErrClusterSendQueueFull = errors.New("cluster send queue full")
+	ErrClusterStopped       = errors.New("cluster stopped")
+	ErrClusterHandshake     = errors.New("cluster handshake failed")
+)
+
+// ClusterMessageKind identifies the purpose of a message exchanged between cluster nodes.
+type ClusterMessageKind uint8
+
+const (
+	ClusterMessageGossip ClusterMessageKind = iota
+	ClusterMessageLeave
+	ClusterMessagePresenceDelta
+	ClusterMessagePresenceVersion
+	ClusterMessagePresenceSync
+	ClusterMessageRoute
+	ClusterMessageSessionDisconnect
+	ClusterMessageMatchJoinAttempt
+	ClusterMessageMatchLabel
+	ClusterMessageMatchData
+	ClusterMessageMatchKick
+	ClusterMessageMatchList
+	ClusterMessageSessionRevoke
+	ClusterMessageMatchSignal
+	ClusterMessageMatchReconnect
+	ClusterMessageHello
+)
+
+// ClusterHandlerFunc processes a message received from another node.
+// The result is returned to the sender if the message was a request, and ignored otherwise.
+type ClusterHandlerFunc func(from string, payload json.RawMessage) (interface{}, error)
+
+// ClusterMember is the gossiped state of a single node.
+// Incarnation changes each time a node starts, heartbeat increases each gossip round while the node is running.
+type ClusterMember struct {
+	Name        string `json:"name"`
+	Address     string `json:"address"`
+	Incarnation int64  `json:"incarnation"`
+	Heartbeat   uint64 `json:"heartbeat"`
+}
+
+func (m *ClusterMember) newerThan(other *ClusterMember) bool {
+	if m.Incarnation != other.Incarnation {
+		return m.Incarnation > other.Incarnation
+	}
+	return m.Heartbeat > other.Heartbeat
+}
+
+type clusterMemberState struct {
+	member  ClusterMember
+	alive   bool
+	updated time.Time
+	// Set when the member announced a graceful leave, so stale gossip about the same incarnation does not revive it.
+	leftIncarnation int64
+}
+
+type clusterMemberEvent struct {
+	name string
+	join bool
+}
+
+type clusterFrame struct {
+	Kind ClusterMessageKind `json:"kind"`
+	From string             `json:"from"`
+	// Non-zero if the sender expects a reply.
+	ID      uint64          `json:"id,omitempty"`
+	Reply   bool            `json:"reply,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Random value chosen by the accepting node for each connection, so frames can't be replayed on another connection.
+	Nonce []byte `json:"nonce,omitempty"`
+	// Position of the frame among those sent in the same direction on its connection, starting at 1.
+	Seq uint64 `json:"seq,omitempty"`
+	// HMAC of all other fields using the shared cluster secret.
+	MAC []byte `json:"mac,omitempty"`
+}
+
+// Cluster maintains membership of the Nakama nodes running together as a cluster through a gossip protocol,
+// and carries messages between them. Each node periodically exchanges its view of the membership with a few
+// random other nodes, a node that stops making progress for long enough is considered failed.
+type Cluster struct {
+	sync.RWMutex
+	logger *zap.Logger
+	config *ClusterConfig
+	name   string
+	secret []byte
+
+	self     ClusterMember
+	members  map[string]*clusterMemberState
+	peers    map[string]*clusterPeer
+	handlers map[ClusterMessageKind]ClusterHandlerFunc
+
+	joinListeners  []func(name string)
+	leaveListeners []func(name string)
+	eventsCh       chan *clusterMemberEvent
+
+	requestID *atomic.Uint64
+	pendingMu sync.Mutex
+	pending   map[uint64]chan *clusterFrame
+
+	listener    net.Listener
+	peersWg     sync.WaitGroup
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func NewCluster(logger *zap.Logger, config Config) *Cluster {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	return &Cluster{
+		logger: logger,
+		config: config.GetCluster(),
+		name:   config.GetName(),
+		secret: []byte(config.GetCluster().Secret),
+
+		self: ClusterMember{
+			Name:        config.GetName(),
+			Address:     fmt.Sprintf("%v:%v", config.GetCluster().GossipAddress, config.GetCluster().GossipPort),
+			Incarnation: time.Now().UTC().UnixNano(),
+		},
+		members:  make(map[string]*clusterMemberState),
+		peers:    make(map[string]*clusterPeer),
+		handlers: make(map[ClusterMessageKind]ClusterHandlerFunc),
+
+		eventsCh: make(chan *clusterMemberEvent, 64),
+
+		requestID: atomic.NewUint64(0),
+		pending:   make(map[uint64]chan *clusterFrame),
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+}
+
+// Name of the current node.
+func (c *Cluster) Name() string {
+	return c.name
+}
+
+// SetHandler registers the handler for a message kind. Messages of that kind received before it is set are ignored.
+func (c *Cluster) SetHandler(kind ClusterMessageKind, fn ClusterHandlerFunc) {
+	c.Lock()
+	c.handlers[kind] = fn
+	c.Unlock()
+}
+
+// AddMemberListener registers callbacks for other nodes joining or leaving the cluster. Must be called before Start.
+// Callbacks are invoked sequentially and in the order membership changes are observed.
+func (c *Cluster) AddMemberListener(join func(name string), leave func(name string)) {
+	c.joinListeners = append(c.joinListeners, join)
+	c.leaveListeners = append(c.leaveListeners, leave)
+}
+
+// Start accepting connections from other nodes, and begin gossiping with any configured nodes to join.
+func (c *Cluster) Start() error {
+	listener, err := net.Listen("tcp", c.self.Address)
+	if err != nil {
+		return err
+	}
+	c.listener = listener
+
+	c.SetHandler(ClusterMessageGossip, c.handleGossip)
+	c.SetHandler(ClusterMessageLeave, c.handleLeave)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				select {
+				case <-c.ctx.Done():
+				default:
+					c.logger.Error("Error accepting cluster connection", zap.Error(err))
+				}
+				return
+			}
+			go c.serve(conn)
+		}
+	}()
+
+	go func() {
+		// Deliver membership events in the order they were observed.
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case e := <-c.eventsCh:
+				if e.join {
+					c.logger.Info("Cluster node joined", zap.String("node", e.name))
+					for _, fn := range c.joinListeners {
+						fn(e.name)
+					}
+				} else {
+					c.logger.Info("Cluster node left", zap.String("node", e.name))
+					c.prunePeer(e.name)
+					for _, fn := range c.leaveListeners {
+						fn(e.name)
+					}
+				}
+			}
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(c.config.GossipIntervalMs) * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-ticker.C:
+				c.gossip()
+			}
+		}
+	}()
+
+	// Start joining right away rather than waiting for the first gossip round.
+	c.gossip()
+
+	return nil
+}
+
+// Stop announces that this node is leaving the cluster, and closes all connections.
+func (c *Cluster) Stop() {
+	c.RLock()
+	self := c.self
+	c.RUnlock()
+	c.Broadcast(ClusterMessageLeave, &self)
+
+	c.ctxCancelFn()
+	if c.listener != nil {
+		c.listener.Close()
+	}
+
+	// Give peer connections a chance to flush any pending messages, including the leave announcement.
+	doneCh := make(chan struct{})
+	go func() {
+		c.peersWg.Wait()
+		close(doneCh)
+	}()
+	select {
+	case <-doneCh:
+	case <-time.After(clusterStopTimeout):
+	}
+}
+
+// Members returns the names of all other nodes currently considered alive.
+func (c *Cluster) Members() []string {
+	c.RLock()
+	names := make([]string, 0, len(c.members))
+	for name, state := range c.members {
+		if state.alive {
+			names = append(names, name)
+		}
+	}
+	c.RUnlock()
+	return names
+}
+
+// Send a message to another node without waiting for any result.
+// Messages to the same node are delivered in the order they were sent, but may be dropped if the node is unreachable.
+func (c *Cluster) Send(node string, kind ClusterMessageKind, payload interface{}) error {
+	address, err := c.address(node)
+	if err != nil {
+		return err
+	}
+	frame, err := c.frame(kind, payload)
+	if err != nil {
+		return err
+	}
+	return c.peer(address).send(frame)
+}
+
+// Broadcast a message to all other nodes currently considered alive.
+func (c *Cluster) Broadcast(kind ClusterMessageKind, payload interface{}) {
+	frame, err := c.frame(kind, payload)
+	if err != nil {
+		c.logger.Error("Error encoding cluster broadcast", zap.Uint8("kind", uint8(kind)), zap.Error(err))
+		return
+	}
+
+	c.RLock()
+	addresses := make([]string, 0, len(c.members))
+	for _, state := range c.members {
+		if state.alive {
+			addresses = append(addresses, state.member.Address)
+		}
+	}
+	c.RUnlock()
+
+	for _, address := range addresses {
+		if err := c.peer(address).send(frame); err != nil {
+			c.logger.Warn("Error sending cluster broadcast", zap.String("address", address), zap.Error(err))
+		}
+	}
+}
+
+// Request sends a message to another node and waits for its result, which is decoded into result if not nil.
+func (c *Cluster) Request(ctx context.Context, node string, kind ClusterMessageKind, payload interface{}, result interface{}) error {
+	address, err := c.address(node)
+	if err != nil {
+		return err
+	}
+	return c.request(ctx, address, kind, payload, result)
+}
+
+func (c *Cluster) request(ctx context.Context, address string, kind ClusterMessageKind, payload interface{}, result interface{}) error {
+	frame, err := c.frame(kind, payload)
+	if err != nil {
+		return err
+	}
+	frame.ID = c.requestID.Inc()
+
+	replyCh := make(chan *clusterFrame, 1)
+	c.pendingMu.Lock()
+	c.pending[frame.ID] = replyCh
+	c.pendingMu.Unlock()
+	defer func() {
+		c.pendingMu.Lock()
+		delete(c.pending, frame.ID)
+		c.pendingMu.Unlock()
+	}()
+
+	if err := c.peer(address).send(frame); err != nil {
+		return err
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var ctxCancelFn context.CancelFunc
+		ctx, ctxCancelFn = context.WithTimeout(ctx, clusterRequestTimeout)
+		defer ctxCancelFn()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.ctx.Done():
+		return ErrClusterStopped
+	case reply := <-replyCh:
+		if reply.Error != "" {
+			return errors.New(reply.Error)
+		}
+		if result != nil && len(reply.Payload) != 0 {
+			return json.Unmarshal(reply.Payload, result)
+		}
+		return nil
+	}
+}
+
+func (c *Cluster) reply(frame *clusterFrame) {
+	c.pendingMu.Lock()
+	replyCh := c.pending[frame.ID]
+	c.pendingMu.Unlock()
+	if replyCh != nil {
+		// Buffered, and only ever a single reply per request.
+		replyCh <- frame
+	}
+}
+
+func (c *Cluster) frame(kind ClusterMessageKind, payload interface{}) (*clusterFrame, error) {
+	frame := &clusterFrame{Kind: kind, From: c.name}
+	if payload != nil {
+		bytes, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		frame.Payload = bytes
+	}
+	// Signed when written to a connection, once the connection nonce and sequence number are known.
+	return frame, nil
+}
+
+// Sign a frame so other nodes can verify it was sent by a node that knows the cluster secret.
+func (c *Cluster) sign(frame *clusterFrame) {
+	frame.MAC = c.mac(frame)
+}
+
+func (c *Cluster) verify(frame *clusterFrame) bool {
+	return len(frame.MAC) != 0 && hmac.Equal(frame.MAC, c.mac(frame))
+}
+
+func (c *Cluster) mac(frame *clusterFrame) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	// Length prefix variable size fields so their boundaries can't be shifted between fields.
+	field := func(b []byte) {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(b)))
+		mac.Write(length[:])
+		mac.Write(b)
+	}
+	var header [18]byte
+	header[0] = byte(frame.Kind)
+	binary.BigEndian.PutUint64(header[1:9], frame.ID)
+	if frame.Reply {
+		header[9] = 1
+	}
+	binary.BigEndian.PutUint64(header[10:18], frame.Seq)
+	mac.Write(header[:])
+	field([]byte(frame.From))
+	field([]byte(frame.Error))
+	field(frame.Payload)
+	field(frame.Nonce)
+	return mac.Sum(nil)
+}
+
+func (c *Cluster) address(node string) (string, error) {
+	c.RLock()
+	defer c.RUnlock()
+	state, ok := c.members[node]
+	if !ok || !state.alive {
+		return "", ErrClusterNodeNotFound
+	}
+	return state.member.Address, nil
+}
+
+func (c *Cluster) peer(address string) *clusterPeer {
+	c.Lock()
+	p, ok := c.peers[address]
+	if !ok {
+		p = &clusterPeer{
+			cluster: c,
+			address: address,
+			sendCh:  make(chan *clusterFrame, c.config.SendQueueSize),
+			stopCh:  make(chan struct{}),
+		}
+		c.peers[address] = p
+		c.peersWg.Add(1)
+		go p.run()
+	}
+	c.Unlock()
+	return p
+}
+
+// Close the connection to a node that has left, unless it has rejoined or another node now uses its address.
+func (c *Cluster) prunePeer(name string) {
+	c.Lock()
+	state, ok := c.members[name]
+	if !ok || state.alive {
+		c.Unlock()
+		return
+	}
+	address := state.member.Address
+	for _, other := range c.members {
+		if other.alive && other.member.Address == address {
+			c.Unlock()
+			return
+		}
+	}
+	p, ok := c.peers[address]
+	if ok {
+		delete(c.peers, address)
+	}
+	c.Unlock()
+
+	if ok {
+		close(p.stopCh)
+	}
+}
+
+// Handle an inbound connection from another node.
+func (c *Cluster) serve(conn net.Conn) {
+	go func() {
+		// Unblock the read loop when the cluster stops.
+		<-c.ctx.Done()
+		conn.Close()
+	}()
+	defer conn.Close()
+
+	// Every frame sent on this connection must carry its nonce, so frames captured from other connections are rejected.
+	nonce := make([]byte, 16)
+	if _, err := crand.Read(nonce); err != nil {
+		c.logger.Error("Error generating cluster connection nonce", zap.Error(err))
+		return
+	}
+
+	var encoderMu sync.Mutex
+	encoder := json.NewEncoder(conn)
+	decoder := json.NewDecoder(conn)
+
+	hello := &clusterFrame{Kind: ClusterMessageHello, From: c.name, Nonce: nonce}
+	c.sign(hello)
+	conn.SetWriteDeadline(time.Now().Add(clusterWriteTimeout))
+	if err := encoder.Encode(hello); err != nil {
+		return
+	}
+
+	// Sequence numbers of the last frame received, and of the last reply sent, guarded by the encoder lock.
+	var recvSeq, sendSeq uint64
+	for {
+		frame := &clusterFrame{}
+		if err := decoder.Decode(frame); err != nil {
+			return
+		}
+		if !c.verify(frame) {
+			// Not sent by a node that knows the cluster secret, drop the connection without handling anything on it.
+			c.logger.Warn("Rejected cluster connection sending message with invalid signature", zap.String("address", conn.RemoteAddr().String()), zap.String("from", frame.From))
+			return
+		}
+		if !bytes.Equal(frame.Nonce, nonce) || frame.Seq != recvSeq+1 {
+			// A genuine frame, but replayed from another connection or repeated on this one.
+			c.logger.Warn("Rejected cluster connection sending replayed message", zap.String("address", conn.RemoteAddr().String()), zap.String("from", frame.From))
+			return
+		}
+		recvSeq = frame.Seq
+
+		c.RLock()
+		handler, ok := c.handlers[frame.Kind]
+		c.RUnlock()
+		if !ok {
+			c.logger.Warn("No handler for cluster message", zap.Uint8("kind", uint8(frame.Kind)), zap.String("from", frame.From))
+			continue
+		}
+
+		if frame.ID == 0 {
+			// Messages that expect no reply are handled in order, so changes such as presence updates are applied in sequence.
+			if _, err := handler(frame.From, frame.Payload); err != nil {
+				c.logger.Warn("Error handling cluster message", zap.Uint8("kind", uint8(frame.Kind)), zap.String("from", frame.From), zap.Error(err))
+			}
+			continue
+		}
+
+		// Requests may take a while to process, so handle them concurrently.
+		go func(frame *clusterFrame) {
+			reply := &clusterFrame{Kind: frame.Kind, From: c.name, ID: frame.ID, Reply: true}
+			if result, err := handler(frame.From, frame.Payload); err != nil {
+				reply.Error = err.Error()
+			} else if result != nil {
+				if reply.Payload, err = json.Marshal(result); err != nil {
+					reply.Error = err.Error()
+				}
+			}
+
+			encoderMu.Lock()
+			sendSeq++
+			reply.Nonce = nonce
+			reply.Seq = sendSeq
+			c.sign(reply)
+			conn.SetWriteDeadline(time.Now().Add(clusterWriteTimeout))
+			err := encoder.Encode(reply)
+			encoderMu.Unlock()
+			if err != nil {
+				c.logger.Debug("Error replying to cluster request", zap.String("from", frame.From), zap.Error(err))
+			}
+		}(frame)
+	}
+}
+
+func (c *Cluster) gossip() {
+	now := time.Now()
+	failureTimeout := time.Duration(c.config.FailureTimeoutMs) * time.Millisecond
+
+	var events []*clusterMemberEvent
+	c.Lock()
+	c.self.Heartbeat++
+
+	// Detect nodes that have stopped making progress.
+	alive := make([]string, 0, len(c.members))
+	for name, state := range c.members {
+		if !state.alive {
+			continue
+		}
+		if now.Sub(state.updated) > failureTimeout {
+			state.alive = false
+			c.logger.Warn("Cluster node failed", zap.String("node", name))
+			events = append(events, &clusterMemberEvent{name: name})
+			continue
+		}
+		alive = append(alive, state.member.Address)
+	}
+
+	var targets []string
+	if len(alive) == 0 {
+		// Not connected to anyone yet, or everyone else has gone away. Try the nodes configured to join through.
+		targets = make([]string, 0, len(c.config.Join))
+		for _, address := range c.config.Join {
+			if address != c.self.Address {
+				targets = append(targets, address)
+			}
+		}
+	} else {
+		rand.Shuffle(len(alive), func(i, j int) {
+			alive[i], alive[j] = alive[j], alive[i]
+		})
+		if len(alive) > c.config.GossipFanout {
+			alive = alive[:c.config.GossipFanout]
+		}
+		targets = alive
+	}
+	c.Unlock()
+	c.queueEvents(events)
+
+	members := c.snapshot()
+	for _, address := range targets {
+		go func(address string) {
+			ctx, ctxCancelFn := context.WithTimeout(c.ctx, failureTimeout)
+			defer ctxCancelFn()
+			var reply []*ClusterMember
+			if err := c.request(ctx, address, ClusterMessageGossip, members, &reply); err != nil {
+				c.logger.Debug("Error exchanging cluster gossip", zap.String("address", address), zap.Error(err))
+				return
+			}
+			c.merge(reply)
+		}(address)
+	}
+}
+
+// Current node's view of the membership, including itself.
+func (c *Cluster) snapshot() []*ClusterMember {
+	c.RLock()
+	members := make([]*ClusterMember, 0, len(c.members)+1)
+	self := c.self
+	members = append(members, &self)
+	for _, state := range c.members {
+		if state.alive {
+			member := state.member
+			members = append(members, &member)
+		}
+	}
+	c.RUnlock()
+	return members
+}
+
+func (c *Cluster) merge(members []*ClusterMember) {
+	now := time.Now()
+
+	var events []*clusterMemberEvent
+	c.Lock()
+	for _, member := range members {
+		if member.Name == c.name {
+			if member.Address != c.self.Address {
+				c.logger.Warn("Another cluster node is using the same name", zap.String("node", member.Name), zap.String("address", member.Address))
+			}
+			continue
+		}
+
+		state, ok := c.members[member.Name]
+		if !ok {
+			c.members[member.Name] = &clusterMemberState{member: *member, alive: true, updated: now}
+			events = append(events, &clusterMemberEvent{name: member.Name, join: true})
+			continue
+		}
+		if !member.newerThan(&state.member) || member.Incarnation == state.leftIncarnation {
+			continue
+		}
+
+		restarted := member.Incarnation != state.member.Incarnation
+		state.member = *member
+		state.updated = now
+		if !state.alive {
+			state.alive = true
+			events = append(events, &clusterMemberEvent{name: member.Name, join: true})
+		} else if restarted {
+			// The node restarted before it was detected as failed, anything known about its previous run is gone.
+			events = append(events, &clusterMemberEvent{name: member.Name}, &clusterMemberEvent{name: member.Name, join: true})
+		}
+	}
+	c.Unlock()
+	c.queueEvents(events)
+}
+
+// Must not be called while holding the cluster lock, as listeners may need it to make progress.
+func (c *Cluster) queueEvents(events []*clusterMemberEvent) {
+	for _, e := range events {
+		select {
+		case c.eventsCh <- e:
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Cluster) handleGossip(from string, payload json.RawMessage) (interface{}, error) {
+	var members []*ClusterMember
+	if err := json.Unmarshal(payload, &members); err != nil {
+		return nil, err
+	}
+	c.merge(members)
+	return c.snapshot(), nil
+}
+
+func (c *Cluster) handleLeave(from string, payload json.RawMessage) (interface{}, error) {
+	var member ClusterMember
+	if err := json.Unmarshal(payload, &member); err != nil {
+		return nil, err
+	}
+
+	var events []*clusterMemberEvent
+	c.Lock()
+	if state, ok := c.members[member.Name]; ok && state.member.Incarnation == member.Incarnation {
+		state.leftIncarnation = member.Incarnation
+		if state.alive {
+			state.alive = false
+			events = append(events, &clusterMemberEvent{name: member.Name})
+		}
+	}
+	c.Unlock()
+	c.queueEvents(events)
+	return nil, nil
+}
+
+// An outbound connection to another node, messages are written in the order they are queued.
+type clusterPeer struct {
+	cluster *Cluster
+	address string
+	sendCh  chan *clusterFrame
+	// Closed when the node at this address has left the cluster.
+	stopCh chan struct{}
+}
+
+func (p *clusterPeer) send(frame *clusterFrame) error {
+	select {
+	case p.sendCh <- frame:
+		return nil
+	default:
+		return ErrClusterSendQueueFull
+	}
+}
+
+func (p *clusterPeer) run() {
+	defer p.cluster.peersWg.Done()
+
+	var conn net.Conn
+	var encoder *json.Encoder
+	var nonce []byte
+	var seq uint64
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+
+	write := func(frame *clusterFrame) {
+		if conn == nil {
+			var err error
+			if conn, err = net.DialTimeout("tcp", p.address, clusterDialTimeout); err != nil {
+				conn = nil
+				p.fail(frame, err)
+				return
+			}
+			decoder := json.NewDecoder(conn)
+			if nonce, err = p.handshake(conn, decoder); err != nil {
+				conn.Close()
+				conn = nil
+				p.fail(frame, err)
+				return
+			}
+			seq = 0
+			encoder = json.NewEncoder(conn)
+			go p.read(conn, decoder, nonce)
+		}
+
+		// The same frame may be broadcast to many peers, so sign a copy bound to this connection.
+		seq++
+		signed := *frame
+		signed.Nonce = nonce
+		signed.Seq = seq
+		p.cluster.sign(&signed)
+
+		conn.SetWriteDeadline(time.Now().Add(clusterWriteTimeout))
+		if err := encoder.Encode(&signed); err != nil {
+			conn.Close()
+			conn = nil
+			p.fail(frame, err)
+		}
+	}
+
+	for {
+		select {
+		case <-p.cluster.ctx.Done():
+			// Flush anything still queued, such as a leave announcement, then stop.
+			for {
+				select {
+				case frame := <-p.sendCh:
+					write(frame)
+				default:
+					return
+				}
+			}
+		case <-p.stopCh:
+			// The node has left, fail anything still queued for it rather than trying to deliver it.
+			for {
+				select {
+				case frame := <-p.sendCh:
+					p.fail(frame, ErrClusterNodeNotFound)
+				default:
+					return
+				}
+			}
+		case frame := <-p.sendCh:
+			write(frame)
+		}
+	}
+}
+
+// Wait for the accepting node to send the nonce that all frames on a new connection must carry.
+func (p *clusterPeer) handshake(conn net.Conn, decoder *json.Decoder) ([]byte, error) {
+	conn.SetReadDeadline(time.Now().Add(clusterDialTimeout))
+	hello := &clusterFrame{}
+	if err := decoder.Decode(hello); err != nil {
+		return nil, err
+	}
+	if hello.Kind != ClusterMessageHello || len(hello.Nonce) == 0 || !p.cluster.verify(hello) {
+		return nil, ErrClusterHandshake
+	}
+	conn.SetReadDeadline(time.Time{})
+	return hello.Nonce, nil
+}
+
+// Read replies to requests sent over this connection.
+func (p *clusterPeer) read(conn net.Conn, decoder *json.Decoder, nonce []byte) {
+	var seq uint64
+	for {
+		frame := &clusterFrame{}
+		if err := decoder.Decode(frame); err != nil {
+			return
+		}
+		if !p.cluster.verify(frame) {
+			p.cluster.logger.Warn("Rejected cluster reply with invalid signature", zap.String("address", p.address), zap.String("from", frame.From))
+			conn.Close()
+			return
+		}
+		if !bytes.Equal(frame.Nonce, nonce) || frame.Seq != seq+1 {
+			p.cluster.logger.Warn("Rejected replayed cluster reply", zap.String("address", p.address), zap.String("from", frame.From))
+			conn.Close()
+			return
+		}
+		seq = frame.Seq
+		if frame.Reply {
+			p.cluster.reply(frame)
+		}
+	}
+}
+
+func (p *clusterPeer) fail(frame *clusterFrame, err error) {
+	p.cluster.logger.Debug("Error sending cluster message", zap.String("address", p.address), zap.Error(err))
+	if frame.ID != 0 {
+		// Fail the request right away rather than waiting for it to time out.
+		p.cluster.reply(&clusterFrame{Kind: frame.Kind, ID: frame.ID, Reply: true, Error: err.Error()})
+	}
+}
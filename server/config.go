@@ -46,9 +46,14 @@ type Config interface {
 	GetGroups() * GroupsConfig
 	GetRuntime() *RuntimeConfig
 	GetMatch() *MatchConfig
+	GetMatchmaker() *MatchmakerConfig
 	GetTracker() *TrackerConfig
+	GetCluster() *ClusterConfig
 	GetConsole() *ConsoleConfig
 	GetLeaderboard() *LeaderboardConfig
+	GetIAP() *IAPConfig
+	GetEmail() *EmailConfig
+	GetErasure() *ErasureConfig
 
 	Clone() (Config, error)
 }
@@ -123,6 +128,15 @@ func CheckConfig(logger *zap.Logger, config Config) map[string]string {
 	if config.GetSession().EncryptionKey == "" {
 		logger.Fatal("Encryption key must be set", zap.String("param", "session.encryption_key"))
 	}
+	if config.GetSession().RefreshEncryptionKey == "" {
+		logger.Fatal("Refresh encryption key must be set", zap.String("param", "session.refresh_encryption_key"))
+	}
+	if config.GetSession().RefreshEncryptionKey == config.GetSession().EncryptionKey {
+		logger.Fatal("Refresh encryption key must be different from encryption key", zap.String("param", "session.refresh_encryption_key"))
+	}
+	if config.GetSession().RefreshTokenExpirySec < 1 {
+		logger.Fatal("Refresh token expiry seconds must be >= 1", zap.Int64("session.refresh_token_expiry_sec", config.GetSession().RefreshTokenExpirySec))
+	}
 	if config.GetRuntime().HTTPKey == "" {
 		logger.Fatal("Runtime HTTP key must be set", zap.String("param", "runtime.http_key"))
 	}
@@ -165,6 +179,15 @@ func CheckConfig(logger *zap.Logger, config Config) map[string]string {
 	if config.GetSocket().PingPeriodMs >= config.GetSocket().PongWaitMs {
 		logger.Fatal("Ping period value must be less than pong wait value", zap.Int("socket.ping_period_ms", config.GetSocket().PingPeriodMs), zap.Int("socket.pong_wait_ms", config.GetSocket().PongWaitMs))
 	}
+	if config.GetSocket().UdpPort < 0 {
+		logger.Fatal("Socket UDP port must be >= 0", zap.Int("socket.udp_port", config.GetSocket().UdpPort))
+	}
+	if config.GetSocket().UdpResendIntervalMs < 1 {
+		logger.Fatal("Socket UDP resend interval milliseconds must be >= 1", zap.Int("socket.udp_resend_interval_ms", config.GetSocket().UdpResendIntervalMs))
+	}
+	if config.GetSocket().UdpMaxResends < 1 {
+		logger.Fatal("Socket UDP max resends must be >= 1", zap.Int("socket.udp_max_resends", config.GetSocket().UdpMaxResends))
+	}
 	if len(config.GetDatabase().Addresses) < 1 {
 		logger.Fatal("At least one database address must be specified", zap.Strings("database.address", config.GetDatabase().Addresses))
 	}
@@ -210,9 +233,64 @@ func CheckConfig(logger *zap.Logger, config Config) map[string]string {
 	if config.GetMatch().JoinMarkerDeadlineMs < 1 {
 		logger.Fatal("Match join marker deadline must be >= 1", zap.Int("match.join_marker_deadline_ms", config.GetMatch().JoinMarkerDeadlineMs))
 	}
+	if config.GetMatch().SpectatorDelayMs < 0 {
+		logger.Fatal("Match spectator delay must be >= 0", zap.Int("match.spectator_delay_ms", config.GetMatch().SpectatorDelayMs))
+	}
+	if config.GetMatch().ReconnectTokenExpirySec < 1 {
+		logger.Fatal("Match reconnect token expiry seconds must be >= 1", zap.Int("match.reconnect_token_expiry_sec", config.GetMatch().ReconnectTokenExpirySec))
+	}
+	if config.GetMatchmaker().IntervalSec < 1 {
+		logger.Fatal("Matchmaker interval time seconds must be >= 1", zap.Int("matchmaker.interval_sec", config.GetMatchmaker().IntervalSec))
+	}
 	if config.GetTracker().EventQueueSize < 1 {
 		logger.Fatal("Tracker presence event queue size must be >= 1", zap.Int("tracker.event_queue_size", config.GetTracker().EventQueueSize))
 	}
+	for name, provider := range config.GetSocial().Oidc {
+		if !oidcProviderNameRegex.MatchString(name) {
+			logger.Fatal("OpenID Connect provider name must be 1-32 lowercase letters, digits, '-' or '_'", zap.String("social.oidc", name))
+		}
+		if isBuiltinIdentityProvider(name) {
+			logger.Fatal("OpenID Connect provider name must not match a built-in provider", zap.String("social.oidc", name))
+		}
+		if provider == nil || provider.Issuer == "" || provider.Audience == "" || provider.JwksURL == "" {
+			logger.Fatal("OpenID Connect provider issuer, audience and JWKS URL must be set", zap.String("social.oidc", name))
+		}
+	}
+	if config.GetEmail().VerifyTokenExpirySec < 1 {
+		logger.Fatal("Email verification token expiry seconds must be >= 1", zap.Int64("email.verify_token_expiry_sec", config.GetEmail().VerifyTokenExpirySec))
+	}
+	if config.GetEmail().ResetTokenExpirySec < 1 {
+		logger.Fatal("Password reset token expiry seconds must be >= 1", zap.Int64("email.reset_token_expiry_sec", config.GetEmail().ResetTokenExpirySec))
+	}
+	if config.GetErasure().GracePeriodSec < 0 {
+		logger.Fatal("Erasure grace period seconds must be >= 0", zap.Int64("erasure.grace_period_sec", config.GetErasure().GracePeriodSec))
+	}
+	if config.GetErasure().IntervalSec < 1 {
+		logger.Fatal("Erasure interval seconds must be >= 1", zap.Int("erasure.interval_sec", config.GetErasure().IntervalSec))
+	}
+	if config.GetErasure().Username == "" {
+		logger.Fatal("Erasure username must be set", zap.String("erasure.username", config.GetErasure().Username))
+	}
+	if config.GetCluster().GossipPort < 0 {
+		logger.Fatal("Cluster gossip port must be >= 0", zap.Int("cluster.gossip_port", config.GetCluster().GossipPort))
+	}
+	if config.GetCluster().GossipPort != 0 {
+		if config.GetCluster().GossipIntervalMs < 1 {
+			logger.Fatal("Cluster gossip interval milliseconds must be >= 1", zap.Int("cluster.gossip_interval_ms", config.GetCluster().GossipIntervalMs))
+		}
+		if config.GetCluster().GossipFanout < 1 {
+			logger.Fatal("Cluster gossip fanout must be >= 1", zap.Int("cluster.gossip_fanout", config.GetCluster().GossipFanout))
+		}
+		if config.GetCluster().FailureTimeoutMs <= config.GetCluster().GossipIntervalMs {
+			logger.Fatal("Cluster failure timeout milliseconds must be greater than the gossip interval", zap.Int("cluster.failure_timeout_ms", config.GetCluster().FailureTimeoutMs))
+		}
+		if config.GetCluster().SendQueueSize < 1 {
+			logger.Fatal("Cluster send queue size must be >= 1", zap.Int("cluster.send_queue_size", config.GetCluster().SendQueueSize))
+		}
+		if config.GetCluster().Secret == "" {
+			logger.Fatal("Cluster secret must be set", zap.String("param", "cluster.secret"))
+		}
+	}
 
 	// If the runtime path is not overridden, set it to `datadir/modules`.
 	if config.GetRuntime().Path == "" {
@@ -242,6 +320,10 @@ func CheckConfig(logger *zap.Logger, config Config) map[string]string {
 		logger.Warn("WARNING: insecure default parameter value, change this for production!", zap.String("param", "session.encryption_key"))
 		configWarnings["socket.encryption_key"] = "Insecure default parameter value, change this for production!"
 	}
+	if config.GetSession().RefreshEncryptionKey == "defaultrefreshencryptionkey" {
+		logger.Warn("WARNING: insecure default parameter value, change this for production!", zap.String("param", "session.refresh_encryption_key"))
+		configWarnings["session.refresh_encryption_key"] = "Insecure default parameter value, change this for production!"
+	}
 	if config.GetRuntime().HTTPKey == "defaultkey" {
 		logger.Warn("WARNING: insecure default parameter value, change this for production!", zap.String("param", "runtime.http_key"))
 		configWarnings["runtime.http_key"] = "Insecure default parameter value, change this for production!"
@@ -314,9 +396,14 @@ type config struct {
 	Groups           *GroupsConfig      `yaml:"groups" json:"groups" usage:"Groups settings."`
 	Runtime          *RuntimeConfig     `yaml:"runtime" json:"runtime" usage:"Script Runtime properties."`
 	Match            *MatchConfig       `yaml:"match" json:"match" usage:"Authoritative realtime match properties."`
+	Matchmaker       *MatchmakerConfig  `yaml:"matchmaker" json:"matchmaker" usage:"Matchmaker properties."`
 	Tracker          *TrackerConfig     `yaml:"tracker" json:"tracker" usage:"Presence tracker properties."`
+	Cluster          *ClusterConfig     `yaml:"cluster" json:"cluster" usage:"Cluster membership and communication properties."`
 	Console          *ConsoleConfig     `yaml:"console" json:"console" usage:"Console settings."`
 	Leaderboard      *LeaderboardConfig `yaml:"leaderboard" json:"leaderboard" usage:"Leaderboard settings."`
+	IAP              *IAPConfig         `yaml:"iap" json:"iap" usage:"In-app purchase validation settings."`
+	Email            *EmailConfig       `yaml:"email" json:"email" usage:"Email verification and password reset settings."`
+	Erasure          *ErasureConfig     `yaml:"erasure" json:"erasure" usage:"Account erasure request settings."`
 }
 
 // NewConfig constructs a Config struct which represents server settings, and populates it with default values.
@@ -338,9 +425,14 @@ func NewConfig(logger *zap.Logger) *config {
 		Groups:           NewGroupsConfig(),
 		Runtime:          NewRuntimeConfig(),
 		Match:            NewMatchConfig(),
+		Matchmaker:       NewMatchmakerConfig(),
 		Tracker:          NewTrackerConfig(),
+		Cluster:          NewClusterConfig(),
 		Console:          NewConsoleConfig(),
 		Leaderboard:      NewLeaderboardConfig(),
+		IAP:              NewIAPConfig(),
+		Email:            NewEmailConfig(),
+		Erasure:          NewErasureConfig(),
 	}
 }
 
@@ -354,9 +446,14 @@ func (c *config) Clone() (Config, error) {
 	configGroups := *(c.Groups)
 	configRuntime := *(c.Runtime)
 	configMatch := *(c.Match)
+	configMatchmaker := *(c.Matchmaker)
 	configTracker := *(c.Tracker)
+	configCluster := *(c.Cluster)
 	configConsole := *(c.Console)
 	configLeaderboard := *(c.Leaderboard)
+	configIAP := *(c.IAP)
+	configEmail := *(c.Email)
+	configErasure := *(c.Erasure)
 	nc := &config{
 		Name:             c.Name,
 		Datadir:          c.Datadir,
@@ -370,9 +467,14 @@ func (c *config) Clone() (Config, error) {
 		Groups:           &configGroups,
 		Runtime:          &configRuntime,
 		Match:            &configMatch,
+		Matchmaker:       &configMatchmaker,
 		Tracker:          &configTracker,
+		Cluster:          &configCluster,
 		Console:          &configConsole,
 		Leaderboard:      &configLeaderboard,
+		IAP:              &configIAP,
+		Email:            &configEmail,
+		Erasure:          &configErasure,
 	}
 	nc.Socket.CertPEMBlock = make([]byte, len(c.Socket.CertPEMBlock))
 	copy(nc.Socket.CertPEMBlock, c.Socket.CertPEMBlock)
@@ -387,12 +489,19 @@ func (c *config) Clone() (Config, error) {
 	}
 	nc.Database.Addresses = make([]string, len(c.Database.Addresses))
 	copy(nc.Database.Addresses, c.Database.Addresses)
+	nc.Cluster.Join = make([]string, len(c.Cluster.Join))
+	copy(nc.Cluster.Join, c.Cluster.Join)
 	nc.Runtime.Env = make([]string, len(c.Runtime.Env))
 	copy(nc.Runtime.Env, c.Runtime.Env)
 	nc.Runtime.Environment = make(map[string]string, len(c.Runtime.Environment))
 	for k, v := range c.Runtime.Environment {
 		nc.Runtime.Environment[k] = v
 	}
+	nc.Social.Oidc = make(map[string]*SocialConfigOidc, len(c.Social.Oidc))
+	for k, v := range c.Social.Oidc {
+		provider := *v
+		nc.Social.Oidc[k] = &provider
+	}
 	nc.Leaderboard.BlacklistRankCache = make([]string, len(c.Leaderboard.BlacklistRankCache))
 	copy(nc.Leaderboard.BlacklistRankCache, c.Leaderboard.BlacklistRankCache)
 
@@ -447,10 +556,18 @@ func (c *config) GetMatch() *MatchConfig {
 	return c.Match
 }
 
+func (c *config) GetMatchmaker() *MatchmakerConfig {
+	return c.Matchmaker
+}
+
 func (c *config) GetTracker() *TrackerConfig {
 	return c.Tracker
 }
 
+func (c *config) GetCluster() *ClusterConfig {
+	return c.Cluster
+}
+
 func (c *config) GetConsole() *ConsoleConfig {
 	return c.Console
 }
@@ -459,6 +576,18 @@ func (c *config) GetLeaderboard() *LeaderboardConfig {
 	return c.Leaderboard
 }
 
+func (c *config) GetIAP() *IAPConfig {
+	return c.IAP
+}
+
+func (c *config) GetEmail() *EmailConfig {
+	return c.Email
+}
+
+func (c *config) GetErasure() *ErasureConfig {
+	return c.Erasure
+}
+
 // LoggerConfig is configuration relevant to logging levels and output.
 type LoggerConfig struct {
 	Level    string `yaml:"level" json:"level" usage:"Log level to set. Valid values are 'debug', 'info', 'warn', 'error'. Default 'info'."`
@@ -510,15 +639,19 @@ func NewMetricsConfig() *MetricsConfig {
 
 // SessionConfig is configuration relevant to the session.
 type SessionConfig struct {
-	EncryptionKey  string `yaml:"encryption_key" json:"encryption_key" usage:"The encryption key used to produce the client token."`
-	TokenExpirySec int64  `yaml:"token_expiry_sec" json:"token_expiry_sec" usage:"Token expiry in seconds."`
+	EncryptionKey         string `yaml:"encryption_key" json:"encryption_key" usage:"The encryption key used to produce the client token."`
+	TokenExpirySec        int64  `yaml:"token_expiry_sec" json:"token_expiry_sec" usage:"Token expiry in seconds."`
+	RefreshEncryptionKey  string `yaml:"refresh_encryption_key" json:"refresh_encryption_key" usage:"The encryption key used to produce the client refresh token."`
+	RefreshTokenExpirySec int64  `yaml:"refresh_token_expiry_sec" json:"refresh_token_expiry_sec" usage:"Refresh token expiry in seconds."`
 }
 
 // NewSessionConfig creates a new SessionConfig struct.
 func NewSessionConfig() *SessionConfig {
 	return &SessionConfig{
-		EncryptionKey:  "defaultencryptionkey",
-		TokenExpirySec: 60,
+		EncryptionKey:         "defaultencryptionkey",
+		TokenExpirySec:        60,
+		RefreshEncryptionKey:  "defaultrefreshencryptionkey",
+		RefreshTokenExpirySec: 3600,
 	}
 }
 
@@ -537,6 +670,10 @@ type SocketConfig struct {
 	PingPeriodMs         int               `yaml:"ping_period_ms" json:"ping_period_ms" usage:"Time in milliseconds to wait between sending ping messages to the client. This value must be less than the pong_wait_ms. Used for real-time connections."`
 	PingBackoffThreshold int               `yaml:"ping_backoff_threshold" json:"ping_backoff_threshold" usage:"Minimum number of messages received from the client during a single ping period that will delay the sending of a ping until the next ping period, to avoid sending unnecessary pings on regularly active connections. Default 20."`
 	OutgoingQueueSize    int               `yaml:"outgoing_queue_size" json:"outgoing_queue_size" usage:"The maximum number of messages waiting to be sent to the client. If this is exceeded the client is considered too slow and will disconnect. Used when processing real-time connections."`
+	Compression          bool              `yaml:"compression" json:"compression" usage:"Compress real-time WebSocket messages using per-message deflate, for clients that request it. Default false."`
+	UdpPort              int               `yaml:"udp_port" json:"udp_port" usage:"The port for accepting real-time UDP connections from the client. Uses the same address as the main port. Set to 0 to disable. Default 0."`
+	UdpResendIntervalMs  int               `yaml:"udp_resend_interval_ms" json:"udp_resend_interval_ms" usage:"Time in milliseconds to wait for an ack from the client before resending a reliable message. Used for real-time UDP connections. Default 100."`
+	UdpMaxResends        int               `yaml:"udp_max_resends" json:"udp_max_resends" usage:"Maximum number of times a reliable message is resent before the client is considered unreachable and will disconnect. Used for real-time UDP connections. Default 20."`
 	SSLCertificate       string            `yaml:"ssl_certificate" json:"ssl_certificate" usage:"Path to certificate file if you want the server to use SSL directly. Must also supply ssl_private_key. NOT recommended for production use."`
 	SSLPrivateKey        string            `yaml:"ssl_private_key" json:"ssl_private_key" usage:"Path to private key file if you want the server to use SSL directly. Must also supply ssl_certificate. NOT recommended for production use."`
 	CertPEMBlock         []byte            `yaml:"-" json:"-"` // Created by fully reading the file contents of SSLCertificate, not set from input args directly.
@@ -560,6 +697,10 @@ func NewSocketConfig() *SocketConfig {
 		PingPeriodMs:         8000,
 		PingBackoffThreshold: 20,
 		OutgoingQueueSize:    64,
+		Compression:          false,
+		UdpPort:              0,
+		UdpResendIntervalMs:  100,
+		UdpMaxResends:        20,
 		SSLCertificate:       "",
 		SSLPrivateKey:        "",
 	}
@@ -585,7 +726,9 @@ func NewDatabaseConfig() *DatabaseConfig {
 
 // SocialConfig is configuration relevant to the social authentication providers.
 type SocialConfig struct {
-	Steam *SocialConfigSteam `yaml:"steam" json:"steam" usage:"Steam configuration."`
+	Steam *SocialConfigSteam           `yaml:"steam" json:"steam" usage:"Steam configuration."`
+	Apple *SocialConfigApple           `yaml:"apple" json:"apple" usage:"Apple Sign In configuration."`
+	Oidc  map[string]*SocialConfigOidc `yaml:"oidc" json:"oidc" usage:"OpenID Connect providers, keyed by the provider name clients authenticate with. Only available in the config file."`
 }
 
 // SocialConfigSteam is configuration relevant to Steam
@@ -594,6 +737,18 @@ type SocialConfigSteam struct {
 	AppID        int    `yaml:"app_id" json:"app_id" usage:"Steam App ID."`
 }
 
+// SocialConfigApple is configuration relevant to Apple Sign In
+type SocialConfigApple struct {
+	BundleID string `yaml:"bundle_id" json:"bundle_id" usage:"Apple Sign In bundle ID, required to verify identity tokens."`
+}
+
+// SocialConfigOidc is configuration relevant to a generic OpenID Connect provider
+type SocialConfigOidc struct {
+	Issuer   string `yaml:"issuer" json:"issuer" usage:"Expected issuer of identity tokens."`
+	Audience string `yaml:"audience" json:"audience" usage:"Expected audience of identity tokens, usually the client ID registered with the provider."`
+	JwksURL  string `yaml:"jwks_url" json:"jwks_url" usage:"URL of the JSON Web Key Set used to verify identity tokens."`
+}
+
 // NewSocialConfig creates a new SocialConfig struct.
 func NewSocialConfig() *SocialConfig {
 	return &SocialConfig{
@@ -601,6 +756,10 @@ func NewSocialConfig() *SocialConfig {
 			PublisherKey: "",
 			AppID:        0,
 		},
+		Apple: &SocialConfigApple{
+			BundleID: "",
+		},
+		Oidc: make(map[string]*SocialConfigOidc),
 	}
 }
 
@@ -648,21 +807,37 @@ func NewRuntimeConfig() *RuntimeConfig {
 
 // MatchConfig is configuration relevant to authoritative realtime multiplayer matches.
 type MatchConfig struct {
-	InputQueueSize       int `yaml:"input_queue_size" json:"input_queue_size" usage:"Size of the authoritative match buffer that stores client messages until they can be processed by the next tick. Default 128."`
-	CallQueueSize        int `yaml:"call_queue_size" json:"call_queue_size" usage:"Size of the authoritative match buffer that sequences calls to match handler callbacks to ensure no overlaps. Default 128."`
-	JoinAttemptQueueSize int `yaml:"join_attempt_queue_size" json:"join_attempt_queue_size" usage:"Size of the authoritative match buffer that limits the number of in-progress join attempts. Default 128."`
-	DeferredQueueSize    int `yaml:"deferred_queue_size" json:"deferred_queue_size" usage:"Size of the authoritative match buffer that holds deferred message broadcasts until the end of each loop execution. Default 128."`
-	JoinMarkerDeadlineMs int `yaml:"join_marker_deadline_ms" json:"join_marker_deadline_ms" usage:"Deadline in milliseconds that client authoritative match joins will wait for match handlers to acknowledge joins. Default 5000."`
+	InputQueueSize          int  `yaml:"input_queue_size" json:"input_queue_size" usage:"Size of the authoritative match buffer that stores client messages until they can be processed by the next tick. Default 128."`
+	CallQueueSize           int  `yaml:"call_queue_size" json:"call_queue_size" usage:"Size of the authoritative match buffer that sequences calls to match handler callbacks to ensure no overlaps. Default 128."`
+	JoinAttemptQueueSize    int  `yaml:"join_attempt_queue_size" json:"join_attempt_queue_size" usage:"Size of the authoritative match buffer that limits the number of in-progress join attempts. Default 128."`
+	DeferredQueueSize       int  `yaml:"deferred_queue_size" json:"deferred_queue_size" usage:"Size of the authoritative match buffer that holds deferred message broadcasts until the end of each loop execution. Default 128."`
+	JoinMarkerDeadlineMs    int  `yaml:"join_marker_deadline_ms" json:"join_marker_deadline_ms" usage:"Deadline in milliseconds that client authoritative match joins will wait for match handlers to acknowledge joins. Default 5000."`
+	ReplayRecording         bool `yaml:"replay_recording" json:"replay_recording" usage:"Record the inputs, joins, leaves and broadcasts of authoritative matches to replay files in the data directory. Default false."`
+	SpectatorDelayMs        int  `yaml:"spectator_delay_ms" json:"spectator_delay_ms" usage:"Delay in milliseconds before authoritative match broadcasts are delivered to spectators, rounded down to whole match ticks. Default 0."`
+	ReconnectTokenExpirySec int  `yaml:"reconnect_token_expiry_sec" json:"reconnect_token_expiry_sec" usage:"Expiry in seconds of the tokens players receive when joining authoritative matches, which let them reclaim their place after disconnecting. Should be at least as long as the longest reconnect grace period matches allow. Default 3600."`
 }
 
 // NewMatchConfig creates a new MatchConfig struct.
 func NewMatchConfig() *MatchConfig {
 	return &MatchConfig{
-		InputQueueSize:       128,
-		CallQueueSize:        128,
-		JoinAttemptQueueSize: 128,
-		DeferredQueueSize:    128,
-		JoinMarkerDeadlineMs: 5000,
+		InputQueueSize:          128,
+		CallQueueSize:           128,
+		JoinAttemptQueueSize:    128,
+		DeferredQueueSize:       128,
+		JoinMarkerDeadlineMs:    5000,
+		ReconnectTokenExpirySec: 3600,
+	}
+}
+
+// MatchmakerConfig is configuration relevant to the matchmaker.
+type MatchmakerConfig struct {
+	IntervalSec int `yaml:"interval_sec" json:"interval_sec" usage:"How often the matchmaker processes pending tickets and attempts to form matches, in seconds. Default 15."`
+}
+
+// NewMatchmakerConfig creates a new MatchmakerConfig struct.
+func NewMatchmakerConfig() *MatchmakerConfig {
+	return &MatchmakerConfig{
+		IntervalSec: 15,
 	}
 }
 
@@ -678,6 +853,31 @@ func NewTrackerConfig() *TrackerConfig {
 	}
 }
 
+// ClusterConfig is configuration relevant to running multiple nodes as a cluster.
+type ClusterConfig struct {
+	GossipAddress    string   `yaml:"gossip_address" json:"gossip_address" usage:"The IP address of the interface to listen for cluster traffic on, also advertised to other nodes. Default is 127.0.0.1."`
+	GossipPort       int      `yaml:"gossip_port" json:"gossip_port" usage:"The port for accepting connections from other cluster nodes. Default 0, which disables clustering and runs as a single node."`
+	Join             []string `yaml:"join" json:"join" usage:"Addresses in the form host:port of one or more existing cluster nodes to join on startup."`
+	GossipIntervalMs int      `yaml:"gossip_interval_ms" json:"gossip_interval_ms" usage:"Time in milliseconds between rounds of cluster membership gossip. Default 500."`
+	GossipFanout     int      `yaml:"gossip_fanout" json:"gossip_fanout" usage:"Number of random cluster nodes to exchange membership with each gossip round. Default 3."`
+	FailureTimeoutMs int      `yaml:"failure_timeout_ms" json:"failure_timeout_ms" usage:"Time in milliseconds without gossip progress before a cluster node is considered failed and its presences removed. Default 5000."`
+	SendQueueSize    int      `yaml:"send_queue_size" json:"send_queue_size" usage:"Size of the outgoing message buffer for each other cluster node. Default 1024."`
+	Secret           string   `yaml:"secret" json:"secret" usage:"Shared secret used to authenticate messages between cluster nodes. Required when clustering is enabled, and must be the same on every node."`
+}
+
+// NewClusterConfig creates a new ClusterConfig struct.
+func NewClusterConfig() *ClusterConfig {
+	return &ClusterConfig{
+		GossipAddress:    "127.0.0.1",
+		GossipPort:       0,
+		Join:             []string{},
+		GossipIntervalMs: 500,
+		GossipFanout:     3,
+		FailureTimeoutMs: 5000,
+		SendQueueSize:    1024,
+	}
+}
+
 // ConsoleConfig is configuration relevant to the embedded console.
 type ConsoleConfig struct {
 	Port                int    `yaml:"port" json:"port" usage:"The port for accepting connections for the embedded console, listening on all interfaces."`
@@ -718,3 +918,56 @@ func NewLeaderboardConfig() *LeaderboardConfig {
 		BlacklistRankCache: []string{},
 	}
 }
+
+// IAPConfig is configuration relevant to in-app purchase validation.
+type IAPConfig struct {
+	AppleSharedPassword string `yaml:"apple_shared_password" json:"apple_shared_password" usage:"App Store app-specific shared secret, required to validate Apple purchases."`
+	AppleBundleID       string `yaml:"apple_bundle_id" json:"apple_bundle_id" usage:"App Store bundle ID that Apple receipts must be issued for, required to validate Apple purchases."`
+	GoogleClientEmail   string `yaml:"google_client_email" json:"google_client_email" usage:"Google Play service account client email, required to validate Google purchases."`
+	GooglePrivateKey    string `yaml:"google_private_key" json:"google_private_key" usage:"Google Play service account PEM encoded private key, required to validate Google purchases."`
+	GooglePackageName   string `yaml:"google_package_name" json:"google_package_name" usage:"Google Play package name that purchases must be made in, required to validate Google purchases."`
+	HuaweiPublicKey     string `yaml:"huawei_public_key" json:"huawei_public_key" usage:"Huawei AppGallery base64 encoded IAP public key, required to validate Huawei purchases."`
+	HuaweiClientID      string `yaml:"huawei_client_id" json:"huawei_client_id" usage:"Huawei AppGallery OAuth client ID, required to validate Huawei purchases."`
+	HuaweiClientSecret  string `yaml:"huawei_client_secret" json:"huawei_client_secret" usage:"Huawei AppGallery OAuth client secret, required to validate Huawei purchases."`
+}
+
+// NewIAPConfig creates a new IAPConfig struct.
+func NewIAPConfig() *IAPConfig {
+	return &IAPConfig{}
+}
+
+// EmailConfig is configuration relevant to email verification and password reset.
+type EmailConfig struct {
+	VerifyTokenExpirySec int64  `yaml:"verify_token_expiry_sec" json:"verify_token_expiry_sec" usage:"Time in seconds an email verification token is valid for. Default 86400."`
+	ResetTokenExpirySec  int64  `yaml:"reset_token_expiry_sec" json:"reset_token_expiry_sec" usage:"Time in seconds a password reset token is valid for. Default 3600."`
+	VerifyURL            string `yaml:"verify_url" json:"verify_url" usage:"Link included in verification emails, with the token added as a 'token' query parameter. If not set only the token is included."`
+	ResetURL             string `yaml:"reset_url" json:"reset_url" usage:"Link included in password reset emails, with the token added as a 'token' query parameter. If not set only the token is included."`
+	SmtpAddress          string `yaml:"smtp_address" json:"smtp_address" usage:"SMTP server host and port used to send emails, unless a runtime email send function is registered."`
+	SmtpUsername         string `yaml:"smtp_username" json:"smtp_username" usage:"SMTP server username, if the server requires authentication."`
+	SmtpPassword         string `yaml:"smtp_password" json:"smtp_password" usage:"SMTP server password, if the server requires authentication."`
+	FromAddress          string `yaml:"from_address" json:"from_address" usage:"Sender address of emails sent through the SMTP server."`
+}
+
+// NewEmailConfig creates a new EmailConfig struct.
+func NewEmailConfig() *EmailConfig {
+	return &EmailConfig{
+		VerifyTokenExpirySec: 86400,
+		ResetTokenExpirySec:  3600,
+	}
+}
+
+// ErasureConfig is configuration relevant to account erasure requested by players.
+type ErasureConfig struct {
+	GracePeriodSec int64  `yaml:"grace_period_sec" json:"grace_period_sec" usage:"Time in seconds between a player requesting erasure and their account being erased, during which the request can be cancelled. Default 2592000."`
+	IntervalSec    int    `yaml:"interval_sec" json:"interval_sec" usage:"How often in seconds to check for accounts due to be erased. Default 3600."`
+	Username       string `yaml:"username" json:"username" usage:"Username shown on chat messages sent by erased accounts. Default 'deleted'."`
+}
+
+// NewErasureConfig creates a new ErasureConfig struct.
+func NewErasureConfig() *ErasureConfig {
+	return &ErasureConfig{
+		GracePeriodSec: 2592000,
+		IntervalSec:    3600,
+		Username:       "deleted",
+	}
+}
@@ -43,14 +43,19 @@ type ConsoleServer struct {
 	logger            *zap.Logger
 	db                *sql.DB
 	config            Config
+	sessionCache      SessionCache
+	leaderboardCache  LeaderboardCache
+	rankCache         LeaderboardRankCache
 	tracker           Tracker
+	router            MessageRouter
+	matchmaker        Matchmaker
 	statusHandler     StatusHandler
 	configWarnings    map[string]string
 	grpcServer        *grpc.Server
 	grpcGatewayServer *http.Server
 }
 
-func StartConsoleServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, config Config, tracker Tracker, statusHandler StatusHandler, configWarnings map[string]string) *ConsoleServer {
+func StartConsoleServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, config Config, sessionCache SessionCache, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, tracker Tracker, router MessageRouter, matchmaker Matchmaker, statusHandler StatusHandler, configWarnings map[string]string) *ConsoleServer {
 	var gatewayContextTimeoutMs string
 	if config.GetConsole().IdleTimeoutMs > 500 {
 		// Ensure the GRPC Gateway timeout is just under the idle timeout (if possible) to ensure it has priority.
@@ -67,13 +72,18 @@ func StartConsoleServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.D
 	grpcServer := grpc.NewServer(serverOpts...)
 
 	s := &ConsoleServer{
-		logger:         logger,
-		db:             db,
-		config:         config,
-		tracker:        tracker,
-		statusHandler:  statusHandler,
-		configWarnings: configWarnings,
-		grpcServer:     grpcServer,
+		logger:           logger,
+		db:               db,
+		config:           config,
+		sessionCache:     sessionCache,
+		leaderboardCache: leaderboardCache,
+		rankCache:        rankCache,
+		tracker:          tracker,
+		router:           router,
+		matchmaker:       matchmaker,
+		statusHandler:    statusHandler,
+		configWarnings:   configWarnings,
+		grpcServer:       grpcServer,
 	}
 
 	console.RegisterConsoleServer(grpcServer, s)
@@ -76,7 +76,7 @@ func (s *ConsoleServer) DeleteGroupUser(ctx context.Context, in *console.DeleteG
 		return nil, status.Error(codes.InvalidArgument, "Requires a valid group ID.")
 	}
 
-	if err = KickGroupUsers(ctx, s.logger, s.db, uuid.Nil, groupID, []uuid.UUID{userID}); err != nil {
+	if err = KickGroupUsers(ctx, s.logger, s.db, s.router, uuid.Nil, groupID, []uuid.UUID{userID}); err != nil {
 		// Error already logged in function above.
 		return nil, status.Error(codes.Internal, "An error occurred while trying to remove the user from the group.")
 	}
@@ -112,101 +112,24 @@ func (s *ConsoleServer) ExportAccount(ctx context.Context, in *console.AccountId
 		return nil, status.Error(codes.InvalidArgument, "Cannot export the system user.")
 	}
 
-	// Core user account.
-	account, err := GetAccount(ctx, s.logger, s.db, nil, userID)
-	if err != nil {
-		if err == ErrAccountNotFound {
-			return nil, status.Error(codes.NotFound, "Account not found.")
-		}
-		s.logger.Error("Could not export account data", zap.Error(err), zap.String("user_id", in.Id))
-		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
-	}
-
-	// Friends.
-	friends, err := GetFriendIDs(ctx, s.logger, s.db, userID)
-	if err != nil {
-		s.logger.Error("Could not fetch friend IDs", zap.Error(err), zap.String("user_id", in.Id))
-		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
-	}
-
-	// Messages.
-	messages, err := GetChannelMessages(ctx, s.logger, s.db, userID)
-	if err != nil {
-		s.logger.Error("Could not fetch messages", zap.Error(err), zap.String("user_id", in.Id))
-		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
-	}
-
-	// Leaderboard records.
-	leaderboardRecords, err := LeaderboardRecordReadAll(ctx, s.logger, s.db, userID)
-	if err != nil {
-		s.logger.Error("Could not fetch leaderboard records", zap.Error(err), zap.String("user_id", in.Id))
-		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
-	}
-
-	groups := make([]*api.Group, 0)
-	groupUsers, err := ListUserGroups(ctx, s.logger, s.db, userID)
-	if err != nil {
-		s.logger.Error("Could not fetch groups that belong to the user", zap.Error(err), zap.String("user_id", in.Id))
-		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
-	}
-	for _, g := range groupUsers.UserGroups {
-		groups = append(groups, g.Group)
-	}
+	return ExportAccount(ctx, s.logger, s.db, userID)
+}
 
-	// Notifications.
-	notifications, err := NotificationList(ctx, s.logger, s.db, userID, 0, "", nil)
+func (s *ConsoleServer) ExportAccountArchive(ctx context.Context, in *console.AccountId) (*api.AccountExportArchive, error) {
+	userID, err := uuid.FromString(in.Id)
 	if err != nil {
-		s.logger.Error("Could not fetch notifications", zap.Error(err), zap.String("user_id", in.Id))
-		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
 	}
-
-	// Storage objects where user is the owner.
-	storageObjects, err := StorageReadAllUserObjects(ctx, s.logger, s.db, userID)
-	if err != nil {
-		s.logger.Error("Could not fetch notifications", zap.Error(err), zap.String("user_id", in.Id))
-		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	if userID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "Cannot export the system user.")
 	}
 
-	// History of user's wallet.
-	walletLedgers, err := ListWalletLedger(ctx, s.logger, s.db, userID)
+	export, err := ExportAccount(ctx, s.logger, s.db, userID)
 	if err != nil {
-		s.logger.Error("Could not fetch wallet ledger items", zap.Error(err), zap.String("user_id", in.Id))
-		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
-	}
-	wl := make([]*console.WalletLedger, len(walletLedgers))
-	for i, w := range walletLedgers {
-		changeset, err := json.Marshal(w.Changeset)
-		if err != nil {
-			s.logger.Error("Could not fetch wallet ledger items, error encoding changeset", zap.Error(err), zap.String("user_id", in.Id))
-			return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
-		}
-		metadata, err := json.Marshal(w.Metadata)
-		if err != nil {
-			s.logger.Error("Could not fetch wallet ledger items, error encoding metadata", zap.Error(err), zap.String("user_id", in.Id))
-			return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
-		}
-		wl[i] = &console.WalletLedger{
-			Id:         w.ID,
-			UserId:     w.UserID,
-			Changeset:  string(changeset),
-			Metadata:   string(metadata),
-			CreateTime: &timestamp.Timestamp{Seconds: w.CreateTime},
-			UpdateTime: &timestamp.Timestamp{Seconds: w.UpdateTime},
-		}
+		return nil, err
 	}
 
-	export := &console.AccountExport{
-		Account:            account,
-		Objects:            storageObjects,
-		Friends:            friends.GetFriends(),
-		Messages:           messages,
-		Groups:             groups,
-		LeaderboardRecords: leaderboardRecords,
-		Notifications:      notifications.GetNotifications(),
-		WalletLedgers:      wl,
-	}
-
-	return export, nil
+	return ExportAccountArchive(s.logger, export)
 }
 
 func (s *ConsoleServer) GetAccount(ctx context.Context, in *console.AccountId) (*api.Account, error) {
@@ -233,7 +156,7 @@ func (s *ConsoleServer) GetFriends(ctx context.Context, in *console.AccountId) (
 		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
 	}
 
-	friends, err := GetFriends(ctx, s.logger, s.db, s.tracker, userID)
+	friends, err := ListFriends(ctx, s.logger, s.db, s.tracker, userID, 0, nil, "")
 	if err != nil {
 		// Error already logged in function above.
 		return nil, status.Error(codes.Internal, "An error occurred while trying to list the user's friends.")
@@ -295,6 +218,34 @@ func (s *ConsoleServer) GetWalletLedger(ctx context.Context, in *console.Account
 	return &console.WalletLedgerList{Items: consoleLedger}, nil
 }
 
+func (s *ConsoleServer) MergeAccounts(ctx context.Context, in *console.MergeAccountsRequest) (*empty.Empty, error) {
+	sourceID, err := uuid.FromString(in.SourceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid source user ID.")
+	}
+	targetID, err := uuid.FromString(in.TargetId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid target user ID.")
+	}
+	for _, conflict := range []console.MergeAccountsRequest_Conflict{in.Identities, in.Storage, in.Leaderboard} {
+		if _, ok := console.MergeAccountsRequest_Conflict_name[int32(conflict)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "Unknown conflict rule.")
+		}
+	}
+
+	// Conflict rules are numbered the same way on both sides.
+	options := &AccountMergeOptions{
+		Identities:  AccountMergeConflict(in.Identities),
+		Storage:     AccountMergeConflict(in.Storage),
+		Leaderboard: AccountMergeConflict(in.Leaderboard),
+	}
+	if err = MergeAccounts(ctx, s.logger, s.db, s.sessionCache, s.leaderboardCache, s.rankCache, sourceID, targetID, options); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *ConsoleServer) UpdateAccount(ctx context.Context, in *console.UpdateAccountRequest) (*empty.Empty, error) {
 	userID, err := uuid.FromString(in.Id)
 	if err != nil {
@@ -399,6 +350,8 @@ func (s *ConsoleServer) UpdateAccount(ctx context.Context, in *console.UpdateAcc
 			} else {
 				params = append(params, e)
 				statements = append(statements, "email = $"+strconv.Itoa(len(params)))
+				// A changed email address must be verified again.
+				statements = append(statements, "verify_time = CASE WHEN email = $"+strconv.Itoa(len(params))+" THEN verify_time ELSE '1970-01-01 00:00:00 UTC' END")
 			}
 		}
 	}
@@ -452,9 +405,11 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
      OR google_id IS NOT NULL
      OR gamecenter_id IS NOT NULL
      OR steam_id IS NOT NULL
+     OR apple_id IS NOT NULL
      OR email IS NOT NULL
      OR custom_id IS NOT NULL))
-   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1))`
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 				res, err := tx.ExecContext(ctx, query, userID, oldDeviceID)
 				if err != nil {
@@ -487,14 +442,17 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
 		}
 
 		if removeCustomId && removeEmail {
-			query := `UPDATE users SET custom_id = NULL, email = NULL, update_time = now()
+			query := `UPDATE users SET custom_id = NULL, email = NULL, verify_time = '1970-01-01 00:00:00 UTC', update_time = now()
 WHERE id = $1
 AND ((facebook_id IS NOT NULL
       OR google_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
-      OR steam_id IS NOT NULL)
+      OR steam_id IS NOT NULL
+      OR apple_id IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 			res, err := tx.ExecContext(ctx, query, userID)
 			if err != nil {
@@ -510,9 +468,12 @@ AND ((facebook_id IS NOT NULL
       OR google_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
       OR steam_id IS NOT NULL
+      OR apple_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 			res, err := tx.ExecContext(ctx, query, userID)
 			if err != nil {
@@ -522,15 +483,18 @@ AND ((facebook_id IS NOT NULL
 				return StatusError(codes.InvalidArgument, "Cannot unlink custom ID when there are no other identifiers.", ErrRowsAffectedCount)
 			}
 		} else if removeEmail {
-			query := `UPDATE users SET email = NULL, password = NULL, update_time = now()
+			query := `UPDATE users SET email = NULL, password = NULL, verify_time = '1970-01-01 00:00:00 UTC', update_time = now()
 WHERE id = $1
 AND ((facebook_id IS NOT NULL
       OR google_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
       OR steam_id IS NOT NULL
+      OR apple_id IS NOT NULL
       OR custom_id IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 			res, err := tx.ExecContext(ctx, query, userID)
 			if err != nil {
@@ -37,6 +37,24 @@ func (s *ConsoleServer) GetConfig(ctx context.Context, in *empty.Empty) (*consol
 	}
 
 	cfg.GetConsole().Password = ObfuscationString
+	if cfg.GetEmail().SmtpPassword != "" {
+		cfg.GetEmail().SmtpPassword = ObfuscationString
+	}
+	if cfg.GetSession().RefreshEncryptionKey != "" {
+		cfg.GetSession().RefreshEncryptionKey = ObfuscationString
+	}
+	if cfg.GetCluster().Secret != "" {
+		cfg.GetCluster().Secret = ObfuscationString
+	}
+	if cfg.GetIAP().AppleSharedPassword != "" {
+		cfg.GetIAP().AppleSharedPassword = ObfuscationString
+	}
+	if cfg.GetIAP().GooglePrivateKey != "" {
+		cfg.GetIAP().GooglePrivateKey = ObfuscationString
+	}
+	if cfg.GetIAP().HuaweiClientSecret != "" {
+		cfg.GetIAP().HuaweiClientSecret = ObfuscationString
+	}
 	for i, address := range cfg.GetDatabase().Addresses {
 		rawUrl := fmt.Sprintf("postgresql://%s", address)
 		parsedUrl, err := url.Parse(rawUrl)
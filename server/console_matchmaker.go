@@ -0,0 +1,62 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/heroiclabs/nakama/console"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ConsoleServer) ListMatchmakerTickets(ctx context.Context, in *console.ListMatchmakerTicketsRequest) (*console.MatchmakerTicketList, error) {
+	if in.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Limit must be 0 or greater.")
+	}
+
+	entries := s.matchmaker.List(int(in.Limit))
+
+	tickets := make([]*console.MatchmakerTicket, 0, len(entries))
+	for _, entry := range entries {
+		presences := make([]*console.MatchmakerTicket_Presence, 0, len(entry.Presences))
+		for _, presence := range entry.Presences {
+			presences = append(presences, &console.MatchmakerTicket_Presence{
+				UserId:    presence.UserId,
+				SessionId: presence.SessionId,
+				Username:  presence.Username,
+				Node:      presence.Node,
+			})
+		}
+		tickets = append(tickets, &console.MatchmakerTicket{
+			Ticket:            entry.Ticket,
+			PartyId:           entry.PartyId,
+			Presences:         presences,
+			Query:             entry.Query,
+			MinCount:          int32(entry.MinCount),
+			MaxCount:          int32(entry.MaxCount),
+			StringProperties:  entry.StringProperties,
+			NumericProperties: entry.NumericProperties,
+			CreateTime:        &timestamp.Timestamp{Seconds: entry.GetCreateTime()},
+			WaitSec:           entry.WaitSec,
+		})
+	}
+
+	return &console.MatchmakerTicketList{
+		Tickets:    tickets,
+		TotalCount: int32(s.matchmaker.Count()),
+	}, nil
+}
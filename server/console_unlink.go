@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"github.com/cockroachdb/cockroach-go/crdb"
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -25,6 +26,38 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+func (s *ConsoleServer) UnlinkApple(ctx context.Context, in *console.AccountId) (*empty.Empty, error) {
+	userID, err := uuid.FromString(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
+	}
+
+	query := `UPDATE users SET apple_id = NULL, update_time = now()
+WHERE id = $1
+AND apple_id IS NOT NULL
+AND ((custom_id IS NOT NULL
+      OR gamecenter_id IS NOT NULL
+      OR facebook_id IS NOT NULL
+      OR google_id IS NOT NULL
+      OR steam_id IS NOT NULL
+      OR email IS NOT NULL)
+     OR
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
+
+	res, err := s.db.ExecContext(ctx, query, userID)
+
+	if err != nil {
+		s.logger.Error("Could not unlink Apple ID.", zap.Error(err), zap.Any("input", in))
+		return nil, status.Error(codes.Internal, "Error while trying to unlink Apple ID.")
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, status.Error(codes.PermissionDenied, "Cannot unlink Apple ID when there are no other identifiers.")
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *ConsoleServer) UnlinkCustom(ctx context.Context, in *console.AccountId) (*empty.Empty, error) {
 	userID, err := uuid.FromString(in.Id)
 	if err != nil {
@@ -38,9 +71,12 @@ AND ((facebook_id IS NOT NULL
       OR google_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
       OR steam_id IS NOT NULL
+      OR apple_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -76,9 +112,11 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
      OR google_id IS NOT NULL
      OR gamecenter_id IS NOT NULL
      OR steam_id IS NOT NULL
+     OR apple_id IS NOT NULL
      OR email IS NOT NULL
      OR custom_id IS NOT NULL))
-   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1))`
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 		res, err := tx.ExecContext(ctx, query, userID, in.DeviceId)
 		if err != nil {
@@ -118,16 +156,19 @@ func (s *ConsoleServer) UnlinkEmail(ctx context.Context, in *console.AccountId)
 		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
 	}
 
-	query := `UPDATE users SET email = NULL, password = NULL, update_time = now()
+	query := `UPDATE users SET email = NULL, password = NULL, verify_time = '1970-01-01 00:00:00 UTC', update_time = now()
 WHERE id = $1
 AND email IS NOT NULL
 AND ((facebook_id IS NOT NULL
       OR google_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
       OR steam_id IS NOT NULL
+      OR apple_id IS NOT NULL
       OR custom_id IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -154,9 +195,12 @@ AND ((custom_id IS NOT NULL
       OR google_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
       OR steam_id IS NOT NULL
+      OR apple_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -183,9 +227,12 @@ AND ((custom_id IS NOT NULL
       OR google_id IS NOT NULL
       OR facebook_id IS NOT NULL
       OR steam_id IS NOT NULL
+      OR apple_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -212,9 +259,12 @@ AND ((custom_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
       OR facebook_id IS NOT NULL
       OR steam_id IS NOT NULL
+      OR apple_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -228,6 +278,32 @@ AND ((custom_id IS NOT NULL
 	return &empty.Empty{}, nil
 }
 
+func (s *ConsoleServer) UnlinkOidc(ctx context.Context, in *console.UnlinkOidcRequest) (*empty.Empty, error) {
+	userID, err := uuid.FromString(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
+	}
+	if in.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid OpenID Connect provider.")
+	}
+
+	var subject string
+	err = s.db.QueryRowContext(ctx, "SELECT subject FROM user_identity WHERE user_id = $1 AND provider = $2", userID, in.Provider).Scan(&subject)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "OpenID Connect identity not found.")
+		}
+		s.logger.Error("Could not look up OpenID Connect identity.", zap.Error(err), zap.Any("input", in))
+		return nil, status.Error(codes.Internal, "Error while trying to unlink OpenID Connect identity.")
+	}
+
+	if err := unlinkOidc(ctx, s.logger, s.db, userID.String(), in.Provider, subject); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *ConsoleServer) UnlinkSteam(ctx context.Context, in *console.AccountId) (*empty.Empty, error) {
 	userID, err := uuid.FromString(in.Id)
 	if err != nil {
@@ -241,9 +317,12 @@ AND ((custom_id IS NOT NULL
       OR gamecenter_id IS NOT NULL
       OR facebook_id IS NOT NULL
       OR google_id IS NOT NULL
+      OR apple_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := s.db.ExecContext(ctx, query, userID)
 
@@ -37,7 +37,7 @@ func (s *ConsoleServer) BanUser(ctx context.Context, in *console.AccountId) (*em
 		return nil, status.Error(codes.InvalidArgument, "Cannot ban the system user.")
 	}
 
-	if err := BanUsers(ctx, s.logger, s.db, []string{in.Id}); err != nil {
+	if err := BanUsers(ctx, s.logger, s.db, s.sessionCache, []string{in.Id}); err != nil {
 		// Error logged in the core function above.
 		return nil, status.Error(codes.Internal, "An error occurred while trying to ban the user.")
 	}
@@ -45,6 +45,30 @@ func (s *ConsoleServer) BanUser(ctx context.Context, in *console.AccountId) (*em
 	return &empty.Empty{}, nil
 }
 
+func (s *ConsoleServer) LogoutUser(ctx context.Context, in *console.LogoutUserRequest) (*empty.Empty, error) {
+	userID, err := uuid.FromString(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Requires a valid user ID.")
+	}
+	if userID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "Cannot log out the system user.")
+	}
+
+	if err := SessionLogout(ctx, s.logger, s.db, s.config, s.sessionCache, userID, in.Token, in.RefreshToken); err != nil {
+		switch err {
+		case ErrSessionTokenInvalid:
+			return nil, status.Error(codes.InvalidArgument, "Session token invalid.")
+		case ErrRefreshTokenInvalid:
+			return nil, status.Error(codes.InvalidArgument, "Refresh token invalid.")
+		default:
+			// Error logged in the core function above.
+			return nil, status.Error(codes.Internal, "An error occurred while trying to log out the user.")
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *ConsoleServer) UnbanUser(ctx context.Context, in *console.AccountId) (*empty.Empty, error) {
 	userID, err := uuid.FromString(in.Id)
 	if err != nil {
@@ -151,9 +175,9 @@ func (s *ConsoleServer) ListUsers(ctx context.Context, in *console.ListUsersRequ
 		var query string
 		params := []interface{}{in.Filter}
 		if err != nil {
-			query = "SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata, facebook_id, google_id, gamecenter_id, steam_id, edge_count, create_time, update_time FROM users WHERE username = $1"
+			query = "SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata, facebook_id, google_id, gamecenter_id, steam_id, apple_id, edge_count, create_time, update_time FROM users WHERE username = $1"
 		} else {
-			query = "SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata, facebook_id, google_id, gamecenter_id, steam_id, edge_count, create_time, update_time FROM users WHERE (username = $1 OR id = $1)"
+			query = "SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata, facebook_id, google_id, gamecenter_id, steam_id, apple_id, edge_count, create_time, update_time FROM users WHERE (username = $1 OR id = $1)"
 		}
 
 		if in.Banned {
@@ -188,9 +212,9 @@ func (s *ConsoleServer) ListUsers(ctx context.Context, in *console.ListUsersRequ
 	var query string
 
 	if in.Banned {
-		query = "SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata, facebook_id, google_id, gamecenter_id, steam_id, edge_count, create_time, update_time FROM users WHERE disable_time <> '1970-01-01 00:00:00 UTC' LIMIT 50"
+		query = "SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata, facebook_id, google_id, gamecenter_id, steam_id, apple_id, edge_count, create_time, update_time FROM users WHERE disable_time <> '1970-01-01 00:00:00 UTC' LIMIT 50"
 	} else {
-		query = "SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata, facebook_id, google_id, gamecenter_id, steam_id, edge_count, create_time, update_time FROM users LIMIT 50"
+		query = "SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata, facebook_id, google_id, gamecenter_id, steam_id, apple_id, edge_count, create_time, update_time FROM users LIMIT 50"
 	}
 
 	rows, err := s.db.QueryContext(ctx, query)
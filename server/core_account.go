@@ -46,6 +46,7 @@ func GetAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tra
 	var google sql.NullString
 	var gamecenter sql.NullString
 	var steam sql.NullString
+	var apple sql.NullString
 	var customID sql.NullString
 	var edgeCount int
 	var createTime pq.NullTime
@@ -55,12 +56,12 @@ func GetAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tra
 
 	query := `
 SELECT u.username, u.display_name, u.avatar_url, u.lang_tag, u.location, u.timezone, u.metadata, u.wallet,
-	u.email, u.facebook_id, u.google_id, u.gamecenter_id, u.steam_id, u.custom_id, u.edge_count,
+	u.email, u.facebook_id, u.google_id, u.gamecenter_id, u.steam_id, u.apple_id, u.custom_id, u.edge_count,
 	u.create_time, u.update_time, u.verify_time, array(select ud.id from user_device ud where u.id = ud.user_id)
 FROM users u
 WHERE u.id = $1`
 
-	if err := db.QueryRowContext(ctx, query, userID).Scan(&username, &displayName, &avatarURL, &langTag, &location, &timezone, &metadata, &wallet, &email, &facebook, &google, &gamecenter, &steam, &customID, &edgeCount, &createTime, &updateTime, &verifyTime, &deviceIDs); err != nil {
+	if err := db.QueryRowContext(ctx, query, userID).Scan(&username, &displayName, &avatarURL, &langTag, &location, &timezone, &metadata, &wallet, &email, &facebook, &google, &gamecenter, &steam, &apple, &customID, &edgeCount, &createTime, &updateTime, &verifyTime, &deviceIDs); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, ErrAccountNotFound
 		}
@@ -83,7 +84,7 @@ WHERE u.id = $1`
 		online = tracker.StreamExists(PresenceStream{Mode: StreamModeNotifications, Subject: userID})
 	}
 
-	return &api.Account{
+	account := &api.Account{
 		User: &api.User{
 			Id:           userID.String(),
 			Username:     username.String,
@@ -97,6 +98,7 @@ WHERE u.id = $1`
 			GoogleId:     google.String,
 			GamecenterId: gamecenter.String,
 			SteamId:      steam.String,
+			AppleId:      apple.String,
 			EdgeCount:    int32(edgeCount),
 			CreateTime:   &timestamp.Timestamp{Seconds: createTime.Time.Unix()},
 			UpdateTime:   &timestamp.Timestamp{Seconds: updateTime.Time.Unix()},
@@ -107,7 +109,13 @@ WHERE u.id = $1`
 		Devices:    devices,
 		CustomId:   customID.String,
 		VerifyTime: verifyTimestamp,
-	}, nil
+	}
+
+	if err := loadAccountIdentities(ctx, logger, db, []*api.Account{account}); err != nil {
+		return nil, err
+	}
+
+	return account, nil
 }
 
 func GetAccounts(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, userIDs []string) ([]*api.Account, error) {
@@ -120,7 +128,7 @@ func GetAccounts(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tr
 
 	query := `
 SELECT u.id, u.username, u.display_name, u.avatar_url, u.lang_tag, u.location, u.timezone, u.metadata, u.wallet,
-	u.email, u.facebook_id, u.google_id, u.gamecenter_id, u.steam_id, u.custom_id, u.edge_count,
+	u.email, u.facebook_id, u.google_id, u.gamecenter_id, u.steam_id, u.apple_id, u.custom_id, u.edge_count,
 	u.create_time, u.update_time, u.verify_time, array(select ud.id from user_device ud where u.id = ud.user_id)
 FROM users u
 WHERE u.id IN (` + strings.Join(statements, ",") + `)`
@@ -147,6 +155,7 @@ WHERE u.id IN (` + strings.Join(statements, ",") + `)`
 		var google sql.NullString
 		var gamecenter sql.NullString
 		var steam sql.NullString
+		var apple sql.NullString
 		var customID sql.NullString
 		var edgeCount int
 		var createTime pq.NullTime
@@ -154,7 +163,7 @@ WHERE u.id IN (` + strings.Join(statements, ",") + `)`
 		var verifyTime pq.NullTime
 		var deviceIDs pq.StringArray
 
-		err = rows.Scan(&userID, &username, &displayName, &avatarURL, &langTag, &location, &timezone, &metadata, &wallet, &email, &facebook, &google, &gamecenter, &steam, &customID, &edgeCount, &createTime, &updateTime, &verifyTime, &deviceIDs)
+		err = rows.Scan(&userID, &username, &displayName, &avatarURL, &langTag, &location, &timezone, &metadata, &wallet, &email, &facebook, &google, &gamecenter, &steam, &apple, &customID, &edgeCount, &createTime, &updateTime, &verifyTime, &deviceIDs)
 		if err != nil {
 			logger.Error("Error retrieving user accounts.", zap.Error(err))
 			return nil, err
@@ -189,6 +198,7 @@ WHERE u.id IN (` + strings.Join(statements, ",") + `)`
 				GoogleId:     google.String,
 				GamecenterId: gamecenter.String,
 				SteamId:      steam.String,
+				AppleId:      apple.String,
 				EdgeCount:    int32(edgeCount),
 				CreateTime:   &timestamp.Timestamp{Seconds: createTime.Time.Unix()},
 				UpdateTime:   &timestamp.Timestamp{Seconds: updateTime.Time.Unix()},
@@ -202,6 +212,10 @@ WHERE u.id IN (` + strings.Join(statements, ",") + `)`
 		})
 	}
 
+	if err := loadAccountIdentities(ctx, logger, db, accounts); err != nil {
+		return nil, err
+	}
+
 	return accounts, nil
 }
 
@@ -309,40 +323,44 @@ func DeleteAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, userID u
 	}
 
 	if err := crdb.ExecuteInTx(ctx, tx, func() error {
-		count, err := DeleteUser(ctx, tx, userID)
-		if err != nil {
-			logger.Debug("Could not delete user", zap.Error(err), zap.String("user_id", userID.String()))
-			return err
-		} else if count == 0 {
-			logger.Info("No user was found to delete. Skipping blacklist.", zap.String("user_id", userID.String()))
-			return nil
-		}
+		return deleteAccount(ctx, logger, tx, userID, recorded)
+	}); err != nil {
+		logger.Error("Error occurred while trying to delete the user.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
 
-		err = LeaderboardRecordsDeleteAll(ctx, logger, tx, userID)
-		if err != nil {
-			logger.Debug("Could not delete leaderboard records.", zap.Error(err), zap.String("user_id", userID.String()))
-			return err
-		}
+	return nil
+}
 
-		err = GroupDeleteAll(ctx, logger, tx, userID)
-		if err != nil {
-			logger.Debug("Could not delete groups and relationships.", zap.Error(err), zap.String("user_id", userID.String()))
-			return err
-		}
+func deleteAccount(ctx context.Context, logger *zap.Logger, tx *sql.Tx, userID uuid.UUID, recorded bool) error {
+	count, err := DeleteUser(ctx, tx, userID)
+	if err != nil {
+		logger.Debug("Could not delete user", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	} else if count == 0 {
+		logger.Info("No user was found to delete. Skipping blacklist.", zap.String("user_id", userID.String()))
+		return nil
+	}
 
-		if recorded {
-			_, err = tx.ExecContext(ctx, `INSERT INTO user_tombstone (user_id) VALUES ($1) ON CONFLICT(user_id) DO NOTHING`, userID)
-			if err != nil {
-				logger.Debug("Could not insert user ID into tombstone", zap.Error(err), zap.String("user_id", userID.String()))
-				return err
-			}
-		}
+	err = LeaderboardRecordsDeleteAll(ctx, logger, tx, userID)
+	if err != nil {
+		logger.Debug("Could not delete leaderboard records.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
 
-		return nil
-	}); err != nil {
-		logger.Error("Error occurred while trying to delete the user.", zap.Error(err), zap.String("user_id", userID.String()))
+	err = GroupDeleteAll(ctx, logger, tx, userID)
+	if err != nil {
+		logger.Debug("Could not delete groups and relationships.", zap.Error(err), zap.String("user_id", userID.String()))
 		return err
 	}
 
+	if recorded {
+		_, err = tx.ExecContext(ctx, `INSERT INTO user_tombstone (user_id) VALUES ($1) ON CONFLICT(user_id) DO NOTHING`, userID)
+		if err != nil {
+			logger.Debug("Could not insert user ID into tombstone", zap.Error(err), zap.String("user_id", userID.String()))
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,220 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/crdb"
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/heroiclabs/nakama/api"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Maximum number of accounts erased in one batch by the erasure scheduler.
+const (
+	accountErasureBatchSize = 100
+	// Longest wait before retrying an account whose erasure keeps failing.
+	accountErasureMaxRetryDelay = 24 * time.Hour
+)
+
+// GetAccountErasure returns the user's pending erasure request, or nil if there is none.
+func GetAccountErasure(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) (*api.AccountErasure, error) {
+	var requestTime, eraseTime pq.NullTime
+	err := db.QueryRowContext(ctx, "SELECT request_time, erase_time FROM user_erasure WHERE user_id = $1", userID).Scan(&requestTime, &eraseTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logger.Error("Could not read account erasure request.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, err
+	}
+
+	return &api.AccountErasure{
+		RequestTime: &timestamp.Timestamp{Seconds: requestTime.Time.Unix()},
+		EraseTime:   &timestamp.Timestamp{Seconds: eraseTime.Time.Unix()},
+	}, nil
+}
+
+// RequestAccountErasure schedules the user's account to be erased once the configured grace period has passed. Repeated
+// requests keep the original erasure time.
+func RequestAccountErasure(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, userID uuid.UUID) (*api.AccountErasure, error) {
+	eraseTime := time.Now().UTC().Add(time.Duration(config.GetErasure().GracePeriodSec) * time.Second)
+	if _, err := db.ExecContext(ctx, "INSERT INTO user_erasure (user_id, erase_time) VALUES ($1, $2) ON CONFLICT (user_id) DO NOTHING", userID, eraseTime); err != nil {
+		logger.Error("Could not insert account erasure request.", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "Error requesting account erasure.")
+	}
+
+	erasure, err := GetAccountErasure(ctx, logger, db, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error requesting account erasure.")
+	}
+	if erasure == nil {
+		// The account was erased in the meantime.
+		return nil, status.Error(codes.NotFound, "Account not found.")
+	}
+
+	logger.Info("Account erasure requested.", zap.String("user_id", userID.String()), zap.Int64("erase_time", erasure.EraseTime.Seconds))
+	return erasure, nil
+}
+
+// CancelAccountErasure removes the user's pending erasure request.
+func CancelAccountErasure(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) error {
+	res, err := db.ExecContext(ctx, "DELETE FROM user_erasure WHERE user_id = $1", userID)
+	if err != nil {
+		logger.Error("Could not delete account erasure request.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error cancelling account erasure.")
+	}
+	if count, _ := res.RowsAffected(); count == 0 {
+		return status.Error(codes.NotFound, "No account erasure has been requested.")
+	}
+
+	logger.Info("Account erasure cancelled.", zap.String("user_id", userID.String()))
+	return nil
+}
+
+// EraseAccount deletes the user's account and records a tombstone for it. Chat messages the user sent are kept for
+// the other participants, but are attributed to the system user under the configured username.
+func EraseAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sessionCache SessionCache, userID uuid.UUID) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("Could not begin database transaction.", zap.Error(err))
+		return err
+	}
+
+	if err := crdb.ExecuteInTx(ctx, tx, func() error {
+		if _, err := tx.ExecContext(ctx, "UPDATE message SET sender_id = $2, username = $3 WHERE sender_id = $1", userID, uuid.Nil, config.GetErasure().Username); err != nil {
+			logger.Debug("Could not anonymize messages.", zap.Error(err), zap.String("user_id", userID.String()))
+			return err
+		}
+		return deleteAccount(ctx, logger, tx, userID, true)
+	}); err != nil {
+		logger.Error("Error occurred while trying to erase the user.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
+
+	sessionCache.RevokeAll(userID)
+
+	logger.Info("Account erased.", zap.String("user_id", userID.String()))
+	return nil
+}
+
+// AccountErasureScheduler periodically erases accounts whose erasure grace period has passed.
+type AccountErasureScheduler struct {
+	logger       *zap.Logger
+	db           *sql.DB
+	config       Config
+	sessionCache SessionCache
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+}
+
+func StartAccountErasureScheduler(logger *zap.Logger, db *sql.DB, config Config, sessionCache SessionCache) *AccountErasureScheduler {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	s := &AccountErasureScheduler{
+		logger:       logger,
+		db:           db,
+		config:       config,
+		sessionCache: sessionCache,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(config.GetErasure().IntervalSec) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				s.eraseDue()
+			}
+		}
+	}()
+
+	return s
+}
+
+func (s *AccountErasureScheduler) Stop() {
+	s.ctxCancelFn()
+}
+
+func (s *AccountErasureScheduler) eraseDue() {
+	for {
+		rows, err := s.db.QueryContext(s.ctx, "SELECT user_id, attempts FROM user_erasure WHERE erase_time <= now() AND (retry_time IS NULL OR retry_time <= now()) ORDER BY erase_time LIMIT $1", accountErasureBatchSize)
+		if err != nil {
+			if s.ctx.Err() == nil {
+				s.logger.Error("Could not list accounts due for erasure.", zap.Error(err))
+			}
+			return
+		}
+		userIDs := make([]uuid.UUID, 0, accountErasureBatchSize)
+		attempts := make([]int, 0, accountErasureBatchSize)
+		for rows.Next() {
+			var userID uuid.UUID
+			var attempt int
+			if err := rows.Scan(&userID, &attempt); err != nil {
+				s.logger.Error("Could not read account due for erasure.", zap.Error(err))
+				break
+			}
+			userIDs = append(userIDs, userID)
+			attempts = append(attempts, attempt)
+		}
+		rows.Close()
+
+		for i, userID := range userIDs {
+			if err := EraseAccount(s.ctx, s.logger, s.db, s.config, s.sessionCache, userID); err != nil {
+				if s.ctx.Err() != nil {
+					return
+				}
+				// Error already logged, back off before retrying so the account does not hold up any others.
+				if !s.retryLater(userID, attempts[i]+1) {
+					// The account would be listed again straight away, leave it to the next run.
+					return
+				}
+			}
+		}
+		if len(userIDs) < accountErasureBatchSize {
+			return
+		}
+	}
+}
+
+// Record a failed erasure attempt, and delay the next one by the erasure interval doubled for each failure so far.
+func (s *AccountErasureScheduler) retryLater(userID uuid.UUID, attempts int) bool {
+	delay := accountErasureMaxRetryDelay
+	if attempts < 32 {
+		if d := time.Duration(s.config.GetErasure().IntervalSec) * time.Second << uint(attempts-1); d > 0 && d < delay {
+			delay = d
+		}
+	}
+	retryTime := time.Now().UTC().Add(delay)
+	if _, err := s.db.ExecContext(s.ctx, "UPDATE user_erasure SET attempts = $2, retry_time = $3 WHERE user_id = $1", userID, attempts, retryTime); err != nil {
+		s.logger.Error("Could not record failed account erasure.", zap.Error(err), zap.String("user_id", userID.String()))
+		return false
+	}
+	s.logger.Warn("Account erasure failed, will retry.", zap.String("user_id", userID.String()), zap.Int("attempts", attempts), zap.Time("retry_time", retryTime))
+	return true
+}
@@ -0,0 +1,233 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/heroiclabs/nakama/api"
+	"github.com/heroiclabs/nakama/console"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportAccount gathers all information stored for a user account.
+func ExportAccount(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) (*console.AccountExport, error) {
+	// Core user account.
+	account, err := GetAccount(ctx, logger, db, nil, userID)
+	if err != nil {
+		if err == ErrAccountNotFound {
+			return nil, status.Error(codes.NotFound, "Account not found.")
+		}
+		logger.Error("Could not export account data", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	// Friends.
+	friends, err := GetFriendIDs(ctx, logger, db, userID)
+	if err != nil {
+		logger.Error("Could not fetch friend IDs", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	// Messages.
+	messages, err := GetChannelMessages(ctx, logger, db, userID)
+	if err != nil {
+		logger.Error("Could not fetch messages", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	// Leaderboard and tournament records.
+	leaderboardRecords, err := LeaderboardRecordReadAll(ctx, logger, db, userID)
+	if err != nil {
+		logger.Error("Could not fetch leaderboard records", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	groups := make([]*api.Group, 0)
+	groupUsers, err := ListUserGroups(ctx, logger, db, userID)
+	if err != nil {
+		logger.Error("Could not fetch groups that belong to the user", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+	for _, g := range groupUsers.UserGroups {
+		groups = append(groups, g.Group)
+	}
+
+	// Notifications.
+	notifications, err := NotificationList(ctx, logger, db, userID, 0, "", nil)
+	if err != nil {
+		logger.Error("Could not fetch notifications", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	// Storage objects where user is the owner.
+	storageObjects, err := StorageReadAllUserObjects(ctx, logger, db, userID)
+	if err != nil {
+		logger.Error("Could not fetch storage objects", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	// History of user's wallet.
+	walletLedgers, err := ListWalletLedger(ctx, logger, db, userID)
+	if err != nil {
+		logger.Error("Could not fetch wallet ledger items", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+	wl := make([]*console.WalletLedger, len(walletLedgers))
+	for i, w := range walletLedgers {
+		changeset, err := json.Marshal(w.Changeset)
+		if err != nil {
+			logger.Error("Could not fetch wallet ledger items, error encoding changeset", zap.Error(err), zap.String("user_id", userID.String()))
+			return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+		}
+		metadata, err := json.Marshal(w.Metadata)
+		if err != nil {
+			logger.Error("Could not fetch wallet ledger items, error encoding metadata", zap.Error(err), zap.String("user_id", userID.String()))
+			return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+		}
+		wl[i] = &console.WalletLedger{
+			Id:         w.ID,
+			UserId:     w.UserID,
+			Changeset:  string(changeset),
+			Metadata:   string(metadata),
+			CreateTime: &timestamp.Timestamp{Seconds: w.CreateTime},
+			UpdateTime: &timestamp.Timestamp{Seconds: w.UpdateTime},
+		}
+	}
+
+	// Validated purchases.
+	purchases := make([]*api.ValidatedPurchase, 0)
+	cursor := ""
+	for {
+		purchaseList, err := ListPurchases(ctx, logger, db, userID, 100, cursor)
+		if err != nil {
+			logger.Error("Could not fetch purchases", zap.Error(err), zap.String("user_id", userID.String()))
+			return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+		}
+		purchases = append(purchases, purchaseList.ValidatedPurchases...)
+		if cursor = purchaseList.Cursor; cursor == "" {
+			break
+		}
+	}
+
+	// Sessions.
+	sessions, err := exportAccountSessions(ctx, db, userID)
+	if err != nil {
+		logger.Error("Could not fetch sessions", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	// Pending erasure request.
+	erasure, err := GetAccountErasure(ctx, logger, db, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	export := &console.AccountExport{
+		Account:            account,
+		Objects:            storageObjects,
+		Friends:            friends.GetFriends(),
+		Messages:           messages,
+		Groups:             groups,
+		LeaderboardRecords: leaderboardRecords,
+		Notifications:      notifications.GetNotifications(),
+		WalletLedgers:      wl,
+		GroupMemberships:   groupUsers.UserGroups,
+		Purchases:          purchases,
+		Sessions:           sessions,
+		Erasure:            erasure,
+	}
+
+	return export, nil
+}
+
+func exportAccountSessions(ctx context.Context, db *sql.DB, userID uuid.UUID) ([]*console.AccountExport_Session, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id, create_time, expiry_time FROM user_refresh_token WHERE user_id = $1 ORDER BY create_time", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*console.AccountExport_Session, 0)
+	for rows.Next() {
+		var id string
+		var createTime, expiryTime pq.NullTime
+		if err := rows.Scan(&id, &createTime, &expiryTime); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &console.AccountExport_Session{
+			Id:         id,
+			CreateTime: &timestamp.Timestamp{Seconds: createTime.Time.Unix()},
+			ExpiryTime: &timestamp.Timestamp{Seconds: expiryTime.Time.Unix()},
+		})
+	}
+	return sessions, rows.Err()
+}
+
+// ExportAccountArchive packs an account export into a zip archive, with one JSON file for each kind of data.
+func ExportAccountArchive(logger *zap.Logger, export *console.AccountExport) (*api.AccountExportArchive, error) {
+	marshaler := &jsonpb.Marshaler{EmitDefaults: true, OrigName: true}
+	data, err := marshaler.MarshalToString(export)
+	if err != nil {
+		logger.Error("Could not encode account export", zap.Error(err))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data), &sections); err != nil {
+		logger.Error("Could not decode account export", zap.Error(err))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	for _, name := range names {
+		f, err := archive.Create(name + ".json")
+		if err != nil {
+			logger.Error("Could not create account export archive file", zap.Error(err), zap.String("name", name))
+			return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+		}
+		section := &bytes.Buffer{}
+		if err := json.Indent(section, sections[name], "", "  "); err != nil {
+			logger.Error("Could not format account export archive file", zap.Error(err), zap.String("name", name))
+			return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+		}
+		if _, err := section.WriteTo(f); err != nil {
+			logger.Error("Could not write account export archive file", zap.Error(err), zap.String("name", name))
+			return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+		}
+	}
+	if err := archive.Close(); err != nil {
+		logger.Error("Could not write account export archive", zap.Error(err))
+		return nil, status.Error(codes.Internal, "An error occurred while trying to export user data.")
+	}
+
+	return &api.AccountExportArchive{Data: buf.Bytes()}, nil
+}
@@ -0,0 +1,550 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/crdb"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccountMergeConflict decides whose data is kept when both users in an account merge have data in the same place.
+type AccountMergeConflict int
+
+const (
+	// Keep the target user's data, discarding the source user's.
+	AccountMergeConflictKeepTarget AccountMergeConflict = iota
+	// Keep the source user's data, replacing the target user's.
+	AccountMergeConflictKeepSource
+	// Keep whichever was updated most recently, preferring the target user's on ties.
+	AccountMergeConflictKeepNewest
+)
+
+// ParseAccountMergeConflict converts a conflict rule name as used by the runtimes. An empty name keeps the target.
+func ParseAccountMergeConflict(name string) (AccountMergeConflict, error) {
+	switch name {
+	case "", "keep_target":
+		return AccountMergeConflictKeepTarget, nil
+	case "keep_source":
+		return AccountMergeConflictKeepSource, nil
+	case "keep_newest":
+		return AccountMergeConflictKeepNewest, nil
+	default:
+		return 0, fmt.Errorf("unknown account merge conflict rule: %v", name)
+	}
+}
+
+func (c AccountMergeConflict) keepSource(targetUpdateTime, sourceUpdateTime time.Time) bool {
+	switch c {
+	case AccountMergeConflictKeepSource:
+		return true
+	case AccountMergeConflictKeepNewest:
+		return sourceUpdateTime.After(targetUpdateTime)
+	default:
+		return false
+	}
+}
+
+// AccountMergeOptions are the conflict rules used for each kind of data when merging accounts. Friends are always
+// resolved in favour of the target, and group memberships keep the higher of the two roles.
+type AccountMergeOptions struct {
+	// Provider IDs, custom ID, email and OpenID Connect identities. Newest compares when the accounts were last updated.
+	Identities AccountMergeConflict
+	// Storage objects with the same collection and key.
+	Storage AccountMergeConflict
+	// Leaderboard and tournament records in the same leaderboard and reset period.
+	Leaderboard AccountMergeConflict
+}
+
+// Identity columns on the users table, in the order they are read and written during a merge.
+var accountMergeIdentityColumns = []string{"custom_id", "email", "facebook_id", "google_id", "gamecenter_id", "steam_id", "apple_id"}
+
+type accountMergeUser struct {
+	username   string
+	wallet     string
+	password   []byte
+	verifyTime pq.NullTime
+	updateTime pq.NullTime
+	identities []sql.NullString
+}
+
+type accountMergeRankChange struct {
+	leaderboardID string
+	expiryTime    int64
+	ownerID       uuid.UUID
+	score         int64
+	subscore      int64
+	insert        bool
+}
+
+// MergeAccounts moves everything the source user owns to the target user, then deletes the source user and records a
+// tombstone for it. Data both users have in the same place is resolved using the given conflict rules.
+func MergeAccounts(ctx context.Context, logger *zap.Logger, db *sql.DB, sessionCache SessionCache, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, sourceID, targetID uuid.UUID, options *AccountMergeOptions) error {
+	if sourceID == targetID {
+		return status.Error(codes.InvalidArgument, "Cannot merge a user account into itself.")
+	}
+	if sourceID == uuid.Nil || targetID == uuid.Nil {
+		return status.Error(codes.InvalidArgument, "Cannot merge the system user.")
+	}
+	if options == nil {
+		options = &AccountMergeOptions{}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("Could not begin database transaction.", zap.Error(err))
+		return status.Error(codes.Internal, "Error merging user accounts.")
+	}
+
+	var rankChanges []*accountMergeRankChange
+	if err = crdb.ExecuteInTx(ctx, tx, func() error {
+		// The transaction may be retried, only keep the rank changes from the attempt that commits.
+		rankChanges = make([]*accountMergeRankChange, 0)
+
+		users, err := accountMergeLoadUsers(ctx, tx, sourceID, targetID)
+		if err != nil {
+			logger.Debug("Could not load users to merge.", zap.Error(err))
+			return err
+		}
+		source, target := users[sourceID], users[targetID]
+		if source == nil || target == nil {
+			return StatusError(codes.NotFound, "User account not found.", ErrRowsAffectedCount)
+		}
+
+		if err := accountMergeIdentities(ctx, tx, sourceID, targetID, source, target, options.Identities); err != nil {
+			logger.Debug("Could not merge identities.", zap.Error(err))
+			return err
+		}
+		if err := accountMergeStorage(ctx, tx, sourceID, targetID, options.Storage); err != nil {
+			logger.Debug("Could not merge storage objects.", zap.Error(err))
+			return err
+		}
+		if err := accountMergeWallet(ctx, tx, sourceID, targetID, source, target); err != nil {
+			logger.Debug("Could not merge wallets.", zap.Error(err))
+			return err
+		}
+		if err := accountMergeFriends(ctx, tx, sourceID, targetID); err != nil {
+			logger.Debug("Could not merge friends.", zap.Error(err))
+			return err
+		}
+		if err := accountMergeGroups(ctx, tx, sourceID, targetID); err != nil {
+			logger.Debug("Could not merge groups.", zap.Error(err))
+			return err
+		}
+		if rankChanges, err = accountMergeLeaderboardRecords(ctx, tx, sourceID, targetID, target.username, options.Leaderboard); err != nil {
+			logger.Debug("Could not merge leaderboard records.", zap.Error(err))
+			return err
+		}
+
+		// Purchases move too, so their receipts can't be redeemed again.
+		if _, err := tx.ExecContext(ctx, "UPDATE purchase SET user_id = $1 WHERE user_id = $2", targetID, sourceID); err != nil {
+			logger.Debug("Could not merge purchases.", zap.Error(err))
+			return err
+		}
+
+		// Anything else the source user still owns is removed with it.
+		if _, err := DeleteUser(ctx, tx, sourceID); err != nil {
+			logger.Debug("Could not delete merged user.", zap.Error(err))
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO user_tombstone (user_id) VALUES ($1) ON CONFLICT(user_id) DO NOTHING", sourceID); err != nil {
+			logger.Debug("Could not insert user ID into tombstone", zap.Error(err))
+			return err
+		}
+		return nil
+	}); err != nil {
+		if e, ok := err.(*statusError); ok {
+			return e.Status()
+		}
+		logger.Error("Error merging user accounts.", zap.Error(err), zap.String("source_id", sourceID.String()), zap.String("target_id", targetID.String()))
+		return status.Error(codes.Internal, "Error merging user accounts.")
+	}
+
+	for _, change := range rankChanges {
+		if !change.insert {
+			rankCache.Delete(change.leaderboardID, change.expiryTime, change.ownerID)
+			continue
+		}
+		rankCache.Delete(change.leaderboardID, change.expiryTime, sourceID)
+		if leaderboard := leaderboardCache.Get(change.leaderboardID); leaderboard != nil {
+			rankCache.Insert(change.leaderboardID, change.expiryTime, leaderboard.SortOrder, change.ownerID, change.score, change.subscore)
+		}
+	}
+
+	// The source user no longer exists, disconnect any sessions it still has.
+	sessionCache.RevokeAll(sourceID)
+
+	logger.Info("Merged user accounts.", zap.String("source_id", sourceID.String()), zap.String("target_id", targetID.String()))
+	return nil
+}
+
+func accountMergeLoadUsers(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID) (map[uuid.UUID]*accountMergeUser, error) {
+	query := "SELECT id, username, wallet, password, verify_time, update_time"
+	for _, column := range accountMergeIdentityColumns {
+		query += ", " + column
+	}
+	query += " FROM users WHERE id IN ($1, $2)"
+
+	rows, err := tx.QueryContext(ctx, query, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make(map[uuid.UUID]*accountMergeUser, 2)
+	for rows.Next() {
+		var id uuid.UUID
+		user := &accountMergeUser{identities: make([]sql.NullString, len(accountMergeIdentityColumns))}
+		dest := []interface{}{&id, &user.username, &user.wallet, &user.password, &user.verifyTime, &user.updateTime}
+		for i := range user.identities {
+			dest = append(dest, &user.identities[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		users[id] = user
+	}
+	return users, rows.Err()
+}
+
+func accountMergeIdentities(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID, source, target *accountMergeUser, conflict AccountMergeConflict) error {
+	keepSource := conflict.keepSource(target.updateTime.Time, source.updateTime.Time)
+
+	// Identity columns are unique, so they must be cleared from the source before they can be set on the target.
+	clearQuery := "UPDATE users SET update_time = now()"
+	setQuery := "UPDATE users SET update_time = now(), password = $2, verify_time = $3"
+	params := []interface{}{targetID, target.password, target.verifyTime}
+	for i, column := range accountMergeIdentityColumns {
+		clearQuery += ", " + column + " = NULL"
+		identity := target.identities[i]
+		if source.identities[i].Valid && (!identity.Valid || keepSource) {
+			identity = source.identities[i]
+			if column == "email" {
+				// The password and verification belong with the email.
+				params[1], params[2] = source.password, source.verifyTime
+			}
+		}
+		params = append(params, identity)
+		setQuery += fmt.Sprintf(", %v = $%v", column, len(params))
+	}
+	if _, err := tx.ExecContext(ctx, clearQuery+" WHERE id = $1", sourceID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, setQuery+" WHERE id = $1", params...); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE user_device SET user_id = $1 WHERE user_id = $2", targetID, sourceID); err != nil {
+		return err
+	}
+
+	// Users have at most one OpenID Connect identity per provider.
+	rows, err := tx.QueryContext(ctx, "SELECT s.provider FROM user_identity s JOIN user_identity t ON s.provider = t.provider WHERE s.user_id = $1 AND t.user_id = $2", sourceID, targetID)
+	if err != nil {
+		return err
+	}
+	providers := make([]string, 0)
+	for rows.Next() {
+		var provider string
+		if err := rows.Scan(&provider); err != nil {
+			rows.Close()
+			return err
+		}
+		providers = append(providers, provider)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, provider := range providers {
+		loserID := sourceID
+		if keepSource {
+			loserID = targetID
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_identity WHERE user_id = $1 AND provider = $2", loserID, provider); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE user_identity SET user_id = $1 WHERE user_id = $2", targetID, sourceID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_identity_activity WHERE user_id = $1 AND (provider, identity_id) IN (SELECT provider, identity_id FROM user_identity_activity WHERE user_id = $2)", sourceID, targetID); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE user_identity_activity SET user_id = $1 WHERE user_id = $2", targetID, sourceID)
+	return err
+}
+
+func accountMergeStorage(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID, conflict AccountMergeConflict) error {
+	rows, err := tx.QueryContext(ctx, "SELECT s.collection, s.key, s.update_time, t.update_time FROM storage s JOIN storage t ON s.collection = t.collection AND s.key = t.key WHERE s.user_id = $1 AND t.user_id = $2", sourceID, targetID)
+	if err != nil {
+		return err
+	}
+	type storageKey struct {
+		collection string
+		key        string
+		ownerID    uuid.UUID
+	}
+	losers := make([]*storageKey, 0)
+	for rows.Next() {
+		var collection, key string
+		var sourceUpdateTime, targetUpdateTime pq.NullTime
+		if err := rows.Scan(&collection, &key, &sourceUpdateTime, &targetUpdateTime); err != nil {
+			rows.Close()
+			return err
+		}
+		loserID := sourceID
+		if conflict.keepSource(targetUpdateTime.Time, sourceUpdateTime.Time) {
+			loserID = targetID
+		}
+		losers = append(losers, &storageKey{collection: collection, key: key, ownerID: loserID})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, loser := range losers {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM storage WHERE collection = $1 AND key = $2 AND user_id = $3", loser.collection, loser.key, loser.ownerID); err != nil {
+			return err
+		}
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE storage SET user_id = $1, update_time = now() WHERE user_id = $2", targetID, sourceID)
+	return err
+}
+
+func accountMergeWallet(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID, source, target *accountMergeUser) error {
+	var sourceWallet, targetWallet map[string]interface{}
+	if err := json.Unmarshal([]byte(source.wallet), &sourceWallet); err != nil {
+		return err
+	}
+	if len(sourceWallet) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(target.wallet), &targetWallet); err != nil {
+		return err
+	}
+
+	// The source wallet is added to the target wallet as if it were a single wallet update.
+	merged, err := applyWalletUpdate(targetWallet, sourceWallet, "")
+	if err != nil {
+		return StatusError(codes.FailedPrecondition, fmt.Sprintf("Wallets cannot be merged: %v", err.Error()), err)
+	}
+	mergedData, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET wallet = $2, update_time = now() WHERE id = $1", targetID, mergedData); err != nil {
+		return err
+	}
+
+	metadata, err := json.Marshal(map[string]string{"merged_user_id": sourceID.String()})
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO wallet_ledger (id, user_id, changeset, metadata) VALUES ($1, $2, $3, $4)", uuid.Must(uuid.NewV4()), targetID, source.wallet, metadata)
+	return err
+}
+
+func accountMergeFriends(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID) error {
+	// Relationships between the two users being merged are dropped.
+	res, err := tx.ExecContext(ctx, "DELETE FROM user_edge WHERE source_id = $1 AND destination_id = $2", targetID, sourceID)
+	if err != nil {
+		return err
+	}
+	removedTargetEdges, _ := res.RowsAffected()
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_edge WHERE source_id = $1 AND destination_id = $2", sourceID, targetID); err != nil {
+		return err
+	}
+
+	// Where both users have a relationship with the same user, the target's is kept.
+	query := `
+SELECT s.destination_id FROM user_edge s WHERE s.source_id = $1 AND s.destination_id IN
+	(SELECT destination_id FROM user_edge WHERE source_id = $2 UNION SELECT source_id FROM user_edge WHERE destination_id = $2)
+UNION
+SELECT s.source_id FROM user_edge s WHERE s.destination_id = $1 AND s.source_id IN
+	(SELECT destination_id FROM user_edge WHERE source_id = $2 UNION SELECT source_id FROM user_edge WHERE destination_id = $2)`
+	rows, err := tx.QueryContext(ctx, query, sourceID, targetID)
+	if err != nil {
+		return err
+	}
+	shared := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		shared = append(shared, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, id := range shared {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_edge WHERE source_id = $1 AND destination_id = $2", sourceID, id); err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, "DELETE FROM user_edge WHERE source_id = $1 AND destination_id = $2", id, sourceID)
+		if err != nil {
+			return err
+		}
+		if count, _ := res.RowsAffected(); count > 0 {
+			if _, err := tx.ExecContext(ctx, "UPDATE users SET edge_count = edge_count - $2, update_time = now() WHERE id = $1", id, count); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Move the remaining relationships, edge counts only track the relationships each user is the source of.
+	res, err = tx.ExecContext(ctx, "UPDATE user_edge SET source_id = $1 WHERE source_id = $2", targetID, sourceID)
+	if err != nil {
+		return err
+	}
+	movedTargetEdges, _ := res.RowsAffected()
+	if _, err := tx.ExecContext(ctx, "UPDATE user_edge SET destination_id = $1 WHERE destination_id = $2", targetID, sourceID); err != nil {
+		return err
+	}
+	if movedTargetEdges != removedTargetEdges {
+		if _, err := tx.ExecContext(ctx, "UPDATE users SET edge_count = edge_count + $2, update_time = now() WHERE id = $1", targetID, movedTargetEdges-removedTargetEdges); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func accountMergeGroups(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID) error {
+	sourceStates, err := accountMergeGroupStates(ctx, tx, sourceID)
+	if err != nil {
+		return err
+	}
+	targetStates, err := accountMergeGroupStates(ctx, tx, targetID)
+	if err != nil {
+		return err
+	}
+
+	for groupID, sourceState := range sourceStates {
+		targetState, found := targetStates[groupID]
+		if !found {
+			continue
+		}
+
+		// Both users are in the group, the target keeps the higher of the two roles.
+		if sourceState < targetState {
+			if _, err := tx.ExecContext(ctx, "UPDATE group_edge SET state = $3, update_time = now() WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1)", groupID, targetID, sourceState); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM group_edge WHERE (source_id = $1 AND destination_id = $2) OR (source_id = $2 AND destination_id = $1)", groupID, sourceID); err != nil {
+			return err
+		}
+		// Join requests do not count towards group membership.
+		if sourceState < 3 && targetState < 3 {
+			if _, err := tx.ExecContext(ctx, "UPDATE groups SET edge_count = edge_count - 1, update_time = now() WHERE id = $1", groupID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE group_edge SET destination_id = $1 WHERE destination_id = $2", targetID, sourceID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE group_edge SET source_id = $1 WHERE source_id = $2", targetID, sourceID); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE groups SET creator_id = $1 WHERE creator_id = $2", targetID, sourceID)
+	return err
+}
+
+func accountMergeGroupStates(ctx context.Context, tx *sql.Tx, userID uuid.UUID) (map[string]int64, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT source_id, state FROM group_edge WHERE destination_id = $1", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	states := make(map[string]int64)
+	for rows.Next() {
+		var groupID string
+		var state int64
+		if err := rows.Scan(&groupID, &state); err != nil {
+			return nil, err
+		}
+		states[groupID] = state
+	}
+	return states, rows.Err()
+}
+
+func accountMergeLeaderboardRecords(ctx context.Context, tx *sql.Tx, sourceID, targetID uuid.UUID, targetUsername string, conflict AccountMergeConflict) ([]*accountMergeRankChange, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT s.leaderboard_id, s.expiry_time, s.update_time, t.update_time FROM leaderboard_record s JOIN leaderboard_record t ON s.leaderboard_id = t.leaderboard_id AND s.expiry_time = t.expiry_time WHERE s.owner_id = $1 AND t.owner_id = $2", sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*accountMergeRankChange, 0)
+	expiryTimes := make([]time.Time, 0)
+	for rows.Next() {
+		var leaderboardID string
+		var expiryTime, sourceUpdateTime, targetUpdateTime pq.NullTime
+		if err := rows.Scan(&leaderboardID, &expiryTime, &sourceUpdateTime, &targetUpdateTime); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		loserID := sourceID
+		if conflict.keepSource(targetUpdateTime.Time, sourceUpdateTime.Time) {
+			loserID = targetID
+		}
+		changes = append(changes, &accountMergeRankChange{leaderboardID: leaderboardID, expiryTime: expiryTime.Time.Unix(), ownerID: loserID})
+		expiryTimes = append(expiryTimes, expiryTime.Time)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i, change := range changes {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM leaderboard_record WHERE owner_id = $1 AND leaderboard_id = $2 AND expiry_time = $3", change.ownerID, change.leaderboardID, expiryTimes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err = tx.QueryContext(ctx, "UPDATE leaderboard_record SET owner_id = $1, username = $2 WHERE owner_id = $3 RETURNING leaderboard_id, expiry_time, score, subscore", targetID, targetUsername, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		change := &accountMergeRankChange{ownerID: targetID, insert: true}
+		var expiryTime pq.NullTime
+		if err := rows.Scan(&change.leaderboardID, &expiryTime, &change.score, &change.subscore); err != nil {
+			return nil, err
+		}
+		change.expiryTime = expiryTime.Time.Unix()
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
@@ -64,6 +64,8 @@ func AuthenticateCustom(ctx context.Context, logger *zap.Logger, db *sql.DB, cus
 			return "", "", false, status.Error(codes.Unauthenticated, "Error finding or creating user account.")
 		}
 
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderCustom, customID, false)
+
 		return dbUserID, dbUsername, false, nil
 	}
 
@@ -96,6 +98,8 @@ func AuthenticateCustom(ctx context.Context, logger *zap.Logger, db *sql.DB, cus
 		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
 	}
 
+	identityAuthenticated(ctx, logger, db, userID, IdentityProviderCustom, customID, true)
+
 	return userID, username, true, nil
 }
 
@@ -135,6 +139,8 @@ func AuthenticateDevice(ctx context.Context, logger *zap.Logger, db *sql.DB, dev
 			return "", "", false, status.Error(codes.Unauthenticated, "Error finding or creating user account.")
 		}
 
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderDevice, deviceID, false)
+
 		return dbUserID, dbUsername, false, nil
 	}
 
@@ -204,6 +210,8 @@ WHERE NOT EXISTS
 		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
 	}
 
+	identityAuthenticated(ctx, logger, db, userID, IdentityProviderDevice, deviceID, true)
+
 	return userID, username, true, nil
 }
 
@@ -240,6 +248,8 @@ func AuthenticateEmail(ctx context.Context, logger *zap.Logger, db *sql.DB, emai
 			return "", "", false, status.Error(codes.Unauthenticated, "Invalid credentials.")
 		}
 
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderEmail, email, false)
+
 		return dbUserID, dbUsername, false, nil
 	}
 
@@ -273,16 +283,19 @@ func AuthenticateEmail(ctx context.Context, logger *zap.Logger, db *sql.DB, emai
 		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
 	}
 
+	identityAuthenticated(ctx, logger, db, userID, IdentityProviderEmail, email, true)
+
 	return userID, username, true, nil
 }
 
 func AuthenticateUsername(ctx context.Context, logger *zap.Logger, db *sql.DB, username, password string) (string, error) {
 	// Look for an existing account.
-	query := "SELECT id, password, disable_time FROM users WHERE username = $1"
+	query := "SELECT id, email, password, disable_time FROM users WHERE username = $1"
 	var dbUserID string
+	var dbEmail sql.NullString
 	var dbPassword []byte
 	var dbDisableTime pq.NullTime
-	err := db.QueryRowContext(ctx, query, username).Scan(&dbUserID, &dbPassword, &dbDisableTime)
+	err := db.QueryRowContext(ctx, query, username).Scan(&dbUserID, &dbEmail, &dbPassword, &dbDisableTime)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			// Account not found and creation is never allowed for this type.
@@ -311,6 +324,10 @@ func AuthenticateUsername(ctx context.Context, logger *zap.Logger, db *sql.DB, u
 		return "", status.Error(codes.Unauthenticated, "Invalid credentials.")
 	}
 
+	if dbEmail.Valid {
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderEmail, dbEmail.String, false)
+	}
+
 	return dbUserID, nil
 }
 
@@ -345,6 +362,8 @@ func AuthenticateFacebook(ctx context.Context, logger *zap.Logger, db *sql.DB, c
 			return "", "", false, status.Error(codes.Unauthenticated, "Error finding or creating user account.")
 		}
 
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderFacebook, facebookProfile.ID, false)
+
 		return dbUserID, dbUsername, false, nil
 	}
 
@@ -377,6 +396,8 @@ func AuthenticateFacebook(ctx context.Context, logger *zap.Logger, db *sql.DB, c
 		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
 	}
 
+	identityAuthenticated(ctx, logger, db, userID, IdentityProviderFacebook, facebookProfile.ID, true)
+
 	return userID, username, true, nil
 }
 
@@ -411,6 +432,8 @@ func AuthenticateGameCenter(ctx context.Context, logger *zap.Logger, db *sql.DB,
 			return "", "", false, status.Error(codes.Unauthenticated, "Error finding or creating user account.")
 		}
 
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderGameCenter, playerID, false)
+
 		return dbUserID, dbUsername, false, nil
 	}
 
@@ -443,6 +466,8 @@ func AuthenticateGameCenter(ctx context.Context, logger *zap.Logger, db *sql.DB,
 		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
 	}
 
+	identityAuthenticated(ctx, logger, db, userID, IdentityProviderGameCenter, playerID, true)
+
 	return userID, username, true, nil
 }
 
@@ -518,6 +543,8 @@ func AuthenticateGoogle(ctx context.Context, logger *zap.Logger, db *sql.DB, cli
 			}
 		}
 
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderGoogle, googleProfile.Sub, false)
+
 		return dbUserID, dbUsername, false, nil
 	}
 
@@ -550,6 +577,177 @@ func AuthenticateGoogle(ctx context.Context, logger *zap.Logger, db *sql.DB, cli
 		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
 	}
 
+	identityAuthenticated(ctx, logger, db, userID, IdentityProviderGoogle, googleProfile.Sub, true)
+
+	return userID, username, true, nil
+}
+
+func AuthenticateApple(ctx context.Context, logger *zap.Logger, db *sql.DB, client *social.Client, bundleID, token, username string, create bool) (string, string, bool, error) {
+	appleProfile, err := client.CheckAppleToken(ctx, bundleID, token)
+	if err != nil {
+		logger.Info("Could not authenticate Apple profile.", zap.Error(err))
+		return "", "", false, status.Error(codes.Unauthenticated, "Could not authenticate Apple profile.")
+	}
+	appleID := appleProfile.ID
+	found := true
+
+	// Look for an existing account.
+	query := "SELECT id, username, disable_time FROM users WHERE apple_id = $1"
+	var dbUserID string
+	var dbUsername string
+	var dbDisableTime pq.NullTime
+	err = db.QueryRowContext(ctx, query, appleID).Scan(&dbUserID, &dbUsername, &dbDisableTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			found = false
+		} else {
+			logger.Error("Error looking up user by Apple ID.", zap.Error(err), zap.String("appleID", appleID), zap.String("username", username), zap.Bool("create", create))
+			return "", "", false, status.Error(codes.Internal, "Error finding user account.")
+		}
+	}
+
+	// Existing account found.
+	if found {
+		// Check if it's disabled.
+		if dbDisableTime.Valid && dbDisableTime.Time.Unix() != 0 {
+			logger.Info("User account is disabled.", zap.Error(err), zap.String("appleID", appleID), zap.String("username", username), zap.Bool("create", create))
+			return "", "", false, status.Error(codes.Unauthenticated, "Error finding or creating user account.")
+		}
+
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderApple, appleID, false)
+
+		return dbUserID, dbUsername, false, nil
+	}
+
+	if !create {
+		// No user account found, and creation is not allowed.
+		return "", "", false, status.Error(codes.NotFound, "User account not found.")
+	}
+
+	// Create a new account.
+	userID := uuid.Must(uuid.NewV4()).String()
+	query = "INSERT INTO users (id, username, apple_id, create_time, update_time) VALUES ($1, $2, $3, now(), now())"
+	result, err := db.ExecContext(ctx, query, userID, username, appleID)
+	if err != nil {
+		if e, ok := err.(*pq.Error); ok && e.Code == dbErrorUniqueViolation {
+			if strings.Contains(e.Message, "users_username_key") {
+				// Username is already in use by a different account.
+				return "", "", false, status.Error(codes.AlreadyExists, "Username is already in use.")
+			} else if strings.Contains(e.Message, "users_apple_id_key") {
+				// A concurrent write has inserted this Apple ID.
+				logger.Info("Did not insert new user as Apple ID already exists.", zap.Error(err), zap.String("appleID", appleID), zap.String("username", username), zap.Bool("create", create))
+				return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
+			}
+		}
+		logger.Error("Cannot find or create user with Apple ID.", zap.Error(err), zap.String("appleID", appleID), zap.String("username", username), zap.Bool("create", create))
+		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
+	}
+
+	if rowsAffectedCount, _ := result.RowsAffected(); rowsAffectedCount != 1 {
+		logger.Error("Did not insert new user.", zap.Int64("rows_affected", rowsAffectedCount))
+		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
+	}
+
+	identityAuthenticated(ctx, logger, db, userID, IdentityProviderApple, appleID, true)
+
+	return userID, username, true, nil
+}
+
+func AuthenticateOidc(ctx context.Context, logger *zap.Logger, db *sql.DB, client *social.Client, config Config, provider, token, username string, create bool) (string, string, bool, error) {
+	oidcProfile, err := checkOidcToken(ctx, logger, client, config, provider, token)
+	if err != nil {
+		return "", "", false, err
+	}
+	subject := oidcProfile.Subject
+	found := true
+
+	// Look for an existing account.
+	query := "SELECT u.id, u.username, u.disable_time FROM user_identity ui JOIN users u ON u.id = ui.user_id WHERE ui.provider = $1 AND ui.subject = $2"
+	var dbUserID string
+	var dbUsername string
+	var dbDisableTime pq.NullTime
+	err = db.QueryRowContext(ctx, query, provider, subject).Scan(&dbUserID, &dbUsername, &dbDisableTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			found = false
+		} else {
+			logger.Error("Error looking up user by OpenID Connect identity.", zap.Error(err), zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username), zap.Bool("create", create))
+			return "", "", false, status.Error(codes.Internal, "Error finding user account.")
+		}
+	}
+
+	// Existing account found.
+	if found {
+		// Check if it's disabled.
+		if dbDisableTime.Valid && dbDisableTime.Time.Unix() != 0 {
+			logger.Info("User account is disabled.", zap.Error(err), zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username), zap.Bool("create", create))
+			return "", "", false, status.Error(codes.Unauthenticated, "Error finding or creating user account.")
+		}
+
+		identityAuthenticated(ctx, logger, db, dbUserID, provider, subject, false)
+
+		return dbUserID, dbUsername, false, nil
+	}
+
+	if !create {
+		// No user account found, and creation is not allowed.
+		return "", "", false, status.Error(codes.NotFound, "User account not found.")
+	}
+
+	// Create a new account.
+	userID := uuid.Must(uuid.NewV4()).String()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("Could not begin database transaction.", zap.Error(err))
+		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
+	}
+
+	err = crdb.ExecuteInTx(ctx, tx, func() error {
+		query := "INSERT INTO users (id, username, create_time, update_time) VALUES ($1, $2, now(), now())"
+		result, err := tx.ExecContext(ctx, query, userID, username)
+		if err != nil {
+			if e, ok := err.(*pq.Error); ok && e.Code == dbErrorUniqueViolation && strings.Contains(e.Message, "users_username_key") {
+				return StatusError(codes.AlreadyExists, "Username is already in use.", err)
+			}
+			logger.Debug("Cannot find or create user with OpenID Connect identity.", zap.Error(err), zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username), zap.Bool("create", create))
+			return err
+		}
+
+		if rowsAffectedCount, _ := result.RowsAffected(); rowsAffectedCount != 1 {
+			logger.Debug("Did not insert new user.", zap.Int64("rows_affected", rowsAffectedCount))
+			return StatusError(codes.Internal, "Error finding or creating user account.", ErrRowsAffectedCount)
+		}
+
+		query = "INSERT INTO user_identity (provider, subject, user_id) VALUES ($1, $2, $3)"
+		result, err = tx.ExecContext(ctx, query, provider, subject, userID)
+		if err != nil {
+			if e, ok := err.(*pq.Error); ok && e.Code == dbErrorUniqueViolation {
+				// A concurrent write has inserted this identity.
+				logger.Info("Did not insert new user as OpenID Connect identity already exists.", zap.Error(err), zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username), zap.Bool("create", create))
+				return StatusError(codes.Internal, "Error finding or creating user account.", err)
+			}
+			logger.Debug("Cannot add OpenID Connect identity.", zap.Error(err), zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username), zap.Bool("create", create))
+			return err
+		}
+
+		if rowsAffectedCount, _ := result.RowsAffected(); rowsAffectedCount != 1 {
+			logger.Debug("Did not insert new user.", zap.Int64("rows_affected", rowsAffectedCount))
+			return StatusError(codes.Internal, "Error finding or creating user account.", ErrRowsAffectedCount)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if e, ok := err.(*statusError); ok {
+			return "", "", false, e.Status()
+		}
+		logger.Error("Error in database transaction.", zap.Error(err))
+		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
+	}
+
+	identityAuthenticated(ctx, logger, db, userID, provider, subject, true)
+
 	return userID, username, true, nil
 }
 
@@ -585,6 +783,8 @@ func AuthenticateSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, clie
 			return "", "", false, status.Error(codes.Unauthenticated, "Error finding or creating user account.")
 		}
 
+		identityAuthenticated(ctx, logger, db, dbUserID, IdentityProviderSteam, steamID, false)
+
 		return dbUserID, dbUsername, false, nil
 	}
 
@@ -617,6 +817,8 @@ func AuthenticateSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, clie
 		return "", "", false, status.Error(codes.Internal, "Error finding or creating user account.")
 	}
 
+	identityAuthenticated(ctx, logger, db, userID, IdentityProviderSteam, steamID, true)
+
 	return userID, username, true, nil
 }
 
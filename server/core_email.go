@@ -0,0 +1,220 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	emailTokenTypeVerify = 0
+	emailTokenTypeReset  = 1
+)
+
+// SendEmailVerification issues a single-use token for the user's current email address, and sends it to that address.
+// Any verification token previously issued to the user is replaced.
+func SendEmailVerification(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sender EmailSender, userID uuid.UUID) error {
+	if sender == nil {
+		return status.Error(codes.FailedPrecondition, "Email delivery is not configured.")
+	}
+
+	var dbUsername string
+	var dbEmail sql.NullString
+	var dbVerifyTime pq.NullTime
+	err := db.QueryRowContext(ctx, "SELECT username, email, verify_time FROM users WHERE id = $1", userID).Scan(&dbUsername, &dbEmail, &dbVerifyTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Error(codes.NotFound, "Account not found.")
+		}
+		logger.Error("Error looking up user by ID.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error sending email verification.")
+	}
+	if !dbEmail.Valid || dbEmail.String == "" {
+		return status.Error(codes.FailedPrecondition, "Account does not have an email address.")
+	}
+	if dbVerifyTime.Valid && dbVerifyTime.Time.Unix() != 0 {
+		return status.Error(codes.FailedPrecondition, "Email address is already verified.")
+	}
+
+	return sendEmailToken(ctx, logger, db, sender, emailTokenTypeVerify, config.GetEmail().VerifyTokenExpirySec, userID, dbUsername, dbEmail.String)
+}
+
+// VerifyEmail consumes an email verification token and marks the email address it was issued for as verified, as
+// long as it is still the account's email address.
+func VerifyEmail(ctx context.Context, logger *zap.Logger, db *sql.DB, token string) error {
+	userID, email, err := consumeEmailToken(ctx, logger, db, emailTokenTypeVerify, token)
+	if err != nil {
+		return err
+	}
+
+	res, err := db.ExecContext(ctx, "UPDATE users SET verify_time = now(), update_time = now() WHERE id = $1 AND email = $2", userID, email)
+	if err != nil {
+		logger.Error("Error verifying email address.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error verifying email address.")
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected != 1 {
+		// The account's email address has changed since the token was issued.
+		return status.Error(codes.InvalidArgument, "Verification token invalid or expired.")
+	}
+
+	return nil
+}
+
+// SendPasswordReset issues a single-use password reset token for the account with the given email address, and sends
+// it to that address. No error is returned if there is no such account, so callers can't discover which email
+// addresses are registered.
+func SendPasswordReset(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sender EmailSender, email string) error {
+	if sender == nil {
+		return status.Error(codes.FailedPrecondition, "Email delivery is not configured.")
+	}
+
+	var dbUserID uuid.UUID
+	var dbUsername string
+	var dbDisableTime pq.NullTime
+	err := db.QueryRowContext(ctx, "SELECT id, username, disable_time FROM users WHERE email = $1", email).Scan(&dbUserID, &dbUsername, &dbDisableTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			logger.Debug("No account found for password reset.", zap.String("email", email))
+			return nil
+		}
+		logger.Error("Error looking up user by email.", zap.Error(err))
+		return status.Error(codes.Internal, "Error sending password reset.")
+	}
+	if dbDisableTime.Valid && dbDisableTime.Time.Unix() != 0 {
+		logger.Info("User account is disabled.", zap.String("user_id", dbUserID.String()))
+		return nil
+	}
+
+	return sendEmailToken(ctx, logger, db, sender, emailTokenTypeReset, config.GetEmail().ResetTokenExpirySec, dbUserID, dbUsername, email)
+}
+
+// ResetPassword consumes a password reset token and sets a new password on the account it was issued for. All of the
+// account's existing sessions are revoked.
+func ResetPassword(ctx context.Context, logger *zap.Logger, db *sql.DB, sessionCache SessionCache, token, password string) error {
+	userID, email, err := consumeEmailToken(ctx, logger, db, emailTokenTypeReset, token)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("Error hashing password.", zap.Error(err))
+		return status.Error(codes.Internal, "Error resetting password.")
+	}
+
+	res, err := db.ExecContext(ctx, "UPDATE users SET password = $3, update_time = now() WHERE id = $1 AND email = $2", userID, email, hashedPassword)
+	if err != nil {
+		logger.Error("Error resetting password.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error resetting password.")
+	}
+	if rowsAffected, _ := res.RowsAffected(); rowsAffected != 1 {
+		// The account's email address has changed since the token was issued.
+		return status.Error(codes.InvalidArgument, "Password reset token invalid or expired.")
+	}
+
+	sessionCache.RevokeAll(userID)
+	if err := SessionRefreshTokensRevoke(ctx, logger, db, userID); err != nil {
+		return status.Error(codes.Internal, "Error resetting password.")
+	}
+
+	return nil
+}
+
+func sendEmailToken(ctx context.Context, logger *zap.Logger, db *sql.DB, sender EmailSender, tokenType int, expirySec int64, userID uuid.UUID, username, email string) error {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		logger.Error("Error generating email token.", zap.Error(err))
+		return status.Error(codes.Internal, "Error sending email.")
+	}
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+	// Only a hash of the token is stored, it is only ever known to the recipient of the email.
+	tokenHash := sha256.Sum256([]byte(token))
+	expiry := time.Now().UTC().Add(time.Duration(expirySec) * time.Second)
+
+	// Only the most recently issued token of each type is valid.
+	if _, err := db.ExecContext(ctx, "DELETE FROM user_email_token WHERE user_id = $1 AND type = $2", userID, tokenType); err != nil {
+		logger.Error("Error removing email tokens.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error sending email.")
+	}
+
+	query := "INSERT INTO user_email_token (token_hash, user_id, type, email, expiry_time) VALUES ($1, $2, $3, $4, $5)"
+	if _, err := db.ExecContext(ctx, query, tokenHash[:], userID, tokenType, email, expiry); err != nil {
+		logger.Error("Error creating email token.", zap.Error(err), zap.String("user_id", userID.String()))
+		return status.Error(codes.Internal, "Error sending email.")
+	}
+
+	messageType := EmailMessageTypeVerify
+	if tokenType == emailTokenTypeReset {
+		messageType = EmailMessageTypeReset
+	}
+	message := &EmailMessage{
+		Type:     messageType,
+		UserID:   userID.String(),
+		Username: username,
+		Email:    email,
+		Token:    token,
+		Expiry:   expiry.Unix(),
+	}
+	if err := sender.Send(ctx, message); err != nil {
+		logger.Error("Error sending email.", zap.Error(err), zap.String("user_id", userID.String()), zap.String("type", messageType))
+		// The token was never delivered, don't leave it usable.
+		if _, err := db.ExecContext(ctx, "DELETE FROM user_email_token WHERE token_hash = $1", tokenHash[:]); err != nil {
+			logger.Error("Error removing email token.", zap.Error(err), zap.String("user_id", userID.String()))
+		}
+		return status.Error(codes.Internal, "Error sending email.")
+	}
+
+	return nil
+}
+
+func consumeEmailToken(ctx context.Context, logger *zap.Logger, db *sql.DB, tokenType int, token string) (uuid.UUID, string, error) {
+	invalidMessage := "Verification token invalid or expired."
+	if tokenType == emailTokenTypeReset {
+		invalidMessage = "Password reset token invalid or expired."
+	}
+
+	tokenHash := sha256.Sum256([]byte(token))
+
+	// Tokens are removed as they're used, so each can only be used once.
+	var dbUserID uuid.UUID
+	var dbEmail string
+	var dbExpiryTime pq.NullTime
+	query := "DELETE FROM user_email_token WHERE token_hash = $1 AND type = $2 RETURNING user_id, email, expiry_time"
+	err := db.QueryRowContext(ctx, query, tokenHash[:], tokenType).Scan(&dbUserID, &dbEmail, &dbExpiryTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, "", status.Error(codes.InvalidArgument, invalidMessage)
+		}
+		logger.Error("Error consuming email token.", zap.Error(err))
+		return uuid.Nil, "", status.Error(codes.Internal, "Error processing token.")
+	}
+	if !dbExpiryTime.Valid || !dbExpiryTime.Time.After(time.Now()) {
+		return uuid.Nil, "", status.Error(codes.InvalidArgument, invalidMessage)
+	}
+
+	return dbUserID, dbEmail, nil
+}
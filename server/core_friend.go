@@ -15,7 +15,10 @@
 package server
 
 import (
+	"bytes"
 	"database/sql"
+	"encoding/base64"
+	"encoding/gob"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -33,9 +36,19 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	ErrFriendInvalidCursor = errors.New("friend cursor invalid")
+	ErrFriendNotFound      = errors.New("friend not found")
+)
+
+type edgeListCursor struct {
+	State    int64
+	Position int64
+}
+
 func GetFriendIDs(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) (*api.Friends, error) {
 	query := `
-SELECT id, state
+SELECT id, state, user_edge.update_time, user_edge.metadata
 FROM users, user_edge WHERE id = destination_id AND source_id = $1`
 
 	rows, err := db.QueryContext(ctx, query, userID)
@@ -50,8 +63,10 @@ FROM users, user_edge WHERE id = destination_id AND source_id = $1`
 	for rows.Next() {
 		var id string
 		var state sql.NullInt64
+		var updateTime pq.NullTime
+		var metadata []byte
 
-		if err = rows.Scan(&id, &state); err != nil {
+		if err = rows.Scan(&id, &state, &updateTime, &metadata); err != nil {
 			logger.Error("Error retrieving friend IDs.", zap.Error(err))
 			return nil, err
 		}
@@ -66,6 +81,8 @@ FROM users, user_edge WHERE id = destination_id AND source_id = $1`
 			State: &wrappers.Int32Value{
 				Value: int32(state.Int64),
 			},
+			UpdateTime: &timestamp.Timestamp{Seconds: updateTime.Time.Unix()},
+			Metadata:   string(metadata),
 		})
 	}
 	if err = rows.Err(); err != nil {
@@ -76,14 +93,46 @@ FROM users, user_edge WHERE id = destination_id AND source_id = $1`
 	return &api.Friends{Friends: friends}, nil
 }
 
-func GetFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, userID uuid.UUID) (*api.Friends, error) {
+// ListFriends returns a page of the user's friends, invites and blocked users, ordered by friend state. A limit of 0
+// returns all of them.
+func ListFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, userID uuid.UUID, limit int, state *wrappers.Int32Value, cursor string) (*api.Friends, error) {
+	var incomingCursor *edgeListCursor
+	if cursor != "" {
+		incomingCursor = &edgeListCursor{}
+		if cb, err := base64.RawURLEncoding.DecodeString(cursor); err != nil {
+			return nil, ErrFriendInvalidCursor
+		} else if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(incomingCursor); err != nil {
+			return nil, ErrFriendInvalidCursor
+		}
+		if state != nil && int64(state.Value) != incomingCursor.State {
+			// The cursor was produced by a listing with a different state filter.
+			return nil, ErrFriendInvalidCursor
+		}
+	}
+
 	query := `
 SELECT id, username, display_name, avatar_url,
-	lang_tag, location, timezone, metadata,
-	create_time, users.update_time, state
+	lang_tag, location, timezone, users.metadata,
+	create_time, users.update_time, state, position,
+	user_edge.update_time, user_edge.metadata
 FROM users, user_edge WHERE id = destination_id AND source_id = $1`
+	params := []interface{}{userID}
+	if state != nil {
+		params = append(params, state.Value)
+		query += fmt.Sprintf(" AND state = $%v", len(params))
+	}
+	if incomingCursor != nil {
+		params = append(params, incomingCursor.State, incomingCursor.Position)
+		query += fmt.Sprintf(" AND (state, position) > ($%v, $%v)", len(params)-1, len(params))
+	}
+	query += " ORDER BY state ASC, position ASC"
+	if limit > 0 {
+		// Fetch one extra row to know if there is a next page.
+		params = append(params, limit+1)
+		query += fmt.Sprintf(" LIMIT $%v", len(params))
+	}
 
-	rows, err := db.QueryContext(ctx, query, userID)
+	rows, err := db.QueryContext(ctx, query, params...)
 	if err != nil {
 		logger.Error("Error retrieving friends.", zap.Error(err))
 		return nil, err
@@ -91,6 +140,8 @@ FROM users, user_edge WHERE id = destination_id AND source_id = $1`
 	defer rows.Close()
 
 	friends := make([]*api.Friend, 0)
+	var outgoingCursor string
+	var lastCursor *edgeListCursor
 
 	for rows.Next() {
 		var id string
@@ -104,12 +155,25 @@ FROM users, user_edge WHERE id = destination_id AND source_id = $1`
 		var createTime pq.NullTime
 		var updateTime pq.NullTime
 		var state sql.NullInt64
+		var position sql.NullInt64
+		var edgeUpdateTime pq.NullTime
+		var edgeMetadata []byte
 
-		if err = rows.Scan(&id, &username, &displayName, &avatarURL, &lang, &location, &timezone, &metadata, &createTime, &updateTime, &state); err != nil {
+		if err = rows.Scan(&id, &username, &displayName, &avatarURL, &lang, &location, &timezone, &metadata, &createTime, &updateTime, &state, &position, &edgeUpdateTime, &edgeMetadata); err != nil {
 			logger.Error("Error retrieving friends.", zap.Error(err))
 			return nil, err
 		}
 
+		if limit > 0 && len(friends) >= limit {
+			cursorBuf := new(bytes.Buffer)
+			if err := gob.NewEncoder(cursorBuf).Encode(lastCursor); err != nil {
+				logger.Error("Error creating friend list cursor.", zap.Error(err))
+				return nil, err
+			}
+			outgoingCursor = base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes())
+			break
+		}
+
 		friendID := uuid.FromStringOrNil(id)
 		online := false
 		if tracker != nil {
@@ -135,14 +199,30 @@ FROM users, user_edge WHERE id = destination_id AND source_id = $1`
 			State: &wrappers.Int32Value{
 				Value: int32(state.Int64),
 			},
+			UpdateTime: &timestamp.Timestamp{Seconds: edgeUpdateTime.Time.Unix()},
+			Metadata:   string(edgeMetadata),
 		})
+		lastCursor = &edgeListCursor{State: state.Int64, Position: position.Int64}
 	}
 	if err = rows.Err(); err != nil {
 		logger.Error("Error retrieving friends.", zap.Error(err))
 		return nil, err
 	}
 
-	return &api.Friends{Friends: friends}, nil
+	return &api.Friends{Friends: friends, Cursor: outgoingCursor}, nil
+}
+
+// UpdateFriendMetadata replaces the metadata the user has stored for their relationship with another user.
+func UpdateFriendMetadata(ctx context.Context, logger *zap.Logger, db *sql.DB, userID, friendID uuid.UUID, metadata string) error {
+	res, err := db.ExecContext(ctx, "UPDATE user_edge SET metadata = $3 WHERE source_id = $1 AND destination_id = $2", userID, friendID, metadata)
+	if err != nil {
+		logger.Error("Error updating friend metadata.", zap.Error(err), zap.String("user", userID.String()), zap.String("friend", friendID.String()))
+		return err
+	}
+	if count, _ := res.RowsAffected(); count == 0 {
+		return ErrFriendNotFound
+	}
+	return nil
 }
 
 func AddFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, messageRouter MessageRouter, userID uuid.UUID, username string, friendIDs []string) error {
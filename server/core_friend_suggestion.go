@@ -0,0 +1,309 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/api"
+	"go.uber.org/zap"
+)
+
+const (
+	// Users and groups with more edges than this are not expanded when looking for mutual friends or suggestions.
+	friendSuggestionMaxEdgeCount = 1000
+	// Max number of candidates each suggestion source may contribute.
+	friendSuggestionMaxCandidates = 1000
+	// How long after playing in the same match users are suggested to each other.
+	friendSuggestionCoplayWindow = 7 * 24 * time.Hour
+	// Max number of other match participants recorded when a user joins a match.
+	matchCoplayMaxUsers = 100
+
+	friendSuggestionMutualWeight = 3
+	friendSuggestionGroupWeight  = 2
+	friendSuggestionCoplayWeight = 1
+)
+
+// Excludes candidates who already have any relationship with the user, or who have blocked the user.
+const friendSuggestionExcludeQuery = `
+NOT EXISTS (SELECT 1 FROM user_edge x WHERE x.source_id = $1 AND x.destination_id = %[1]v)
+AND NOT EXISTS (SELECT 1 FROM user_edge x WHERE x.source_id = %[1]v AND x.destination_id = $1 AND x.state = 3)`
+
+type friendSuggestionCandidate struct {
+	id           string
+	mutualCount  int32
+	groupCount   int32
+	playedRecent bool
+}
+
+func (c *friendSuggestionCandidate) score() int32 {
+	score := c.mutualCount*friendSuggestionMutualWeight + c.groupCount*friendSuggestionGroupWeight
+	if c.playedRecent {
+		score += friendSuggestionCoplayWeight
+	}
+	return score
+}
+
+// ListMutualFriends returns users who are friends with both given users.
+func ListMutualFriends(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, userID, otherUserID uuid.UUID, limit int) (*api.Users, error) {
+	edgeCount, err := friendEdgeCount(ctx, logger, db, userID)
+	if err != nil {
+		return nil, err
+	}
+	otherEdgeCount, err := friendEdgeCount(ctx, logger, db, otherUserID)
+	if err != nil {
+		return nil, err
+	}
+	if edgeCount == 0 || otherEdgeCount == 0 {
+		return &api.Users{Users: make([]*api.User, 0)}, nil
+	}
+
+	// Scan the smaller friend list, and only as many edges as that user has friends.
+	if otherEdgeCount < edgeCount {
+		userID, otherUserID = otherUserID, userID
+		edgeCount = otherEdgeCount
+	}
+	query := `
+SELECT destination_id FROM user_edge
+WHERE source_id = $1 AND state = 0
+AND EXISTS (SELECT 1 FROM user_edge x WHERE x.source_id = $2 AND x.destination_id = user_edge.destination_id AND x.state = 0)
+LIMIT $3`
+	if edgeCount < limit {
+		limit = edgeCount
+	}
+
+	rows, err := db.QueryContext(ctx, query, userID, otherUserID, limit)
+	if err != nil {
+		logger.Error("Error retrieving mutual friends.", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			logger.Error("Error retrieving mutual friends.", zap.Error(err))
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("Error retrieving mutual friends.", zap.Error(err))
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return &api.Users{Users: make([]*api.User, 0)}, nil
+	}
+	return GetUsers(ctx, logger, db, tracker, ids, nil, nil)
+}
+
+// ListFriendSuggestions ranks users the given user is not yet connected to by how many friends and groups they share,
+// and whether they recently played in the same match.
+func ListFriendSuggestions(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, userID uuid.UUID, limit int) (*api.FriendSuggestionList, error) {
+	edgeCount, err := friendEdgeCount(ctx, logger, db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[string]*friendSuggestionCandidate)
+	candidate := func(id string) *friendSuggestionCandidate {
+		c, found := candidates[id]
+		if !found {
+			c = &friendSuggestionCandidate{id: id}
+			candidates[id] = c
+		}
+		return c
+	}
+
+	// Friends of friends, skipping friends with very large friend lists.
+	query := `
+SELECT e.destination_id, count(*) AS mutual_count
+FROM user_edge e, users f
+WHERE f.id = e.source_id AND f.edge_count <= $2 AND e.state = 0 AND e.destination_id <> $1
+AND e.source_id IN (SELECT destination_id FROM user_edge WHERE source_id = $1 AND state = 0 LIMIT $4)
+AND ` + fmt.Sprintf(friendSuggestionExcludeQuery, "e.destination_id") + `
+GROUP BY e.destination_id
+ORDER BY mutual_count DESC
+LIMIT $3`
+	if err := friendSuggestionScan(ctx, logger, db, query, []interface{}{userID, friendSuggestionMaxEdgeCount, friendSuggestionMaxCandidates, edgeCount}, func(id string, count int32) {
+		candidate(id).mutualCount = count
+	}); err != nil {
+		return nil, err
+	}
+
+	// Members of the user's groups, skipping very large or disabled groups and pending join requests.
+	query = `
+SELECT m.destination_id, count(*) AS group_count
+FROM group_edge m, groups g
+WHERE g.id = m.source_id AND g.edge_count <= $2 AND g.disable_time = '1970-01-01 00:00:00 UTC'
+AND m.state < 3 AND m.destination_id <> $1
+AND m.source_id IN (SELECT destination_id FROM group_edge WHERE source_id = $1 AND state < 3 LIMIT $2)
+AND ` + fmt.Sprintf(friendSuggestionExcludeQuery, "m.destination_id") + `
+GROUP BY m.destination_id
+ORDER BY group_count DESC
+LIMIT $3`
+	if err := friendSuggestionScan(ctx, logger, db, query, []interface{}{userID, friendSuggestionMaxEdgeCount, friendSuggestionMaxCandidates}, func(id string, count int32) {
+		candidate(id).groupCount = count
+	}); err != nil {
+		return nil, err
+	}
+
+	// Users recently seen in the same match.
+	query = `
+SELECT c.destination_id, 1
+FROM user_coplay c
+WHERE c.source_id = $1 AND c.update_time > $2
+AND ` + fmt.Sprintf(friendSuggestionExcludeQuery, "c.destination_id") + `
+ORDER BY c.update_time DESC
+LIMIT $3`
+	if err := friendSuggestionScan(ctx, logger, db, query, []interface{}{userID, time.Now().UTC().Add(-friendSuggestionCoplayWindow), friendSuggestionMaxCandidates}, func(id string, _ int32) {
+		candidate(id).playedRecent = true
+	}); err != nil {
+		return nil, err
+	}
+
+	ranked := make([]*friendSuggestionCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		ranked = append(ranked, c)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if si, sj := ranked[i].score(), ranked[j].score(); si != sj {
+			return si > sj
+		}
+		if ranked[i].mutualCount != ranked[j].mutualCount {
+			return ranked[i].mutualCount > ranked[j].mutualCount
+		}
+		return ranked[i].id < ranked[j].id
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	suggestions := make([]*api.FriendSuggestion, 0, len(ranked))
+	if len(ranked) == 0 {
+		return &api.FriendSuggestionList{Suggestions: suggestions}, nil
+	}
+
+	ids := make([]string, 0, len(ranked))
+	for _, c := range ranked {
+		ids = append(ids, c.id)
+	}
+	users, err := GetUsers(ctx, logger, db, tracker, ids, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[string]*api.User, len(users.Users))
+	for _, u := range users.Users {
+		usersByID[u.Id] = u
+	}
+
+	for _, c := range ranked {
+		user, found := usersByID[c.id]
+		if !found {
+			// User was deleted after the suggestions were gathered.
+			continue
+		}
+		suggestions = append(suggestions, &api.FriendSuggestion{
+			User:              user,
+			MutualFriendCount: c.mutualCount,
+			SharedGroupCount:  c.groupCount,
+			PlayedRecently:    c.playedRecent,
+		})
+	}
+
+	return &api.FriendSuggestionList{Suggestions: suggestions}, nil
+}
+
+// friendEdgeCount returns the number of friends the user has, capped to the max number of edges scanned per user.
+func friendEdgeCount(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) (int, error) {
+	var edgeCount int
+	if err := db.QueryRowContext(ctx, "SELECT edge_count FROM users WHERE id = $1", userID).Scan(&edgeCount); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ErrAccountNotFound
+		}
+		logger.Error("Error retrieving user edge count.", zap.Error(err), zap.String("user_id", userID.String()))
+		return 0, err
+	}
+	if edgeCount > friendSuggestionMaxEdgeCount {
+		edgeCount = friendSuggestionMaxEdgeCount
+	}
+	return edgeCount, nil
+}
+
+func friendSuggestionScan(ctx context.Context, logger *zap.Logger, db *sql.DB, query string, params []interface{}, fn func(id string, count int32)) error {
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Error retrieving friend suggestions.", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		var count int32
+		if err = rows.Scan(&id, &count); err != nil {
+			logger.Error("Error retrieving friend suggestions.", zap.Error(err))
+			return err
+		}
+		fn(id, count)
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("Error retrieving friend suggestions.", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// RecordMatchCoplay notes that a user joined a match alongside the given users, in both directions.
+func RecordMatchCoplay(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, matchID string, otherUserIDs []uuid.UUID) error {
+	statements := make([]string, 0, len(otherUserIDs)*2)
+	params := []interface{}{userID, matchID}
+	seen := make(map[uuid.UUID]struct{}, len(otherUserIDs))
+	for _, otherUserID := range otherUserIDs {
+		if otherUserID == userID || otherUserID == uuid.Nil {
+			continue
+		}
+		if _, found := seen[otherUserID]; found {
+			continue
+		}
+		if len(seen) >= matchCoplayMaxUsers {
+			break
+		}
+		seen[otherUserID] = struct{}{}
+		params = append(params, otherUserID)
+		statements = append(statements, fmt.Sprintf("($1, $%[1]v, $2, now()), ($%[1]v, $1, $2, now())", len(params)))
+	}
+	if len(statements) == 0 {
+		return nil
+	}
+
+	query := `
+INSERT INTO user_coplay (source_id, destination_id, match_id, update_time)
+VALUES ` + strings.Join(statements, ", ") + `
+ON CONFLICT (source_id, destination_id) DO UPDATE SET match_id = excluded.match_id, update_time = excluded.update_time`
+	if _, err := db.ExecContext(ctx, query, params...); err != nil {
+		logger.Error("Error recording match coplay.", zap.Error(err), zap.String("user_id", userID.String()), zap.String("match_id", matchID))
+		return err
+	}
+	return nil
+}
@@ -46,6 +46,7 @@ var (
 	ErrGroupNotFound         = errors.New("group not found")
 	ErrGroupFull             = errors.New("group is full")
 	ErrGroupLastSuperadmin   = errors.New("user is last group superadmin")
+	ErrGroupInvalidCursor    = errors.New("group cursor invalid")
 )
 
 type groupListCursor struct {
@@ -315,7 +316,7 @@ WHERE (id = $1) AND (disable_time = '1970-01-01 00:00:00 UTC')`
 
 		// If it's a private group notify superadmins/admins that someone has requested to join.
 		// Prepare notification data.
-		notificationContentBytes, err := json.Marshal(map[string]string{"username": username})
+		notificationContentBytes, err := json.Marshal(map[string]string{"username": username, "group_id": group.Id, "name": group.Name})
 		if err != nil {
 			logger.Error("Could not encode notification content.", zap.Error(err))
 		} else {
@@ -508,13 +509,14 @@ func AddGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, router M
 	}
 
 	// Prepare notification data.
-	notificationContentBytes, err := json.Marshal(map[string]string{"name": groupName.String})
+	notificationContentBytes, err := json.Marshal(map[string]string{"group_id": groupID.String(), "name": groupName.String})
 	if err != nil {
 		logger.Error("Could not encode notification content.", zap.Error(err))
 		return err
 	}
 	notificationContent := string(notificationContentBytes)
 	notificationSubject := fmt.Sprintf("You've been added to group %v", groupName.String)
+	acceptNotificationSubject := fmt.Sprintf("Your request to join group %v was accepted", groupName.String)
 	var notifications map[uuid.UUID][]*api.Notification
 
 	if err := crdb.ExecuteInTx(ctx, tx, func() error {
@@ -527,6 +529,7 @@ func AddGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, router M
 			}
 
 			incrementEdgeCount := true
+			joinRequestAccepted := false
 			var userExists sql.NullBool
 			query := "SELECT EXISTS(SELECT 1 FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID)"
 			if err := tx.QueryRowContext(ctx, query, groupID, uid).Scan(&userExists); err != nil {
@@ -547,6 +550,8 @@ func AddGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, router M
 				}
 				if res != 2 {
 					incrementEdgeCount = false
+				} else {
+					joinRequestAccepted = true
 				}
 			}
 
@@ -567,16 +572,10 @@ func AddGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, router M
 				}
 			}
 
-			notifications[uid] = []*api.Notification{
-				&api.Notification{
-					Id:         uuid.Must(uuid.NewV4()).String(),
-					Subject:    notificationSubject,
-					Content:    notificationContent,
-					SenderId:   caller.String(),
-					Code:       NotificationCodeGroupAdd,
-					Persistent: true,
-					CreateTime: &timestamp.Timestamp{Seconds: time.Now().UTC().Unix()},
-				},
+			if joinRequestAccepted {
+				notifications[uid] = groupNotification(caller, NotificationCodeGroupJoinAccept, acceptNotificationSubject, notificationContent)
+			} else {
+				notifications[uid] = groupNotification(caller, NotificationCodeGroupAdd, notificationSubject, notificationContent)
 			}
 		}
 		return nil
@@ -591,7 +590,7 @@ func AddGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, router M
 	return nil
 }
 
-func KickGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+func KickGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
 	myState := 0
 	if caller != uuid.Nil {
 		var dbState sql.NullInt64
@@ -612,13 +611,36 @@ func KickGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, caller
 		}
 	}
 
+	var groupName sql.NullString
+	query := "SELECT name FROM groups WHERE id = $1"
+	if err := db.QueryRowContext(ctx, query, groupID).Scan(&groupName); err != nil {
+		if err == sql.ErrNoRows {
+			// Nothing to kick users from.
+			return nil
+		}
+		logger.Error("Could not look up group when kicking users.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return err
+	}
+
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		logger.Error("Could not begin database transaction.", zap.Error(err))
 		return err
 	}
 
+	// Prepare notification data.
+	notificationContentBytes, err := json.Marshal(map[string]string{"group_id": groupID.String(), "name": groupName.String})
+	if err != nil {
+		logger.Error("Could not encode notification content.", zap.Error(err))
+		return err
+	}
+	notificationContent := string(notificationContentBytes)
+	var notifications map[uuid.UUID][]*api.Notification
+
 	if err := crdb.ExecuteInTx(ctx, tx, func() error {
+		// If the transaction is retried ensure we wipe any notifications that may have been prepared by previous attempts.
+		notifications = make(map[uuid.UUID][]*api.Notification, len(userIDs))
+
 		for _, uid := range userIDs {
 			// shouldn't kick self
 			if uid == caller {
@@ -682,6 +704,10 @@ RETURNING state`
 					logger.Debug("Could not update group edge_count.", zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
 					return err
 				}
+				notifications[uid] = groupNotification(caller, NotificationCodeGroupKick, fmt.Sprintf("You've been removed from group %v", groupName.String), notificationContent)
+			} else {
+				// Kicking a user who requested to join declines their request.
+				notifications[uid] = groupNotification(caller, NotificationCodeGroupJoinDecline, fmt.Sprintf("Your request to join group %v was declined", groupName.String), notificationContent)
 			}
 		}
 		return nil
@@ -690,9 +716,114 @@ RETURNING state`
 		return err
 	}
 
+	if len(notifications) > 0 {
+		NotificationSend(ctx, logger, db, router, notifications)
+	}
+
+	return nil
+}
+
+// DeclineGroupJoinRequests removes pending join requests from the given users, and lets them know their request was
+// declined. Users who are already members of the group are not affected.
+func DeclineGroupJoinRequests(ctx context.Context, logger *zap.Logger, db *sql.DB, router MessageRouter, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
+	if caller != uuid.Nil {
+		var dbState sql.NullInt64
+		query := "SELECT state FROM group_edge WHERE source_id = $1::UUID AND destination_id = $2::UUID"
+		if err := db.QueryRowContext(ctx, query, groupID, caller).Scan(&dbState); err != nil {
+			if err == sql.ErrNoRows {
+				logger.Info("Could not retrieve state as no group relationship exists.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+				return ErrGroupPermissionDenied
+			}
+			logger.Error("Could not retrieve state from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+			return err
+		}
+
+		if dbState.Int64 > 1 {
+			logger.Info("Cannot decline join requests as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()), zap.Int64("state", dbState.Int64))
+			return ErrGroupPermissionDenied
+		}
+	}
+
+	var groupName sql.NullString
+	query := "SELECT name FROM groups WHERE id = $1 AND disable_time = '1970-01-01 00:00:00 UTC'"
+	if err := db.QueryRowContext(ctx, query, groupID).Scan(&groupName); err != nil {
+		if err == sql.ErrNoRows {
+			logger.Info("Cannot decline join requests to disabled group.", zap.String("group_id", groupID.String()))
+			return ErrGroupNotFound
+		}
+		logger.Error("Could not look up group when declining join requests.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("Could not begin database transaction.", zap.Error(err))
+		return err
+	}
+
+	// Prepare notification data.
+	notificationContentBytes, err := json.Marshal(map[string]string{"group_id": groupID.String(), "name": groupName.String})
+	if err != nil {
+		logger.Error("Could not encode notification content.", zap.Error(err))
+		return err
+	}
+	notificationContent := string(notificationContentBytes)
+	notificationSubject := fmt.Sprintf("Your request to join group %v was declined", groupName.String)
+	var notifications map[uuid.UUID][]*api.Notification
+
+	if err := crdb.ExecuteInTx(ctx, tx, func() error {
+		// If the transaction is retried ensure we wipe any notifications that may have been prepared by previous attempts.
+		notifications = make(map[uuid.UUID][]*api.Notification, len(userIDs))
+
+		for _, uid := range userIDs {
+			query := `
+DELETE FROM group_edge
+WHERE
+	(
+		(source_id = $1::UUID AND destination_id = $2::UUID)
+		OR
+		(source_id = $2::UUID AND destination_id = $1::UUID)
+	)
+AND state = 3`
+			res, err := tx.ExecContext(ctx, query, groupID, uid)
+			if err != nil {
+				logger.Debug("Could not delete join request from group_edge.", zap.Error(err), zap.String("group_id", groupID.String()), zap.String("user_id", uid.String()))
+				return err
+			}
+			if rowsAffected, _ := res.RowsAffected(); rowsAffected == 0 {
+				// No pending join request from this user.
+				continue
+			}
+
+			notifications[uid] = groupNotification(caller, NotificationCodeGroupJoinDecline, notificationSubject, notificationContent)
+		}
+		return nil
+	}); err != nil {
+		logger.Error("Error declining group join requests.", zap.Error(err))
+		return err
+	}
+
+	if len(notifications) > 0 {
+		NotificationSend(ctx, logger, db, router, notifications)
+	}
+
 	return nil
 }
 
+func groupNotification(sender uuid.UUID, code int32, subject, content string) []*api.Notification {
+	return []*api.Notification{
+		&api.Notification{
+			Id:         uuid.Must(uuid.NewV4()).String(),
+			Subject:    subject,
+			Content:    content,
+			SenderId:   sender.String(),
+			Code:       code,
+			Persistent: true,
+			CreateTime: &timestamp.Timestamp{Seconds: time.Now().UTC().Unix()},
+		},
+	}
+}
+
 func PromoteGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, caller uuid.UUID, groupID uuid.UUID, userIDs []uuid.UUID) error {
 	myState := 0
 	if caller != uuid.Nil {
@@ -785,7 +916,7 @@ func ListGroupUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker
 	query := `
 SELECT u.id, u.username, u.display_name, u.avatar_url,
 	u.lang_tag, u.location, u.timezone, u.metadata,
-	u.facebook_id, u.google_id, u.gamecenter_id, u.steam_id, u.edge_count,
+	u.facebook_id, u.google_id, u.gamecenter_id, u.steam_id, u.apple_id, u.edge_count,
 	u.create_time, u.update_time, ge.state
 FROM users u, group_edge ge
 WHERE u.id = ge.source_id AND ge.destination_id = $1 AND u.disable_time = '1970-01-01 00:00:00 UTC'`
@@ -815,13 +946,14 @@ WHERE u.id = ge.source_id AND ge.destination_id = $1 AND u.disable_time = '1970-
 		var google sql.NullString
 		var gamecenter sql.NullString
 		var steam sql.NullString
+		var apple sql.NullString
 		var edgeCount int
 		var createTime pq.NullTime
 		var updateTime pq.NullTime
 		var state sql.NullInt64
 
 		if err := rows.Scan(&id, &username, &displayName, &avatarURL, &langTag, &location, &timezone, &metadata,
-			&facebook, &google, &gamecenter, &steam, &edgeCount, &createTime, &updateTime, &state); err != nil {
+			&facebook, &google, &gamecenter, &steam, &apple, &edgeCount, &createTime, &updateTime, &state); err != nil {
 			if err == sql.ErrNoRows {
 				return nil, ErrGroupNotFound
 			}
@@ -843,6 +975,7 @@ WHERE u.id = ge.source_id AND ge.destination_id = $1 AND u.disable_time = '1970-
 			GoogleId:     google.String,
 			GamecenterId: gamecenter.String,
 			SteamId:      steam.String,
+			AppleId:      apple.String,
 			EdgeCount:    int32(edgeCount),
 			CreateTime:   &timestamp.Timestamp{Seconds: createTime.Time.Unix()},
 			UpdateTime:   &timestamp.Timestamp{Seconds: updateTime.Time.Unix()},
@@ -862,6 +995,128 @@ WHERE u.id = ge.source_id AND ge.destination_id = $1 AND u.disable_time = '1970-
 	return &api.GroupUserList{GroupUsers: groupUsers}, nil
 }
 
+// ListGroupJoinRequests returns a page of the users waiting for their request to join the group to be accepted, oldest
+// requests first. Only group admins and superadmins may list join requests.
+func ListGroupJoinRequests(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, caller uuid.UUID, groupID uuid.UUID, limit int, cursor string) (*api.GroupUserList, error) {
+	var incomingCursor *edgeListCursor
+	if cursor != "" {
+		incomingCursor = &edgeListCursor{}
+		if cb, err := base64.RawURLEncoding.DecodeString(cursor); err != nil {
+			return nil, ErrGroupInvalidCursor
+		} else if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(incomingCursor); err != nil {
+			return nil, ErrGroupInvalidCursor
+		}
+	}
+
+	if caller != uuid.Nil {
+		allowed, err := groupCheckUserPermission(ctx, logger, db, groupID, caller, 1)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			logger.Info("Cannot list join requests as user does not have correct permissions.", zap.String("group_id", groupID.String()), zap.String("user_id", caller.String()))
+			return nil, ErrGroupPermissionDenied
+		}
+	}
+
+	query := `
+SELECT u.id, u.username, u.display_name, u.avatar_url,
+	u.lang_tag, u.location, u.timezone, u.metadata,
+	u.facebook_id, u.google_id, u.gamecenter_id, u.steam_id, u.apple_id, u.edge_count,
+	u.create_time, u.update_time, ge.position
+FROM users u, group_edge ge
+WHERE u.id = ge.destination_id AND ge.source_id = $1 AND ge.state = 3 AND u.disable_time = '1970-01-01 00:00:00 UTC'`
+	params := []interface{}{groupID}
+	if incomingCursor != nil {
+		params = append(params, incomingCursor.Position)
+		query += " AND ge.position > $2"
+	}
+	// Fetch one extra row to know if there is a next page.
+	params = append(params, limit+1)
+	query += fmt.Sprintf(" ORDER BY ge.position ASC LIMIT $%v", len(params))
+
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Could not list join requests for group.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	groupUsers := make([]*api.GroupUserList_GroupUser, 0)
+	var outgoingCursor string
+	var lastPosition int64
+	for rows.Next() {
+		var id string
+		var displayName sql.NullString
+		var username sql.NullString
+		var avatarURL sql.NullString
+		var langTag sql.NullString
+		var location sql.NullString
+		var timezone sql.NullString
+		var metadata []byte
+		var facebook sql.NullString
+		var google sql.NullString
+		var gamecenter sql.NullString
+		var steam sql.NullString
+		var apple sql.NullString
+		var edgeCount int
+		var createTime pq.NullTime
+		var updateTime pq.NullTime
+		var position int64
+
+		if err := rows.Scan(&id, &username, &displayName, &avatarURL, &langTag, &location, &timezone, &metadata,
+			&facebook, &google, &gamecenter, &steam, &apple, &edgeCount, &createTime, &updateTime, &position); err != nil {
+			logger.Error("Could not parse rows when listing join requests for a group.", zap.Error(err), zap.String("group_id", groupID.String()))
+			return nil, err
+		}
+
+		if len(groupUsers) >= limit {
+			cursorBuf := new(bytes.Buffer)
+			if err := gob.NewEncoder(cursorBuf).Encode(&edgeListCursor{State: 3, Position: lastPosition}); err != nil {
+				logger.Error("Error creating group join request list cursor.", zap.Error(err))
+				return nil, err
+			}
+			outgoingCursor = base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes())
+			break
+		}
+
+		userID := uuid.Must(uuid.FromString(id))
+		user := &api.User{
+			Id:           userID.String(),
+			Username:     username.String,
+			DisplayName:  displayName.String,
+			AvatarUrl:    avatarURL.String,
+			LangTag:      langTag.String,
+			Location:     location.String,
+			Timezone:     timezone.String,
+			Metadata:     string(metadata),
+			FacebookId:   facebook.String,
+			GoogleId:     google.String,
+			GamecenterId: gamecenter.String,
+			SteamId:      steam.String,
+			AppleId:      apple.String,
+			EdgeCount:    int32(edgeCount),
+			CreateTime:   &timestamp.Timestamp{Seconds: createTime.Time.Unix()},
+			UpdateTime:   &timestamp.Timestamp{Seconds: updateTime.Time.Unix()},
+			Online:       tracker.StreamExists(PresenceStream{Mode: StreamModeNotifications, Subject: userID}),
+		}
+
+		groupUsers = append(groupUsers, &api.GroupUserList_GroupUser{
+			User: user,
+			State: &wrappers.Int32Value{
+				Value: 3,
+			},
+		})
+		lastPosition = position
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("Could not list join requests for group.", zap.Error(err), zap.String("group_id", groupID.String()))
+		return nil, err
+	}
+
+	return &api.GroupUserList{GroupUsers: groupUsers, Cursor: outgoingCursor}, nil
+}
+
 func ListUserGroups(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) (*api.UserGroupList, error) {
 	query := `
 SELECT id, creator_id, name, description, avatar_url, 
@@ -0,0 +1,243 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/heroiclabs/nakama/api"
+	"github.com/heroiclabs/nakama/social"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	IdentityProviderDevice     = "device"
+	IdentityProviderCustom     = "custom"
+	IdentityProviderEmail      = "email"
+	IdentityProviderFacebook   = "facebook"
+	IdentityProviderGameCenter = "gamecenter"
+	IdentityProviderGoogle     = "google"
+	IdentityProviderSteam      = "steam"
+	IdentityProviderApple      = "apple"
+)
+
+// OpenID Connect provider names share a namespace with the built-in providers, and must fit the identity tables.
+var oidcProviderNameRegex = regexp.MustCompile("^[a-z0-9_-]{1,32}$")
+
+func isBuiltinIdentityProvider(name string) bool {
+	switch name {
+	case IdentityProviderDevice, IdentityProviderCustom, IdentityProviderEmail, IdentityProviderFacebook, IdentityProviderGameCenter, IdentityProviderGoogle, IdentityProviderSteam, IdentityProviderApple:
+		return true
+	default:
+		return false
+	}
+}
+
+type identityActivity struct {
+	linkTime     pq.NullTime
+	lastAuthTime pq.NullTime
+}
+
+// identityAuthenticated records that a user has just authenticated with the given identity. If the account was just
+// created with it, this is also when the identity was linked. Failures are logged but do not affect authentication.
+func identityAuthenticated(ctx context.Context, logger *zap.Logger, db *sql.DB, userID, provider, identityID string, created bool) {
+	query := `
+INSERT INTO user_identity_activity (user_id, provider, identity_id, link_time, last_auth_time)
+VALUES ($1, $2, $3, CASE WHEN $4 THEN now() ELSE NULL END, now())
+ON CONFLICT (user_id, provider, identity_id) DO UPDATE SET last_auth_time = now()`
+	if _, err := db.ExecContext(ctx, query, userID, provider, identityID, created); err != nil {
+		logger.Error("Error recording identity authentication.", zap.Error(err), zap.String("user_id", userID), zap.String("provider", provider))
+	}
+}
+
+// identityLinked records that an identity has just been linked to a user's account. Failures are logged but do not
+// affect the link.
+func identityLinked(ctx context.Context, logger *zap.Logger, db *sql.DB, userID, provider, identityID string) {
+	query := `
+INSERT INTO user_identity_activity (user_id, provider, identity_id, link_time)
+VALUES ($1, $2, $3, now())
+ON CONFLICT (user_id, provider, identity_id) DO UPDATE SET link_time = now()`
+	if _, err := db.ExecContext(ctx, query, userID, provider, identityID); err != nil {
+		logger.Error("Error recording identity link.", zap.Error(err), zap.String("user_id", userID), zap.String("provider", provider))
+	}
+}
+
+// loadAccountIdentities sets the identities currently linked to each account, along with any recorded link and
+// authentication times. Activity recorded for identities that have since been unlinked is not included.
+func loadAccountIdentities(ctx context.Context, logger *zap.Logger, db *sql.DB, accounts []*api.Account) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	statements := make([]string, 0, len(accounts))
+	params := make([]interface{}, 0, len(accounts))
+	for i, account := range accounts {
+		statements = append(statements, "$"+strconv.Itoa(i+1))
+		params = append(params, account.User.Id)
+	}
+
+	oidcIdentities, err := loadOidcIdentities(ctx, logger, db, statements, params)
+	if err != nil {
+		return err
+	}
+
+	query := "SELECT user_id, provider, identity_id, link_time, last_auth_time FROM user_identity_activity WHERE user_id IN (" + strings.Join(statements, ", ") + ")"
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Error retrieving identity activity.", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	activity := make(map[string]*identityActivity)
+	for rows.Next() {
+		var dbUserID, dbProvider, dbIdentityID string
+		a := &identityActivity{}
+		if err := rows.Scan(&dbUserID, &dbProvider, &dbIdentityID, &a.linkTime, &a.lastAuthTime); err != nil {
+			logger.Error("Error retrieving identity activity.", zap.Error(err))
+			return err
+		}
+		activity[identityActivityKey(dbUserID, dbProvider, dbIdentityID)] = a
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error retrieving identity activity.", zap.Error(err))
+		return err
+	}
+
+	for _, account := range accounts {
+		identities := make([]*api.AccountIdentity, 0, len(account.Devices)+7)
+		add := func(provider, identityID string) {
+			if identityID == "" {
+				return
+			}
+			identity := &api.AccountIdentity{Provider: provider, Id: identityID}
+			if a, ok := activity[identityActivityKey(account.User.Id, provider, identityID)]; ok {
+				if a.linkTime.Valid {
+					identity.LinkTime = &timestamp.Timestamp{Seconds: a.linkTime.Time.Unix()}
+				}
+				if a.lastAuthTime.Valid {
+					identity.LastAuthTime = &timestamp.Timestamp{Seconds: a.lastAuthTime.Time.Unix()}
+				}
+			}
+			identities = append(identities, identity)
+		}
+
+		for _, device := range account.Devices {
+			add(IdentityProviderDevice, device.Id)
+		}
+		add(IdentityProviderCustom, account.CustomId)
+		add(IdentityProviderEmail, account.Email)
+		add(IdentityProviderFacebook, account.User.FacebookId)
+		add(IdentityProviderGameCenter, account.User.GamecenterId)
+		add(IdentityProviderGoogle, account.User.GoogleId)
+		add(IdentityProviderSteam, account.User.SteamId)
+		add(IdentityProviderApple, account.User.AppleId)
+		for _, identity := range oidcIdentities[account.User.Id] {
+			add(identity[0], identity[1])
+		}
+
+		account.Identities = identities
+	}
+
+	return nil
+}
+
+// loadOidcIdentities returns the provider and subject of each OpenID Connect identity linked to the given users.
+func loadOidcIdentities(ctx context.Context, logger *zap.Logger, db *sql.DB, statements []string, params []interface{}) (map[string][][2]string, error) {
+	query := "SELECT user_id, provider, subject FROM user_identity WHERE user_id IN (" + strings.Join(statements, ", ") + ") ORDER BY provider"
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Error retrieving OpenID Connect identities.", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := make(map[string][][2]string, len(params))
+	for rows.Next() {
+		var dbUserID, dbProvider, dbSubject string
+		if err := rows.Scan(&dbUserID, &dbProvider, &dbSubject); err != nil {
+			logger.Error("Error retrieving OpenID Connect identities.", zap.Error(err))
+			return nil, err
+		}
+		identities[dbUserID] = append(identities[dbUserID], [2]string{dbProvider, dbSubject})
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error retrieving OpenID Connect identities.", zap.Error(err))
+		return nil, err
+	}
+	return identities, nil
+}
+
+// checkOidcToken verifies an identity token issued by one of the configured OpenID Connect providers.
+func checkOidcToken(ctx context.Context, logger *zap.Logger, client *social.Client, config Config, provider, token string) (*social.OidcProfile, error) {
+	if provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "OpenID Connect provider is required.")
+	}
+	providerConfig, ok := config.GetSocial().Oidc[provider]
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "OpenID Connect provider is not configured.")
+	}
+	if token == "" {
+		return nil, status.Error(codes.InvalidArgument, "OpenID Connect ID token is required.")
+	}
+
+	oidcProfile, err := client.CheckOidcToken(ctx, providerConfig.Issuer, providerConfig.Audience, providerConfig.JwksURL, token)
+	if err != nil {
+		logger.Info("Could not authenticate OpenID Connect profile.", zap.Error(err), zap.String("provider", provider))
+		return nil, status.Error(codes.Unauthenticated, "Could not authenticate OpenID Connect profile.")
+	}
+	return oidcProfile, nil
+}
+
+// unlinkOidc removes an OpenID Connect identity from a user's account, unless it is the last way to authenticate.
+func unlinkOidc(ctx context.Context, logger *zap.Logger, db *sql.DB, userID, provider, subject string) error {
+	query := `DELETE FROM user_identity WHERE user_id = $1 AND provider = $2 AND subject = $3
+AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
+    (facebook_id IS NOT NULL
+     OR google_id IS NOT NULL
+     OR gamecenter_id IS NOT NULL
+     OR steam_id IS NOT NULL
+     OR apple_id IS NOT NULL
+     OR email IS NOT NULL
+     OR custom_id IS NOT NULL))
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 AND provider <> $2 LIMIT 1))`
+
+	res, err := db.ExecContext(ctx, query, userID, provider, subject)
+	if err != nil {
+		logger.Error("Could not unlink OpenID Connect identity.", zap.Error(err), zap.String("user_id", userID), zap.String("provider", provider))
+		return status.Error(codes.Internal, "Error while trying to unlink OpenID Connect identity.")
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return status.Error(codes.PermissionDenied, "Cannot unlink last account identifier. Check profile exists and is not last link.")
+	}
+
+	if _, err = db.ExecContext(ctx, "UPDATE users SET update_time = now() WHERE id = $1", userID); err != nil {
+		logger.Error("Could not update user after unlinking OpenID Connect identity.", zap.Error(err), zap.String("user_id", userID))
+	}
+
+	return nil
+}
+
+func identityActivityKey(userID, provider, identityID string) string {
+	return userID + "/" + provider + "/" + identityID
+}
@@ -39,6 +39,10 @@ const (
 	NotificationCodeGroupAdd         int32 = -4
 	NotificationCodeGroupJoinRequest int32 = -5
 	NotificationCodeFriendJoinGame   int32 = -6
+	NotificationCodeGroupJoinAccept  int32 = -7
+	NotificationCodeGroupJoinDecline int32 = -8
+	NotificationCodeGroupKick        int32 = -9
+	NotificationCodeMatchRejoin      int32 = -10
 )
 
 type notificationCacheableCursor struct {
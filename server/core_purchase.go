@@ -0,0 +1,337 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/heroiclabs/nakama/api"
+	"github.com/heroiclabs/nakama/social"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Google Play and Huawei App Gallery purchase state of a completed purchase.
+	purchaseStatePurchased = 0
+	// Google Play and Huawei App Gallery purchase type of a test purchase.
+	purchaseTypeTest = 0
+)
+
+type purchasesListCursor struct {
+	TransactionID string
+	PurchaseTime  int64
+}
+
+type storagePurchase struct {
+	userID        uuid.UUID
+	store         api.ValidatedPurchase_Store
+	productID     string
+	transactionID string
+	rawResponse   string
+	purchaseTime  time.Time
+	environment   api.ValidatedPurchase_Environment
+}
+
+func ValidatePurchaseApple(ctx context.Context, logger *zap.Logger, db *sql.DB, socialClient *social.Client, password, bundleID string, userID uuid.UUID, receipt string) (*api.ValidatePurchaseResponse, error) {
+	if password == "" || bundleID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "Apple IAP is not configured.")
+	}
+
+	validation, raw, err := socialClient.ValidateApplePurchase(ctx, password, receipt)
+	if err != nil {
+		logger.Info("Could not validate Apple purchase.", zap.Error(err), zap.String("uid", userID.String()))
+		return nil, status.Error(codes.InvalidArgument, "Purchase validation failed.")
+	}
+	if validation.Receipt.BundleID != bundleID {
+		// A valid receipt issued for any other app must not be granted.
+		logger.Info("Apple purchase receipt bundle ID does not match.", zap.String("bundle_id", validation.Receipt.BundleID), zap.String("uid", userID.String()))
+		return nil, status.Error(codes.InvalidArgument, "Purchase validation failed.")
+	}
+
+	environment := api.ValidatedPurchase_PRODUCTION
+	if validation.Environment == "Sandbox" {
+		environment = api.ValidatedPurchase_SANDBOX
+	}
+
+	purchases := make([]*storagePurchase, 0, len(validation.Receipt.InApp))
+	for _, inApp := range validation.Receipt.InApp {
+		if inApp.CancellationDateMs != "" {
+			// Refunded or revoked purchases are never stored.
+			continue
+		}
+		purchases = append(purchases, &storagePurchase{
+			userID:        userID,
+			store:         api.ValidatedPurchase_APPLE_APP_STORE,
+			productID:     inApp.ProductID,
+			transactionID: inApp.TransactionID,
+			rawResponse:   string(raw),
+			purchaseTime:  parseMillisecondsString(inApp.PurchaseDateMs),
+			environment:   environment,
+		})
+	}
+
+	return storePurchases(ctx, logger, db, userID, purchases)
+}
+
+func ValidatePurchaseGoogle(ctx context.Context, logger *zap.Logger, db *sql.DB, socialClient *social.Client, clientEmail, privateKey, packageName string, userID uuid.UUID, purchase string) (*api.ValidatePurchaseResponse, error) {
+	if clientEmail == "" || privateKey == "" || packageName == "" {
+		return nil, status.Error(codes.FailedPrecondition, "Google IAP is not configured.")
+	}
+
+	var receipt struct {
+		PackageName   string `json:"packageName"`
+		ProductID     string `json:"productId"`
+		PurchaseToken string `json:"purchaseToken"`
+	}
+	if err := json.Unmarshal([]byte(purchase), &receipt); err != nil || receipt.PackageName == "" || receipt.ProductID == "" || receipt.PurchaseToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "Purchase must be a JSON object with packageName, productId and purchaseToken.")
+	}
+	if receipt.PackageName != packageName {
+		return nil, status.Error(codes.InvalidArgument, "Purchase package name does not match.")
+	}
+
+	validation, raw, err := socialClient.ValidateGooglePurchase(ctx, clientEmail, privateKey, receipt.PackageName, receipt.ProductID, receipt.PurchaseToken)
+	if err != nil {
+		logger.Info("Could not validate Google purchase.", zap.Error(err), zap.String("uid", userID.String()))
+		return nil, status.Error(codes.InvalidArgument, "Purchase validation failed.")
+	}
+	if validation.PurchaseState != purchaseStatePurchased {
+		return nil, status.Error(codes.InvalidArgument, "Purchase is not in a purchased state.")
+	}
+
+	environment := api.ValidatedPurchase_PRODUCTION
+	if validation.PurchaseType != nil && *validation.PurchaseType == purchaseTypeTest {
+		environment = api.ValidatedPurchase_SANDBOX
+	}
+
+	// Test purchases have no order ID, fall back to the purchase token which is also unique.
+	transactionID := validation.OrderID
+	if transactionID == "" {
+		transactionID = validation.PurchaseToken
+	}
+
+	return storePurchases(ctx, logger, db, userID, []*storagePurchase{&storagePurchase{
+		userID:        userID,
+		store:         api.ValidatedPurchase_GOOGLE_PLAY_STORE,
+		productID:     validation.ProductID,
+		transactionID: transactionID,
+		rawResponse:   string(raw),
+		purchaseTime:  parseMillisecondsString(validation.PurchaseTimeMillis),
+		environment:   environment,
+	}})
+}
+
+func ValidatePurchaseHuawei(ctx context.Context, logger *zap.Logger, db *sql.DB, socialClient *social.Client, publicKey, clientID, clientSecret string, userID uuid.UUID, purchase, signature string) (*api.ValidatePurchaseResponse, error) {
+	if publicKey == "" || clientID == "" || clientSecret == "" {
+		return nil, status.Error(codes.FailedPrecondition, "Huawei IAP is not configured.")
+	}
+
+	validation, raw, err := socialClient.ValidateHuaweiPurchase(ctx, clientID, clientSecret, publicKey, purchase, signature)
+	if err != nil {
+		logger.Info("Could not validate Huawei purchase.", zap.Error(err), zap.String("uid", userID.String()))
+		return nil, status.Error(codes.InvalidArgument, "Purchase validation failed.")
+	}
+	if validation.PurchaseState != purchaseStatePurchased {
+		return nil, status.Error(codes.InvalidArgument, "Purchase is not in a purchased state.")
+	}
+
+	environment := api.ValidatedPurchase_PRODUCTION
+	if validation.PurchaseType != nil && *validation.PurchaseType == purchaseTypeTest {
+		environment = api.ValidatedPurchase_SANDBOX
+	}
+
+	return storePurchases(ctx, logger, db, userID, []*storagePurchase{&storagePurchase{
+		userID:        userID,
+		store:         api.ValidatedPurchase_HUAWEI_APP_GALLERY,
+		productID:     validation.ProductID,
+		transactionID: validation.OrderID,
+		rawResponse:   string(raw),
+		purchaseTime:  time.Unix(0, validation.PurchaseTime*int64(time.Millisecond)).UTC(),
+		environment:   environment,
+	}})
+}
+
+func ListPurchases(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, limit int, cursorStr string) (*api.PurchaseList, error) {
+	var cursor *purchasesListCursor = nil
+	if cursorStr != "" {
+		cursor = &purchasesListCursor{}
+		if cb, err := base64.RawURLEncoding.DecodeString(cursorStr); err != nil {
+			logger.Warn("Could not base64 decode purchase listing cursor.", zap.String("cursor", cursorStr))
+			return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+		} else {
+			if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(cursor); err != nil {
+				logger.Warn("Could not decode purchase listing cursor.", zap.String("cursor", cursorStr))
+				return nil, status.Error(codes.InvalidArgument, "Malformed cursor was used.")
+			}
+		}
+	}
+
+	// Fetch one extra row to know if there is a next page.
+	params := []interface{}{userID, limit + 1}
+	cursorQuery := ""
+	if cursor != nil {
+		params = append(params, time.Unix(0, cursor.PurchaseTime).UTC(), cursor.TransactionID)
+		cursorQuery = " AND (purchase_time, transaction_id) < ($3, $4)"
+	}
+
+	rows, err := db.QueryContext(ctx, `
+SELECT transaction_id, product_id, store, environment, raw_response, purchase_time, create_time, update_time
+FROM purchase
+WHERE user_id = $1`+cursorQuery+`
+ORDER BY purchase_time DESC, transaction_id DESC
+LIMIT $2`, params...)
+	if err != nil {
+		logger.Error("Could not list purchases.", zap.Error(err), zap.String("uid", userID.String()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	purchaseList := &api.PurchaseList{ValidatedPurchases: make([]*api.ValidatedPurchase, 0, limit)}
+	var lastPurchaseTime int64
+	for rows.Next() {
+		if len(purchaseList.ValidatedPurchases) >= limit {
+			cursorBuf := new(bytes.Buffer)
+			lastPurchase := purchaseList.ValidatedPurchases[len(purchaseList.ValidatedPurchases)-1]
+			if err := gob.NewEncoder(cursorBuf).Encode(&purchasesListCursor{TransactionID: lastPurchase.TransactionId, PurchaseTime: lastPurchaseTime}); err != nil {
+				logger.Error("Could not create new cursor.", zap.Error(err))
+				return nil, err
+			}
+			purchaseList.Cursor = base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes())
+			break
+		}
+
+		var transactionID string
+		var productID string
+		var store int32
+		var environment int32
+		var rawResponse string
+		var purchaseTime pq.NullTime
+		var createTime pq.NullTime
+		var updateTime pq.NullTime
+		if err := rows.Scan(&transactionID, &productID, &store, &environment, &rawResponse, &purchaseTime, &createTime, &updateTime); err != nil {
+			logger.Error("Could not scan purchase from database.", zap.Error(err))
+			return nil, err
+		}
+
+		lastPurchaseTime = purchaseTime.Time.UnixNano()
+		purchaseList.ValidatedPurchases = append(purchaseList.ValidatedPurchases, &api.ValidatedPurchase{
+			ProductId:        productID,
+			TransactionId:    transactionID,
+			Store:            &wrappers.Int32Value{Value: store},
+			PurchaseTime:     &timestamp.Timestamp{Seconds: purchaseTime.Time.Unix()},
+			CreateTime:       &timestamp.Timestamp{Seconds: createTime.Time.Unix()},
+			UpdateTime:       &timestamp.Timestamp{Seconds: updateTime.Time.Unix()},
+			ProviderResponse: rawResponse,
+			Environment:      &wrappers.Int32Value{Value: environment},
+		})
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("Could not list purchases.", zap.Error(err), zap.String("uid", userID.String()))
+		return nil, err
+	}
+
+	return purchaseList, nil
+}
+
+// Store validated purchases, returning only those not seen before. Replayed receipts where every purchase has
+// already been stored are rejected.
+func storePurchases(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID, purchases []*storagePurchase) (*api.ValidatePurchaseResponse, error) {
+	if len(purchases) == 0 {
+		return &api.ValidatePurchaseResponse{ValidatedPurchases: []*api.ValidatedPurchase{}}, nil
+	}
+
+	statements := make([]string, 0, len(purchases))
+	params := make([]interface{}, 0, len(purchases)*7)
+	purchasesByTransactionID := make(map[string]*storagePurchase, len(purchases))
+	for _, purchase := range purchases {
+		if purchase.transactionID == "" {
+			return nil, status.Error(codes.InvalidArgument, "Purchase has no transaction ID.")
+		}
+		if _, found := purchasesByTransactionID[purchase.transactionID]; found {
+			continue
+		}
+		purchasesByTransactionID[purchase.transactionID] = purchase
+
+		p := len(params)
+		statements = append(statements, "($"+strconv.Itoa(p+1)+", $"+strconv.Itoa(p+2)+", $"+strconv.Itoa(p+3)+", $"+strconv.Itoa(p+4)+", $"+strconv.Itoa(p+5)+", $"+strconv.Itoa(p+6)+", $"+strconv.Itoa(p+7)+")")
+		params = append(params, purchase.transactionID, purchase.userID, purchase.store, purchase.productID, purchase.environment, purchase.rawResponse, purchase.purchaseTime)
+	}
+
+	query := `
+INSERT INTO purchase (transaction_id, user_id, store, product_id, environment, raw_response, purchase_time)
+VALUES ` + strings.Join(statements, ", ") + `
+ON CONFLICT (transaction_id) DO NOTHING
+RETURNING transaction_id, create_time, update_time`
+	rows, err := db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Could not store validated purchases.", zap.Error(err), zap.String("uid", userID.String()))
+		return nil, status.Error(codes.Internal, "Error storing validated purchases.")
+	}
+	defer rows.Close()
+
+	validatedPurchases := make([]*api.ValidatedPurchase, 0, len(purchasesByTransactionID))
+	for rows.Next() {
+		var transactionID string
+		var createTime pq.NullTime
+		var updateTime pq.NullTime
+		if err := rows.Scan(&transactionID, &createTime, &updateTime); err != nil {
+			logger.Error("Could not store validated purchases.", zap.Error(err), zap.String("uid", userID.String()))
+			return nil, status.Error(codes.Internal, "Error storing validated purchases.")
+		}
+		purchase := purchasesByTransactionID[transactionID]
+		validatedPurchases = append(validatedPurchases, &api.ValidatedPurchase{
+			ProductId:        purchase.productID,
+			TransactionId:    purchase.transactionID,
+			Store:            &wrappers.Int32Value{Value: int32(purchase.store)},
+			PurchaseTime:     &timestamp.Timestamp{Seconds: purchase.purchaseTime.Unix()},
+			CreateTime:       &timestamp.Timestamp{Seconds: createTime.Time.Unix()},
+			UpdateTime:       &timestamp.Timestamp{Seconds: updateTime.Time.Unix()},
+			ProviderResponse: purchase.rawResponse,
+			Environment:      &wrappers.Int32Value{Value: int32(purchase.environment)},
+		})
+	}
+	if err = rows.Err(); err != nil {
+		logger.Error("Could not store validated purchases.", zap.Error(err), zap.String("uid", userID.String()))
+		return nil, status.Error(codes.Internal, "Error storing validated purchases.")
+	}
+
+	if len(validatedPurchases) == 0 {
+		return nil, status.Error(codes.AlreadyExists, "Purchase has already been validated.")
+	}
+
+	return &api.ValidatePurchaseResponse{ValidatedPurchases: validatedPurchases}, nil
+}
+
+func parseMillisecondsString(ms string) time.Time {
+	millis, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return time.Now().UTC()
+	}
+	return time.Unix(0, millis*int64(time.Millisecond)).UTC()
+}
@@ -0,0 +1,175 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrSessionTokenInvalid = errors.New("session token invalid")
+	ErrRefreshTokenInvalid = errors.New("refresh token invalid")
+)
+
+// SessionRefreshTokenCreate issues a new refresh token for the given user. Each refresh token is recorded
+// so it can be used only once, and can be revoked before it expires.
+func SessionRefreshTokenCreate(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, userID, username string) (string, error) {
+	tokenID := uuid.Must(uuid.NewV4())
+	exp := time.Now().UTC().Add(time.Duration(config.GetSession().RefreshTokenExpirySec) * time.Second)
+
+	// Clean up any of the user's refresh tokens that have expired since they were last issued one.
+	if _, err := db.ExecContext(ctx, "DELETE FROM user_refresh_token WHERE user_id = $1 AND expiry_time <= now()", userID); err != nil {
+		logger.Error("Error removing expired refresh tokens.", zap.Error(err), zap.String("user_id", userID))
+		return "", status.Error(codes.Internal, "Error creating refresh token.")
+	}
+
+	query := "INSERT INTO user_refresh_token (id, user_id, expiry_time) VALUES ($1, $2, $3)"
+	if _, err := db.ExecContext(ctx, query, tokenID, userID, exp); err != nil {
+		logger.Error("Error creating refresh token.", zap.Error(err), zap.String("user_id", userID))
+		return "", status.Error(codes.Internal, "Error creating refresh token.")
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"tid": tokenID.String(),
+		"uid": userID,
+		"exp": exp.Unix(),
+		"usn": username,
+	})
+	signedToken, _ := token.SignedString([]byte(config.GetSession().RefreshEncryptionKey))
+	return signedToken, nil
+}
+
+// SessionRefresh exchanges a refresh token for the user ID and username it was issued to, along with a
+// replacement refresh token. The refresh token given is consumed and cannot be used again.
+func SessionRefresh(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, token string) (string, string, string, error) {
+	tokenID, userID, ok := parseRefreshToken([]byte(config.GetSession().RefreshEncryptionKey), token)
+	if !ok {
+		return "", "", "", status.Error(codes.Unauthenticated, "Refresh token invalid or expired.")
+	}
+
+	// Consume the refresh token, it will not exist if it has been used already or was revoked.
+	result, err := db.ExecContext(ctx, "DELETE FROM user_refresh_token WHERE id = $1 AND user_id = $2 AND expiry_time > now()", tokenID, userID)
+	if err != nil {
+		logger.Error("Error removing refresh token.", zap.Error(err), zap.String("user_id", userID.String()))
+		return "", "", "", status.Error(codes.Internal, "Error refreshing session.")
+	}
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected != 1 {
+		return "", "", "", status.Error(codes.Unauthenticated, "Refresh token invalid or expired.")
+	}
+
+	// Look up the username again, it may have changed since the refresh token was issued.
+	var dbUsername string
+	var dbDisableTime pq.NullTime
+	err = db.QueryRowContext(ctx, "SELECT username, disable_time FROM users WHERE id = $1", userID).Scan(&dbUsername, &dbDisableTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", "", "", status.Error(codes.Unauthenticated, "Refresh token invalid or expired.")
+		}
+		logger.Error("Error looking up user by ID.", zap.Error(err), zap.String("user_id", userID.String()))
+		return "", "", "", status.Error(codes.Internal, "Error refreshing session.")
+	}
+	if dbDisableTime.Valid && dbDisableTime.Time.Unix() != 0 {
+		logger.Info("User account is disabled.", zap.String("user_id", userID.String()))
+		return "", "", "", status.Error(codes.Unauthenticated, "Error refreshing session.")
+	}
+
+	refreshToken, err := SessionRefreshTokenCreate(ctx, logger, db, config, userID.String(), dbUsername)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return userID.String(), dbUsername, refreshToken, nil
+}
+
+// SessionRefreshTokensRevoke invalidates all outstanding refresh tokens for the given user.
+func SessionRefreshTokensRevoke(ctx context.Context, logger *zap.Logger, db *sql.DB, userID uuid.UUID) error {
+	if _, err := db.ExecContext(ctx, "DELETE FROM user_refresh_token WHERE user_id = $1", userID); err != nil {
+		logger.Error("Error revoking refresh tokens.", zap.Error(err), zap.String("user_id", userID.String()))
+		return err
+	}
+	return nil
+}
+
+// SessionLogout revokes the given session token and refresh token, either of which may be empty, and disconnects
+// any sessions opened with the session token. If neither token is given all of the user's tokens are revoked.
+func SessionLogout(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, sessionCache SessionCache, userID uuid.UUID, token, refreshToken string) error {
+	if token == "" && refreshToken == "" {
+		sessionCache.RevokeAll(userID)
+		return SessionRefreshTokensRevoke(ctx, logger, db, userID)
+	}
+
+	if token != "" {
+		tokenUserID, _, tokenID, exp, _, ok := parseToken([]byte(config.GetSession().EncryptionKey), token)
+		if !ok || tokenUserID != userID {
+			return ErrSessionTokenInvalid
+		}
+		sessionCache.Revoke(userID, tokenID, exp)
+	}
+
+	if refreshToken != "" {
+		tokenID, tokenUserID, ok := parseRefreshToken([]byte(config.GetSession().RefreshEncryptionKey), refreshToken)
+		if !ok || tokenUserID != userID {
+			return ErrRefreshTokenInvalid
+		}
+		if _, err := db.ExecContext(ctx, "DELETE FROM user_refresh_token WHERE id = $1 AND user_id = $2", tokenID, userID); err != nil {
+			logger.Error("Error revoking refresh token.", zap.Error(err), zap.String("user_id", userID.String()))
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseRefreshToken(hmacSecretByte []byte, tokenString string) (tokenID uuid.UUID, userID uuid.UUID, ok bool) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if s, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || s.Hash != crypto.SHA256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return hmacSecretByte, nil
+	})
+	if err != nil {
+		return
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return
+	}
+	tid, tidOk := claims["tid"].(string)
+	uid, uidOk := claims["uid"].(string)
+	if !tidOk || !uidOk {
+		return uuid.Nil, uuid.Nil, false
+	}
+	tokenID, err = uuid.FromString(tid)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	userID, err = uuid.FromString(uid)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return tokenID, userID, true
+}
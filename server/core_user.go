@@ -30,7 +30,7 @@ import (
 func GetUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, tracker Tracker, ids, usernames, fbIDs []string) (*api.Users, error) {
 	query := `
 SELECT id, username, display_name, avatar_url, lang_tag, location, timezone, metadata,
-	facebook_id, google_id, gamecenter_id, steam_id, edge_count, create_time, update_time
+	facebook_id, google_id, gamecenter_id, steam_id, apple_id, edge_count, create_time, update_time
 FROM users
 WHERE`
 
@@ -112,7 +112,7 @@ func DeleteUser(ctx context.Context, tx *sql.Tx, userID uuid.UUID) (int64, error
 	return res.RowsAffected()
 }
 
-func BanUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, ids []string) error {
+func BanUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, sessionCache SessionCache, ids []string) error {
 	statements := make([]string, 0, len(ids))
 	params := make([]interface{}, 0, len(ids))
 	for i, id := range ids {
@@ -126,6 +126,21 @@ func BanUsers(ctx context.Context, logger *zap.Logger, db *sql.DB, ids []string)
 		logger.Error("Error banning user accounts.", zap.Error(err), zap.Strings("ids", ids))
 		return err
 	}
+
+	// Banned users must not be able to refresh their sessions.
+	query = "DELETE FROM user_refresh_token WHERE user_id IN (" + strings.Join(statements, ", ") + ")"
+	_, err = db.ExecContext(ctx, query, params...)
+	if err != nil {
+		logger.Error("Error revoking refresh tokens of banned user accounts.", zap.Error(err), zap.Strings("ids", ids))
+		return err
+	}
+
+	// Reject their existing session tokens, and disconnect any live sessions.
+	for _, id := range ids {
+		if userID, err := uuid.FromString(id); err == nil {
+			sessionCache.RevokeAll(userID)
+		}
+	}
 	return nil
 }
 
@@ -172,12 +187,13 @@ func convertUser(tracker Tracker, rows *sql.Rows) (*api.User, error) {
 	var google sql.NullString
 	var gamecenter sql.NullString
 	var steam sql.NullString
+	var apple sql.NullString
 	var edge_count int
 	var createTime pq.NullTime
 	var updateTime pq.NullTime
 
 	err := rows.Scan(&id, &username, &displayName, &avatarURL, &langTag, &location, &timezone, &metadata,
-		&facebook, &google, &gamecenter, &steam, &edge_count, &createTime, &updateTime)
+		&facebook, &google, &gamecenter, &steam, &apple, &edge_count, &createTime, &updateTime)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +212,7 @@ func convertUser(tracker Tracker, rows *sql.Rows) (*api.User, error) {
 		GoogleId:     google.String,
 		GamecenterId: gamecenter.String,
 		SteamId:      steam.String,
+		AppleId:      apple.String,
 		EdgeCount:    int32(edge_count),
 		CreateTime:   &timestamp.Timestamp{Seconds: createTime.Time.Unix()},
 		UpdateTime:   &timestamp.Timestamp{Seconds: updateTime.Time.Unix()},
@@ -0,0 +1,150 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	EmailMessageTypeVerify = "verify"
+	EmailMessageTypeReset  = "reset"
+)
+
+// EmailMessage holds a token to deliver to a user's email address, either to verify the address or to reset the
+// account password.
+type EmailMessage struct {
+	Type     string
+	UserID   string
+	Username string
+	Email    string
+	Token    string
+	Expiry   int64
+}
+
+func (m *EmailMessage) GetType() string {
+	return m.Type
+}
+
+func (m *EmailMessage) GetUserId() string {
+	return m.UserID
+}
+
+func (m *EmailMessage) GetUsername() string {
+	return m.Username
+}
+
+func (m *EmailMessage) GetEmail() string {
+	return m.Email
+}
+
+func (m *EmailMessage) GetToken() string {
+	return m.Token
+}
+
+func (m *EmailMessage) GetExpiry() int64 {
+	return m.Expiry
+}
+
+// EmailSender delivers email verification and password reset messages.
+type EmailSender interface {
+	Send(ctx context.Context, message *EmailMessage) error
+}
+
+// NewEmailSender returns a sender that delegates to the runtime email send function if one is registered, or
+// otherwise to the configured SMTP server. If neither is available it returns nil.
+func NewEmailSender(logger *zap.Logger, config Config, runtime *Runtime) EmailSender {
+	if runtime != nil {
+		if fn := runtime.EmailSend(); fn != nil {
+			return &RuntimeEmailSender{fn: fn}
+		}
+	}
+	if config.GetEmail().SmtpAddress != "" {
+		return NewSmtpEmailSender(logger, config)
+	}
+	return nil
+}
+
+// RuntimeEmailSender hands messages to a function registered in the runtime.
+type RuntimeEmailSender struct {
+	fn RuntimeEmailSendFunction
+}
+
+func (s *RuntimeEmailSender) Send(ctx context.Context, message *EmailMessage) error {
+	return s.fn(ctx, message)
+}
+
+// SmtpEmailSender delivers messages as plain text emails through an SMTP server.
+type SmtpEmailSender struct {
+	logger *zap.Logger
+	config *EmailConfig
+}
+
+func NewSmtpEmailSender(logger *zap.Logger, config Config) *SmtpEmailSender {
+	return &SmtpEmailSender{
+		logger: logger,
+		config: config.GetEmail(),
+	}
+}
+
+func (s *SmtpEmailSender) Send(ctx context.Context, message *EmailMessage) error {
+	var subject, action, link string
+	switch message.Type {
+	case EmailMessageTypeVerify:
+		subject = "Verify your email address"
+		action = "verify your email address"
+		link = s.config.VerifyURL
+	case EmailMessageTypeReset:
+		subject = "Reset your password"
+		action = "reset your password"
+		link = s.config.ResetURL
+	default:
+		return fmt.Errorf("unknown email message type: %v", message.Type)
+	}
+
+	var instructions string
+	if link != "" {
+		if strings.Contains(link, "?") {
+			link += "&token=" + url.QueryEscape(message.Token)
+		} else {
+			link += "?token=" + url.QueryEscape(message.Token)
+		}
+		instructions = fmt.Sprintf("Follow this link to %v:\r\n\r\n%v", action, link)
+	} else {
+		instructions = fmt.Sprintf("Use this code to %v:\r\n\r\n%v", action, message.Token)
+	}
+
+	body := fmt.Sprintf("From: %v\r\nTo: %v\r\nSubject: %v\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\nHello %v,\r\n\r\n%v\r\n\r\nThis expires at %v.\r\n",
+		s.config.FromAddress, message.Email, subject, message.Username, instructions, time.Unix(message.Expiry, 0).UTC().Format(time.RFC1123))
+
+	var auth smtp.Auth
+	if s.config.SmtpUsername != "" {
+		host, _, err := net.SplitHostPort(s.config.SmtpAddress)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", s.config.SmtpUsername, s.config.SmtpPassword, host)
+	}
+
+	return smtp.SendMail(s.config.SmtpAddress, auth, s.config.FromAddress, []string{message.Email}, []byte(body))
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
 	"github.com/pkg/errors"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -33,6 +34,7 @@ type MatchDataMessage struct {
 	OpCode      int64
 	Data        []byte
 	ReceiveTime int64
+	Reliable    bool
 }
 
 func (m *MatchDataMessage) GetUserId() string {
@@ -65,6 +67,20 @@ func (m *MatchDataMessage) GetData() []byte {
 func (m *MatchDataMessage) GetReceiveTime() int64 {
 	return m.ReceiveTime
 }
+func (m *MatchDataMessage) GetReliable() bool {
+	return m.Reliable
+}
+
+type matchSpectatorMessage struct {
+	tick int64
+	msg  *DeferredMessage
+}
+
+// A place held in the match for a player who disconnected, until they reconnect or the expiry tick passes.
+type matchDisconnect struct {
+	presence   *MatchPresence
+	expiryTick int64
+}
 
 type MatchHandler struct {
 	logger        *zap.Logger
@@ -74,12 +90,14 @@ type MatchHandler struct {
 	JoinMarkerList *MatchJoinMarkerList
 	PresenceList   *MatchPresenceList
 	core           RuntimeMatchCore
+	recorder       *MatchRecorder
 
 	// Identification not (directly) controlled by match init.
 	ID     uuid.UUID
 	Node   string
 	IDStr  string
 	Stream PresenceStream
+	Module string
 
 	// Internal state.
 	tick int64
@@ -94,6 +112,13 @@ type MatchHandler struct {
 
 	deferredCh chan *DeferredMessage
 
+	// Broadcasts held back from spectators until their delay has passed, oldest first.
+	spectatorDelayTicks int64
+	spectatorBuffer     []*matchSpectatorMessage
+
+	// Places held for disconnected players, keyed by their previous session ID.
+	disconnected map[uuid.UUID]*matchDisconnect
+
 	// Configuration set by match init.
 	Rate int64
 
@@ -101,7 +126,7 @@ type MatchHandler struct {
 	state interface{}
 }
 
-func NewMatchHandler(logger *zap.Logger, config Config, matchRegistry MatchRegistry, router MessageRouter, core RuntimeMatchCore, id uuid.UUID, node string, params map[string]interface{}) (*MatchHandler, error) {
+func NewMatchHandler(logger *zap.Logger, config Config, matchRegistry MatchRegistry, router MessageRouter, core RuntimeMatchCore, id uuid.UUID, node string, module string, params map[string]interface{}, snapshot *MatchSnapshot) (*MatchHandler, error) {
 	presenceList := NewMatchPresenceList()
 
 	deferredCh := make(chan *DeferredMessage, config.GetMatch().DeferredQueueSize)
@@ -114,7 +139,17 @@ func NewMatchHandler(logger *zap.Logger, config Config, matchRegistry MatchRegis
 		}
 	}
 
-	state, rateInt, err := core.MatchInit(presenceList, deferMessageFn, params)
+	var state interface{}
+	var rateInt int
+	var tick int64
+	var err error
+	if snapshot != nil {
+		// Resume the match from its stored state instead of initialising a new one.
+		state, rateInt, err = core.MatchRestore(presenceList, deferMessageFn, snapshot.Tick, string(snapshot.State))
+		tick = snapshot.Tick
+	} else {
+		state, rateInt, err = core.MatchInit(presenceList, deferMessageFn, params)
+	}
 	if err != nil {
 		core.Cancel()
 		return nil, err
@@ -142,8 +177,9 @@ func NewMatchHandler(logger *zap.Logger, config Config, matchRegistry MatchRegis
 			Subject: id,
 			Label:   node,
 		},
+		Module: module,
 
-		tick: 0,
+		tick: tick,
 
 		inputCh: make(chan *MatchDataMessage, config.GetMatch().InputQueueSize),
 		// Ticker below.
@@ -155,9 +191,43 @@ func NewMatchHandler(logger *zap.Logger, config Config, matchRegistry MatchRegis
 
 		Rate: int64(rateInt),
 
+		disconnected: make(map[uuid.UUID]*matchDisconnect),
+
 		state: state,
 	}
 
+	// Spectators see broadcasts either as they happen, or a fixed number of ticks later.
+	mh.spectatorDelayTicks = int64(config.GetMatch().SpectatorDelayMs) * mh.Rate / 1000
+	core.SetSpectatorMessageFunction(func(msg *DeferredMessage) error {
+		if mh.spectatorDelayTicks == 0 {
+			mh.router.SendToPresenceIDs(mh.logger, msg.PresenceIDs, true, StreamModeMatchAuthoritative, msg.Envelope, msg.Reliable)
+			return nil
+		}
+		mh.spectatorBuffer = append(mh.spectatorBuffer, &matchSpectatorMessage{tick: mh.tick, msg: msg})
+		return nil
+	})
+
+	// Optionally record everything the match sees and does, from the point it's ready to run.
+	if config.GetMatch().ReplayRecording {
+		header := &MatchRecordingHeader{MatchID: mh.IDStr, Module: module, Params: params}
+		if snapshot != nil {
+			header.Restored = true
+			header.RestoreTick = snapshot.Tick
+			header.RestoreState = snapshot.State
+		}
+		recorder, err := NewMatchRecorder(logger, MatchRecordingDir(config), header)
+		if err != nil {
+			// Recording is not essential to the match, so it runs without one.
+			logger.Warn("Error creating match recording", zap.Error(err))
+		} else {
+			mh.recorder = recorder
+			core.SetBroadcastListener(func(presenceIDs []*PresenceID, envelope *rtapi.Envelope, reliable bool) bool {
+				mh.recorder.RecordBroadcast(mh.tick, presenceIDs, envelope, reliable)
+				return true
+			})
+		}
+	}
+
 	// Set up the ticker that governs the match loop.
 	mh.ticker = time.NewTicker(time.Second / time.Duration(mh.Rate))
 
@@ -202,6 +272,9 @@ func (mh *MatchHandler) Close() {
 	mh.core.Cancel()
 	close(mh.stopCh)
 	mh.ticker.Stop()
+	if mh.recorder != nil {
+		mh.recorder.Close()
+	}
 }
 
 func (mh *MatchHandler) Label() string {
@@ -244,8 +317,18 @@ func loop(mh *MatchHandler) {
 		return
 	}
 
+	// Drain the input queue into a slice.
+	size := len(mh.inputCh)
+	messages := make([]*MatchDataMessage, size)
+	for i := 0; i < size; i++ {
+		messages[i] = <-mh.inputCh
+	}
+	if mh.recorder != nil {
+		mh.recorder.RecordLoop(mh.tick, messages)
+	}
+
 	// Execute the loop.
-	state, err := mh.core.MatchLoop(mh.tick, mh.state, mh.inputCh)
+	state, err := mh.core.MatchLoop(mh.tick, mh.state, messages)
 	if err != nil {
 		mh.Stop()
 		mh.logger.Warn("Stopping match after error from match_loop execution", zap.Int64("tick", mh.tick), zap.Error(err))
@@ -264,6 +347,19 @@ func loop(mh *MatchHandler) {
 		mh.router.SendDeferred(mh.logger, true, StreamModeMatchAuthoritative, deferredMessages)
 	}
 
+	// Release any spectator broadcasts whose delay has passed.
+	var released int
+	for _, sm := range mh.spectatorBuffer {
+		if sm.tick+mh.spectatorDelayTicks > mh.tick {
+			break
+		}
+		mh.router.SendToPresenceIDs(mh.logger, sm.msg.PresenceIDs, true, StreamModeMatchAuthoritative, sm.msg.Envelope, sm.msg.Reliable)
+		released++
+	}
+	if released != 0 {
+		mh.spectatorBuffer = mh.spectatorBuffer[released:]
+	}
+
 	// Check if we need to stop the match.
 	if state == nil {
 		mh.Stop()
@@ -271,6 +367,23 @@ func loop(mh *MatchHandler) {
 		return
 	}
 
+	// Players who did not reconnect in time leave the match.
+	if len(mh.disconnected) != 0 {
+		var expired []*MatchPresence
+		for sessionID, disconnect := range mh.disconnected {
+			if disconnect.expiryTick <= mh.tick {
+				leave := *disconnect.presence
+				leave.Disconnected = false
+				expired = append(expired, &leave)
+				delete(mh.disconnected, sessionID)
+			}
+		}
+		if len(expired) != 0 {
+			// Doesn't matter if the call queue was full here. If the match is being closed then leaves don't matter anyway.
+			mh.QueueLeave(expired)
+		}
+	}
+
 	// Every 30 seconds clear expired join markers.
 	if mh.tick%(mh.Rate*30) == 0 {
 		presences := mh.JoinMarkerList.ClearExpired(mh.tick)
@@ -284,7 +397,7 @@ func loop(mh *MatchHandler) {
 	mh.tick++
 }
 
-func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *MatchJoinResult, userID, sessionID uuid.UUID, username, node string, metadata map[string]string) bool {
+func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *MatchJoinResult, userID, sessionID uuid.UUID, username, node string, metadata map[string]string, spectator bool) bool {
 	if mh.stopped.Load() {
 		return false
 	}
@@ -304,7 +417,20 @@ func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *M
 			return
 		}
 
-		state, allow, reason, err := mh.core.MatchJoinAttempt(mh.tick, mh.state, userID, sessionID, username, node, metadata)
+		presence := &MatchPresence{Node: node, UserID: userID, SessionID: sessionID, Username: username, Spectator: spectator}
+		if mh.recorder != nil {
+			mh.recorder.RecordJoinAttempt(mh.tick, presence, metadata)
+		}
+
+		var state interface{}
+		var allow bool
+		var reason string
+		var err error
+		if spectator {
+			state, allow, reason, err = mh.core.MatchSpectatorJoinAttempt(mh.tick, mh.state, userID, sessionID, username, node, metadata)
+		} else {
+			state, allow, reason, err = mh.core.MatchJoinAttempt(mh.tick, mh.state, userID, sessionID, username, node, metadata)
+		}
 		if err != nil {
 			mh.Stop()
 			mh.logger.Warn("Stopping match after error from match_join_attempt execution", zap.Int64("tick", mh.tick), zap.Error(err))
@@ -320,7 +446,6 @@ func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *M
 
 		mh.state = state
 		if allow {
-			presence := &MatchPresence{Node: node, UserID: userID, SessionID: sessionID, Username: username}
 			mh.JoinMarkerList.Add(presence, mh.tick)
 			mh.QueueJoin([]*MatchPresence{presence}, false)
 		}
@@ -339,6 +464,51 @@ func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *M
 	}
 }
 
+func (mh *MatchHandler) QueueSignal(ctx context.Context, resultCh chan<- *MatchSignalResult, data string) bool {
+	if mh.stopped.Load() {
+		return false
+	}
+
+	signal := func(mh *MatchHandler) {
+		select {
+		case <-ctx.Done():
+			// Do not process the signal through the match handler if the caller has gone away between when this call
+			// was inserted into the match call queue and when it's due for processing.
+			resultCh <- &MatchSignalResult{Success: false}
+			return
+		default:
+		}
+
+		if mh.stopped.Load() {
+			resultCh <- &MatchSignalResult{Success: false}
+			return
+		}
+
+		if mh.recorder != nil {
+			mh.recorder.RecordSignal(mh.tick, data)
+		}
+
+		state, result, err := mh.core.MatchSignal(mh.tick, mh.state, data)
+		if err != nil {
+			mh.Stop()
+			mh.logger.Warn("Stopping match after error from match_signal execution", zap.Int64("tick", mh.tick), zap.Error(err))
+			resultCh <- &MatchSignalResult{Success: false}
+			return
+		}
+		if state == nil {
+			mh.Stop()
+			mh.logger.Info("Match signal returned nil or no state, stopping match")
+			resultCh <- &MatchSignalResult{Success: false}
+			return
+		}
+
+		mh.state = state
+		resultCh <- &MatchSignalResult{Success: true, Result: result}
+	}
+
+	return mh.queueCall(signal)
+}
+
 func (mh *MatchHandler) QueueJoin(joins []*MatchPresence, mark bool) bool {
 	if mh.stopped.Load() {
 		return false
@@ -357,8 +527,16 @@ func (mh *MatchHandler) QueueJoin(joins []*MatchPresence, mark bool) bool {
 			return
 		}
 
-		processed := mh.PresenceList.Join(joins)
+		processed, spectators := splitMatchSpectators(mh.PresenceList.Join(joins))
+		if len(spectators) != 0 && mh.recorder != nil {
+			// Spectators are not match participants, so the match is not told they joined.
+			mh.recorder.RecordSpectatorJoin(mh.tick, spectators)
+		}
 		if len(processed) != 0 {
+			if mh.recorder != nil {
+				mh.recorder.RecordJoin(mh.tick, processed)
+			}
+
 			state, err := mh.core.MatchJoin(mh.tick, mh.state, processed)
 			if err != nil {
 				mh.Stop()
@@ -388,13 +566,32 @@ func (mh *MatchHandler) QueueLeave(leaves []*MatchPresence) bool {
 			return
 		}
 
-		processed := mh.PresenceList.Leave(leaves)
+		// Disconnected players may keep their place for a while instead, if the match allows it.
+		leaves = mh.holdDisconnected(leaves)
+		if mh.stopped.Load() {
+			return
+		}
+
+		processed, spectators := splitMatchSpectators(mh.PresenceList.Leave(leaves))
+		if len(spectators) != 0 {
+			for _, leave := range spectators {
+				mh.JoinMarkerList.Mark(leave.SessionID)
+			}
+
+			if mh.recorder != nil {
+				mh.recorder.RecordSpectatorLeave(mh.tick, spectators)
+			}
+		}
 		if len(processed) != 0 {
 			for _, leave := range processed {
 				mh.JoinMarkerList.Mark(leave.SessionID)
 			}
 
-			state, err := mh.core.MatchLeave(mh.tick, mh.state, leaves)
+			if mh.recorder != nil {
+				mh.recorder.RecordLeave(mh.tick, processed)
+			}
+
+			state, err := mh.core.MatchLeave(mh.tick, mh.state, processed)
 			if err != nil {
 				mh.Stop()
 				mh.logger.Warn("Stopping match after error from match_leave execution", zap.Int("tick", int(mh.tick)), zap.Error(err))
@@ -413,6 +610,122 @@ func (mh *MatchHandler) QueueLeave(leaves []*MatchPresence) bool {
 	return mh.queueCall(leave)
 }
 
+// Offers any disconnected players among the leaves to the match, and returns the leaves that should go ahead.
+func (mh *MatchHandler) holdDisconnected(leaves []*MatchPresence) []*MatchPresence {
+	var disconnects []*MatchPresence
+	remaining := make([]*MatchPresence, 0, len(leaves))
+	for _, leave := range leaves {
+		if leave.Disconnected && mh.PresenceList.Contains(&PresenceID{Node: leave.Node, SessionID: leave.SessionID}) {
+			disconnects = append(disconnects, leave)
+		} else {
+			remaining = append(remaining, leave)
+		}
+	}
+	if len(disconnects) == 0 {
+		return leaves
+	}
+
+	if mh.recorder != nil {
+		mh.recorder.RecordDisconnect(mh.tick, disconnects)
+	}
+
+	state, reconnectSeconds, err := mh.core.MatchDisconnect(mh.tick, mh.state, disconnects)
+	if err != nil {
+		mh.Stop()
+		mh.logger.Warn("Stopping match after error from match_disconnect execution", zap.Int64("tick", mh.tick), zap.Error(err))
+		return nil
+	}
+	if state == nil {
+		mh.Stop()
+		mh.logger.Info("Match disconnect returned nil or no state, stopping match")
+		return nil
+	}
+
+	mh.state = state
+	if reconnectSeconds <= 0 {
+		// The match does not want to wait for these players.
+		return leaves
+	}
+
+	expiryTick := mh.tick + int64(reconnectSeconds)*mh.Rate
+	for _, disconnect := range disconnects {
+		mh.disconnected[disconnect.SessionID] = &matchDisconnect{presence: disconnect, expiryTick: expiryTick}
+	}
+	return remaining
+}
+
+func (mh *MatchHandler) QueueReconnect(ctx context.Context, resultCh chan<- *MatchJoinResult, userID, sessionID uuid.UUID, username, node string) bool {
+	if mh.stopped.Load() {
+		return false
+	}
+
+	reconnect := func(mh *MatchHandler) {
+		select {
+		case <-ctx.Done():
+			// Do not process the reconnect through the match handler if the client has gone away between when this
+			// call was inserted into the match call queue and when it's due for processing.
+			resultCh <- &MatchJoinResult{Allow: false}
+			return
+		default:
+		}
+
+		if mh.stopped.Load() {
+			resultCh <- &MatchJoinResult{Allow: false}
+			return
+		}
+
+		var held *MatchPresence
+		for previousSessionID, disconnect := range mh.disconnected {
+			if disconnect.presence.UserID == userID {
+				held = disconnect.presence
+				delete(mh.disconnected, previousSessionID)
+				break
+			}
+		}
+		if held == nil {
+			resultCh <- &MatchJoinResult{Allow: false, Reason: "Match is not holding a place to reconnect to"}
+			return
+		}
+
+		presence := &MatchPresence{Node: node, UserID: userID, SessionID: sessionID, Username: username}
+		if mh.recorder != nil {
+			mh.recorder.RecordReconnect(mh.tick, held, presence)
+		}
+
+		// The new session takes over the place of the previous one, without the match seeing a leave and join.
+		mh.PresenceList.Leave([]*MatchPresence{held})
+		mh.PresenceList.Join([]*MatchPresence{presence})
+
+		state, err := mh.core.MatchReconnect(mh.tick, mh.state, []*MatchPresence{presence})
+		if err != nil {
+			mh.Stop()
+			mh.logger.Warn("Stopping match after error from match_reconnect execution", zap.Int64("tick", mh.tick), zap.Error(err))
+			resultCh <- &MatchJoinResult{Allow: false}
+			return
+		}
+		if state == nil {
+			mh.Stop()
+			mh.logger.Info("Match reconnect returned nil or no state, stopping match")
+			resultCh <- &MatchJoinResult{Allow: false}
+			return
+		}
+
+		mh.state = state
+		mh.JoinMarkerList.Add(presence, mh.tick)
+		// Signal client.
+		resultCh <- &MatchJoinResult{Allow: true, Label: mh.core.Label()}
+	}
+
+	select {
+	case mh.joinAttemptCh <- reconnect:
+		return true
+	default:
+		// Match join queue is full, the handler isn't processing these fast enough or there are just too many.
+		mh.logger.Warn("Match handler join attempt queue full")
+		return false
+	}
+}
+
 func (mh *MatchHandler) QueueTerminate(graceSeconds int) bool {
 	if mh.stopped.Load() {
 		return false
@@ -423,6 +736,10 @@ func (mh *MatchHandler) QueueTerminate(graceSeconds int) bool {
 			return
 		}
 
+		if mh.recorder != nil {
+			mh.recorder.RecordTerminate(mh.tick, graceSeconds)
+		}
+
 		state, err := mh.core.MatchTerminate(mh.tick, mh.state, graceSeconds)
 		if err != nil {
 			mh.Stop()
@@ -437,6 +754,20 @@ func (mh *MatchHandler) QueueTerminate(graceSeconds int) bool {
 
 		mh.state = state
 
+		// Matches able to snapshot their state are stored and stopped, to be restored when a server next starts.
+		snapshot, err := mh.core.MatchSnapshot(mh.tick, mh.state)
+		if err != nil {
+			mh.logger.Warn("Error from match_snapshot execution, match will not be restored", zap.Int64("tick", mh.tick), zap.Error(err))
+		} else if snapshot != "" {
+			if err := mh.matchRegistry.StoreSnapshot(mh.ID, mh.Module, mh.tick, snapshot); err != nil {
+				mh.logger.Warn("Error storing match snapshot, match will not be restored", zap.Int64("tick", mh.tick), zap.Error(err))
+			} else {
+				mh.Stop()
+				mh.logger.Info("Match snapshot stored, stopping match")
+				return
+			}
+		}
+
 		// If grace period is 0 end the match immediately after the callback returns.
 		if graceSeconds == 0 {
 			mh.Stop()
@@ -445,3 +776,17 @@ func (mh *MatchHandler) QueueTerminate(graceSeconds int) bool {
 
 	return mh.queueCall(terminate)
 }
+
+// Separates spectators from match players, keeping the order of each.
+func splitMatchSpectators(presences []*MatchPresence) ([]*MatchPresence, []*MatchPresence) {
+	players := make([]*MatchPresence, 0, len(presences))
+	var spectators []*MatchPresence
+	for _, presence := range presences {
+		if presence.Spectator {
+			spectators = append(spectators, presence)
+		} else {
+			players = append(players, presence)
+		}
+	}
+	return players, spectators
+}
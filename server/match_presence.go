@@ -26,6 +26,10 @@ type MatchPresence struct {
 	UserID    uuid.UUID
 	SessionID uuid.UUID
 	Username  string
+	// Spectators receive match broadcasts but do not participate in the match.
+	Spectator bool
+	// Set on leaves caused by the session disconnecting, rather than the user leaving the match.
+	Disconnected bool
 }
 
 func (p *MatchPresence) GetUserId() string {
@@ -99,26 +103,43 @@ func (m *MatchJoinMarkerList) ClearExpired(tick int64) []*MatchPresence {
 	return presences
 }
 
-// Maintains the match presences for routing and validation purposes.
+// Maintains the match presences for routing and validation purposes. Players and spectators are tracked separately,
+// only players count towards the match size.
 type MatchPresenceList struct {
 	sync.RWMutex
-	size        *atomic.Int32
-	presences   []*PresenceID
-	presenceMap map[uuid.UUID]struct{}
+	size         *atomic.Int32
+	presences    []*PresenceID
+	presenceMap  map[uuid.UUID]struct{}
+	spectators   []*PresenceID
+	spectatorMap map[uuid.UUID]struct{}
 }
 
 func NewMatchPresenceList() *MatchPresenceList {
 	return &MatchPresenceList{
-		size:        atomic.NewInt32(0),
-		presences:   make([]*PresenceID, 0, 10),
-		presenceMap: make(map[uuid.UUID]struct{}, 10),
+		size:         atomic.NewInt32(0),
+		presences:    make([]*PresenceID, 0, 10),
+		presenceMap:  make(map[uuid.UUID]struct{}, 10),
+		spectators:   make([]*PresenceID, 0),
+		spectatorMap: make(map[uuid.UUID]struct{}),
 	}
 }
 
 func (m *MatchPresenceList) Join(joins []*MatchPresence) []*MatchPresence {
 	processed := make([]*MatchPresence, 0, len(joins))
+	var players int32
 	m.Lock()
 	for _, join := range joins {
+		if join.Spectator {
+			if _, ok := m.spectatorMap[join.SessionID]; !ok {
+				m.spectators = append(m.spectators, &PresenceID{
+					Node:      join.Node,
+					SessionID: join.SessionID,
+				})
+				m.spectatorMap[join.SessionID] = struct{}{}
+				processed = append(processed, join)
+			}
+			continue
+		}
 		if _, ok := m.presenceMap[join.SessionID]; !ok {
 			m.presences = append(m.presences, &PresenceID{
 				Node:      join.Node,
@@ -126,37 +147,53 @@ func (m *MatchPresenceList) Join(joins []*MatchPresence) []*MatchPresence {
 			})
 			m.presenceMap[join.SessionID] = struct{}{}
 			processed = append(processed, join)
+			players++
 		}
 	}
 	m.Unlock()
-	if l := len(processed); l != 0 {
-		m.size.Add(int32(l))
+	if players != 0 {
+		m.size.Add(players)
 	}
 	return processed
 }
 
 func (m *MatchPresenceList) Leave(leaves []*MatchPresence) []*MatchPresence {
 	processed := make([]*MatchPresence, 0, len(leaves))
+	var players int32
 	m.Lock()
 	for _, leave := range leaves {
-		if _, ok := m.presenceMap[leave.SessionID]; ok {
-			for i, presenceID := range m.presences {
-				if presenceID.SessionID == leave.SessionID && presenceID.Node == leave.Node {
-					m.presences = append(m.presences[:i], m.presences[i+1:]...)
-					break
-				}
+		if leave.Spectator {
+			if _, ok := m.spectatorMap[leave.SessionID]; ok {
+				m.spectators = removePresenceID(m.spectators, leave)
+				delete(m.spectatorMap, leave.SessionID)
+				processed = append(processed, leave)
 			}
+			continue
+		}
+		if _, ok := m.presenceMap[leave.SessionID]; ok {
+			m.presences = removePresenceID(m.presences, leave)
 			delete(m.presenceMap, leave.SessionID)
 			processed = append(processed, leave)
+			players++
 		}
 	}
 	m.Unlock()
-	if l := len(processed); l != 0 {
-		m.size.Sub(int32(l))
+	if players != 0 {
+		m.size.Sub(players)
 	}
 	return processed
 }
 
+func removePresenceID(presences []*PresenceID, leave *MatchPresence) []*PresenceID {
+	for i, presenceID := range presences {
+		if presenceID.SessionID == leave.SessionID && presenceID.Node == leave.Node {
+			return append(presences[:i], presences[i+1:]...)
+		}
+	}
+	return presences
+}
+
+// Contains reports whether the given presence is a player in the match.
 func (m *MatchPresenceList) Contains(presence *PresenceID) bool {
 	var found bool
 	m.RLock()
@@ -170,6 +207,21 @@ func (m *MatchPresenceList) Contains(presence *PresenceID) bool {
 	return found
 }
 
+// ContainsSpectator reports whether the given presence is a spectator of the match.
+func (m *MatchPresenceList) ContainsSpectator(presence *PresenceID) bool {
+	var found bool
+	m.RLock()
+	for _, p := range m.spectators {
+		if p.SessionID == presence.SessionID && p.Node == presence.Node {
+			found = true
+			break
+		}
+	}
+	m.RUnlock()
+	return found
+}
+
+// List returns the match players.
 func (m *MatchPresenceList) List() []*PresenceID {
 	m.RLock()
 	list := make([]*PresenceID, 0, len(m.presences))
@@ -180,6 +232,18 @@ func (m *MatchPresenceList) List() []*PresenceID {
 	return list
 }
 
+// ListSpectators returns the match spectators.
+func (m *MatchPresenceList) ListSpectators() []*PresenceID {
+	m.RLock()
+	list := make([]*PresenceID, 0, len(m.spectators))
+	for _, presence := range m.spectators {
+		list = append(list, presence)
+	}
+	m.RUnlock()
+	return list
+}
+
+// Size returns the number of match players, spectators are not counted.
 func (m *MatchPresenceList) Size() int {
 	return int(m.size.Load())
 }
@@ -0,0 +1,533 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
+	"go.uber.org/zap"
+)
+
+const (
+	matchRecordingMagic     = "NKMR"
+	matchRecordingVersion   = byte(1)
+	matchRecordingExtension = ".replay"
+)
+
+// Kinds of events in a match recording, each followed by the tick it happened on and its own payload.
+const (
+	matchRecordJoinAttempt byte = iota + 1
+	matchRecordJoin
+	matchRecordLeave
+	matchRecordLoop
+	matchRecordSignal
+	matchRecordTerminate
+	matchRecordBroadcast
+	matchRecordSpectatorJoinAttempt
+	matchRecordSpectatorJoin
+	matchRecordSpectatorLeave
+	matchRecordDisconnect
+	matchRecordReconnect
+)
+
+var ErrMatchRecordingNotFound = errors.New("match recording not found")
+
+// MatchRecordingDir is where the current node stores its match recordings.
+func MatchRecordingDir(config Config) string {
+	return filepath.Join(config.GetDataDir(), "replays")
+}
+
+// MatchRecordingHeader describes the match a recording was taken from, and how to start a fresh copy of it.
+type MatchRecordingHeader struct {
+	ID         string
+	MatchID    string
+	Module     string
+	Params     map[string]interface{}
+	CreateTime int64
+	// Set if the match was restored from a snapshot rather than initialised with params.
+	Restored     bool
+	RestoreTick  int64
+	RestoreState []byte
+}
+
+// MatchRecorder writes everything that enters and leaves an authoritative match to a recording file. Recordings use a
+// compact binary format of varint encoded fields, and can be re-run against a fresh copy of the match.
+// Events are only recorded from the match handler's own goroutine, but the recorder may be closed from any.
+type MatchRecorder struct {
+	sync.Mutex
+	logger *zap.Logger
+	file   *os.File
+	writer *bufio.Writer
+	buf    []byte
+	closed bool
+}
+
+func NewMatchRecorder(logger *zap.Logger, dir string, header *MatchRecordingHeader) (*MatchRecorder, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	header.CreateTime = time.Now().UTC().Unix()
+	header.ID = fmt.Sprintf("%v.%v", header.MatchID, header.CreateTime)
+	file, err := os.OpenFile(filepath.Join(dir, header.ID+matchRecordingExtension), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &MatchRecorder{
+		logger: logger,
+		file:   file,
+		writer: bufio.NewWriter(file),
+		buf:    make([]byte, 0, 256),
+	}
+
+	params, err := json.Marshal(header.Params)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	b := append(r.buf[:0], matchRecordingMagic...)
+	b = append(b, matchRecordingVersion)
+	b = appendRecordString(b, header.MatchID)
+	b = appendRecordString(b, header.Module)
+	b = appendRecordBytes(b, params)
+	b = appendRecordVarint(b, header.CreateTime)
+	b = appendRecordBool(b, header.Restored)
+	b = appendRecordVarint(b, header.RestoreTick)
+	b = appendRecordBytes(b, header.RestoreState)
+	r.write(b)
+
+	return r, nil
+}
+
+func (r *MatchRecorder) RecordJoinAttempt(tick int64, presence *MatchPresence, metadata map[string]string) {
+	kind := matchRecordJoinAttempt
+	if presence.Spectator {
+		kind = matchRecordSpectatorJoinAttempt
+	}
+	b := appendRecordEvent(r.buf[:0], kind, tick)
+	b = appendRecordPresence(b, presence)
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	b = appendRecordUvarint(b, uint64(len(keys)))
+	for _, k := range keys {
+		b = appendRecordString(b, k)
+		b = appendRecordString(b, metadata[k])
+	}
+	r.write(b)
+}
+
+func (r *MatchRecorder) RecordJoin(tick int64, presences []*MatchPresence) {
+	r.write(appendRecordPresences(appendRecordEvent(r.buf[:0], matchRecordJoin, tick), presences))
+}
+
+func (r *MatchRecorder) RecordLeave(tick int64, presences []*MatchPresence) {
+	r.write(appendRecordPresences(appendRecordEvent(r.buf[:0], matchRecordLeave, tick), presences))
+}
+
+func (r *MatchRecorder) RecordSpectatorJoin(tick int64, presences []*MatchPresence) {
+	r.write(appendRecordPresences(appendRecordEvent(r.buf[:0], matchRecordSpectatorJoin, tick), presences))
+}
+
+func (r *MatchRecorder) RecordSpectatorLeave(tick int64, presences []*MatchPresence) {
+	r.write(appendRecordPresences(appendRecordEvent(r.buf[:0], matchRecordSpectatorLeave, tick), presences))
+}
+
+func (r *MatchRecorder) RecordDisconnect(tick int64, presences []*MatchPresence) {
+	r.write(appendRecordPresences(appendRecordEvent(r.buf[:0], matchRecordDisconnect, tick), presences))
+}
+
+// RecordReconnect stores the previous presence of the player followed by the presence that replaced it.
+func (r *MatchRecorder) RecordReconnect(tick int64, previous, presence *MatchPresence) {
+	r.write(appendRecordPresences(appendRecordEvent(r.buf[:0], matchRecordReconnect, tick), []*MatchPresence{previous, presence}))
+}
+
+func (r *MatchRecorder) RecordLoop(tick int64, messages []*MatchDataMessage) {
+	b := appendRecordEvent(r.buf[:0], matchRecordLoop, tick)
+	b = appendRecordUvarint(b, uint64(len(messages)))
+	for _, msg := range messages {
+		b = appendRecordPresence(b, &MatchPresence{Node: msg.Node, UserID: msg.UserID, SessionID: msg.SessionID, Username: msg.Username})
+		b = appendRecordVarint(b, msg.OpCode)
+		b = appendRecordBool(b, msg.Data != nil)
+		b = appendRecordBytes(b, msg.Data)
+		b = appendRecordBool(b, msg.Reliable)
+		b = appendRecordVarint(b, msg.ReceiveTime)
+	}
+	r.write(b)
+}
+
+func (r *MatchRecorder) RecordSignal(tick int64, data string) {
+	r.write(appendRecordString(appendRecordEvent(r.buf[:0], matchRecordSignal, tick), data))
+}
+
+func (r *MatchRecorder) RecordTerminate(tick int64, graceSeconds int) {
+	r.write(appendRecordVarint(appendRecordEvent(r.buf[:0], matchRecordTerminate, tick), int64(graceSeconds)))
+}
+
+func (r *MatchRecorder) RecordBroadcast(tick int64, presenceIDs []*PresenceID, envelope *rtapi.Envelope, reliable bool) {
+	b := appendRecordEvent(r.buf[:0], matchRecordBroadcast, tick)
+	r.write(appendRecordBytes(b, encodeRecordBroadcast(presenceIDs, envelope, reliable)))
+}
+
+func (r *MatchRecorder) Close() {
+	r.Lock()
+	defer r.Unlock()
+	if r.closed {
+		return
+	}
+	r.closed = true
+	if err := r.writer.Flush(); err != nil {
+		r.logger.Warn("Error flushing match recording", zap.Error(err))
+	}
+	if err := r.file.Close(); err != nil {
+		r.logger.Warn("Error closing match recording", zap.Error(err))
+	}
+}
+
+func (r *MatchRecorder) write(b []byte) {
+	r.Lock()
+	defer r.Unlock()
+	// Keep any growth of the scratch buffer for the next event.
+	r.buf = b[:0]
+	if r.closed {
+		return
+	}
+	if _, err := r.writer.Write(b); err != nil {
+		// Stop recording rather than leave a recording with missing events.
+		r.logger.Warn("Error writing match recording, recording stopped", zap.Error(err))
+		r.closed = true
+		r.file.Close()
+	}
+}
+
+// Broadcasts are compared byte for byte during replays, so exclude the match ID which differs between runs.
+func encodeRecordBroadcast(presenceIDs []*PresenceID, envelope *rtapi.Envelope, reliable bool) []byte {
+	data := envelope.GetMatchData()
+	b := make([]byte, 0, 64+len(data.GetData()))
+	b = appendRecordVarint(b, data.GetOpCode())
+	b = appendRecordBytes(b, data.GetData())
+	sender := data.GetPresence()
+	b = appendRecordBool(b, sender != nil)
+	if sender != nil {
+		b = appendRecordString(b, sender.UserId)
+		b = appendRecordString(b, sender.SessionId)
+		b = appendRecordString(b, sender.Username)
+	}
+	b = appendRecordUvarint(b, uint64(len(presenceIDs)))
+	for _, presenceID := range presenceIDs {
+		b = append(b, presenceID.SessionID.Bytes()...)
+		b = appendRecordString(b, presenceID.Node)
+	}
+	return appendRecordBool(b, reliable)
+}
+
+func appendRecordEvent(b []byte, kind byte, tick int64) []byte {
+	return appendRecordVarint(append(b, kind), tick)
+}
+
+func appendRecordUvarint(b []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(b, tmp[:binary.PutUvarint(tmp[:], v)]...)
+}
+
+func appendRecordVarint(b []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(b, tmp[:binary.PutVarint(tmp[:], v)]...)
+}
+
+func appendRecordBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, 1)
+	}
+	return append(b, 0)
+}
+
+func appendRecordBytes(b []byte, v []byte) []byte {
+	return append(appendRecordUvarint(b, uint64(len(v))), v...)
+}
+
+func appendRecordString(b []byte, v string) []byte {
+	return append(appendRecordUvarint(b, uint64(len(v))), v...)
+}
+
+func appendRecordPresence(b []byte, presence *MatchPresence) []byte {
+	b = append(b, presence.UserID.Bytes()...)
+	b = append(b, presence.SessionID.Bytes()...)
+	b = appendRecordString(b, presence.Username)
+	return appendRecordString(b, presence.Node)
+}
+
+func appendRecordPresences(b []byte, presences []*MatchPresence) []byte {
+	b = appendRecordUvarint(b, uint64(len(presences)))
+	for _, presence := range presences {
+		b = appendRecordPresence(b, presence)
+	}
+	return b
+}
+
+// matchRecordEvent is a single decoded event from a recording, only the fields relevant to its kind are set.
+type matchRecordEvent struct {
+	kind         byte
+	tick         int64
+	presences    []*MatchPresence
+	metadata     map[string]string
+	messages     []*MatchDataMessage
+	data         string
+	graceSeconds int
+	broadcast    []byte
+}
+
+// matchRecordReader decodes a recording. The first error encountered is kept and returned by all later reads.
+type matchRecordReader struct {
+	reader *bufio.Reader
+	err    error
+}
+
+func openMatchRecording(dir, id string) (*os.File, *matchRecordReader, *MatchRecordingHeader, error) {
+	// Recording IDs are file names, never allow them to point elsewhere.
+	if id == "" || id != filepath.Base(id) || strings.HasPrefix(id, ".") {
+		return nil, nil, nil, ErrMatchRecordingNotFound
+	}
+	file, err := os.Open(filepath.Join(dir, id+matchRecordingExtension))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil, ErrMatchRecordingNotFound
+		}
+		return nil, nil, nil, err
+	}
+
+	r := &matchRecordReader{reader: bufio.NewReader(file)}
+	header, err := r.readHeader()
+	if err != nil {
+		file.Close()
+		return nil, nil, nil, err
+	}
+	header.ID = id
+	return file, r, header, nil
+}
+
+func (r *matchRecordReader) readHeader() (*MatchRecordingHeader, error) {
+	magic := make([]byte, len(matchRecordingMagic)+1)
+	if _, err := io.ReadFull(r.reader, magic); err != nil || string(magic[:len(matchRecordingMagic)]) != matchRecordingMagic {
+		return nil, errors.New("not a match recording")
+	}
+	if version := magic[len(matchRecordingMagic)]; version != matchRecordingVersion {
+		return nil, fmt.Errorf("unsupported match recording version %v", version)
+	}
+
+	header := &MatchRecordingHeader{
+		MatchID: r.readString(),
+		Module:  r.readString(),
+	}
+	params := r.readBytes()
+	header.CreateTime = r.readVarint()
+	header.Restored = r.readBool()
+	header.RestoreTick = r.readVarint()
+	header.RestoreState = r.readBytes()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if err := json.Unmarshal(params, &header.Params); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// Returns io.EOF once all events have been read.
+func (r *matchRecordReader) next() (*matchRecordEvent, error) {
+	kind, err := r.reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	event := &matchRecordEvent{kind: kind, tick: r.readVarint()}
+	switch kind {
+	case matchRecordJoinAttempt, matchRecordSpectatorJoinAttempt:
+		presence := r.readPresence()
+		presence.Spectator = kind == matchRecordSpectatorJoinAttempt
+		event.presences = []*MatchPresence{presence}
+		count := r.readCount()
+		event.metadata = make(map[string]string, count)
+		for i := 0; i < count; i++ {
+			k := r.readString()
+			event.metadata[k] = r.readString()
+		}
+	case matchRecordJoin, matchRecordLeave, matchRecordSpectatorJoin, matchRecordSpectatorLeave, matchRecordDisconnect, matchRecordReconnect:
+		spectator := kind == matchRecordSpectatorJoin || kind == matchRecordSpectatorLeave
+		count := r.readCount()
+		event.presences = make([]*MatchPresence, 0, count)
+		for i := 0; i < count; i++ {
+			presence := r.readPresence()
+			presence.Spectator = spectator
+			event.presences = append(event.presences, presence)
+		}
+	case matchRecordLoop:
+		count := r.readCount()
+		event.messages = make([]*MatchDataMessage, 0, count)
+		for i := 0; i < count; i++ {
+			presence := r.readPresence()
+			msg := &MatchDataMessage{
+				UserID:    presence.UserID,
+				SessionID: presence.SessionID,
+				Username:  presence.Username,
+				Node:      presence.Node,
+				OpCode:    r.readVarint(),
+			}
+			hasData := r.readBool()
+			if data := r.readBytes(); hasData {
+				msg.Data = data
+			}
+			msg.Reliable = r.readBool()
+			msg.ReceiveTime = r.readVarint()
+			event.messages = append(event.messages, msg)
+		}
+	case matchRecordSignal:
+		event.data = r.readString()
+	case matchRecordTerminate:
+		event.graceSeconds = int(r.readVarint())
+	case matchRecordBroadcast:
+		event.broadcast = r.readBytes()
+	default:
+		return nil, fmt.Errorf("unknown match recording event kind %v", kind)
+	}
+
+	if r.err != nil {
+		if r.err == io.EOF {
+			// The recording ended part way through an event, usually because the server was not shut down cleanly.
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, r.err
+	}
+	return event, nil
+}
+
+func (r *matchRecordReader) readUvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, err := binary.ReadUvarint(r.reader)
+	r.err = err
+	return v
+}
+
+func (r *matchRecordReader) readVarint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, err := binary.ReadVarint(r.reader)
+	r.err = err
+	return v
+}
+
+func (r *matchRecordReader) readCount() int {
+	count := r.readUvarint()
+	if count > 1<<20 && r.err == nil {
+		r.err = errors.New("match recording is corrupt")
+		return 0
+	}
+	return int(count)
+}
+
+func (r *matchRecordReader) readBool() bool {
+	if r.err != nil {
+		return false
+	}
+	b, err := r.reader.ReadByte()
+	r.err = err
+	return b == 1
+}
+
+func (r *matchRecordReader) readBytes() []byte {
+	size := r.readCount()
+	if r.err != nil {
+		return nil
+	}
+	b := make([]byte, size)
+	_, r.err = io.ReadFull(r.reader, b)
+	return b
+}
+
+func (r *matchRecordReader) readString() string {
+	return string(r.readBytes())
+}
+
+func (r *matchRecordReader) readUUID() uuid.UUID {
+	if r.err != nil {
+		return uuid.Nil
+	}
+	var id uuid.UUID
+	_, r.err = io.ReadFull(r.reader, id[:])
+	return id
+}
+
+func (r *matchRecordReader) readPresence() *MatchPresence {
+	return &MatchPresence{
+		UserID:    r.readUUID(),
+		SessionID: r.readUUID(),
+		Username:  r.readString(),
+		Node:      r.readString(),
+	}
+}
+
+// ListMatchRecordings returns the headers of all recordings in the given directory, newest first.
+func ListMatchRecordings(logger *zap.Logger, dir string) ([]*MatchRecordingHeader, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make([]*MatchRecordingHeader, 0), nil
+		}
+		logger.Error("Error listing match recordings.", zap.Error(err))
+		return nil, err
+	}
+
+	headers := make([]*MatchRecordingHeader, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), matchRecordingExtension) {
+			continue
+		}
+		file, _, header, err := openMatchRecording(dir, strings.TrimSuffix(entry.Name(), matchRecordingExtension))
+		if err != nil {
+			logger.Warn("Skipping unreadable match recording.", zap.String("name", entry.Name()), zap.Error(err))
+			continue
+		}
+		file.Close()
+		headers = append(headers, header)
+	}
+
+	sort.Slice(headers, func(i, j int) bool {
+		if headers[i].CreateTime != headers[j].CreateTime {
+			return headers[i].CreateTime > headers[j].CreateTime
+		}
+		return headers[i].ID < headers[j].ID
+	})
+	return headers, nil
+}
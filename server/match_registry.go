@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"github.com/blevesearch/bleve/search/query"
@@ -26,8 +27,10 @@ import (
 	"github.com/blevesearch/bleve"
 	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/heroiclabs/nakama/api"
+	"github.com/heroiclabs/nakama/runtime"
 	"github.com/pkg/errors"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -46,6 +49,8 @@ var (
 	ErrMatchLabelTooLong     = errors.New("match label too long, must be 0-2048 bytes")
 	ErrDeferredBroadcastFull = errors.New("too many deferred message broadcasts per tick")
 	ErrNoJoinMarker          = errors.New("no join marker received")
+	ErrMatchNotFound         = errors.New("match not found")
+	ErrMatchBusy             = errors.New("match busy")
 )
 
 type MatchIndexEntry struct {
@@ -60,11 +65,20 @@ type MatchJoinResult struct {
 	Label  string
 }
 
+type MatchSignalResult struct {
+	Success bool
+	Result  string
+}
+
 type MatchRegistry interface {
 	// Create and start a new match, given a Lua module name or registered Go match function.
 	CreateMatch(ctx context.Context, logger *zap.Logger, createFn RuntimeMatchCreateFunction, module string, params map[string]interface{}) (string, error)
 	// Register and initialise a match that's ready to run.
-	NewMatch(logger *zap.Logger, id uuid.UUID, core RuntimeMatchCore, params map[string]interface{}) (*MatchHandler, error)
+	NewMatch(logger *zap.Logger, id uuid.UUID, module string, core RuntimeMatchCore, params map[string]interface{}) (*MatchHandler, error)
+	// Recreate matches from snapshots stored during a previous graceful shutdown of this or any other node.
+	RestoreMatches(ctx context.Context, createFn RuntimeMatchCreateFunction) error
+	// Store a snapshot of a match's state, along with its current participants, so it can be restored later.
+	StoreSnapshot(id uuid.UUID, module string, tick int64, state string) error
 	// Return a match handler by ID, only from the local node.
 	GetMatch(id uuid.UUID) *MatchHandler
 	// Remove a tracked match and ensure all its presences are cleaned up.
@@ -82,8 +96,10 @@ type MatchRegistry interface {
 	// Returns the total number of currently active authoritative matches.
 	Count() int
 
-	// Pass a user join attempt to a match handler. Returns if the match was found, if the join was accepted, a reason for any rejection, and the match label.
-	JoinAttempt(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, metadata map[string]string) (bool, bool, string, string)
+	// Pass a user join attempt to a match handler, either as a player or a spectator. Returns if the match was found, if the join was accepted, a reason for any rejection, and the match label.
+	JoinAttempt(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, metadata map[string]string, spectator bool) (bool, bool, string, string)
+	// Pass a user's attempt to reclaim their place in a match from a new session after a disconnect. Returns the same as JoinAttempt.
+	Reconnect(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string) (bool, bool, string, string)
 	// Notify a match handler that one or more users have successfully joined the match.
 	// Expects that the caller has already determined the match is hosted on the current node.
 	Join(id uuid.UUID, presences []*MatchPresence)
@@ -94,13 +110,18 @@ type MatchRegistry interface {
 	Kick(stream PresenceStream, presences []*MatchPresence)
 	// Pass a data payload (usually from a user) to the appropriate match handler.
 	// Assumes that the data sender has already been validated as a match participant before this call.
-	SendData(id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, opCode int64, data []byte, receiveTime int64)
+	SendData(id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, opCode int64, data []byte, reliable bool, receiveTime int64)
+	// Pass an arbitrary signal payload to a match handler and wait for the string result it returns.
+	Signal(ctx context.Context, id uuid.UUID, node string, data string) (string, error)
+	// Re-run a match recording stored on this node against a fresh instance of the same match, without delivering anything it sends.
+	Replay(ctx context.Context, createFn RuntimeMatchCreateFunction, recordingID string) (*runtime.MatchReplayResult, error)
 }
 
 type LocalMatchRegistry struct {
 	sync.RWMutex
 	logger  *zap.Logger
 	config  Config
+	db      *sql.DB
 	tracker Tracker
 	router  MessageRouter
 	node    string
@@ -113,7 +134,7 @@ type LocalMatchRegistry struct {
 	stoppedCh chan struct{}
 }
 
-func NewLocalMatchRegistry(logger, startupLogger *zap.Logger, config Config, tracker Tracker, router MessageRouter, node string) MatchRegistry {
+func NewLocalMatchRegistry(logger, startupLogger *zap.Logger, config Config, db *sql.DB, tracker Tracker, router MessageRouter, node string) MatchRegistry {
 	mapping := bleve.NewIndexMapping()
 	mapping.DefaultAnalyzer = keyword.Name
 
@@ -125,6 +146,7 @@ func NewLocalMatchRegistry(logger, startupLogger *zap.Logger, config Config, tra
 	return &LocalMatchRegistry{
 		logger:  logger,
 		config:  config,
+		db:      db,
 		tracker: tracker,
 		router:  router,
 		node:    node,
@@ -151,7 +173,7 @@ func (r *LocalMatchRegistry) CreateMatch(ctx context.Context, logger *zap.Logger
 	}
 
 	// Start the match.
-	mh, err := r.NewMatch(matchLogger, id, core, params)
+	mh, err := r.NewMatch(matchLogger, id, module, core, params)
 	if err != nil {
 		return "", fmt.Errorf("error creating match: %v", err.Error())
 	}
@@ -159,13 +181,17 @@ func (r *LocalMatchRegistry) CreateMatch(ctx context.Context, logger *zap.Logger
 	return mh.IDStr, nil
 }
 
-func (r *LocalMatchRegistry) NewMatch(logger *zap.Logger, id uuid.UUID, core RuntimeMatchCore, params map[string]interface{}) (*MatchHandler, error) {
+func (r *LocalMatchRegistry) NewMatch(logger *zap.Logger, id uuid.UUID, module string, core RuntimeMatchCore, params map[string]interface{}) (*MatchHandler, error) {
+	return r.newMatch(logger, id, module, core, params, nil)
+}
+
+func (r *LocalMatchRegistry) newMatch(logger *zap.Logger, id uuid.UUID, module string, core RuntimeMatchCore, params map[string]interface{}, snapshot *MatchSnapshot) (*MatchHandler, error) {
 	if r.stopped.Load() {
 		// Server is shutting down, reject new matches.
 		return nil, errors.New("shutdown in progress")
 	}
 
-	match, err := NewMatchHandler(logger, r.config, r, r.router, core, id, r.node, params)
+	match, err := NewMatchHandler(logger, r.config, r, r.router, core, id, r.node, module, params, snapshot)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +202,96 @@ func (r *LocalMatchRegistry) NewMatch(logger *zap.Logger, id uuid.UUID, core Run
 	return match, nil
 }
 
+func (r *LocalMatchRegistry) RestoreMatches(ctx context.Context, createFn RuntimeMatchCreateFunction) error {
+	ids, err := ListMatchSnapshotIDs(ctx, r.logger, r.db)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		matchLogger := r.logger.With(zap.String("mid", id.String()))
+
+		var mh *MatchHandler
+		var snapshot *MatchSnapshot
+		claimed, err := ClaimMatchSnapshot(ctx, matchLogger, r.db, id, func(s *MatchSnapshot) error {
+			snapshot = s
+			core, err := createFn(ctx, matchLogger, s.ID, r.node, s.Module)
+			if err != nil {
+				return err
+			}
+			if core == nil {
+				return errors.New("not found")
+			}
+			mh, err = r.newMatch(matchLogger, s.ID, s.Module, core, nil, s)
+			return err
+		})
+		if err != nil {
+			if mh != nil {
+				// The match was restored but the snapshot is still stored, stop it so it is only ever running once.
+				mh.Stop()
+			}
+			module := ""
+			if snapshot != nil {
+				module = snapshot.Module
+			}
+			matchLogger.Error("Error restoring match, snapshot kept", zap.String("module", module), zap.Error(err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		// Invite users who were in the match when it was stored to rejoin, their sessions did not survive the shutdown.
+		if len(snapshot.UserIDs) != 0 {
+			content, _ := json.Marshal(map[string]string{"match_id": mh.IDStr, "previous_node": snapshot.Node})
+			notifications := make(map[uuid.UUID][]*api.Notification, len(snapshot.UserIDs))
+			for _, userID := range snapshot.UserIDs {
+				notifications[userID] = []*api.Notification{
+					&api.Notification{
+						Id:         uuid.Must(uuid.NewV4()).String(),
+						Subject:    "The match you were playing has been restored",
+						Content:    string(content),
+						SenderId:   uuid.Nil.String(),
+						Code:       NotificationCodeMatchRejoin,
+						Persistent: true,
+						CreateTime: &timestamp.Timestamp{Seconds: time.Now().UTC().Unix()},
+					},
+				}
+			}
+			if err := NotificationSend(ctx, matchLogger, r.db, r.router, notifications); err != nil {
+				matchLogger.Warn("Error sending match rejoin notifications", zap.Error(err))
+			}
+		}
+
+		matchLogger.Info("Match restored from snapshot", zap.String("previous_node", snapshot.Node), zap.Int64("tick", snapshot.Tick))
+	}
+
+	return nil
+}
+
+func (r *LocalMatchRegistry) StoreSnapshot(id uuid.UUID, module string, tick int64, state string) error {
+	// Participants may be connected to any node, so rely on the tracker to find them all.
+	presences := r.tracker.ListByStream(PresenceStream{Mode: StreamModeMatchAuthoritative, Subject: id, Label: r.node}, true, true)
+	userIDs := make([]uuid.UUID, 0, len(presences))
+	seen := make(map[uuid.UUID]struct{}, len(presences))
+	for _, presence := range presences {
+		if _, found := seen[presence.UserID]; found {
+			continue
+		}
+		seen[presence.UserID] = struct{}{}
+		userIDs = append(userIDs, presence.UserID)
+	}
+
+	return StoreMatchSnapshot(context.Background(), r.logger, r.db, &MatchSnapshot{
+		ID:      id,
+		Node:    r.node,
+		Module:  module,
+		Tick:    tick,
+		State:   []byte(state),
+		UserIDs: userIDs,
+	})
+}
+
 func (r *LocalMatchRegistry) GetMatch(id uuid.UUID) *MatchHandler {
 	mh, ok := r.matches.Load(id)
 	if !ok {
@@ -189,6 +305,7 @@ func (r *LocalMatchRegistry) RemoveMatch(id uuid.UUID, stream PresenceStream) {
 	matchesRemaining := r.matchCount.Dec()
 
 	r.tracker.UntrackByStream(stream)
+	r.tracker.UntrackByStream(PresenceStream{Mode: StreamModeMatchSpectator, Subject: stream.Subject, Label: stream.Label})
 	if err := r.index.Delete(fmt.Sprintf("%v.%v", id.String(), r.node)); err != nil {
 		r.logger.Warn("Error removing match list index", zap.String("id", fmt.Sprintf("%v.%v", id.String(), r.node)), zap.Error(err))
 	}
@@ -456,7 +573,7 @@ func (r *LocalMatchRegistry) Count() int {
 	return int(r.matchCount.Load())
 }
 
-func (r *LocalMatchRegistry) JoinAttempt(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, metadata map[string]string) (bool, bool, string, string) {
+func (r *LocalMatchRegistry) JoinAttempt(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, metadata map[string]string, spectator bool) (bool, bool, string, string) {
 	if node != r.node {
 		return false, false, "", ""
 	}
@@ -468,7 +585,7 @@ func (r *LocalMatchRegistry) JoinAttempt(ctx context.Context, id uuid.UUID, node
 	mh := m.(*MatchHandler)
 
 	resultCh := make(chan *MatchJoinResult, 1)
-	if !mh.QueueJoinAttempt(ctx, resultCh, userID, sessionID, username, fromNode, metadata) {
+	if !mh.QueueJoinAttempt(ctx, resultCh, userID, sessionID, username, fromNode, metadata, spectator) {
 		// The match call queue was full, so will be closed and therefore can't be joined.
 		return true, false, "Match is not currently accepting join requests", ""
 	}
@@ -487,6 +604,36 @@ func (r *LocalMatchRegistry) JoinAttempt(ctx context.Context, id uuid.UUID, node
 	}
 }
 
+func (r *LocalMatchRegistry) Reconnect(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string) (bool, bool, string, string) {
+	if node != r.node {
+		return false, false, "", ""
+	}
+
+	m, ok := r.matches.Load(id)
+	if !ok {
+		return false, false, "", ""
+	}
+	mh := m.(*MatchHandler)
+
+	resultCh := make(chan *MatchJoinResult, 1)
+	if !mh.QueueReconnect(ctx, resultCh, userID, sessionID, username, fromNode) {
+		// The match call queue was full, so will be closed and therefore can't be joined.
+		return true, false, "Match is not currently accepting join requests", ""
+	}
+
+	// Set up a limit to how long the call will wait, default is 10 seconds.
+	timer := time.NewTimer(time.Second * 10)
+	select {
+	case <-timer.C:
+		// The reconnect has timed out, it is assumed to be rejected.
+		return true, false, "", ""
+	case r := <-resultCh:
+		// Doesn't matter if the timer has fired concurrently, we're in the desired case anyway.
+		timer.Stop()
+		return true, r.Allow, r.Reason, r.Label
+	}
+}
+
 func (r *LocalMatchRegistry) Join(id uuid.UUID, presences []*MatchPresence) {
 	mh, ok := r.matches.Load(id)
 	if !ok {
@@ -513,10 +660,12 @@ func (r *LocalMatchRegistry) Kick(stream PresenceStream, presences []*MatchPrese
 			continue
 		}
 		r.tracker.Untrack(presence.SessionID, stream, presence.UserID)
+		// The presence may be spectating rather than playing.
+		r.tracker.Untrack(presence.SessionID, PresenceStream{Mode: StreamModeMatchSpectator, Subject: stream.Subject, Label: stream.Label}, presence.UserID)
 	}
 }
 
-func (r *LocalMatchRegistry) SendData(id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, opCode int64, data []byte, receiveTime int64) {
+func (r *LocalMatchRegistry) SendData(id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, opCode int64, data []byte, reliable bool, receiveTime int64) {
 	if node != r.node {
 		return
 	}
@@ -530,9 +679,44 @@ func (r *LocalMatchRegistry) SendData(id uuid.UUID, node string, userID, session
 		UserID:      userID,
 		SessionID:   sessionID,
 		Username:    username,
-		Node:        node,
+		Node:        fromNode,
 		OpCode:      opCode,
 		Data:        data,
+		Reliable:    reliable,
 		ReceiveTime: receiveTime,
 	})
 }
+
+func (r *LocalMatchRegistry) Signal(ctx context.Context, id uuid.UUID, node string, data string) (string, error) {
+	if node != r.node {
+		return "", ErrMatchNotFound
+	}
+
+	m, ok := r.matches.Load(id)
+	if !ok {
+		return "", ErrMatchNotFound
+	}
+	mh := m.(*MatchHandler)
+
+	resultCh := make(chan *MatchSignalResult, 1)
+	if !mh.QueueSignal(ctx, resultCh, data) {
+		// The match call queue was full, so will be closed and therefore can't receive signals.
+		return "", ErrMatchBusy
+	}
+
+	// Set up a limit to how long the call will wait, default is 10 seconds.
+	timer := time.NewTimer(time.Second * 10)
+	select {
+	case <-timer.C:
+		// The signal has timed out, the match is too busy to handle it.
+		return "", ErrMatchBusy
+	case r := <-resultCh:
+		// Doesn't matter if the timer has fired concurrently, we're in the desired case anyway.
+		timer.Stop()
+		if !r.Success {
+			// The match stopped before or while handling the signal.
+			return "", ErrMatchNotFound
+		}
+		return r.Result, nil
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/heroiclabs/nakama/api"
+	"go.uber.org/zap"
+)
+
+type clusterMatchJoinAttempt struct {
+	ID        uuid.UUID         `json:"id"`
+	UserID    uuid.UUID         `json:"user_id"`
+	SessionID uuid.UUID         `json:"session_id"`
+	Username  string            `json:"username"`
+	FromNode  string            `json:"from_node"`
+	Metadata  map[string]string `json:"metadata"`
+	Spectator bool              `json:"spectator"`
+}
+
+type clusterMatchJoinResult struct {
+	Found  bool   `json:"found"`
+	Allow  bool   `json:"allow"`
+	Reason string `json:"reason"`
+	Label  string `json:"label"`
+}
+
+type clusterMatchData struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	SessionID   uuid.UUID `json:"session_id"`
+	Username    string    `json:"username"`
+	FromNode    string    `json:"from_node"`
+	OpCode      int64     `json:"op_code"`
+	Data        []byte    `json:"data"`
+	Reliable    bool      `json:"reliable"`
+	ReceiveTime int64     `json:"receive_time"`
+}
+
+type clusterMatchSignal struct {
+	ID   uuid.UUID `json:"id"`
+	Data string    `json:"data"`
+}
+
+type clusterMatchSignalResult struct {
+	Found  bool   `json:"found"`
+	Busy   bool   `json:"busy"`
+	Result string `json:"result"`
+}
+
+type clusterMatchKick struct {
+	Stream    PresenceStream   `json:"stream"`
+	Presences []*MatchPresence `json:"presences"`
+}
+
+type clusterMatchList struct {
+	Limit   int                   `json:"limit"`
+	Label   *wrappers.StringValue `json:"label"`
+	MinSize *wrappers.Int32Value  `json:"min_size"`
+	MaxSize *wrappers.Int32Value  `json:"max_size"`
+	Query   *wrappers.StringValue `json:"query"`
+}
+
+// ClusterMatchRegistry runs matches on the current node, and forwards operations on matches hosted
+// by other nodes in the cluster to those nodes.
+type ClusterMatchRegistry struct {
+	MatchRegistry
+	logger  *zap.Logger
+	cluster *Cluster
+}
+
+func NewClusterMatchRegistry(logger, startupLogger *zap.Logger, config Config, db *sql.DB, tracker Tracker, router MessageRouter, cluster *Cluster) MatchRegistry {
+	r := &ClusterMatchRegistry{
+		MatchRegistry: NewLocalMatchRegistry(logger, startupLogger, config, db, tracker, router, config.GetName()),
+		logger:        logger,
+		cluster:       cluster,
+	}
+
+	cluster.SetHandler(ClusterMessageMatchJoinAttempt, r.handleJoinAttempt)
+	cluster.SetHandler(ClusterMessageMatchLabel, r.handleLabel)
+	cluster.SetHandler(ClusterMessageMatchData, r.handleData)
+	cluster.SetHandler(ClusterMessageMatchKick, r.handleKick)
+	cluster.SetHandler(ClusterMessageMatchList, r.handleList)
+	cluster.SetHandler(ClusterMessageMatchSignal, r.handleSignal)
+	cluster.SetHandler(ClusterMessageMatchReconnect, r.handleReconnect)
+
+	return r
+}
+
+func (r *ClusterMatchRegistry) GetMatchLabel(ctx context.Context, id uuid.UUID, node string) (string, error) {
+	if node == r.cluster.Name() {
+		return r.MatchRegistry.GetMatchLabel(ctx, id, node)
+	}
+
+	var label string
+	if err := r.cluster.Request(ctx, node, ClusterMessageMatchLabel, id, &label); err != nil {
+		return "", err
+	}
+	return label, nil
+}
+
+func (r *ClusterMatchRegistry) ListMatches(ctx context.Context, limit int, authoritative *wrappers.BoolValue, label *wrappers.StringValue, minSize *wrappers.Int32Value, maxSize *wrappers.Int32Value, query *wrappers.StringValue) ([]*api.Match, error) {
+	// Relayed matches are visible through presences shared across the cluster, so are already included here.
+	results, err := r.MatchRegistry.ListMatches(ctx, limit, authoritative, label, minSize, maxSize, query)
+	if err != nil || len(results) >= limit || (authoritative != nil && !authoritative.Value) {
+		return results, err
+	}
+
+	// Fill any remaining space with authoritative matches hosted on other nodes.
+	for _, node := range r.cluster.Members() {
+		var matches []*api.Match
+		if err := r.cluster.Request(ctx, node, ClusterMessageMatchList, &clusterMatchList{
+			Limit:   limit - len(results),
+			Label:   label,
+			MinSize: minSize,
+			MaxSize: maxSize,
+			Query:   query,
+		}, &matches); err != nil {
+			r.logger.Warn("Error listing matches from cluster node", zap.String("node", node), zap.Error(err))
+			continue
+		}
+		results = append(results, matches...)
+		if len(results) >= limit {
+			return results[:limit], nil
+		}
+	}
+	return results, nil
+}
+
+func (r *ClusterMatchRegistry) JoinAttempt(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, metadata map[string]string, spectator bool) (bool, bool, string, string) {
+	if node == r.cluster.Name() {
+		return r.MatchRegistry.JoinAttempt(ctx, id, node, userID, sessionID, username, fromNode, metadata, spectator)
+	}
+
+	result := &clusterMatchJoinResult{}
+	if err := r.cluster.Request(ctx, node, ClusterMessageMatchJoinAttempt, &clusterMatchJoinAttempt{
+		ID:        id,
+		UserID:    userID,
+		SessionID: sessionID,
+		Username:  username,
+		FromNode:  fromNode,
+		Metadata:  metadata,
+		Spectator: spectator,
+	}, result); err != nil {
+		if err == ErrClusterNodeNotFound {
+			// The host node is not part of the cluster, so the match does not exist.
+			return false, false, "", ""
+		}
+		r.logger.Warn("Error forwarding match join attempt", zap.String("node", node), zap.Error(err))
+		// The join attempt could not be completed, join is assumed to be rejected.
+		return true, false, "", ""
+	}
+	return result.Found, result.Allow, result.Reason, result.Label
+}
+
+func (r *ClusterMatchRegistry) Reconnect(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string) (bool, bool, string, string) {
+	if node == r.cluster.Name() {
+		return r.MatchRegistry.Reconnect(ctx, id, node, userID, sessionID, username, fromNode)
+	}
+
+	result := &clusterMatchJoinResult{}
+	if err := r.cluster.Request(ctx, node, ClusterMessageMatchReconnect, &clusterMatchJoinAttempt{
+		ID:        id,
+		UserID:    userID,
+		SessionID: sessionID,
+		Username:  username,
+		FromNode:  fromNode,
+	}, result); err != nil {
+		if err == ErrClusterNodeNotFound {
+			// The host node is not part of the cluster, so the match does not exist.
+			return false, false, "", ""
+		}
+		r.logger.Warn("Error forwarding match reconnect", zap.String("node", node), zap.Error(err))
+		// The reconnect could not be completed, it is assumed to be rejected.
+		return true, false, "", ""
+	}
+	return result.Found, result.Allow, result.Reason, result.Label
+}
+
+func (r *ClusterMatchRegistry) Kick(stream PresenceStream, presences []*MatchPresence) {
+	r.MatchRegistry.Kick(stream, presences)
+
+	// Presences connected to other nodes must be removed by the node they're connected to.
+	var remotePresences map[string][]*MatchPresence
+	for _, presence := range presences {
+		if presence.Node == r.cluster.Name() {
+			continue
+		}
+		if remotePresences == nil {
+			remotePresences = make(map[string][]*MatchPresence)
+		}
+		remotePresences[presence.Node] = append(remotePresences[presence.Node], presence)
+	}
+	for node, presences := range remotePresences {
+		if err := r.cluster.Send(node, ClusterMessageMatchKick, &clusterMatchKick{Stream: stream, Presences: presences}); err != nil {
+			r.logger.Warn("Error forwarding match kick", zap.String("node", node), zap.Error(err))
+		}
+	}
+}
+
+func (r *ClusterMatchRegistry) SendData(id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, opCode int64, data []byte, reliable bool, receiveTime int64) {
+	if node == r.cluster.Name() {
+		r.MatchRegistry.SendData(id, node, userID, sessionID, username, fromNode, opCode, data, reliable, receiveTime)
+		return
+	}
+
+	if err := r.cluster.Send(node, ClusterMessageMatchData, &clusterMatchData{
+		ID:          id,
+		UserID:      userID,
+		SessionID:   sessionID,
+		Username:    username,
+		FromNode:    fromNode,
+		OpCode:      opCode,
+		Data:        data,
+		Reliable:    reliable,
+		ReceiveTime: receiveTime,
+	}); err != nil {
+		r.logger.Warn("Error forwarding match data", zap.String("node", node), zap.Error(err))
+	}
+}
+
+func (r *ClusterMatchRegistry) Signal(ctx context.Context, id uuid.UUID, node string, data string) (string, error) {
+	if node == r.cluster.Name() {
+		return r.MatchRegistry.Signal(ctx, id, node, data)
+	}
+
+	result := &clusterMatchSignalResult{}
+	if err := r.cluster.Request(ctx, node, ClusterMessageMatchSignal, &clusterMatchSignal{ID: id, Data: data}, result); err != nil {
+		if err == ErrClusterNodeNotFound {
+			// The host node is not part of the cluster, so the match does not exist.
+			return "", ErrMatchNotFound
+		}
+		r.logger.Warn("Error forwarding match signal", zap.String("node", node), zap.Error(err))
+		return "", err
+	}
+	if !result.Found {
+		return "", ErrMatchNotFound
+	}
+	if result.Busy {
+		return "", ErrMatchBusy
+	}
+	return result.Result, nil
+}
+
+func (r *ClusterMatchRegistry) handleJoinAttempt(from string, payload json.RawMessage) (interface{}, error) {
+	attempt := &clusterMatchJoinAttempt{}
+	if err := json.Unmarshal(payload, attempt); err != nil {
+		return nil, err
+	}
+
+	found, allow, reason, label := r.MatchRegistry.JoinAttempt(context.Background(), attempt.ID, r.cluster.Name(), attempt.UserID, attempt.SessionID, attempt.Username, attempt.FromNode, attempt.Metadata, attempt.Spectator)
+	return &clusterMatchJoinResult{Found: found, Allow: allow, Reason: reason, Label: label}, nil
+}
+
+func (r *ClusterMatchRegistry) handleReconnect(from string, payload json.RawMessage) (interface{}, error) {
+	attempt := &clusterMatchJoinAttempt{}
+	if err := json.Unmarshal(payload, attempt); err != nil {
+		return nil, err
+	}
+
+	found, allow, reason, label := r.MatchRegistry.Reconnect(context.Background(), attempt.ID, r.cluster.Name(), attempt.UserID, attempt.SessionID, attempt.Username, attempt.FromNode)
+	return &clusterMatchJoinResult{Found: found, Allow: allow, Reason: reason, Label: label}, nil
+}
+
+func (r *ClusterMatchRegistry) handleLabel(from string, payload json.RawMessage) (interface{}, error) {
+	var id uuid.UUID
+	if err := json.Unmarshal(payload, &id); err != nil {
+		return nil, err
+	}
+	return r.MatchRegistry.GetMatchLabel(context.Background(), id, r.cluster.Name())
+}
+
+func (r *ClusterMatchRegistry) handleData(from string, payload json.RawMessage) (interface{}, error) {
+	data := &clusterMatchData{}
+	if err := json.Unmarshal(payload, data); err != nil {
+		return nil, err
+	}
+	r.MatchRegistry.SendData(data.ID, r.cluster.Name(), data.UserID, data.SessionID, data.Username, data.FromNode, data.OpCode, data.Data, data.Reliable, data.ReceiveTime)
+	return nil, nil
+}
+
+func (r *ClusterMatchRegistry) handleKick(from string, payload json.RawMessage) (interface{}, error) {
+	kick := &clusterMatchKick{}
+	if err := json.Unmarshal(payload, kick); err != nil {
+		return nil, err
+	}
+	r.MatchRegistry.Kick(kick.Stream, kick.Presences)
+	return nil, nil
+}
+
+func (r *ClusterMatchRegistry) handleList(from string, payload json.RawMessage) (interface{}, error) {
+	list := &clusterMatchList{}
+	if err := json.Unmarshal(payload, list); err != nil {
+		return nil, err
+	}
+	// Only authoritative matches hosted on this node, relayed matches are already known to the requesting node.
+	return r.MatchRegistry.ListMatches(context.Background(), list.Limit, &wrappers.BoolValue{Value: true}, list.Label, list.MinSize, list.MaxSize, list.Query)
+}
+
+func (r *ClusterMatchRegistry) handleSignal(from string, payload json.RawMessage) (interface{}, error) {
+	signal := &clusterMatchSignal{}
+	if err := json.Unmarshal(payload, signal); err != nil {
+		return nil, err
+	}
+
+	result, err := r.MatchRegistry.Signal(context.Background(), signal.ID, r.cluster.Name(), signal.Data)
+	switch err {
+	case nil:
+		return &clusterMatchSignalResult{Found: true, Result: result}, nil
+	case ErrMatchNotFound:
+		return &clusterMatchSignalResult{Found: false}, nil
+	case ErrMatchBusy:
+		return &clusterMatchSignalResult{Found: true, Busy: true}, nil
+	default:
+		return nil, err
+	}
+}
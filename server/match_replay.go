@@ -0,0 +1,156 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
+	"github.com/heroiclabs/nakama/runtime"
+	"go.uber.org/zap"
+)
+
+type matchReplayBroadcast struct {
+	tick int64
+	data []byte
+}
+
+func (r *LocalMatchRegistry) Replay(ctx context.Context, createFn RuntimeMatchCreateFunction, recordingID string) (*runtime.MatchReplayResult, error) {
+	file, reader, header, err := openMatchRecording(MatchRecordingDir(r.config), recordingID)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	// The replay runs under its own match ID, so it can never be confused with the recorded match if that is still running.
+	id := uuid.Must(uuid.NewV4())
+	idStr := fmt.Sprintf("%v.%v", id.String(), r.node)
+	logger := r.logger.With(zap.String("mid", id.String()), zap.String("recording_id", recordingID))
+
+	core, err := createFn(ctx, logger, id, r.node, header.Module)
+	if err != nil {
+		return nil, err
+	}
+	if core == nil {
+		return nil, errors.New("error replaying match: not found")
+	}
+	defer func() {
+		core.Cancel()
+		// The replay is not a joinable match, but its label may have been indexed.
+		if err := r.index.Delete(idStr); err != nil {
+			logger.Warn("Error removing match list index", zap.String("id", idStr), zap.Error(err))
+		}
+	}()
+
+	// Capture broadcasts rather than deliver them, recorded presences may still be connected.
+	var tick int64
+	replayed := make([]*matchReplayBroadcast, 0)
+	core.SetBroadcastListener(func(presenceIDs []*PresenceID, envelope *rtapi.Envelope, reliable bool) bool {
+		replayed = append(replayed, &matchReplayBroadcast{tick: tick, data: encodeRecordBroadcast(presenceIDs, envelope, reliable)})
+		return false
+	})
+	presenceList := NewMatchPresenceList()
+	deferMessageFn := func(msg *DeferredMessage) error { return nil }
+
+	var state interface{}
+	if header.Restored {
+		tick = header.RestoreTick
+		state, _, err = core.MatchRestore(presenceList, deferMessageFn, header.RestoreTick, string(header.RestoreState))
+	} else {
+		state, _, err = core.MatchInit(presenceList, deferMessageFn, header.Params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &runtime.MatchReplayResult{DivergedTick: -1}
+	recorded := make([]*matchReplayBroadcast, 0)
+	for {
+		event, err := reader.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Events++
+		if state == nil && event.kind != matchRecordBroadcast {
+			// The replayed match has ended, only broadcasts still matter for comparison.
+			continue
+		}
+		tick = event.tick
+
+		switch event.kind {
+		case matchRecordJoinAttempt:
+			presence := event.presences[0]
+			state, _, _, err = core.MatchJoinAttempt(tick, state, presence.UserID, presence.SessionID, presence.Username, presence.Node, event.metadata)
+		case matchRecordJoin:
+			state, err = core.MatchJoin(tick, state, presenceList.Join(event.presences))
+		case matchRecordLeave:
+			presenceList.Leave(event.presences)
+			state, err = core.MatchLeave(tick, state, event.presences)
+		case matchRecordDisconnect:
+			// Players that are not held for reconnecting are recorded leaving separately.
+			state, _, err = core.MatchDisconnect(tick, state, event.presences)
+		case matchRecordReconnect:
+			if len(event.presences) != 2 {
+				return nil, fmt.Errorf("error replaying match at tick %v: invalid reconnect", tick)
+			}
+			presenceList.Leave(event.presences[:1])
+			presenceList.Join(event.presences[1:])
+			state, err = core.MatchReconnect(tick, state, event.presences[1:])
+		case matchRecordSpectatorJoinAttempt:
+			presence := event.presences[0]
+			state, _, _, err = core.MatchSpectatorJoinAttempt(tick, state, presence.UserID, presence.SessionID, presence.Username, presence.Node, event.metadata)
+		case matchRecordSpectatorJoin:
+			presenceList.Join(event.presences)
+		case matchRecordSpectatorLeave:
+			presenceList.Leave(event.presences)
+		case matchRecordLoop:
+			result.Ticks++
+			state, err = core.MatchLoop(tick, state, event.messages)
+		case matchRecordSignal:
+			state, _, err = core.MatchSignal(tick, state, event.data)
+		case matchRecordTerminate:
+			state, err = core.MatchTerminate(tick, state, event.graceSeconds)
+		case matchRecordBroadcast:
+			recorded = append(recorded, &matchReplayBroadcast{tick: tick, data: event.broadcast})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error replaying match at tick %v: %v", tick, err.Error())
+		}
+	}
+
+	result.RecordedBroadcasts = len(recorded)
+	result.ReplayedBroadcasts = len(replayed)
+	for i := 0; i < len(recorded) || i < len(replayed); i++ {
+		if i >= len(recorded) {
+			result.DivergedTick = replayed[i].tick
+			break
+		}
+		if i >= len(replayed) || !bytes.Equal(recorded[i].data, replayed[i].data) {
+			result.DivergedTick = recorded[i].tick
+			break
+		}
+	}
+
+	logger.Info("Match recording replayed", zap.Int("events", result.Events), zap.Int64("diverged_tick", result.DivergedTick))
+	return result, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+)
+
+// MatchSnapshot is the serialized state of an authoritative match, kept across a server shutdown so the match can be
+// recreated with the same ID by the next node to start.
+type MatchSnapshot struct {
+	ID      uuid.UUID
+	Node    string
+	Module  string
+	Tick    int64
+	State   []byte
+	UserIDs []uuid.UUID
+}
+
+// StoreMatchSnapshot persists a match snapshot, replacing any earlier snapshot of the same match.
+func StoreMatchSnapshot(ctx context.Context, logger *zap.Logger, db *sql.DB, snapshot *MatchSnapshot) error {
+	userIDs := snapshot.UserIDs
+	if userIDs == nil {
+		userIDs = make([]uuid.UUID, 0)
+	}
+	userIDsBytes, err := json.Marshal(userIDs)
+	if err != nil {
+		logger.Error("Error encoding match snapshot user IDs.", zap.Error(err))
+		return err
+	}
+
+	query := `
+INSERT INTO match_snapshot (id, node, module, tick, state, user_ids)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO UPDATE SET node = $2, module = $3, tick = $4, state = $5, user_ids = $6, create_time = now()`
+	if _, err := db.ExecContext(ctx, query, snapshot.ID, snapshot.Node, snapshot.Module, snapshot.Tick, snapshot.State, userIDsBytes); err != nil {
+		logger.Error("Error storing match snapshot.", zap.Error(err), zap.String("mid", snapshot.ID.String()))
+		return err
+	}
+	return nil
+}
+
+// ListMatchSnapshotIDs returns the IDs of all stored match snapshots.
+func ListMatchSnapshotIDs(ctx context.Context, logger *zap.Logger, db *sql.DB) ([]uuid.UUID, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id FROM match_snapshot")
+	if err != nil {
+		logger.Error("Error listing match snapshots.", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			logger.Error("Error listing match snapshots.", zap.Error(err))
+			return nil, err
+		}
+		ids = append(ids, uuid.FromStringOrNil(id))
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error listing match snapshots.", zap.Error(err))
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ClaimMatchSnapshot passes a stored match snapshot to the given restore function, and removes the snapshot only if
+// the restore succeeds, so each snapshot is restored by one node at most and kept if it cannot be restored. Returns
+// false without calling the restore function if the snapshot no longer exists. If the snapshot cannot be removed after
+// a successful restore an error is returned, and the caller must undo the restore.
+func ClaimMatchSnapshot(ctx context.Context, logger *zap.Logger, db *sql.DB, id uuid.UUID, restoreFn func(*MatchSnapshot) error) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("Could not begin database transaction.", zap.Error(err))
+		return false, err
+	}
+
+	snapshot := &MatchSnapshot{ID: id}
+	var userIDsBytes []byte
+	query := "SELECT node, module, tick, state, user_ids FROM match_snapshot WHERE id = $1 FOR UPDATE"
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&snapshot.Node, &snapshot.Module, &snapshot.Tick, &snapshot.State, &userIDsBytes); err != nil {
+		_ = tx.Rollback()
+		if err == sql.ErrNoRows {
+			// Already restored by another node.
+			return false, nil
+		}
+		logger.Error("Error claiming match snapshot.", zap.Error(err), zap.String("mid", id.String()))
+		return false, err
+	}
+	if err := json.Unmarshal(userIDsBytes, &snapshot.UserIDs); err != nil {
+		// Still restore the match, but its users will not be invited to rejoin.
+		logger.Warn("Error decoding match snapshot user IDs.", zap.Error(err), zap.String("mid", id.String()))
+	}
+
+	if err := restoreFn(snapshot); err != nil {
+		// Keep the snapshot for a later attempt.
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM match_snapshot WHERE id = $1", id); err != nil {
+		_ = tx.Rollback()
+		logger.Error("Error removing match snapshot.", zap.Error(err), zap.String("mid", id.String()))
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		logger.Error("Error removing match snapshot.", zap.Error(err), zap.String("mid", id.String()))
+		return false, err
+	}
+
+	return true, nil
+}
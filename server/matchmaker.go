@@ -15,18 +15,29 @@
 package server
 
 import (
+	"context"
+	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/dgrijalva/jwt-go"
 	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
 	"github.com/heroiclabs/nakama/runtime"
 	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
 	"go.uber.org/zap"
 )
 
-var ErrMatchmakerTicketNotFound = errors.New("ticket not found")
+var (
+	ErrMatchmakerQueryInvalid   = errors.New("matchmaker query invalid")
+	ErrMatchmakerTicketNotFound = errors.New("ticket not found")
+)
 
 type MatchmakerPresence struct {
 	UserId    string `json:"user_id"`
@@ -57,14 +68,90 @@ func (p *MatchmakerPresence) GetStatus() string {
 	return ""
 }
 
+// MatchmakerRange is an inclusive range a numeric property must fall within.
+type MatchmakerRange struct {
+	Min float64
+	Max float64
+}
+
+// MatchmakerRelaxation widens a ticket's criteria once it has waited at least WaitSec seconds.
+type MatchmakerRelaxation struct {
+	WaitSec int
+	// Lower minimum count to use, 0 if unchanged.
+	MinCount int
+	// Replacement query to use, empty if unchanged.
+	Query string
+	// Amount to widen each numeric range by in both directions.
+	WidenRanges map[string]float64
+}
+
 type MatchmakerEntry struct {
 	Ticket     string                 `json:"ticket"`
 	Presence   *MatchmakerPresence    `json:"presence"`
 	Properties map[string]interface{} `json:"properties"`
+	// Party this ticket was submitted for, if any.
+	PartyId string `json:"party_id"`
+	// Time in seconds the ticket has been waiting, updated on each matchmaker process interval.
+	WaitSec int64 `json:"wait_sec"`
 	// Cached for when we need them returned to clients, but not indexed.
 	StringProperties  map[string]string  `json:"-"`
 	NumericProperties map[string]float64 `json:"-"`
 	SessionID         uuid.UUID          `json:"-"`
+	// All users covered by this ticket, more than one if submitted for a party.
+	Presences []*MatchmakerPresence `json:"-"`
+	// Matching criteria for this ticket, not indexed.
+	Query    string `json:"-"`
+	MinCount int    `json:"-"`
+	MaxCount int    `json:"-"`
+	// Time the ticket was created, in UTC milliseconds.
+	CreateTime int64 `json:"-"`
+	// Optional criteria widened over time by relaxations, which are sorted by wait time.
+	NumericRanges map[string]*MatchmakerRange `json:"-"`
+	Relaxations   []*MatchmakerRelaxation     `json:"-"`
+
+	// Effective criteria as of the latest matchmaker process interval.
+	activeQuery    query.Query
+	activeMinCount int
+}
+
+// Apply all relaxations reached at the given wait time, and update the effective criteria.
+func (m *MatchmakerEntry) relax(waitSec int64) {
+	queryString := m.Query
+	minCount := m.MinCount
+	widen := make(map[string]float64, len(m.NumericRanges))
+	for _, relaxation := range m.Relaxations {
+		if int64(relaxation.WaitSec) > waitSec {
+			break
+		}
+		if relaxation.Query != "" {
+			queryString = relaxation.Query
+		}
+		if relaxation.MinCount != 0 && relaxation.MinCount < minCount {
+			minCount = relaxation.MinCount
+		}
+		for property, amount := range relaxation.WidenRanges {
+			widen[property] += amount
+		}
+	}
+
+	conjuncts := make([]query.Query, 0, len(m.NumericRanges)+1)
+	conjuncts = append(conjuncts, bleve.NewQueryStringQuery(queryString))
+	inclusive := true
+	for property, numericRange := range m.NumericRanges {
+		min := numericRange.Min - widen[property]
+		max := numericRange.Max + widen[property]
+		rangeQuery := bleve.NewNumericRangeInclusiveQuery(&min, &max, &inclusive, &inclusive)
+		rangeQuery.SetField("properties." + property)
+		conjuncts = append(conjuncts, rangeQuery)
+	}
+
+	m.WaitSec = waitSec
+	m.activeMinCount = minCount
+	if len(conjuncts) == 1 {
+		m.activeQuery = conjuncts[0]
+	} else {
+		m.activeQuery = bleve.NewConjunctionQuery(conjuncts...)
+	}
 }
 
 func (m *MatchmakerEntry) GetPresence() runtime.Presence {
@@ -76,21 +163,61 @@ func (m *MatchmakerEntry) GetTicket() string {
 func (m *MatchmakerEntry) GetProperties() map[string]interface{} {
 	return m.Properties
 }
+func (m *MatchmakerEntry) GetPartyId() string {
+	return m.PartyId
+}
+func (m *MatchmakerEntry) GetPresences() []runtime.Presence {
+	presences := make([]runtime.Presence, 0, len(m.Presences))
+	for _, presence := range m.Presences {
+		presences = append(presences, presence)
+	}
+	return presences
+}
+func (m *MatchmakerEntry) GetQuery() string {
+	return m.Query
+}
+func (m *MatchmakerEntry) GetMinCount() int {
+	return m.MinCount
+}
+func (m *MatchmakerEntry) GetMaxCount() int {
+	return m.MaxCount
+}
+func (m *MatchmakerEntry) GetCreateTime() int64 {
+	// Tracked in milliseconds, but exposed to the runtimes in seconds like all other create times.
+	return m.CreateTime / 1000
+}
+func (m *MatchmakerEntry) GetWaitSec() int64 {
+	return m.WaitSec
+}
 
 type Matchmaker interface {
-	Add(session Session, query string, minCount int, maxCount int, stringProperties map[string]string, numericProperties map[string]float64) (string, []*MatchmakerEntry, error)
+	Start(runtime *Runtime)
+	Stop()
+	Add(presences []*MatchmakerPresence, sessionID uuid.UUID, partyId string, query string, minCount int, maxCount int, stringProperties map[string]string, numericProperties map[string]float64, numericRanges map[string]*MatchmakerRange, relaxations []*MatchmakerRelaxation) (string, error)
 	Remove(sessionID uuid.UUID, ticket string) error
 	RemoveAll(sessionID uuid.UUID) error
+	// Get the current number of pending tickets.
+	Count() int
+	// List a snapshot of up to limit pending tickets, oldest first, with their wait time as of now.
+	List(limit int) []*MatchmakerEntry
 }
 
 type LocalMatchmaker struct {
 	sync.Mutex
+	logger  *zap.Logger
 	node    string
+	config  Config
+	router  MessageRouter
+	runtime *Runtime
 	entries map[string]*MatchmakerEntry
 	index   bleve.Index
+
+	statsCtx    context.Context
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
 }
 
-func NewLocalMatchmaker(startupLogger *zap.Logger, node string) Matchmaker {
+func NewLocalMatchmaker(logger, startupLogger *zap.Logger, config Config, router MessageRouter) Matchmaker {
 	mapping := bleve.NewIndexMapping()
 	mapping.DefaultAnalyzer = keyword.Name
 
@@ -99,92 +226,473 @@ func NewLocalMatchmaker(startupLogger *zap.Logger, node string) Matchmaker {
 		startupLogger.Fatal("Failed to create matchmaker index", zap.Error(err))
 	}
 
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
 	return &LocalMatchmaker{
-		node:    node,
+		logger:  logger,
+		node:    config.GetName(),
+		config:  config,
+		router:  router,
 		entries: make(map[string]*MatchmakerEntry),
 		index:   index,
+
+		statsCtx:    context.Background(),
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
 	}
 }
 
-func (m *LocalMatchmaker) Add(session Session, query string, minCount int, maxCount int, stringProperties map[string]string, numericProperties map[string]float64) (string, []*MatchmakerEntry, error) {
-	// Merge incoming properties.
-	properties := make(map[string]interface{}, len(stringProperties)+len(numericProperties))
-	for k, v := range stringProperties {
-		properties[k] = v
-	}
-	for k, v := range numericProperties {
-		properties[k] = v
-	}
+func (m *LocalMatchmaker) Start(runtime *Runtime) {
+	m.runtime = runtime
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(m.config.GetMatchmaker().IntervalSec) * time.Second)
+		for {
+			select {
+			case <-m.ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				m.Process()
+			}
+		}
+	}()
+}
 
-	indexQuery := bleve.NewQueryStringQuery(query)
-	search := bleve.NewSearchRequestOptions(indexQuery, maxCount-1, 0, false)
-	ticket := uuid.Must(uuid.NewV4()).String()
-	entry := &MatchmakerEntry{
-		Ticket: ticket,
-		Presence: &MatchmakerPresence{
-			UserId:    session.UserID().String(),
-			SessionId: session.ID().String(),
-			Username:  session.Username(),
-			Node:      m.node,
-		},
-		Properties:        properties,
-		StringProperties:  stringProperties,
-		NumericProperties: numericProperties,
-		SessionID:         session.ID(),
-	}
+func (m *LocalMatchmaker) Stop() {
+	m.ctxCancelFn()
+}
+
+// Process evaluates all pending tickets and delivers any matches formed as a batch.
+// Matches are only formed where each ticket's query accepts every other ticket in the match.
+func (m *LocalMatchmaker) Process() {
+	startTime := time.Now()
 
 	m.Lock()
-	result, err := m.index.SearchInContext(session.Context(), search)
-	if err != nil {
+	if len(m.entries) == 0 {
 		m.Unlock()
-		return ticket, nil, err
+		stats.Record(m.statsCtx, MetricsMatchmakerTicketCount.M(0))
+		return
 	}
 
-	// Check if we have enough results to return them, or if we just add a new entry to the matchmaker.
-	resultCount := result.Hits.Len()
-	if resultCount < minCount-1 {
-		if err := m.index.Index(ticket, entry); err != nil {
+	// Refresh each ticket's wait time and relaxed criteria, and re-index so queries can reference the wait time.
+	now := time.Now().UTC().UnixNano() / int64(time.Millisecond)
+	activeEntries := make([]*MatchmakerEntry, 0, len(m.entries))
+	relaxBatch := m.index.NewBatch()
+	for _, entry := range m.entries {
+		if waitSec := (now - entry.CreateTime) / 1000; waitSec != entry.WaitSec {
+			entry.relax(waitSec)
+			if err := relaxBatch.Index(entry.Ticket, entry); err != nil {
+				m.Unlock()
+				m.logger.Error("Error updating matchmaker ticket", zap.String("ticket", entry.Ticket), zap.Error(err))
+				return
+			}
+		}
+		activeEntries = append(activeEntries, entry)
+	}
+	if relaxBatch.Size() != 0 {
+		if err := m.index.Batch(relaxBatch); err != nil {
 			m.Unlock()
-			return ticket, nil, err
+			m.logger.Error("Error updating matchmaker tickets", zap.Error(err))
+			return
 		}
-		m.entries[ticket] = entry
+	}
+
+	// Oldest tickets get the first opportunity to form a match.
+	sort.Slice(activeEntries, func(i, j int) bool {
+		return activeEntries[i].CreateTime < activeEntries[j].CreateTime
+	})
 
+	var matchedEntries [][]*MatchmakerEntry
+	var matchedTickets map[string]struct{}
+	if fn := m.matchmakerOverride(); fn != nil {
+		// The runtime decides the groupings, release the lock while it runs so tickets can still be added and removed.
+		candidates := expandEntries(activeEntries)
 		m.Unlock()
-		return ticket, nil, nil
+		groups, err := fn(context.Background(), candidates)
+		if err != nil {
+			m.logger.Error("Error running Matchmaker Override hook.", zap.Error(err))
+			return
+		}
+		m.Lock()
+		matchedEntries, matchedTickets = m.resolveGroups(groups)
+	} else {
+		matchedEntries = make([][]*MatchmakerEntry, 0, 5)
+		matchedTickets = make(map[string]struct{}, len(activeEntries))
+		for _, entry := range activeEntries {
+			if _, found := matchedTickets[entry.Ticket]; found {
+				continue
+			}
+
+			group, err := m.formGroup(entry, matchedTickets)
+			if err != nil {
+				m.logger.Error("Error processing matchmaker ticket", zap.String("ticket", entry.Ticket), zap.Error(err))
+				continue
+			}
+			if group == nil {
+				continue
+			}
+
+			for _, member := range group {
+				matchedTickets[member.Ticket] = struct{}{}
+			}
+			matchedEntries = append(matchedEntries, group)
+		}
 	}
 
-	// We have enough entries to satisfy the request.
-	entries := make([]*MatchmakerEntry, 0, resultCount+1)
-	tickets := make([]string, 0, resultCount)
-	batch := m.index.NewBatch()
+	if len(matchedEntries) != 0 {
+		batch := m.index.NewBatch()
+		for ticket := range matchedTickets {
+			batch.Delete(ticket)
+		}
+		if err := m.index.Batch(batch); err != nil {
+			m.Unlock()
+			m.logger.Error("Error removing matched matchmaker tickets", zap.Error(err))
+			return
+		}
+		for ticket := range matchedTickets {
+			delete(m.entries, ticket)
+		}
+	}
+	ticketCount := len(m.entries)
+	m.Unlock()
+
+	measurements := make([]stats.Measurement, 0, len(matchedTickets)+2)
+	measurements = append(measurements, MetricsMatchmakerTicketCount.M(int64(ticketCount)), MetricsMatchmakerMatchCount.M(int64(len(matchedEntries))))
+	for _, entries := range matchedEntries {
+		for _, entry := range entries {
+			measurements = append(measurements, MetricsMatchmakerTimeToMatch.M(float64(now-entry.CreateTime)/1000))
+		}
+	}
+	stats.Record(m.statsCtx, measurements...)
+
+	if len(matchedEntries) != 0 {
+		m.deliver(matchedEntries)
+	}
+
+	m.logger.Debug("Matchmaker process complete", zap.Int("matched", len(matchedEntries)), zap.Duration("duration", time.Since(startTime)))
+}
+
+func (m *LocalMatchmaker) matchmakerOverride() RuntimeMatchmakerOverrideFunction {
+	if m.runtime == nil {
+		return nil
+	}
+	return m.runtime.MatchmakerOverride()
+}
+
+// Convert ticket groupings returned by the matchmaker override hook into matches.
+// Groupings referencing tickets that no longer exist, or that were already used in an earlier grouping, are discarded.
+// Must be called while holding the matchmaker lock.
+func (m *LocalMatchmaker) resolveGroups(groups [][]string) ([][]*MatchmakerEntry, map[string]struct{}) {
+	matchedEntries := make([][]*MatchmakerEntry, 0, len(groups))
+	matchedTickets := make(map[string]struct{}, len(m.entries))
+	for _, tickets := range groups {
+		group := make([]*MatchmakerEntry, 0, len(tickets))
+		groupTickets := make(map[string]struct{}, len(tickets))
+		valid := true
+		for _, ticket := range tickets {
+			if _, found := groupTickets[ticket]; found {
+				// Party tickets appear once per member, but only need to be included once.
+				continue
+			}
+			entry, found := m.entries[ticket]
+			if !found {
+				valid = false
+				break
+			}
+			if _, found := matchedTickets[ticket]; found {
+				valid = false
+				break
+			}
+			groupTickets[ticket] = struct{}{}
+			group = append(group, entry)
+		}
+		if !valid || len(group) == 0 {
+			m.logger.Debug("Discarding invalid matchmaker override grouping", zap.Strings("tickets", tickets))
+			continue
+		}
+
+		for ticket := range groupTickets {
+			matchedTickets[ticket] = struct{}{}
+		}
+		matchedEntries = append(matchedEntries, group)
+	}
+	return matchedEntries, matchedTickets
+}
+
+// Find a set of tickets that together with the given entry form a valid match, if possible.
+// Must be called while holding the matchmaker lock.
+func (m *LocalMatchmaker) formGroup(entry *MatchmakerEntry, matchedTickets map[string]struct{}) ([]*MatchmakerEntry, error) {
+	// Never consider already matched tickets, or the entry itself.
+	excludedTickets := make([]string, 0, len(matchedTickets)+1)
+	excludedTickets = append(excludedTickets, entry.Ticket)
+	for ticket := range matchedTickets {
+		excludedTickets = append(excludedTickets, ticket)
+	}
+	indexQuery := bleve.NewBooleanQuery()
+	indexQuery.AddMust(entry.activeQuery)
+	indexQuery.AddMustNot(bleve.NewDocIDQuery(excludedTickets))
+
+	search := bleve.NewSearchRequestOptions(indexQuery, len(m.entries), 0, false)
+	result, err := m.index.SearchInContext(m.ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*MatchmakerEntry, 0, result.Hits.Len())
+	poolTickets := make([]string, 0, result.Hits.Len()+1)
+	poolTickets = append(poolTickets, entry.Ticket)
+	poolCount := len(entry.Presences)
 	for _, hit := range result.Hits {
-		entry, ok := m.entries[hit.ID]
+		candidate, ok := m.entries[hit.ID]
 		if !ok {
 			// Index and entries map are out of sync, should not happen but check to be sure.
-			m.Unlock()
-			return ticket, nil, ErrMatchmakerTicketNotFound
+			return nil, ErrMatchmakerTicketNotFound
 		}
-		entries = append(entries, entry)
-		tickets = append(tickets, hit.ID)
-		batch.Delete(hit.ID)
+		candidates = append(candidates, candidate)
+		poolTickets = append(poolTickets, hit.ID)
+		poolCount += len(candidate.Presences)
+	}
+	if poolCount < entry.activeMinCount {
+		// Not enough candidates to satisfy this ticket even before checking the reverse direction.
+		return nil, nil
 	}
 
-	// Only remove the entries after we've processed each one to make sure
-	// there were no sync issues between the index and the entries map.
-	if err := m.index.Batch(batch); err != nil {
-		m.Unlock()
-		return ticket, nil, err
+	// Determine which pool members each ticket's query accepts.
+	accepts := make(map[string]map[string]struct{}, len(poolTickets))
+	selfAccepts := make(map[string]struct{}, len(candidates))
+	for _, candidate := range candidates {
+		selfAccepts[candidate.Ticket] = struct{}{}
 	}
-	for _, ticket := range tickets {
-		delete(m.entries, ticket)
+	accepts[entry.Ticket] = selfAccepts
+	for _, candidate := range candidates {
+		candidateAccepts, err := m.accepted(candidate, poolTickets)
+		if err != nil {
+			return nil, err
+		}
+		accepts[candidate.Ticket] = candidateAccepts
 	}
 
-	m.Unlock()
+	// Greedily grow the group, admitting only candidates with mutual acceptance of every existing member.
+	// Counts are in users rather than tickets, since party tickets cover several users.
+	group := make([]*MatchmakerEntry, 0, entry.MaxCount)
+	group = append(group, entry)
+	count := len(entry.Presences)
+	sessionIDs := make(map[string]struct{}, entry.MaxCount)
+	for _, presence := range entry.Presences {
+		sessionIDs[presence.SessionId] = struct{}{}
+	}
+	minCount := entry.activeMinCount
+	maxCount := entry.MaxCount
+	for _, candidate := range candidates {
+		if count >= maxCount {
+			break
+		}
+		if count+len(candidate.Presences) > maxCount || count+len(candidate.Presences) > candidate.MaxCount {
+			continue
+		}
+		overlap := false
+		for _, presence := range candidate.Presences {
+			if _, found := sessionIDs[presence.SessionId]; found {
+				// A session cannot be matched with itself.
+				overlap = true
+				break
+			}
+		}
+		if overlap {
+			continue
+		}
+		mutual := true
+		for _, member := range group {
+			if _, ok := accepts[member.Ticket][candidate.Ticket]; !ok {
+				mutual = false
+				break
+			}
+			if _, ok := accepts[candidate.Ticket][member.Ticket]; !ok {
+				mutual = false
+				break
+			}
+		}
+		if !mutual {
+			continue
+		}
+
+		group = append(group, candidate)
+		count += len(candidate.Presences)
+		for _, presence := range candidate.Presences {
+			sessionIDs[presence.SessionId] = struct{}{}
+		}
+		if candidate.activeMinCount > minCount {
+			minCount = candidate.activeMinCount
+		}
+		if candidate.MaxCount < maxCount {
+			maxCount = candidate.MaxCount
+		}
+	}
+
+	if count < minCount {
+		return nil, nil
+	}
+	return group, nil
+}
+
+// Run a ticket's query restricted to the given set of tickets, and return the tickets it accepts.
+// Must be called while holding the matchmaker lock.
+func (m *LocalMatchmaker) accepted(entry *MatchmakerEntry, tickets []string) (map[string]struct{}, error) {
+	indexQuery := bleve.NewConjunctionQuery(entry.activeQuery, bleve.NewDocIDQuery(tickets))
+	search := bleve.NewSearchRequestOptions(indexQuery, len(tickets), 0, false)
+	result, err := m.index.SearchInContext(m.ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make(map[string]struct{}, result.Hits.Len())
+	for _, hit := range result.Hits {
+		accepted[hit.ID] = struct{}{}
+	}
+	return accepted, nil
+}
+
+// Expand party tickets so each user is represented as a separate entry sharing the ticket.
+func expandEntries(ticketEntries []*MatchmakerEntry) []*MatchmakerEntry {
+	entries := make([]*MatchmakerEntry, 0, len(ticketEntries))
+	for _, entry := range ticketEntries {
+		if len(entry.Presences) == 1 {
+			entries = append(entries, entry)
+			continue
+		}
+		for _, presence := range entry.Presences {
+			entries = append(entries, &MatchmakerEntry{
+				Ticket:            entry.Ticket,
+				Presence:          presence,
+				Properties:        entry.Properties,
+				PartyId:           entry.PartyId,
+				StringProperties:  entry.StringProperties,
+				NumericProperties: entry.NumericProperties,
+				SessionID:         uuid.FromStringOrNil(presence.SessionId),
+				Presences:         []*MatchmakerPresence{presence},
+			})
+		}
+	}
+	return entries
+}
+
+// Notify all users in each match, running the matchmaker matched runtime hook if one is registered.
+func (m *LocalMatchmaker) deliver(matchedEntries [][]*MatchmakerEntry) {
+	for _, ticketEntries := range matchedEntries {
+		entries := expandEntries(ticketEntries)
+
+		var tokenOrMatchID string
+		var isMatchID bool
+		var err error
+
+		// Check if there's a matchmaker matched runtime callback, call it, and see if it returns a match ID.
+		if m.runtime != nil {
+			if fn := m.runtime.MatchmakerMatched(); fn != nil {
+				tokenOrMatchID, isMatchID, err = fn(context.Background(), entries)
+				if err != nil {
+					m.logger.Error("Error running Matchmaker Matched hook.", zap.Error(err))
+				}
+			}
+		}
+
+		if !isMatchID {
+			// If there was no callback or it didn't return a valid match ID always return at least a token.
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"mid": fmt.Sprintf("%v.", uuid.Must(uuid.NewV4()).String()),
+				"exp": time.Now().UTC().Add(30 * time.Second).Unix(),
+			})
+			tokenOrMatchID, _ = token.SignedString([]byte(m.config.GetSession().EncryptionKey))
+		}
+
+		users := make([]*rtapi.MatchmakerMatched_MatchmakerUser, 0, len(entries))
+		for _, entry := range entries {
+			users = append(users, &rtapi.MatchmakerMatched_MatchmakerUser{
+				Presence: &rtapi.UserPresence{
+					UserId:    entry.Presence.UserId,
+					SessionId: entry.Presence.SessionId,
+					Username:  entry.Presence.Username,
+				},
+				StringProperties:  entry.StringProperties,
+				NumericProperties: entry.NumericProperties,
+				PartyId:           entry.PartyId,
+			})
+		}
+
+		for i, entry := range entries {
+			// Each recipient gets its own envelope since the router may deliver asynchronously.
+			outgoing := &rtapi.Envelope{Message: &rtapi.Envelope_MatchmakerMatched{MatchmakerMatched: &rtapi.MatchmakerMatched{
+				Ticket: entry.Ticket,
+				Users:  users,
+				Self:   users[i],
+			}}}
+			if isMatchID {
+				outgoing.GetMatchmakerMatched().Id = &rtapi.MatchmakerMatched_MatchId{MatchId: tokenOrMatchID}
+			} else {
+				outgoing.GetMatchmakerMatched().Id = &rtapi.MatchmakerMatched_Token{Token: tokenOrMatchID}
+			}
+
+			// Route outgoing message.
+			m.router.SendToPresenceIDs(m.logger, []*PresenceID{&PresenceID{Node: entry.Presence.Node, SessionID: entry.SessionID}}, false, 0, outgoing, true)
+		}
+	}
+}
+
+func (m *LocalMatchmaker) Add(presences []*MatchmakerPresence, sessionID uuid.UUID, partyId string, query string, minCount int, maxCount int, stringProperties map[string]string, numericProperties map[string]float64, numericRanges map[string]*MatchmakerRange, relaxations []*MatchmakerRelaxation) (string, error) {
+	// Merge incoming properties.
+	properties := make(map[string]interface{}, len(stringProperties)+len(numericProperties))
+	for k, v := range stringProperties {
+		properties[k] = v
+	}
+	for k, v := range numericProperties {
+		properties[k] = v
+	}
+
+	// Validate all queries before accepting the ticket.
+	if err := bleve.NewQueryStringQuery(query).Validate(); err != nil {
+		return "", ErrMatchmakerQueryInvalid
+	}
+	for _, relaxation := range relaxations {
+		if relaxation.Query == "" {
+			continue
+		}
+		if err := bleve.NewQueryStringQuery(relaxation.Query).Validate(); err != nil {
+			return "", ErrMatchmakerQueryInvalid
+		}
+	}
+	sort.SliceStable(relaxations, func(i, j int) bool {
+		return relaxations[i].WaitSec < relaxations[j].WaitSec
+	})
+
+	ticket := uuid.Must(uuid.NewV4()).String()
+	entry := &MatchmakerEntry{
+		Ticket:            ticket,
+		Presence:          presences[0],
+		Properties:        properties,
+		PartyId:           partyId,
+		StringProperties:  stringProperties,
+		NumericProperties: numericProperties,
+		SessionID:         sessionID,
+		Presences:         presences,
+		Query:             query,
+		MinCount:          minCount,
+		MaxCount:          maxCount,
+		CreateTime:        time.Now().UTC().UnixNano() / int64(time.Millisecond),
+		NumericRanges:     numericRanges,
+		Relaxations:       relaxations,
+	}
+	entry.relax(0)
 
-	// Add the current user.
-	entries = append(entries, entry)
+	m.Lock()
+	if err := m.index.Index(ticket, entry); err != nil {
+		m.Unlock()
+		return "", err
+	}
+	m.entries[ticket] = entry
+	m.Unlock()
 
-	return ticket, entries, nil
+	// Matches are formed on the next matchmaker process interval.
+	return ticket, nil
 }
 
 func (m *LocalMatchmaker) Remove(sessionID uuid.UUID, ticket string) error {
@@ -205,6 +713,35 @@ func (m *LocalMatchmaker) Remove(sessionID uuid.UUID, ticket string) error {
 	return nil
 }
 
+func (m *LocalMatchmaker) Count() int {
+	m.Lock()
+	count := len(m.entries)
+	m.Unlock()
+	return count
+}
+
+func (m *LocalMatchmaker) List(limit int) []*MatchmakerEntry {
+	now := time.Now().UTC().UnixNano() / int64(time.Millisecond)
+
+	m.Lock()
+	entries := make([]*MatchmakerEntry, 0, len(m.entries))
+	for _, entry := range m.entries {
+		// Copy entries so callers can safely read them while the matchmaker continues processing.
+		snapshot := *entry
+		snapshot.WaitSec = (now - entry.CreateTime) / 1000
+		entries = append(entries, &snapshot)
+	}
+	m.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreateTime < entries[j].CreateTime
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
 func (m *LocalMatchmaker) RemoveAll(sessionID uuid.UUID) error {
 	query := bleve.NewMatchQuery(sessionID.String())
 	query.SetField("presence.session_id")
@@ -16,6 +16,8 @@ package server
 
 import (
 	"bytes"
+	"fmt"
+
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
 	"github.com/heroiclabs/nakama/rtapi"
@@ -27,11 +29,12 @@ import (
 type DeferredMessage struct {
 	PresenceIDs []*PresenceID
 	Envelope    *rtapi.Envelope
+	Reliable    bool
 }
 
 // MessageRouter is responsible for sending a message to a list of presences or to an entire stream.
 type MessageRouter interface {
-	SendToPresenceIDs(*zap.Logger, []*PresenceID, bool, uint8, *rtapi.Envelope)
+	SendToPresenceIDs(*zap.Logger, []*PresenceID, bool, uint8, *rtapi.Envelope, bool)
 	SendToStream(*zap.Logger, PresenceStream, *rtapi.Envelope)
 	SendDeferred(*zap.Logger, bool, uint8, []*DeferredMessage)
 }
@@ -50,7 +53,7 @@ func NewLocalMessageRouter(sessionRegistry SessionRegistry, tracker Tracker, jso
 	}
 }
 
-func (r *LocalMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs []*PresenceID, isStream bool, mode uint8, envelope *rtapi.Envelope) {
+func (r *LocalMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs []*PresenceID, isStream bool, mode uint8, envelope *rtapi.Envelope, reliable bool) {
 	if len(presenceIDs) == 0 {
 		return
 	}
@@ -77,7 +80,7 @@ func (r *LocalMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs [
 					return
 				}
 			}
-			err = session.SendBytes(isStream, mode, payloadProtobuf)
+			err = sendBytes(session, isStream, mode, payloadProtobuf, reliable)
 		case SessionFormatJson:
 			fallthrough
 		default:
@@ -91,7 +94,7 @@ func (r *LocalMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs [
 					return
 				}
 			}
-			err = session.SendBytes(isStream, mode, payloadJson)
+			err = sendBytes(session, isStream, mode, payloadJson, reliable)
 		}
 		if err != nil {
 			logger.Error("Failed to route message", zap.String("sid", presenceID.SessionID.String()), zap.Error(err))
@@ -101,11 +104,70 @@ func (r *LocalMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs [
 
 func (r *LocalMessageRouter) SendToStream(logger *zap.Logger, stream PresenceStream, envelope *rtapi.Envelope) {
 	presenceIDs := r.tracker.ListPresenceIDByStream(stream)
-	r.SendToPresenceIDs(logger, presenceIDs, true, stream.Mode, envelope)
+	r.SendToPresenceIDs(logger, presenceIDs, true, stream.Mode, envelope, true)
 }
 
 func (r *LocalMessageRouter) SendDeferred(logger *zap.Logger, isStream bool, mode uint8, messages []*DeferredMessage) {
-	for _, message := range messages {
-		r.SendToPresenceIDs(logger, message.PresenceIDs, isStream, mode, message.Envelope)
+	// Sessions that accept batches receive all their deferred messages at once, others receive each message separately.
+	var batchIndexes map[PresenceID][]int
+	for i, message := range messages {
+		presenceIDs := make([]*PresenceID, 0, len(message.PresenceIDs))
+		for _, presenceID := range message.PresenceIDs {
+			if session := r.sessionRegistry.Get(presenceID.SessionID); session != nil && session.BatchEnabled() {
+				if batchIndexes == nil {
+					batchIndexes = make(map[PresenceID][]int)
+				}
+				batchIndexes[*presenceID] = append(batchIndexes[*presenceID], i)
+				continue
+			}
+			presenceIDs = append(presenceIDs, presenceID)
+		}
+		r.SendToPresenceIDs(logger, presenceIDs, isStream, mode, message.Envelope, message.Reliable)
+	}
+
+	if len(batchIndexes) == 0 {
+		return
+	}
+
+	// Sessions receiving the same set of messages share a batch, so it is only marshalled once for each format.
+	batches := make(map[string]*deferredBatch)
+	for presenceID, indexes := range batchIndexes {
+		key := fmt.Sprint(indexes)
+		batch, ok := batches[key]
+		if !ok {
+			batch = &deferredBatch{}
+			if len(indexes) == 1 {
+				// No need to wrap a single message.
+				batch.envelope = messages[indexes[0]].Envelope
+				batch.reliable = messages[indexes[0]].Reliable
+			} else {
+				envelopes := make([]*rtapi.Envelope, 0, len(indexes))
+				for _, i := range indexes {
+					envelopes = append(envelopes, messages[i].Envelope)
+					// The batch must be delivered reliably if any of its messages must be.
+					batch.reliable = batch.reliable || messages[i].Reliable
+				}
+				batch.envelope = &rtapi.Envelope{Message: &rtapi.Envelope_Batch{Batch: &rtapi.EnvelopeBatch{Envelopes: envelopes}}}
+			}
+			batches[key] = batch
+		}
+		batch.presenceIDs = append(batch.presenceIDs, &PresenceID{Node: presenceID.Node, SessionID: presenceID.SessionID})
+	}
+
+	for _, batch := range batches {
+		r.SendToPresenceIDs(logger, batch.presenceIDs, isStream, mode, batch.envelope, batch.reliable)
+	}
+}
+
+type deferredBatch struct {
+	envelope    *rtapi.Envelope
+	reliable    bool
+	presenceIDs []*PresenceID
+}
+
+func sendBytes(session Session, isStream bool, mode uint8, payload []byte, reliable bool) error {
+	if reliable {
+		return session.SendBytes(isStream, mode, payload)
 	}
+	return session.SendBytesUnreliable(isStream, mode, payload)
 }
@@ -0,0 +1,137 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/heroiclabs/nakama/rtapi"
+	"go.uber.org/zap"
+)
+
+// A message to deliver to sessions connected to the receiving node.
+type clusterRoute struct {
+	SessionIDs []uuid.UUID `json:"session_ids"`
+	IsStream   bool        `json:"is_stream"`
+	Mode       uint8       `json:"mode"`
+	Reliable   bool        `json:"reliable"`
+	// Protobuf encoded envelope.
+	Envelope []byte `json:"envelope"`
+}
+
+// ClusterMessageRouter delivers messages to sessions on the current node directly, and forwards messages
+// for sessions connected to other nodes in the cluster to those nodes.
+type ClusterMessageRouter struct {
+	local   MessageRouter
+	tracker Tracker
+	cluster *Cluster
+	logger  *zap.Logger
+}
+
+func NewClusterMessageRouter(logger *zap.Logger, sessionRegistry SessionRegistry, tracker Tracker, jsonpbMarshaler *jsonpb.Marshaler, cluster *Cluster) MessageRouter {
+	r := &ClusterMessageRouter{
+		local:   NewLocalMessageRouter(sessionRegistry, tracker, jsonpbMarshaler),
+		tracker: tracker,
+		cluster: cluster,
+		logger:  logger,
+	}
+
+	cluster.SetHandler(ClusterMessageRoute, r.handleRoute)
+
+	return r
+}
+
+func (r *ClusterMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs []*PresenceID, isStream bool, mode uint8, envelope *rtapi.Envelope, reliable bool) {
+	if len(presenceIDs) == 0 {
+		return
+	}
+
+	// Group recipients by the node their session is connected to.
+	localPresenceIDs := make([]*PresenceID, 0, len(presenceIDs))
+	var remoteSessionIDs map[string][]uuid.UUID
+	for _, presenceID := range presenceIDs {
+		if presenceID.Node == r.cluster.Name() {
+			localPresenceIDs = append(localPresenceIDs, presenceID)
+			continue
+		}
+		if remoteSessionIDs == nil {
+			remoteSessionIDs = make(map[string][]uuid.UUID)
+		}
+		remoteSessionIDs[presenceID.Node] = append(remoteSessionIDs[presenceID.Node], presenceID.SessionID)
+	}
+
+	r.local.SendToPresenceIDs(logger, localPresenceIDs, isStream, mode, envelope, reliable)
+
+	if len(remoteSessionIDs) == 0 {
+		return
+	}
+	payload, err := proto.Marshal(envelope)
+	if err != nil {
+		logger.Error("Could not marshal message", zap.Error(err))
+		return
+	}
+	for node, sessionIDs := range remoteSessionIDs {
+		if err := r.cluster.Send(node, ClusterMessageRoute, &clusterRoute{SessionIDs: sessionIDs, IsStream: isStream, Mode: mode, Reliable: reliable, Envelope: payload}); err != nil {
+			logger.Warn("Failed to route message to cluster node", zap.String("node", node), zap.Error(err))
+		}
+	}
+}
+
+func (r *ClusterMessageRouter) SendToStream(logger *zap.Logger, stream PresenceStream, envelope *rtapi.Envelope) {
+	presenceIDs := r.tracker.ListPresenceIDByStream(stream)
+	r.SendToPresenceIDs(logger, presenceIDs, true, stream.Mode, envelope, true)
+}
+
+func (r *ClusterMessageRouter) SendDeferred(logger *zap.Logger, isStream bool, mode uint8, messages []*DeferredMessage) {
+	// Messages for sessions on this node may be batched, messages for other nodes are forwarded individually.
+	localMessages := make([]*DeferredMessage, 0, len(messages))
+	for _, message := range messages {
+		localPresenceIDs := make([]*PresenceID, 0, len(message.PresenceIDs))
+		remotePresenceIDs := make([]*PresenceID, 0, len(message.PresenceIDs))
+		for _, presenceID := range message.PresenceIDs {
+			if presenceID.Node == r.cluster.Name() {
+				localPresenceIDs = append(localPresenceIDs, presenceID)
+			} else {
+				remotePresenceIDs = append(remotePresenceIDs, presenceID)
+			}
+		}
+		if len(localPresenceIDs) != 0 {
+			localMessages = append(localMessages, &DeferredMessage{PresenceIDs: localPresenceIDs, Envelope: message.Envelope, Reliable: message.Reliable})
+		}
+		r.SendToPresenceIDs(logger, remotePresenceIDs, isStream, mode, message.Envelope, message.Reliable)
+	}
+	r.local.SendDeferred(logger, isStream, mode, localMessages)
+}
+
+func (r *ClusterMessageRouter) handleRoute(from string, payload json.RawMessage) (interface{}, error) {
+	route := &clusterRoute{}
+	if err := json.Unmarshal(payload, route); err != nil {
+		return nil, err
+	}
+	envelope := &rtapi.Envelope{}
+	if err := proto.Unmarshal(route.Envelope, envelope); err != nil {
+		return nil, err
+	}
+
+	presenceIDs := make([]*PresenceID, 0, len(route.SessionIDs))
+	for _, sessionID := range route.SessionIDs {
+		presenceIDs = append(presenceIDs, &PresenceID{Node: r.cluster.Name(), SessionID: sessionID})
+	}
+	r.local.SendToPresenceIDs(r.logger, presenceIDs, route.IsStream, route.Mode, envelope, route.Reliable)
+	return nil, nil
+}
@@ -34,14 +34,20 @@ import (
 
 var (
 	// Metrics stats measurements.
-	MetricsRuntimeCount          = stats.Int64("nakama/runtime/count", "Number of pooled runtime instances", stats.UnitDimensionless)
-	MetricsSocketWsTimeSpentMsec = stats.Float64("nakama.socket/ws/server_elapsed_time", "Elapsed time in msecs spent in WebSocket connections", stats.UnitMilliseconds)
-	MetricsSocketWsOpenCount     = stats.Int64("nakama.socket/ws/open_count", "Number of opened WebSocket connections", stats.UnitDimensionless)
-	MetricsSocketWsCloseCount    = stats.Int64("nakama.socket/ws/close_count", "Number of closed WebSocket connections", stats.UnitDimensionless)
-	MetricsApiTimeSpentMsec      = stats.Float64("nakama.api/server/server_elapsed_time", "Elapsed time in msecs spent in API functions", stats.UnitMilliseconds)
-	MetricsApiCount              = stats.Int64("nakama.api/server/request_count", "Number of calls to API functions", stats.UnitDimensionless)
-	MetricsRtapiTimeSpentMsec    = stats.Float64("nakama.rtapi/server/server_elapsed_time", "Elapsed time in msecs spent in realtime socket functions", stats.UnitMilliseconds)
-	MetricsRtapiCount            = stats.Int64("nakama.rtapi/server/request_count", "Number of calls to realtime socket functions", stats.UnitDimensionless)
+	MetricsRuntimeCount           = stats.Int64("nakama/runtime/count", "Number of pooled runtime instances", stats.UnitDimensionless)
+	MetricsSocketWsTimeSpentMsec  = stats.Float64("nakama.socket/ws/server_elapsed_time", "Elapsed time in msecs spent in WebSocket connections", stats.UnitMilliseconds)
+	MetricsSocketWsOpenCount      = stats.Int64("nakama.socket/ws/open_count", "Number of opened WebSocket connections", stats.UnitDimensionless)
+	MetricsSocketWsCloseCount     = stats.Int64("nakama.socket/ws/close_count", "Number of closed WebSocket connections", stats.UnitDimensionless)
+	MetricsSocketUdpTimeSpentMsec = stats.Float64("nakama.socket/udp/server_elapsed_time", "Elapsed time in msecs spent in UDP connections", stats.UnitMilliseconds)
+	MetricsSocketUdpOpenCount     = stats.Int64("nakama.socket/udp/open_count", "Number of opened UDP connections", stats.UnitDimensionless)
+	MetricsSocketUdpCloseCount    = stats.Int64("nakama.socket/udp/close_count", "Number of closed UDP connections", stats.UnitDimensionless)
+	MetricsApiTimeSpentMsec       = stats.Float64("nakama.api/server/server_elapsed_time", "Elapsed time in msecs spent in API functions", stats.UnitMilliseconds)
+	MetricsApiCount               = stats.Int64("nakama.api/server/request_count", "Number of calls to API functions", stats.UnitDimensionless)
+	MetricsRtapiTimeSpentMsec     = stats.Float64("nakama.rtapi/server/server_elapsed_time", "Elapsed time in msecs spent in realtime socket functions", stats.UnitMilliseconds)
+	MetricsRtapiCount             = stats.Int64("nakama.rtapi/server/request_count", "Number of calls to realtime socket functions", stats.UnitDimensionless)
+	MetricsMatchmakerTicketCount  = stats.Int64("nakama.matchmaker/ticket_count", "Number of pending matchmaker tickets", stats.UnitDimensionless)
+	MetricsMatchmakerMatchCount   = stats.Int64("nakama.matchmaker/match_count", "Number of matches formed by the matchmaker", stats.UnitDimensionless)
+	MetricsMatchmakerTimeToMatch  = stats.Float64("nakama.matchmaker/time_to_match", "Time in secs matchmaker tickets waited before being matched", "s")
 
 	// Metrics stats tag keys.
 	MetricsFunction, _ = tag.NewKey("function")
@@ -96,6 +102,33 @@ func NewMetrics(logger, startupLogger *zap.Logger, config Config, metricsExporte
 	}); err != nil {
 		startupLogger.Fatal("Error subscribing socket ws count metrics view", zap.Error(err))
 	}
+	if err := view.Register(&view.View{
+		Name:        "nakama.socket/udp/server_elapsed_time",
+		Description: "Elapsed time in msecs spent in UDP connections",
+		TagKeys:     []tag.Key{},
+		Measure:     MetricsSocketUdpTimeSpentMsec,
+		Aggregation: ocgrpc.DefaultMillisecondsDistribution,
+	}); err != nil {
+		startupLogger.Fatal("Error subscribing socket udp elapsed time metrics view", zap.Error(err))
+	}
+	if err := view.Register(&view.View{
+		Name:        "nakama.socket/udp/open_count",
+		Description: "Number of opened UDP connections",
+		TagKeys:     []tag.Key{},
+		Measure:     MetricsSocketUdpOpenCount,
+		Aggregation: view.Count(),
+	}); err != nil {
+		startupLogger.Fatal("Error subscribing socket udp opened count metrics view", zap.Error(err))
+	}
+	if err := view.Register(&view.View{
+		Name:        "nakama.socket/udp/close_count",
+		Description: "Number of closed UDP connections",
+		TagKeys:     []tag.Key{},
+		Measure:     MetricsSocketUdpCloseCount,
+		Aggregation: view.Count(),
+	}); err != nil {
+		startupLogger.Fatal("Error subscribing socket udp count metrics view", zap.Error(err))
+	}
 	if err := view.Register(&view.View{
 		Name:        "nakama.api/server/server_elapsed_time",
 		Description: "Elapsed time in msecs spent in API functions",
@@ -133,6 +166,34 @@ func NewMetrics(logger, startupLogger *zap.Logger, config Config, metricsExporte
 		startupLogger.Fatal("Error subscribing rtapi request count metrics view", zap.Error(err))
 	}
 
+	if err := view.Register(&view.View{
+		Name:        "nakama.matchmaker/ticket_count",
+		Description: "Number of pending matchmaker tickets",
+		TagKeys:     []tag.Key{},
+		Measure:     MetricsMatchmakerTicketCount,
+		Aggregation: view.LastValue(),
+	}); err != nil {
+		startupLogger.Fatal("Error subscribing matchmaker ticket count metrics view", zap.Error(err))
+	}
+	if err := view.Register(&view.View{
+		Name:        "nakama.matchmaker/match_count",
+		Description: "Number of matches formed by the matchmaker",
+		TagKeys:     []tag.Key{},
+		Measure:     MetricsMatchmakerMatchCount,
+		Aggregation: view.Sum(),
+	}); err != nil {
+		startupLogger.Fatal("Error subscribing matchmaker match count metrics view", zap.Error(err))
+	}
+	if err := view.Register(&view.View{
+		Name:        "nakama.matchmaker/time_to_match",
+		Description: "Time in secs matchmaker tickets waited before being matched",
+		TagKeys:     []tag.Key{},
+		Measure:     MetricsMatchmakerTimeToMatch,
+		Aggregation: view.Distribution(1, 5, 10, 15, 30, 45, 60, 90, 120, 180, 300, 600),
+	}); err != nil {
+		startupLogger.Fatal("Error subscribing matchmaker time to match metrics view", zap.Error(err))
+	}
+
 	view.SetReportingPeriod(time.Duration(config.GetMetrics().ReportingFreqSec) * time.Second)
 
 	view.RegisterExporter(metricsExporter)
@@ -0,0 +1,289 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPartyNotFound        = errors.New("party not found")
+	ErrPartyFull            = errors.New("party full")
+	ErrPartyJoinRejected    = errors.New("party join rejected")
+	ErrPartyNotLeader       = errors.New("party leader only")
+	ErrPartyNotMember       = errors.New("party member not found")
+	ErrPartyExceedsMaxCount = errors.New("party size exceeds matchmaker maximum count")
+)
+
+type PartyPresence struct {
+	ID       PresenceID
+	UserID   uuid.UUID
+	Username string
+}
+
+func (p *PartyPresence) toUserPresence() *rtapi.UserPresence {
+	return &rtapi.UserPresence{
+		UserId:    p.UserID.String(),
+		SessionId: p.ID.SessionID.String(),
+		Username:  p.Username,
+	}
+}
+
+type PartyHandler struct {
+	sync.Mutex
+	logger     *zap.Logger
+	matchmaker Matchmaker
+	tracker    Tracker
+	router     MessageRouter
+
+	ID      uuid.UUID
+	Node    string
+	IDStr   string
+	Stream  PresenceStream
+	MaxSize int
+
+	// Members in the order they joined, the oldest remaining member takes over if the leader leaves.
+	members []*PartyPresence
+	leader  *PartyPresence
+	closed  bool
+
+	// The party's pending matchmaker ticket, if any, and the session that submitted it.
+	ticket          string
+	ticketSessionID uuid.UUID
+}
+
+func NewPartyHandler(logger *zap.Logger, matchmaker Matchmaker, tracker Tracker, router MessageRouter, id uuid.UUID, node string, maxSize int) *PartyHandler {
+	idStr := fmt.Sprintf("%v.%v", id.String(), node)
+	return &PartyHandler{
+		logger:     logger.With(zap.String("pid", idStr)),
+		matchmaker: matchmaker,
+		tracker:    tracker,
+		router:     router,
+
+		ID:      id,
+		Node:    node,
+		IDStr:   idStr,
+		Stream:  PresenceStream{Mode: StreamModeParty, Subject: id, Label: node},
+		MaxSize: maxSize,
+
+		members: make([]*PartyPresence, 0, maxSize),
+	}
+}
+
+// Join adds the session to the party, and returns the resulting party state.
+// The first member to join becomes the party leader.
+func (p *PartyHandler) Join(session Session) (*rtapi.Party, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.closed {
+		return nil, ErrPartyNotFound
+	}
+
+	for _, member := range p.members {
+		if member.ID.SessionID == session.ID() {
+			// Already a member, nothing to do.
+			return p.party(member), nil
+		}
+	}
+	if p.MaxSize > 0 && len(p.members) >= p.MaxSize {
+		return nil, ErrPartyFull
+	}
+
+	if success, _ := p.tracker.Track(session.ID(), p.Stream, session.UserID(), PresenceMeta{
+		Username: session.Username(),
+		Format:   session.Format(),
+	}, false); !success {
+		// Presence creation was rejected due to `allowIfFirstForSession` flag, session is gone.
+		return nil, ErrPartyJoinRejected
+	}
+
+	member := &PartyPresence{
+		ID:       PresenceID{Node: p.Node, SessionID: session.ID()},
+		UserID:   session.UserID(),
+		Username: session.Username(),
+	}
+	p.members = append(p.members, member)
+	if p.leader == nil {
+		p.leader = member
+	}
+
+	// Any pending matchmaking no longer covers all members.
+	p.cancelTicket()
+
+	return p.party(member), nil
+}
+
+// Leave removes the given presences from the party, and reports if the party is now empty and closed.
+// Safe to call for presences that have already left.
+func (p *PartyHandler) Leave(leaves []*PresenceID) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.closed {
+		return true
+	}
+
+	removed := false
+	for _, leave := range leaves {
+		for i, member := range p.members {
+			if member.ID == *leave {
+				p.members = append(p.members[:i], p.members[i+1:]...)
+				removed = true
+				break
+			}
+		}
+	}
+	if !removed {
+		return false
+	}
+
+	// Any pending matchmaking no longer reflects the party members.
+	p.cancelTicket()
+
+	if len(p.members) == 0 {
+		p.closed = true
+		p.leader = nil
+		return true
+	}
+
+	// If the leader has left promote the longest standing remaining member.
+	leaderFound := false
+	for _, member := range p.members {
+		if member == p.leader {
+			leaderFound = true
+			break
+		}
+	}
+	if !leaderFound {
+		p.leader = p.members[0]
+		p.announceLeader()
+	}
+
+	return false
+}
+
+// Promote makes the given member the new party leader, only the current leader may do so.
+func (p *PartyHandler) Promote(sessionID uuid.UUID, presence *rtapi.UserPresence) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.closed {
+		return ErrPartyNotFound
+	}
+	if p.leader.ID.SessionID != sessionID {
+		return ErrPartyNotLeader
+	}
+
+	for _, member := range p.members {
+		if member.ID.SessionID.String() == presence.SessionId && member.UserID.String() == presence.UserId {
+			if member != p.leader {
+				p.leader = member
+				p.cancelTicket()
+				p.announceLeader()
+			}
+			return nil
+		}
+	}
+	return ErrPartyNotMember
+}
+
+// MatchmakerAdd submits a single matchmaker ticket covering all party members, only the current leader may do so.
+// Any previous ticket for the party is replaced.
+func (p *PartyHandler) MatchmakerAdd(sessionID uuid.UUID, query string, minCount int, maxCount int, stringProperties map[string]string, numericProperties map[string]float64, numericRanges map[string]*MatchmakerRange, relaxations []*MatchmakerRelaxation) (string, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.closed {
+		return "", ErrPartyNotFound
+	}
+	if p.leader.ID.SessionID != sessionID {
+		return "", ErrPartyNotLeader
+	}
+	if len(p.members) > maxCount {
+		return "", ErrPartyExceedsMaxCount
+	}
+
+	// The leader is always the first presence, so the ticket is attributed to them.
+	presences := make([]*MatchmakerPresence, 0, len(p.members))
+	presences = append(presences, &MatchmakerPresence{
+		UserId:    p.leader.UserID.String(),
+		SessionId: p.leader.ID.SessionID.String(),
+		Username:  p.leader.Username,
+		Node:      p.leader.ID.Node,
+	})
+	for _, member := range p.members {
+		if member == p.leader {
+			continue
+		}
+		presences = append(presences, &MatchmakerPresence{
+			UserId:    member.UserID.String(),
+			SessionId: member.ID.SessionID.String(),
+			Username:  member.Username,
+			Node:      member.ID.Node,
+		})
+	}
+
+	p.cancelTicket()
+	ticket, err := p.matchmaker.Add(presences, sessionID, p.IDStr, query, minCount, maxCount, stringProperties, numericProperties, numericRanges, relaxations)
+	if err != nil {
+		return "", err
+	}
+	p.ticket = ticket
+	p.ticketSessionID = sessionID
+
+	return ticket, nil
+}
+
+// Must be called while holding the party lock.
+func (p *PartyHandler) cancelTicket() {
+	if p.ticket == "" {
+		return
+	}
+	if err := p.matchmaker.Remove(p.ticketSessionID, p.ticket); err != nil && err != ErrMatchmakerTicketNotFound {
+		p.logger.Error("Error removing party matchmaker ticket", zap.String("ticket", p.ticket), zap.Error(err))
+	}
+	p.ticket = ""
+	p.ticketSessionID = uuid.Nil
+}
+
+// Must be called while holding the party lock.
+func (p *PartyHandler) announceLeader() {
+	p.router.SendToStream(p.logger, p.Stream, &rtapi.Envelope{Message: &rtapi.Envelope_PartyLeader{PartyLeader: &rtapi.PartyLeader{
+		PartyId:  p.IDStr,
+		Presence: p.leader.toUserPresence(),
+	}}})
+}
+
+// Must be called while holding the party lock.
+func (p *PartyHandler) party(self *PartyPresence) *rtapi.Party {
+	presences := make([]*rtapi.UserPresence, 0, len(p.members))
+	for _, member := range p.members {
+		presences = append(presences, member.toUserPresence())
+	}
+	return &rtapi.Party{
+		PartyId:   p.IDStr,
+		MaxSize:   int32(p.MaxSize),
+		Leader:    p.leader.toUserPresence(),
+		Presences: presences,
+		Self:      self.toUserPresence(),
+	}
+}
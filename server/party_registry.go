@@ -0,0 +1,103 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+)
+
+type PartyRegistry interface {
+	// Create a new party with the given session as its leader and only member.
+	Create(session Session, maxSize int) (*PartyHandler, error)
+	// Return a party handler by ID, only from the local node.
+	Get(id uuid.UUID) *PartyHandler
+	// Returns the total number of currently active parties.
+	Count() int
+
+	// Notify a party handler that one or more members have left or disconnected.
+	// Expects that the caller has already determined the party is hosted on the current node.
+	Leave(id uuid.UUID, leaves []*PresenceID)
+}
+
+type LocalPartyRegistry struct {
+	sync.RWMutex
+	logger     *zap.Logger
+	matchmaker Matchmaker
+	tracker    Tracker
+	router     MessageRouter
+	node       string
+
+	parties map[uuid.UUID]*PartyHandler
+}
+
+func NewLocalPartyRegistry(logger *zap.Logger, matchmaker Matchmaker, tracker Tracker, router MessageRouter, node string) PartyRegistry {
+	return &LocalPartyRegistry{
+		logger:     logger,
+		matchmaker: matchmaker,
+		tracker:    tracker,
+		router:     router,
+		node:       node,
+
+		parties: make(map[uuid.UUID]*PartyHandler),
+	}
+}
+
+func (r *LocalPartyRegistry) Create(session Session, maxSize int) (*PartyHandler, error) {
+	id := uuid.Must(uuid.NewV4())
+	handler := NewPartyHandler(r.logger, r.matchmaker, r.tracker, r.router, id, r.node, maxSize)
+
+	r.Lock()
+	r.parties[id] = handler
+	r.Unlock()
+
+	if _, err := handler.Join(session); err != nil {
+		r.Lock()
+		delete(r.parties, id)
+		r.Unlock()
+		return nil, err
+	}
+
+	return handler, nil
+}
+
+func (r *LocalPartyRegistry) Get(id uuid.UUID) *PartyHandler {
+	r.RLock()
+	handler := r.parties[id]
+	r.RUnlock()
+	return handler
+}
+
+func (r *LocalPartyRegistry) Count() int {
+	r.RLock()
+	count := len(r.parties)
+	r.RUnlock()
+	return count
+}
+
+func (r *LocalPartyRegistry) Leave(id uuid.UUID, leaves []*PresenceID) {
+	handler := r.Get(id)
+	if handler == nil {
+		return
+	}
+
+	if closed := handler.Leave(leaves); closed {
+		r.Lock()
+		delete(r.parties, id)
+		r.Unlock()
+	}
+}
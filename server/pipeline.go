@@ -33,13 +33,14 @@ type Pipeline struct {
 	sessionRegistry   SessionRegistry
 	matchRegistry     MatchRegistry
 	matchmaker        Matchmaker
+	partyRegistry     PartyRegistry
 	tracker           Tracker
 	router            MessageRouter
 	runtime           *Runtime
 	node              string
 }
 
-func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, runtime *Runtime) *Pipeline {
+func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, matchmaker Matchmaker, partyRegistry PartyRegistry, tracker Tracker, router MessageRouter, runtime *Runtime) *Pipeline {
 	return &Pipeline{
 		logger:            logger,
 		config:            config,
@@ -49,6 +50,7 @@ func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, jsonpbMarshaler
 		sessionRegistry:   sessionRegistry,
 		matchRegistry:     matchRegistry,
 		matchmaker:        matchmaker,
+		partyRegistry:     partyRegistry,
 		tracker:           tracker,
 		router:            router,
 		runtime:           runtime,
@@ -69,6 +71,27 @@ func (p *Pipeline) ProcessRequest(logger *zap.Logger, session Session, envelope
 		return false
 	}
 
+	// Batches are unpacked and each message processed in order, stopping at the first one that fails.
+	if batch, ok := envelope.Message.(*rtapi.Envelope_Batch); ok {
+		for _, batchEnvelope := range batch.Batch.Envelopes {
+			if _, ok := batchEnvelope.GetMessage().(*rtapi.Envelope_Batch); ok {
+				session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+					Code:    int32(rtapi.Error_BAD_INPUT),
+					Message: "Batches may not be nested.",
+				}}})
+				return false
+			}
+			batchLogger := logger
+			if batchEnvelope.Cid != "" {
+				batchLogger = logger.With(zap.String("cid", batchEnvelope.Cid))
+			}
+			if !p.ProcessRequest(batchLogger, session, batchEnvelope) {
+				return false
+			}
+		}
+		return true
+	}
+
 	var pipelineFn func(*zap.Logger, Session, *rtapi.Envelope)
 
 	switch envelope.Message.(type) {
@@ -94,6 +117,16 @@ func (p *Pipeline) ProcessRequest(logger *zap.Logger, session Session, envelope
 		pipelineFn = p.matchmakerAdd
 	case *rtapi.Envelope_MatchmakerRemove:
 		pipelineFn = p.matchmakerRemove
+	case *rtapi.Envelope_PartyCreate:
+		pipelineFn = p.partyCreate
+	case *rtapi.Envelope_PartyDataSend:
+		pipelineFn = p.partyDataSend
+	case *rtapi.Envelope_PartyJoin:
+		pipelineFn = p.partyJoin
+	case *rtapi.Envelope_PartyLeave:
+		pipelineFn = p.partyLeave
+	case *rtapi.Envelope_PartyPromote:
+		pipelineFn = p.partyPromote
 	case *rtapi.Envelope_Rpc:
 		pipelineFn = p.rpc
 	case *rtapi.Envelope_StatusFollow:
@@ -28,6 +28,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// Distinguishes match reconnect tokens from session tokens signed with the same key.
+const matchReconnectTokenType = "match_reconnect"
+
 type matchDataFilter struct {
 	userID    uuid.UUID
 	sessionID uuid.UUID
@@ -67,6 +70,7 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 	var node string
 	var matchIDString string
 	allowEmpty := false
+	reconnect := false
 
 	switch incoming.Id.(type) {
 	case *rtapi.MatchJoin_MatchId:
@@ -131,6 +135,50 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 		}
 		node = matchIDComponents[1]
 		allowEmpty = true
+	case *rtapi.MatchJoin_ReconnectToken:
+		token, err := jwt.Parse(incoming.GetReconnectToken(), func(token *jwt.Token) (interface{}, error) {
+			if s, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || s.Hash != crypto.SHA256 {
+				return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+			}
+			return []byte(p.config.GetSession().EncryptionKey), nil
+		})
+		if err != nil {
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Invalid reconnect token",
+			}}})
+			return
+		}
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok || !token.Valid {
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Invalid reconnect token",
+			}}})
+			return
+		}
+		// Reconnect tokens only allow the user they were issued to back into the match.
+		matchIDString, _ = claims["mid"].(string)
+		userIDString, _ := claims["uid"].(string)
+		tokenType, _ := claims["typ"].(string)
+		matchIDComponents := strings.SplitN(matchIDString, ".", 2)
+		if tokenType != matchReconnectTokenType || len(matchIDComponents) != 2 || matchIDComponents[1] == "" || userIDString != session.UserID().String() {
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Invalid reconnect token",
+			}}})
+			return
+		}
+		matchID, err = uuid.FromString(matchIDComponents[0])
+		if err != nil {
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Invalid reconnect token",
+			}}})
+			return
+		}
+		node = matchIDComponents[1]
+		reconnect = true
 	case nil:
 		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
 			Code:    int32(rtapi.Error_BAD_INPUT),
@@ -153,6 +201,19 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 
 	stream := PresenceStream{Mode: mode, Subject: matchID, Label: node}
 
+	// Spectators are tracked apart from players, which keeps them out of the match size and match data sending.
+	joinStream := stream
+	if incoming.Spectator {
+		if mode != StreamModeMatchAuthoritative || reconnect {
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Only authoritative matches can be joined as a spectator",
+			}}})
+			return
+		}
+		joinStream = PresenceStream{Mode: StreamModeMatchSpectator, Subject: matchID, Label: node}
+	}
+
 	// Relayed matches must 'exist' by already having some members, unless they're being joined via a token.
 	if mode == StreamModeMatchRelayed && !allowEmpty && !p.tracker.StreamExists(stream) {
 		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
@@ -163,7 +224,7 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 	}
 
 	var label *wrappers.StringValue
-	meta := p.tracker.GetLocalBySessionIDStreamUserID(session.ID(), stream, session.UserID())
+	meta := p.tracker.GetLocalBySessionIDStreamUserID(session.ID(), joinStream, session.UserID())
 	isNew := meta == nil
 	if isNew {
 		username := session.Username()
@@ -172,9 +233,12 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 		var reason string
 		var l string
 		// The user is not yet part of the match, attempt to join.
-		if mode == StreamModeMatchAuthoritative {
+		if reconnect {
+			// Ask the match handler to hand over the place it held after the user disconnected.
+			found, allow, reason, l = p.matchRegistry.Reconnect(session.Context(), matchID, node, session.UserID(), session.ID(), username, p.node)
+		} else if mode == StreamModeMatchAuthoritative {
 			// If it's an authoritative match, ask the match handler if it will allow the join.
-			found, allow, reason, l = p.matchRegistry.JoinAttempt(session.Context(), matchID, node, session.UserID(), session.ID(), username, p.node, incoming.Metadata)
+			found, allow, reason, l = p.matchRegistry.JoinAttempt(session.Context(), matchID, node, session.UserID(), session.ID(), username, p.node, incoming.Metadata, incoming.Spectator)
 		}
 		if !found {
 			// Match did not exist.
@@ -200,7 +264,7 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 			Username: username,
 			Format:   session.Format(),
 		}
-		if success, _ := p.tracker.Track(session.ID(), stream, session.UserID(), m, false); !success {
+		if success, _ := p.tracker.Track(session.ID(), joinStream, session.UserID(), m, false); !success {
 			// Presence creation was rejected due to `allowIfFirstForSession` flag, session is gone so no need to reply.
 			return
 		}
@@ -228,6 +292,7 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 	// Whether the user has just (successfully) joined the match or was already a member, return the match info anyway.
 	ps := p.tracker.ListByStream(stream, false, true)
 	presences := make([]*rtapi.UserPresence, 0, len(ps))
+	coplayUserIDs := make([]uuid.UUID, 0, len(ps))
 	for _, p := range ps {
 		if isNew && p.UserID == session.UserID() && p.ID.SessionID == session.ID() {
 			// Ensure the user themselves does not appear in the list of existing match presences.
@@ -239,6 +304,19 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 			SessionId: p.ID.SessionID.String(),
 			Username:  p.Meta.Username,
 		})
+		coplayUserIDs = append(coplayUserIDs, p.UserID)
+	}
+
+	// Players in authoritative matches may use this to reclaim their place from a new session if they disconnect.
+	var reconnectToken string
+	if mode == StreamModeMatchAuthoritative && !incoming.Spectator {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"typ": matchReconnectTokenType,
+			"mid": matchIDString,
+			"uid": session.UserID().String(),
+			"exp": time.Now().UTC().Add(time.Duration(p.config.GetMatch().ReconnectTokenExpirySec) * time.Second).Unix(),
+		})
+		reconnectToken, _ = token.SignedString([]byte(p.config.GetSession().EncryptionKey))
 	}
 
 	session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Match{Match: &rtapi.Match{
@@ -252,7 +330,13 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 			SessionId: session.ID().String(),
 			Username:  meta.Username,
 		},
+		ReconnectToken: reconnectToken,
 	}}})
+
+	if isNew && !reconnect && !incoming.Spectator && len(coplayUserIDs) != 0 {
+		// Remember who the user played alongside, for friend suggestions. Failures are logged but don't affect the join.
+		RecordMatchCoplay(session.Context(), logger, p.db, session.UserID(), matchIDString, coplayUserIDs)
+	}
 }
 
 func (p *Pipeline) matchLeave(logger *zap.Logger, session Session, envelope *rtapi.Envelope) {
@@ -284,6 +368,10 @@ func (p *Pipeline) matchLeave(logger *zap.Logger, session Session, envelope *rta
 	stream := PresenceStream{Mode: mode, Subject: matchID, Label: matchIDComponents[1]}
 
 	p.tracker.Untrack(session.ID(), stream, session.UserID())
+	if mode == StreamModeMatchAuthoritative {
+		// The user may have been spectating rather than playing.
+		p.tracker.Untrack(session.ID(), PresenceStream{Mode: StreamModeMatchSpectator, Subject: matchID, Label: matchIDComponents[1]}, session.UserID())
+	}
 
 	session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid})
 }
@@ -312,11 +400,11 @@ func (p *Pipeline) matchDataSend(logger *zap.Logger, session Session, envelope *
 	// If it's an authoritative match pass the data to the match handler.
 	if matchIDComponents[1] != "" {
 		if p.tracker.GetLocalBySessionIDStreamUserID(session.ID(), PresenceStream{Mode: StreamModeMatchAuthoritative, Subject: matchID, Label: matchIDComponents[1]}, session.UserID()) == nil {
-			// User is not part of the match.
+			// User is not part of the match, or is only spectating.
 			return
 		}
 
-		p.matchRegistry.SendData(matchID, matchIDComponents[1], session.UserID(), session.ID(), session.Username(), p.node, incoming.OpCode, incoming.Data, time.Now().UTC().UnixNano()/int64(time.Millisecond))
+		p.matchRegistry.SendData(matchID, matchIDComponents[1], session.UserID(), session.ID(), session.Username(), p.node, incoming.OpCode, incoming.Data, incoming.Reliable, time.Now().UTC().UnixNano()/int64(time.Millisecond))
 		return
 	}
 
@@ -395,9 +483,10 @@ func (p *Pipeline) matchDataSend(logger *zap.Logger, session Session, envelope *
 			SessionId: session.ID().String(),
 			Username:  session.Username(),
 		},
-		OpCode: incoming.OpCode,
-		Data:   incoming.Data,
+		OpCode:   incoming.OpCode,
+		Data:     incoming.Data,
+		Reliable: incoming.Reliable,
 	}}}
 
-	p.router.SendToPresenceIDs(logger, presenceIDs, true, stream.Mode, outgoing)
+	p.router.SendToPresenceIDs(logger, presenceIDs, true, stream.Mode, outgoing, incoming.Reliable)
 }
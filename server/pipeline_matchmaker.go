@@ -15,12 +15,6 @@
 package server
 
 import (
-	"context"
-	"fmt"
-	"time"
-
-	"github.com/dgrijalva/jwt-go"
-	"github.com/gofrs/uuid"
 	"github.com/heroiclabs/nakama/rtapi"
 	"go.uber.org/zap"
 )
@@ -53,9 +47,111 @@ func (p *Pipeline) matchmakerAdd(logger *zap.Logger, session Session, envelope *
 		query = "*"
 	}
 
-	// Run matchmaker add.
-	ticket, entries, err := p.matchmaker.Add(session, query, minCount, maxCount, incoming.StringProperties, incoming.NumericProperties)
+	// Numeric ranges, if any, must not be inverted.
+	var numericRanges map[string]*MatchmakerRange
+	if len(incoming.NumericRanges) != 0 {
+		numericRanges = make(map[string]*MatchmakerRange, len(incoming.NumericRanges))
+		for property, numericRange := range incoming.NumericRanges {
+			if numericRange == nil || numericRange.Min > numericRange.Max {
+				session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+					Code:    int32(rtapi.Error_BAD_INPUT),
+					Message: "Invalid numeric range, minimum must be <= maximum",
+				}}})
+				return
+			}
+			numericRanges[property] = &MatchmakerRange{Min: numericRange.Min, Max: numericRange.Max}
+		}
+	}
+
+	// Relaxation policy, applied progressively as the ticket waits.
+	relaxations := make([]*MatchmakerRelaxation, 0, len(incoming.Relaxations))
+	for _, r := range incoming.Relaxations {
+		if r == nil || r.WaitSec < 1 {
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Invalid relaxation wait time, must be >= 1",
+			}}})
+			return
+		}
+		relaxation := &MatchmakerRelaxation{WaitSec: int(r.WaitSec), WidenRanges: r.WidenRanges}
+		if r.MinCount != nil {
+			relaxation.MinCount = int(r.MinCount.Value)
+			if relaxation.MinCount < 2 {
+				session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+					Code:    int32(rtapi.Error_BAD_INPUT),
+					Message: "Invalid relaxation minimum count, must be >= 2",
+				}}})
+				return
+			}
+		}
+		if r.Query != nil {
+			relaxation.Query = r.Query.Value
+			if relaxation.Query == "" {
+				relaxation.Query = "*"
+			}
+		}
+		for _, amount := range r.WidenRanges {
+			if amount < 0 {
+				session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+					Code:    int32(rtapi.Error_BAD_INPUT),
+					Message: "Invalid relaxation range widening, must be >= 0",
+				}}})
+				return
+			}
+		}
+		relaxations = append(relaxations, relaxation)
+	}
+
+	// Run matchmaker add, either for the party as a whole or just the current session.
+	var ticket string
+	var err error
+	if incoming.PartyId != "" {
+		handler := p.getParty(incoming.PartyId)
+		if handler == nil {
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_PARTY_NOT_FOUND),
+				Message: "Party not found",
+			}}})
+			return
+		}
+		ticket, err = handler.MatchmakerAdd(session.ID(), query, minCount, maxCount, incoming.StringProperties, incoming.NumericProperties, numericRanges, relaxations)
+	} else {
+		presences := []*MatchmakerPresence{&MatchmakerPresence{
+			UserId:    session.UserID().String(),
+			SessionId: session.ID().String(),
+			Username:  session.Username(),
+			Node:      p.node,
+		}}
+		ticket, err = p.matchmaker.Add(presences, session.ID(), "", query, minCount, maxCount, incoming.StringProperties, incoming.NumericProperties, numericRanges, relaxations)
+	}
 	if err != nil {
+		switch err {
+		case ErrMatchmakerQueryInvalid:
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Invalid matchmaker query",
+			}}})
+			return
+		case ErrPartyNotFound:
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_PARTY_NOT_FOUND),
+				Message: "Party not found",
+			}}})
+			return
+		case ErrPartyNotLeader:
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Only the party leader can submit a matchmaker ticket for the party",
+			}}})
+			return
+		case ErrPartyExceedsMaxCount:
+			session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Invalid maximum count, must be >= party size",
+			}}})
+			return
+		}
+
 		logger.Error("Error adding to matchmaker", zap.Error(err))
 		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
 			Code:    int32(rtapi.Error_RUNTIME_EXCEPTION),
@@ -64,69 +160,10 @@ func (p *Pipeline) matchmakerAdd(logger *zap.Logger, session Session, envelope *
 		return
 	}
 
-	// Return the ticket first whether or not matchmaking was successful.
+	// Return the ticket, matching itself happens asynchronously on the matchmaker's process interval.
 	session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_MatchmakerTicket{MatchmakerTicket: &rtapi.MatchmakerTicket{
 		Ticket: ticket,
 	}}})
-
-	if entries == nil {
-		// Matchmaking was unsuccessful, no further messages to send out.
-		return
-	}
-
-	var tokenOrMatchID string
-	var isMatchID bool
-
-	// Check if there's a matchmaker matched runtime callback, call it, and see if it returns a match ID.
-	fn := p.runtime.MatchmakerMatched()
-	if fn != nil {
-		tokenOrMatchID, isMatchID, err = fn(context.Background(), entries)
-		if err != nil {
-			p.logger.Error("Error running Matchmaker Matched hook.", zap.Error(err))
-		}
-	}
-
-	if !isMatchID {
-		// If there was no callback or it didn't return a valid match ID always return at least a token.
-		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-			"mid": fmt.Sprintf("%v.", uuid.Must(uuid.NewV4()).String()),
-			"exp": time.Now().UTC().Add(30 * time.Second).Unix(),
-		})
-		tokenOrMatchID, _ = token.SignedString([]byte(p.config.GetSession().EncryptionKey))
-	}
-
-	users := make([]*rtapi.MatchmakerMatched_MatchmakerUser, 0, len(entries))
-	for _, entry := range entries {
-		users = append(users, &rtapi.MatchmakerMatched_MatchmakerUser{
-			Presence: &rtapi.UserPresence{
-				UserId:    entry.Presence.UserId,
-				SessionId: entry.Presence.SessionId,
-				Username:  entry.Presence.Username,
-			},
-			StringProperties:  entry.StringProperties,
-			NumericProperties: entry.NumericProperties,
-		})
-	}
-	outgoing := &rtapi.Envelope{Message: &rtapi.Envelope_MatchmakerMatched{MatchmakerMatched: &rtapi.MatchmakerMatched{
-		// Ticket is set individually below for each recipient.
-		// Id set below to account for token or match ID case.
-		Users: users,
-		// Self is set individually below for each recipient.
-	}}}
-	if isMatchID {
-		outgoing.GetMatchmakerMatched().Id = &rtapi.MatchmakerMatched_MatchId{MatchId: tokenOrMatchID}
-	} else {
-		outgoing.GetMatchmakerMatched().Id = &rtapi.MatchmakerMatched_Token{Token: tokenOrMatchID}
-	}
-
-	for i, entry := range entries {
-		// Set per-recipient fields.
-		outgoing.GetMatchmakerMatched().Self = users[i]
-		outgoing.GetMatchmakerMatched().Ticket = entry.Ticket
-
-		// Route outgoing message.
-		p.router.SendToPresenceIDs(logger, []*PresenceID{&PresenceID{Node: entry.Presence.Node, SessionID: entry.SessionID}}, false, 0, outgoing)
-	}
 }
 
 func (p *Pipeline) matchmakerRemove(logger *zap.Logger, session Session, envelope *rtapi.Envelope) {
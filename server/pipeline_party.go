@@ -0,0 +1,213 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
+	"go.uber.org/zap"
+)
+
+// Split a party ID into its unique ID and host node components.
+func parsePartyID(partyIDString string) (uuid.UUID, string, bool) {
+	partyIDComponents := strings.SplitN(partyIDString, ".", 2)
+	if len(partyIDComponents) != 2 || partyIDComponents[1] == "" {
+		return uuid.Nil, "", false
+	}
+	partyID, err := uuid.FromString(partyIDComponents[0])
+	if err != nil {
+		return uuid.Nil, "", false
+	}
+	return partyID, partyIDComponents[1], true
+}
+
+// Look up a party hosted on the current node.
+func (p *Pipeline) getParty(partyIDString string) *PartyHandler {
+	partyID, node, ok := parsePartyID(partyIDString)
+	if !ok || node != p.node {
+		return nil
+	}
+	return p.partyRegistry.Get(partyID)
+}
+
+func (p *Pipeline) partyCreate(logger *zap.Logger, session Session, envelope *rtapi.Envelope) {
+	incoming := envelope.GetPartyCreate()
+
+	if incoming.MaxSize < 0 {
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Invalid maximum size, must be >= 0",
+		}}})
+		return
+	}
+
+	handler, err := p.partyRegistry.Create(session, int(incoming.MaxSize))
+	if err != nil {
+		// Party creation can only fail if the session is gone, so no need to reply.
+		return
+	}
+
+	// The creator is the leader and only member of the new party.
+	self := &rtapi.UserPresence{
+		UserId:    session.UserID().String(),
+		SessionId: session.ID().String(),
+		Username:  session.Username(),
+	}
+	session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Party{Party: &rtapi.Party{
+		PartyId:   handler.IDStr,
+		MaxSize:   incoming.MaxSize,
+		Leader:    self,
+		Presences: []*rtapi.UserPresence{self},
+		Self:      self,
+	}}})
+}
+
+func (p *Pipeline) partyJoin(logger *zap.Logger, session Session, envelope *rtapi.Envelope) {
+	handler := p.getParty(envelope.GetPartyJoin().PartyId)
+	if handler == nil {
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_PARTY_NOT_FOUND),
+			Message: "Party not found",
+		}}})
+		return
+	}
+
+	party, err := handler.Join(session)
+	switch err {
+	case nil:
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Party{Party: party}})
+	case ErrPartyNotFound:
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_PARTY_NOT_FOUND),
+			Message: "Party not found",
+		}}})
+	case ErrPartyFull:
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_PARTY_JOIN_REJECTED),
+			Message: "Party is full",
+		}}})
+	default:
+		// Join can otherwise only fail if the session is gone, so no need to reply.
+	}
+}
+
+func (p *Pipeline) partyLeave(logger *zap.Logger, session Session, envelope *rtapi.Envelope) {
+	partyID, node, ok := parsePartyID(envelope.GetPartyLeave().PartyId)
+	if !ok {
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Invalid party ID",
+		}}})
+		return
+	}
+
+	// Check and drop the presence if possible, will always succeed.
+	p.tracker.Untrack(session.ID(), PresenceStream{Mode: StreamModeParty, Subject: partyID, Label: node}, session.UserID())
+
+	// Apply the leave immediately rather than waiting for the presence event, so leadership changes are visible right away.
+	if node == p.node {
+		p.partyRegistry.Leave(partyID, []*PresenceID{&PresenceID{Node: p.node, SessionID: session.ID()}})
+	}
+
+	session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid})
+}
+
+func (p *Pipeline) partyPromote(logger *zap.Logger, session Session, envelope *rtapi.Envelope) {
+	incoming := envelope.GetPartyPromote()
+
+	if incoming.Presence == nil {
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Presence to promote is required",
+		}}})
+		return
+	}
+
+	handler := p.getParty(incoming.PartyId)
+	if handler == nil {
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_PARTY_NOT_FOUND),
+			Message: "Party not found",
+		}}})
+		return
+	}
+
+	switch err := handler.Promote(session.ID(), incoming.Presence); err {
+	case nil:
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid})
+	case ErrPartyNotFound:
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_PARTY_NOT_FOUND),
+			Message: "Party not found",
+		}}})
+	case ErrPartyNotLeader:
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Only the party leader can promote a new leader",
+		}}})
+	default:
+		session.Send(false, 0, &rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Presence to promote is not a party member",
+		}}})
+	}
+}
+
+func (p *Pipeline) partyDataSend(logger *zap.Logger, session Session, envelope *rtapi.Envelope) {
+	incoming := envelope.GetPartyDataSend()
+
+	partyID, node, ok := parsePartyID(incoming.PartyId)
+	if !ok {
+		session.Send(false, 0, &rtapi.Envelope{Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Invalid party ID",
+		}}})
+		return
+	}
+
+	stream := PresenceStream{Mode: StreamModeParty, Subject: partyID, Label: node}
+	if p.tracker.GetLocalBySessionIDStreamUserID(session.ID(), stream, session.UserID()) == nil {
+		// User is not part of the party.
+		return
+	}
+
+	presenceIDs := p.tracker.ListPresenceIDByStream(stream)
+	for i := 0; i < len(presenceIDs); i++ {
+		if presenceIDs[i].SessionID == session.ID() {
+			// Don't echo back to sender.
+			presenceIDs[i] = presenceIDs[len(presenceIDs)-1]
+			presenceIDs = presenceIDs[:len(presenceIDs)-1]
+			break
+		}
+	}
+	if len(presenceIDs) == 0 {
+		return
+	}
+
+	outgoing := &rtapi.Envelope{Message: &rtapi.Envelope_PartyData{PartyData: &rtapi.PartyData{
+		PartyId: incoming.PartyId,
+		Presence: &rtapi.UserPresence{
+			UserId:    session.UserID().String(),
+			SessionId: session.ID().String(),
+			Username:  session.Username(),
+		},
+		OpCode: incoming.OpCode,
+		Data:   incoming.Data,
+	}}}
+
+	p.router.SendToPresenceIDs(logger, presenceIDs, true, StreamModeParty, outgoing, true)
+}
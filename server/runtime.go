@@ -46,7 +46,15 @@ type (
 	RuntimeAfterRtFunction  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, sessionID, clientIP, clientPort string, envelope *rtapi.Envelope) error
 
 	RuntimeBeforeGetAccountFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string) (error, codes.Code)
+	RuntimeBeforeExportAccountFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string) (error, codes.Code)
+	RuntimeBeforeRequestAccountErasureFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string) (error, codes.Code)
 	RuntimeAfterGetAccountFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Account) error
+	RuntimeAfterExportAccountFunction                      func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.AccountExportArchive) error
+	RuntimeAfterRequestAccountErasureFunction              func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.AccountErasure) error
+	RuntimeBeforeSendEmailVerificationFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string) (error, codes.Code)
+	RuntimeBeforeCancelAccountErasureFunction              func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string) (error, codes.Code)
+	RuntimeAfterSendEmailVerificationFunction              func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string) error
+	RuntimeAfterCancelAccountErasureFunction               func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string) error
 	RuntimeBeforeUpdateAccountFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.UpdateAccountRequest) (*api.UpdateAccountRequest, error, codes.Code)
 	RuntimeAfterUpdateAccountFunction                      func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.UpdateAccountRequest) error
 	RuntimeBeforeAuthenticateCustomFunction                func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AuthenticateCustomRequest) (*api.AuthenticateCustomRequest, error, codes.Code)
@@ -60,12 +68,26 @@ type (
 	RuntimeBeforeAuthenticateGameCenterFunction            func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AuthenticateGameCenterRequest) (*api.AuthenticateGameCenterRequest, error, codes.Code)
 	RuntimeAfterAuthenticateGameCenterFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateGameCenterRequest) error
 	RuntimeBeforeAuthenticateGoogleFunction                func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AuthenticateGoogleRequest) (*api.AuthenticateGoogleRequest, error, codes.Code)
+	RuntimeBeforeAuthenticateOidcFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AuthenticateOidcRequest) (*api.AuthenticateOidcRequest, error, codes.Code)
 	RuntimeAfterAuthenticateGoogleFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateGoogleRequest) error
+	RuntimeAfterAuthenticateOidcFunction                   func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateOidcRequest) error
 	RuntimeBeforeAuthenticateSteamFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AuthenticateSteamRequest) (*api.AuthenticateSteamRequest, error, codes.Code)
 	RuntimeAfterAuthenticateSteamFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateSteamRequest) error
+	RuntimeBeforeAuthenticateAppleFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AuthenticateAppleRequest) (*api.AuthenticateAppleRequest, error, codes.Code)
+	RuntimeAfterAuthenticateAppleFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateAppleRequest) error
+	RuntimeBeforeSessionRefreshFunction                    func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.SessionRefreshRequest) (*api.SessionRefreshRequest, error, codes.Code)
+	RuntimeAfterSessionRefreshFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.SessionRefreshRequest) error
+	RuntimeBeforeSessionLogoutFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.SessionLogoutRequest) (*api.SessionLogoutRequest, error, codes.Code)
+	RuntimeAfterSessionLogoutFunction                      func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.SessionLogoutRequest) error
+	RuntimeBeforeResetPasswordFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ResetPasswordRequest) (*api.ResetPasswordRequest, error, codes.Code)
+	RuntimeAfterResetPasswordFunction                      func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ResetPasswordRequest) error
+	RuntimeBeforeSendPasswordResetFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.SendPasswordResetRequest) (*api.SendPasswordResetRequest, error, codes.Code)
+	RuntimeAfterSendPasswordResetFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.SendPasswordResetRequest) error
+	RuntimeBeforeVerifyEmailFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.VerifyEmailRequest) (*api.VerifyEmailRequest, error, codes.Code)
+	RuntimeAfterVerifyEmailFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.VerifyEmailRequest) error
 	RuntimeBeforeListChannelMessagesFunction               func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListChannelMessagesRequest) (*api.ListChannelMessagesRequest, error, codes.Code)
 	RuntimeAfterListChannelMessagesFunction                func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.ChannelMessageList, in *api.ListChannelMessagesRequest) error
-	RuntimeBeforeListFriendsFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string) (error, codes.Code)
+	RuntimeBeforeListFriendsFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListFriendsRequest) (*api.ListFriendsRequest, error, codes.Code)
 	RuntimeAfterListFriendsFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Friends) error
 	RuntimeBeforeAddFriendsFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AddFriendsRequest) (*api.AddFriendsRequest, error, codes.Code)
 	RuntimeAfterAddFriendsFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AddFriendsRequest) error
@@ -75,10 +97,20 @@ type (
 	RuntimeAfterBlockFriendsFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.BlockFriendsRequest) error
 	RuntimeBeforeImportFacebookFriendsFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ImportFacebookFriendsRequest) (*api.ImportFacebookFriendsRequest, error, codes.Code)
 	RuntimeAfterImportFacebookFriendsFunction              func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ImportFacebookFriendsRequest) error
+	RuntimeBeforeListFriendSuggestionsFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListFriendSuggestionsRequest) (*api.ListFriendSuggestionsRequest, error, codes.Code)
+	RuntimeAfterListFriendSuggestionsFunction              func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.FriendSuggestionList) error
+	RuntimeBeforeListMutualFriendsFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListMutualFriendsRequest) (*api.ListMutualFriendsRequest, error, codes.Code)
+	RuntimeAfterListMutualFriendsFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Users) error
 	RuntimeBeforeCreateGroupFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.CreateGroupRequest) (*api.CreateGroupRequest, error, codes.Code)
 	RuntimeAfterCreateGroupFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Group, in *api.CreateGroupRequest) error
 	RuntimeBeforeUpdateGroupFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.UpdateGroupRequest) (*api.UpdateGroupRequest, error, codes.Code)
 	RuntimeAfterUpdateGroupFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.UpdateGroupRequest) error
+	RuntimeBeforeValidatePurchaseAppleFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ValidatePurchaseAppleRequest) (*api.ValidatePurchaseAppleRequest, error, codes.Code)
+	RuntimeAfterValidatePurchaseAppleFunction              func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.ValidatePurchaseResponse, in *api.ValidatePurchaseAppleRequest) error
+	RuntimeBeforeValidatePurchaseGoogleFunction            func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ValidatePurchaseGoogleRequest) (*api.ValidatePurchaseGoogleRequest, error, codes.Code)
+	RuntimeAfterValidatePurchaseGoogleFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.ValidatePurchaseResponse, in *api.ValidatePurchaseGoogleRequest) error
+	RuntimeBeforeValidatePurchaseHuaweiFunction            func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ValidatePurchaseHuaweiRequest) (*api.ValidatePurchaseHuaweiRequest, error, codes.Code)
+	RuntimeAfterValidatePurchaseHuaweiFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.ValidatePurchaseResponse, in *api.ValidatePurchaseHuaweiRequest) error
 	RuntimeBeforeDeleteGroupFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.DeleteGroupRequest) (*api.DeleteGroupRequest, error, codes.Code)
 	RuntimeAfterDeleteGroupFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.DeleteGroupRequest) error
 	RuntimeBeforeJoinGroupFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.JoinGroupRequest) (*api.JoinGroupRequest, error, codes.Code)
@@ -89,10 +121,14 @@ type (
 	RuntimeAfterAddGroupUsersFunction                      func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AddGroupUsersRequest) error
 	RuntimeBeforeKickGroupUsersFunction                    func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.KickGroupUsersRequest) (*api.KickGroupUsersRequest, error, codes.Code)
 	RuntimeAfterKickGroupUsersFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.KickGroupUsersRequest) error
+	RuntimeBeforeDeclineGroupJoinRequestsFunction          func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.DeclineGroupJoinRequestsRequest) (*api.DeclineGroupJoinRequestsRequest, error, codes.Code)
+	RuntimeAfterDeclineGroupJoinRequestsFunction           func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.DeclineGroupJoinRequestsRequest) error
 	RuntimeBeforePromoteGroupUsersFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.PromoteGroupUsersRequest) (*api.PromoteGroupUsersRequest, error, codes.Code)
 	RuntimeAfterPromoteGroupUsersFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.PromoteGroupUsersRequest) error
 	RuntimeBeforeListGroupUsersFunction                    func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListGroupUsersRequest) (*api.ListGroupUsersRequest, error, codes.Code)
 	RuntimeAfterListGroupUsersFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.GroupUserList, in *api.ListGroupUsersRequest) error
+	RuntimeBeforeListGroupJoinRequestsFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListGroupJoinRequestsRequest) (*api.ListGroupJoinRequestsRequest, error, codes.Code)
+	RuntimeAfterListGroupJoinRequestsFunction              func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.GroupUserList, in *api.ListGroupJoinRequestsRequest) error
 	RuntimeBeforeListUserGroupsFunction                    func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListUserGroupsRequest) (*api.ListUserGroupsRequest, error, codes.Code)
 	RuntimeAfterListUserGroupsFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.UserGroupList, in *api.ListUserGroupsRequest) error
 	RuntimeBeforeListGroupsFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListGroupsRequest) (*api.ListGroupsRequest, error, codes.Code)
@@ -116,13 +152,19 @@ type (
 	RuntimeBeforeLinkGameCenterFunction                    func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountGameCenter) (*api.AccountGameCenter, error, codes.Code)
 	RuntimeAfterLinkGameCenterFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountGameCenter) error
 	RuntimeBeforeLinkGoogleFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountGoogle) (*api.AccountGoogle, error, codes.Code)
+	RuntimeBeforeLinkOidcFunction                          func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountOidc) (*api.AccountOidc, error, codes.Code)
 	RuntimeAfterLinkGoogleFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountGoogle) error
+	RuntimeAfterLinkOidcFunction                           func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountOidc) error
 	RuntimeBeforeLinkSteamFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountSteam) (*api.AccountSteam, error, codes.Code)
 	RuntimeAfterLinkSteamFunction                          func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountSteam) error
+	RuntimeBeforeLinkAppleFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountApple) (*api.AccountApple, error, codes.Code)
+	RuntimeAfterLinkAppleFunction                          func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountApple) error
 	RuntimeBeforeListMatchesFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListMatchesRequest) (*api.ListMatchesRequest, error, codes.Code)
 	RuntimeAfterListMatchesFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.MatchList, in *api.ListMatchesRequest) error
 	RuntimeBeforeListNotificationsFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListNotificationsRequest) (*api.ListNotificationsRequest, error, codes.Code)
 	RuntimeAfterListNotificationsFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.NotificationList, in *api.ListNotificationsRequest) error
+	RuntimeBeforeListPurchasesFunction                     func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListPurchasesRequest) (*api.ListPurchasesRequest, error, codes.Code)
+	RuntimeAfterListPurchasesFunction                      func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.PurchaseList, in *api.ListPurchasesRequest) error
 	RuntimeBeforeDeleteNotificationFunction                func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.DeleteNotificationsRequest) (*api.DeleteNotificationsRequest, error, codes.Code)
 	RuntimeAfterDeleteNotificationFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.DeleteNotificationsRequest) error
 	RuntimeBeforeListStorageObjectsFunction                func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.ListStorageObjectsRequest) (*api.ListStorageObjectsRequest, error, codes.Code)
@@ -154,22 +196,32 @@ type (
 	RuntimeBeforeUnlinkGameCenterFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountGameCenter) (*api.AccountGameCenter, error, codes.Code)
 	RuntimeAfterUnlinkGameCenterFunction                   func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountGameCenter) error
 	RuntimeBeforeUnlinkGoogleFunction                      func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountGoogle) (*api.AccountGoogle, error, codes.Code)
+	RuntimeBeforeUnlinkOidcFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountOidc) (*api.AccountOidc, error, codes.Code)
 	RuntimeAfterUnlinkGoogleFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountGoogle) error
+	RuntimeAfterUnlinkOidcFunction                         func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountOidc) error
 	RuntimeBeforeUnlinkSteamFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountSteam) (*api.AccountSteam, error, codes.Code)
 	RuntimeAfterUnlinkSteamFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountSteam) error
+	RuntimeBeforeUnlinkAppleFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountApple) (*api.AccountApple, error, codes.Code)
+	RuntimeAfterUnlinkAppleFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.AccountApple) error
 	RuntimeBeforeGetUsersFunction                          func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, in *api.GetUsersRequest) (*api.GetUsersRequest, error, codes.Code)
 	RuntimeAfterGetUsersFunction                           func(ctx context.Context, logger *zap.Logger, userID, username string, expiry int64, clientIP, clientPort string, out *api.Users, in *api.GetUsersRequest) error
 
 	RuntimeMatchmakerMatchedFunction func(ctx context.Context, entries []*MatchmakerEntry) (string, bool, error)
 
+	RuntimeMatchmakerOverrideFunction func(ctx context.Context, candidates []*MatchmakerEntry) ([][]string, error)
+
 	RuntimeMatchCreateFunction       func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (RuntimeMatchCore, error)
 	RuntimeMatchDeferMessageFunction func(msg *DeferredMessage) error
+	// Sees every broadcast a match makes, which is only delivered if it returns true.
+	RuntimeMatchBroadcastListener func(presenceIDs []*PresenceID, envelope *rtapi.Envelope, reliable bool) bool
 
 	RuntimeTournamentEndFunction   func(ctx context.Context, tournament *api.Tournament, end, reset int64) error
 	RuntimeTournamentResetFunction func(ctx context.Context, tournament *api.Tournament, end, reset int64) error
 
 	RuntimeLeaderboardResetFunction func(ctx context.Context, leaderboard runtime.Leaderboard, reset int64) error
 
+	RuntimeEmailSendFunction func(ctx context.Context, message runtime.EmailMessage) error
+
 	RuntimeEventFunction func(ctx context.Context, logger runtime.Logger, evt *api.Event)
 
 	RuntimeEventSessionStartFunction func(userID, username string, expiry int64, sessionID, clientIP, clientPort string, evtTimeSec int64)
@@ -190,6 +242,8 @@ const (
 	RuntimeExecutionModeTournamentEnd
 	RuntimeExecutionModeTournamentReset
 	RuntimeExecutionModeLeaderboardReset
+	RuntimeExecutionModeMatchmakerOverride
+	RuntimeExecutionModeEmailSend
 )
 
 func (e RuntimeExecutionMode) String() string {
@@ -216,6 +270,10 @@ func (e RuntimeExecutionMode) String() string {
 		return "tournament_reset"
 	case RuntimeExecutionModeLeaderboardReset:
 		return "leaderboard_reset"
+	case RuntimeExecutionModeMatchmakerOverride:
+		return "matchmaker_override"
+	case RuntimeExecutionModeEmailSend:
+		return "email_send"
 	}
 
 	return ""
@@ -224,10 +282,18 @@ func (e RuntimeExecutionMode) String() string {
 type RuntimeMatchCore interface {
 	MatchInit(presenceList *MatchPresenceList, deferMessageFn RuntimeMatchDeferMessageFunction, params map[string]interface{}) (interface{}, int, error)
 	MatchJoinAttempt(tick int64, state interface{}, userID, sessionID uuid.UUID, username, node string, metadata map[string]string) (interface{}, bool, string, error)
+	MatchSpectatorJoinAttempt(tick int64, state interface{}, userID, sessionID uuid.UUID, username, node string, metadata map[string]string) (interface{}, bool, string, error)
 	MatchJoin(tick int64, state interface{}, joins []*MatchPresence) (interface{}, error)
 	MatchLeave(tick int64, state interface{}, leaves []*MatchPresence) (interface{}, error)
-	MatchLoop(tick int64, state interface{}, inputCh <-chan *MatchDataMessage) (interface{}, error)
+	MatchDisconnect(tick int64, state interface{}, disconnects []*MatchPresence) (interface{}, int, error)
+	MatchReconnect(tick int64, state interface{}, reconnects []*MatchPresence) (interface{}, error)
+	MatchLoop(tick int64, state interface{}, messages []*MatchDataMessage) (interface{}, error)
 	MatchTerminate(tick int64, state interface{}, graceSeconds int) (interface{}, error)
+	MatchSignal(tick int64, state interface{}, data string) (interface{}, string, error)
+	MatchSnapshot(tick int64, state interface{}) (string, error)
+	MatchRestore(presenceList *MatchPresenceList, deferMessageFn RuntimeMatchDeferMessageFunction, tick int64, snapshot string) (interface{}, int, error)
+	SetBroadcastListener(fn RuntimeMatchBroadcastListener)
+	SetSpectatorMessageFunction(fn RuntimeMatchDeferMessageFunction)
 	Label() string
 	Cancel()
 }
@@ -239,6 +305,10 @@ type RuntimeEventFunctions struct {
 
 type RuntimeBeforeReqFunctions struct {
 	beforeGetAccountFunction                        RuntimeBeforeGetAccountFunction
+	beforeExportAccountFunction                     RuntimeBeforeExportAccountFunction
+	beforeRequestAccountErasureFunction             RuntimeBeforeRequestAccountErasureFunction
+	beforeSendEmailVerificationFunction             RuntimeBeforeSendEmailVerificationFunction
+	beforeCancelAccountErasureFunction              RuntimeBeforeCancelAccountErasureFunction
 	beforeUpdateAccountFunction                     RuntimeBeforeUpdateAccountFunction
 	beforeAuthenticateCustomFunction                RuntimeBeforeAuthenticateCustomFunction
 	beforeAuthenticateDeviceFunction                RuntimeBeforeAuthenticateDeviceFunction
@@ -246,22 +316,36 @@ type RuntimeBeforeReqFunctions struct {
 	beforeAuthenticateFacebookFunction              RuntimeBeforeAuthenticateFacebookFunction
 	beforeAuthenticateGameCenterFunction            RuntimeBeforeAuthenticateGameCenterFunction
 	beforeAuthenticateGoogleFunction                RuntimeBeforeAuthenticateGoogleFunction
+	beforeAuthenticateOidcFunction                  RuntimeBeforeAuthenticateOidcFunction
 	beforeAuthenticateSteamFunction                 RuntimeBeforeAuthenticateSteamFunction
+	beforeAuthenticateAppleFunction                 RuntimeBeforeAuthenticateAppleFunction
+	beforeSessionRefreshFunction                    RuntimeBeforeSessionRefreshFunction
+	beforeSessionLogoutFunction                     RuntimeBeforeSessionLogoutFunction
+	beforeResetPasswordFunction                     RuntimeBeforeResetPasswordFunction
+	beforeSendPasswordResetFunction                 RuntimeBeforeSendPasswordResetFunction
+	beforeVerifyEmailFunction                       RuntimeBeforeVerifyEmailFunction
 	beforeListChannelMessagesFunction               RuntimeBeforeListChannelMessagesFunction
 	beforeListFriendsFunction                       RuntimeBeforeListFriendsFunction
 	beforeAddFriendsFunction                        RuntimeBeforeAddFriendsFunction
 	beforeDeleteFriendsFunction                     RuntimeBeforeDeleteFriendsFunction
 	beforeBlockFriendsFunction                      RuntimeBeforeBlockFriendsFunction
 	beforeImportFacebookFriendsFunction             RuntimeBeforeImportFacebookFriendsFunction
+	beforeListFriendSuggestionsFunction             RuntimeBeforeListFriendSuggestionsFunction
+	beforeListMutualFriendsFunction                 RuntimeBeforeListMutualFriendsFunction
 	beforeCreateGroupFunction                       RuntimeBeforeCreateGroupFunction
 	beforeUpdateGroupFunction                       RuntimeBeforeUpdateGroupFunction
+	beforeValidatePurchaseAppleFunction             RuntimeBeforeValidatePurchaseAppleFunction
+	beforeValidatePurchaseGoogleFunction            RuntimeBeforeValidatePurchaseGoogleFunction
+	beforeValidatePurchaseHuaweiFunction            RuntimeBeforeValidatePurchaseHuaweiFunction
 	beforeDeleteGroupFunction                       RuntimeBeforeDeleteGroupFunction
 	beforeJoinGroupFunction                         RuntimeBeforeJoinGroupFunction
 	beforeLeaveGroupFunction                        RuntimeBeforeLeaveGroupFunction
 	beforeAddGroupUsersFunction                     RuntimeBeforeAddGroupUsersFunction
 	beforeKickGroupUsersFunction                    RuntimeBeforeKickGroupUsersFunction
+	beforeDeclineGroupJoinRequestsFunction          RuntimeBeforeDeclineGroupJoinRequestsFunction
 	beforePromoteGroupUsersFunction                 RuntimeBeforePromoteGroupUsersFunction
 	beforeListGroupUsersFunction                    RuntimeBeforeListGroupUsersFunction
+	beforeListGroupJoinRequestsFunction             RuntimeBeforeListGroupJoinRequestsFunction
 	beforeListUserGroupsFunction                    RuntimeBeforeListUserGroupsFunction
 	beforeListGroupsFunction                        RuntimeBeforeListGroupsFunction
 	beforeDeleteLeaderboardRecordFunction           RuntimeBeforeDeleteLeaderboardRecordFunction
@@ -274,9 +358,12 @@ type RuntimeBeforeReqFunctions struct {
 	beforeLinkFacebookFunction                      RuntimeBeforeLinkFacebookFunction
 	beforeLinkGameCenterFunction                    RuntimeBeforeLinkGameCenterFunction
 	beforeLinkGoogleFunction                        RuntimeBeforeLinkGoogleFunction
+	beforeLinkOidcFunction                          RuntimeBeforeLinkOidcFunction
 	beforeLinkSteamFunction                         RuntimeBeforeLinkSteamFunction
+	beforeLinkAppleFunction                         RuntimeBeforeLinkAppleFunction
 	beforeListMatchesFunction                       RuntimeBeforeListMatchesFunction
 	beforeListNotificationsFunction                 RuntimeBeforeListNotificationsFunction
+	beforeListPurchasesFunction                     RuntimeBeforeListPurchasesFunction
 	beforeDeleteNotificationFunction                RuntimeBeforeDeleteNotificationFunction
 	beforeListStorageObjectsFunction                RuntimeBeforeListStorageObjectsFunction
 	beforeReadStorageObjectsFunction                RuntimeBeforeReadStorageObjectsFunction
@@ -293,12 +380,18 @@ type RuntimeBeforeReqFunctions struct {
 	beforeUnlinkFacebookFunction                    RuntimeBeforeUnlinkFacebookFunction
 	beforeUnlinkGameCenterFunction                  RuntimeBeforeUnlinkGameCenterFunction
 	beforeUnlinkGoogleFunction                      RuntimeBeforeUnlinkGoogleFunction
+	beforeUnlinkOidcFunction                        RuntimeBeforeUnlinkOidcFunction
 	beforeUnlinkSteamFunction                       RuntimeBeforeUnlinkSteamFunction
+	beforeUnlinkAppleFunction                       RuntimeBeforeUnlinkAppleFunction
 	beforeGetUsersFunction                          RuntimeBeforeGetUsersFunction
 }
 
 type RuntimeAfterReqFunctions struct {
 	afterGetAccountFunction                        RuntimeAfterGetAccountFunction
+	afterExportAccountFunction                     RuntimeAfterExportAccountFunction
+	afterRequestAccountErasureFunction             RuntimeAfterRequestAccountErasureFunction
+	afterSendEmailVerificationFunction             RuntimeAfterSendEmailVerificationFunction
+	afterCancelAccountErasureFunction              RuntimeAfterCancelAccountErasureFunction
 	afterUpdateAccountFunction                     RuntimeAfterUpdateAccountFunction
 	afterAuthenticateCustomFunction                RuntimeAfterAuthenticateCustomFunction
 	afterAuthenticateDeviceFunction                RuntimeAfterAuthenticateDeviceFunction
@@ -306,22 +399,36 @@ type RuntimeAfterReqFunctions struct {
 	afterAuthenticateFacebookFunction              RuntimeAfterAuthenticateFacebookFunction
 	afterAuthenticateGameCenterFunction            RuntimeAfterAuthenticateGameCenterFunction
 	afterAuthenticateGoogleFunction                RuntimeAfterAuthenticateGoogleFunction
+	afterAuthenticateOidcFunction                  RuntimeAfterAuthenticateOidcFunction
 	afterAuthenticateSteamFunction                 RuntimeAfterAuthenticateSteamFunction
+	afterAuthenticateAppleFunction                 RuntimeAfterAuthenticateAppleFunction
+	afterSessionRefreshFunction                    RuntimeAfterSessionRefreshFunction
+	afterSessionLogoutFunction                     RuntimeAfterSessionLogoutFunction
+	afterResetPasswordFunction                     RuntimeAfterResetPasswordFunction
+	afterSendPasswordResetFunction                 RuntimeAfterSendPasswordResetFunction
+	afterVerifyEmailFunction                       RuntimeAfterVerifyEmailFunction
 	afterListChannelMessagesFunction               RuntimeAfterListChannelMessagesFunction
 	afterListFriendsFunction                       RuntimeAfterListFriendsFunction
 	afterAddFriendsFunction                        RuntimeAfterAddFriendsFunction
 	afterDeleteFriendsFunction                     RuntimeAfterDeleteFriendsFunction
 	afterBlockFriendsFunction                      RuntimeAfterBlockFriendsFunction
 	afterImportFacebookFriendsFunction             RuntimeAfterImportFacebookFriendsFunction
+	afterListFriendSuggestionsFunction             RuntimeAfterListFriendSuggestionsFunction
+	afterListMutualFriendsFunction                 RuntimeAfterListMutualFriendsFunction
 	afterCreateGroupFunction                       RuntimeAfterCreateGroupFunction
 	afterUpdateGroupFunction                       RuntimeAfterUpdateGroupFunction
+	afterValidatePurchaseAppleFunction             RuntimeAfterValidatePurchaseAppleFunction
+	afterValidatePurchaseGoogleFunction            RuntimeAfterValidatePurchaseGoogleFunction
+	afterValidatePurchaseHuaweiFunction            RuntimeAfterValidatePurchaseHuaweiFunction
 	afterDeleteGroupFunction                       RuntimeAfterDeleteGroupFunction
 	afterJoinGroupFunction                         RuntimeAfterJoinGroupFunction
 	afterLeaveGroupFunction                        RuntimeAfterLeaveGroupFunction
 	afterAddGroupUsersFunction                     RuntimeAfterAddGroupUsersFunction
 	afterKickGroupUsersFunction                    RuntimeAfterKickGroupUsersFunction
+	afterDeclineGroupJoinRequestsFunction          RuntimeAfterDeclineGroupJoinRequestsFunction
 	afterPromoteGroupUsersFunction                 RuntimeAfterPromoteGroupUsersFunction
 	afterListGroupUsersFunction                    RuntimeAfterListGroupUsersFunction
+	afterListGroupJoinRequestsFunction             RuntimeAfterListGroupJoinRequestsFunction
 	afterListUserGroupsFunction                    RuntimeAfterListUserGroupsFunction
 	afterListGroupsFunction                        RuntimeAfterListGroupsFunction
 	afterDeleteLeaderboardRecordFunction           RuntimeAfterDeleteLeaderboardRecordFunction
@@ -334,9 +441,12 @@ type RuntimeAfterReqFunctions struct {
 	afterLinkFacebookFunction                      RuntimeAfterLinkFacebookFunction
 	afterLinkGameCenterFunction                    RuntimeAfterLinkGameCenterFunction
 	afterLinkGoogleFunction                        RuntimeAfterLinkGoogleFunction
+	afterLinkOidcFunction                          RuntimeAfterLinkOidcFunction
 	afterLinkSteamFunction                         RuntimeAfterLinkSteamFunction
+	afterLinkAppleFunction                         RuntimeAfterLinkAppleFunction
 	afterListMatchesFunction                       RuntimeAfterListMatchesFunction
 	afterListNotificationsFunction                 RuntimeAfterListNotificationsFunction
+	afterListPurchasesFunction                     RuntimeAfterListPurchasesFunction
 	afterDeleteNotificationFunction                RuntimeAfterDeleteNotificationFunction
 	afterListStorageObjectsFunction                RuntimeAfterListStorageObjectsFunction
 	afterReadStorageObjectsFunction                RuntimeAfterReadStorageObjectsFunction
@@ -353,7 +463,9 @@ type RuntimeAfterReqFunctions struct {
 	afterUnlinkFacebookFunction                    RuntimeAfterUnlinkFacebookFunction
 	afterUnlinkGameCenterFunction                  RuntimeAfterUnlinkGameCenterFunction
 	afterUnlinkGoogleFunction                      RuntimeAfterUnlinkGoogleFunction
+	afterUnlinkOidcFunction                        RuntimeAfterUnlinkOidcFunction
 	afterUnlinkSteamFunction                       RuntimeAfterUnlinkSteamFunction
+	afterUnlinkAppleFunction                       RuntimeAfterUnlinkAppleFunction
 	afterGetUsersFunction                          RuntimeAfterGetUsersFunction
 }
 
@@ -368,17 +480,20 @@ type Runtime struct {
 	beforeReqFunctions *RuntimeBeforeReqFunctions
 	afterReqFunctions  *RuntimeAfterReqFunctions
 
-	matchmakerMatchedFunction RuntimeMatchmakerMatchedFunction
+	matchmakerMatchedFunction  RuntimeMatchmakerMatchedFunction
+	matchmakerOverrideFunction RuntimeMatchmakerOverrideFunction
 
 	tournamentEndFunction   RuntimeTournamentEndFunction
 	tournamentResetFunction RuntimeTournamentResetFunction
 
 	leaderboardResetFunction RuntimeLeaderboardResetFunction
 
+	emailSendFunction RuntimeEmailSendFunction
+
 	eventFunctions *RuntimeEventFunctions
 }
 
-func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, tracker Tracker, streamManager StreamManager, router MessageRouter) (*Runtime, error) {
+func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, streamManager StreamManager, router MessageRouter) (*Runtime, error) {
 	runtimeConfig := config.GetRuntime()
 	startupLogger.Info("Initialising runtime", zap.String("path", runtimeConfig.Path))
 
@@ -407,13 +522,13 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	eventQueue := NewRuntimeEventQueue(logger, config)
 	startupLogger.Info("Runtime event queue processor started", zap.Int("size", config.GetRuntime().EventQueueSize), zap.Int("workers", config.GetRuntime().EventQueueWorkers))
 
-	goModules, goRpcFunctions, goBeforeRtFunctions, goAfterRtFunctions, goBeforeReqFunctions, goAfterReqFunctions, goMatchmakerMatchedFunction, goMatchCreateFn, goTournamentEndFunction, goTournamentResetFunction, goLeaderboardResetFunction, allEventFunctions, goSetMatchCreateFn, goMatchNamesListFn, err := NewRuntimeProviderGo(logger, startupLogger, db, config, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, matchRegistry, tracker, streamManager, router, runtimeConfig.Path, paths, eventQueue)
+	goModules, goRpcFunctions, goBeforeRtFunctions, goAfterRtFunctions, goBeforeReqFunctions, goAfterReqFunctions, goMatchmakerMatchedFunction, goMatchmakerOverrideFunction, goMatchCreateFn, goTournamentEndFunction, goTournamentResetFunction, goLeaderboardResetFunction, goEmailSendFunction, allEventFunctions, goSetMatchCreateFn, goMatchNamesListFn, err := NewRuntimeProviderGo(logger, startupLogger, db, config, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, matchRegistry, matchmaker, tracker, streamManager, router, runtimeConfig.Path, paths, eventQueue)
 	if err != nil {
 		startupLogger.Error("Error initialising Go runtime provider", zap.Error(err))
 		return nil, err
 	}
 
-	luaModules, luaRpcFunctions, luaBeforeRtFunctions, luaAfterRtFunctions, luaBeforeReqFunctions, luaAfterReqFunctions, luaMatchmakerMatchedFunction, allMatchCreateFn, luaTournamentEndFunction, luaTournamentResetFunction, luaLeaderboardResetFunction, err := NewRuntimeProviderLua(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, matchRegistry, tracker, streamManager, router, goMatchCreateFn, runtimeConfig.Path, paths)
+	luaModules, luaRpcFunctions, luaBeforeRtFunctions, luaAfterRtFunctions, luaBeforeReqFunctions, luaAfterReqFunctions, luaMatchmakerMatchedFunction, luaMatchmakerOverrideFunction, allMatchCreateFn, luaTournamentEndFunction, luaTournamentResetFunction, luaLeaderboardResetFunction, luaEmailSendFunction, err := NewRuntimeProviderLua(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, matchRegistry, matchmaker, tracker, streamManager, router, goMatchCreateFn, runtimeConfig.Path, paths)
 	if err != nil {
 		startupLogger.Error("Error initialising Lua runtime provider", zap.Error(err))
 		return nil, err
@@ -472,6 +587,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allBeforeReqFunctions.beforeGetAccountFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "getaccount"))
 	}
+	if allBeforeReqFunctions.beforeExportAccountFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "exportaccount"))
+	}
+	if allBeforeReqFunctions.beforeRequestAccountErasureFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "requestaccounterasure"))
+	}
+	if allBeforeReqFunctions.beforeSendEmailVerificationFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "sendemailverification"))
+	}
+	if allBeforeReqFunctions.beforeCancelAccountErasureFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "cancelaccounterasure"))
+	}
 	if allBeforeReqFunctions.beforeUpdateAccountFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "updateaccount"))
 	}
@@ -493,9 +620,30 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allBeforeReqFunctions.beforeAuthenticateGoogleFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if allBeforeReqFunctions.beforeAuthenticateOidcFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if allBeforeReqFunctions.beforeAuthenticateSteamFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if allBeforeReqFunctions.beforeAuthenticateAppleFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticateapple"))
+	}
+	if allBeforeReqFunctions.beforeSessionRefreshFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "sessionrefresh"))
+	}
+	if allBeforeReqFunctions.beforeSessionLogoutFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "sessionlogout"))
+	}
+	if allBeforeReqFunctions.beforeResetPasswordFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "resetpassword"))
+	}
+	if allBeforeReqFunctions.beforeSendPasswordResetFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "sendpasswordreset"))
+	}
+	if allBeforeReqFunctions.beforeVerifyEmailFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "verifyemail"))
+	}
 	if allBeforeReqFunctions.beforeListChannelMessagesFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listchannelmessages"))
 	}
@@ -514,12 +662,27 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allBeforeReqFunctions.beforeImportFacebookFriendsFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "importfacebookfriends"))
 	}
+	if allBeforeReqFunctions.beforeListFriendSuggestionsFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listfriendsuggestions"))
+	}
+	if allBeforeReqFunctions.beforeListMutualFriendsFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listmutualfriends"))
+	}
 	if allBeforeReqFunctions.beforeCreateGroupFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "creategroup"))
 	}
 	if allBeforeReqFunctions.beforeUpdateGroupFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "updategroup"))
 	}
+	if allBeforeReqFunctions.beforeValidatePurchaseAppleFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "validatepurchaseapple"))
+	}
+	if allBeforeReqFunctions.beforeValidatePurchaseGoogleFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "validatepurchasegoogle"))
+	}
+	if allBeforeReqFunctions.beforeValidatePurchaseHuaweiFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "validatepurchasehuawei"))
+	}
 	if allBeforeReqFunctions.beforeDeleteGroupFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "deletegroup"))
 	}
@@ -535,12 +698,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allBeforeReqFunctions.beforeKickGroupUsersFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "kickgroupusers"))
 	}
+	if allBeforeReqFunctions.beforeDeclineGroupJoinRequestsFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "declinegroupjoinrequests"))
+	}
 	if allBeforeReqFunctions.beforePromoteGroupUsersFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "promotegroupusers"))
 	}
 	if allBeforeReqFunctions.beforeListGroupUsersFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listgroupusers"))
 	}
+	if allBeforeReqFunctions.beforeListGroupJoinRequestsFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listgroupjoinrequests"))
+	}
 	if allBeforeReqFunctions.beforeListUserGroupsFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listusergroups"))
 	}
@@ -577,15 +746,24 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allBeforeReqFunctions.beforeLinkGoogleFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "linkgoogle"))
 	}
+	if allBeforeReqFunctions.beforeLinkOidcFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "linkoidc"))
+	}
 	if allBeforeReqFunctions.beforeLinkSteamFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "linksteam"))
 	}
+	if allBeforeReqFunctions.beforeLinkAppleFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "linkapple"))
+	}
 	if allBeforeReqFunctions.beforeListMatchesFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listmatches"))
 	}
 	if allBeforeReqFunctions.beforeListNotificationsFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listnotifications"))
 	}
+	if allBeforeReqFunctions.beforeListPurchasesFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listpurchases"))
+	}
 	if allBeforeReqFunctions.beforeDeleteNotificationFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "deletenotification"))
 	}
@@ -634,9 +812,15 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allBeforeReqFunctions.beforeUnlinkGoogleFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "unlinkgoogle"))
 	}
+	if allBeforeReqFunctions.beforeUnlinkOidcFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if allBeforeReqFunctions.beforeUnlinkSteamFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "unlinksteam"))
 	}
+	if allBeforeReqFunctions.beforeUnlinkAppleFunction != nil {
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "unlinkapple"))
+	}
 	if allBeforeReqFunctions.beforeGetUsersFunction != nil {
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "getusers"))
 	}
@@ -644,6 +828,22 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeGetAccountFunction = goBeforeReqFunctions.beforeGetAccountFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "getaccount"))
 	}
+	if goBeforeReqFunctions.beforeExportAccountFunction != nil {
+		allBeforeReqFunctions.beforeExportAccountFunction = goBeforeReqFunctions.beforeExportAccountFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "exportaccount"))
+	}
+	if goBeforeReqFunctions.beforeRequestAccountErasureFunction != nil {
+		allBeforeReqFunctions.beforeRequestAccountErasureFunction = goBeforeReqFunctions.beforeRequestAccountErasureFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "requestaccounterasure"))
+	}
+	if goBeforeReqFunctions.beforeSendEmailVerificationFunction != nil {
+		allBeforeReqFunctions.beforeSendEmailVerificationFunction = goBeforeReqFunctions.beforeSendEmailVerificationFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "sendemailverification"))
+	}
+	if goBeforeReqFunctions.beforeCancelAccountErasureFunction != nil {
+		allBeforeReqFunctions.beforeCancelAccountErasureFunction = goBeforeReqFunctions.beforeCancelAccountErasureFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "cancelaccounterasure"))
+	}
 	if goBeforeReqFunctions.beforeUpdateAccountFunction != nil {
 		allBeforeReqFunctions.beforeUpdateAccountFunction = goBeforeReqFunctions.beforeUpdateAccountFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "updateaccount"))
@@ -672,10 +872,38 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeAuthenticateGoogleFunction = goBeforeReqFunctions.beforeAuthenticateGoogleFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if goBeforeReqFunctions.beforeAuthenticateOidcFunction != nil {
+		allBeforeReqFunctions.beforeAuthenticateOidcFunction = goBeforeReqFunctions.beforeAuthenticateOidcFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if goBeforeReqFunctions.beforeAuthenticateSteamFunction != nil {
 		allBeforeReqFunctions.beforeAuthenticateSteamFunction = goBeforeReqFunctions.beforeAuthenticateSteamFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if goBeforeReqFunctions.beforeAuthenticateAppleFunction != nil {
+		allBeforeReqFunctions.beforeAuthenticateAppleFunction = goBeforeReqFunctions.beforeAuthenticateAppleFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticateapple"))
+	}
+	if goBeforeReqFunctions.beforeSessionRefreshFunction != nil {
+		allBeforeReqFunctions.beforeSessionRefreshFunction = goBeforeReqFunctions.beforeSessionRefreshFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "sessionrefresh"))
+	}
+	if goBeforeReqFunctions.beforeSessionLogoutFunction != nil {
+		allBeforeReqFunctions.beforeSessionLogoutFunction = goBeforeReqFunctions.beforeSessionLogoutFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "sessionlogout"))
+	}
+	if goBeforeReqFunctions.beforeResetPasswordFunction != nil {
+		allBeforeReqFunctions.beforeResetPasswordFunction = goBeforeReqFunctions.beforeResetPasswordFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "resetpassword"))
+	}
+	if goBeforeReqFunctions.beforeSendPasswordResetFunction != nil {
+		allBeforeReqFunctions.beforeSendPasswordResetFunction = goBeforeReqFunctions.beforeSendPasswordResetFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "sendpasswordreset"))
+	}
+	if goBeforeReqFunctions.beforeVerifyEmailFunction != nil {
+		allBeforeReqFunctions.beforeVerifyEmailFunction = goBeforeReqFunctions.beforeVerifyEmailFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "verifyemail"))
+	}
 	if goBeforeReqFunctions.beforeListChannelMessagesFunction != nil {
 		allBeforeReqFunctions.beforeListChannelMessagesFunction = goBeforeReqFunctions.beforeListChannelMessagesFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listchannelmessages"))
@@ -700,6 +928,14 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeImportFacebookFriendsFunction = goBeforeReqFunctions.beforeImportFacebookFriendsFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "importfacebookfriends"))
 	}
+	if goBeforeReqFunctions.beforeListFriendSuggestionsFunction != nil {
+		allBeforeReqFunctions.beforeListFriendSuggestionsFunction = goBeforeReqFunctions.beforeListFriendSuggestionsFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listfriendsuggestions"))
+	}
+	if goBeforeReqFunctions.beforeListMutualFriendsFunction != nil {
+		allBeforeReqFunctions.beforeListMutualFriendsFunction = goBeforeReqFunctions.beforeListMutualFriendsFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listmutualfriends"))
+	}
 	if goBeforeReqFunctions.beforeCreateGroupFunction != nil {
 		allBeforeReqFunctions.beforeCreateGroupFunction = goBeforeReqFunctions.beforeCreateGroupFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "creategroup"))
@@ -708,6 +944,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeUpdateGroupFunction = goBeforeReqFunctions.beforeUpdateGroupFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "updategroup"))
 	}
+	if goBeforeReqFunctions.beforeValidatePurchaseAppleFunction != nil {
+		allBeforeReqFunctions.beforeValidatePurchaseAppleFunction = goBeforeReqFunctions.beforeValidatePurchaseAppleFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "validatepurchaseapple"))
+	}
+	if goBeforeReqFunctions.beforeValidatePurchaseGoogleFunction != nil {
+		allBeforeReqFunctions.beforeValidatePurchaseGoogleFunction = goBeforeReqFunctions.beforeValidatePurchaseGoogleFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "validatepurchasegoogle"))
+	}
+	if goBeforeReqFunctions.beforeValidatePurchaseHuaweiFunction != nil {
+		allBeforeReqFunctions.beforeValidatePurchaseHuaweiFunction = goBeforeReqFunctions.beforeValidatePurchaseHuaweiFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "validatepurchasehuawei"))
+	}
 	if goBeforeReqFunctions.beforeDeleteGroupFunction != nil {
 		allBeforeReqFunctions.beforeDeleteGroupFunction = goBeforeReqFunctions.beforeDeleteGroupFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "deletegroup"))
@@ -728,6 +976,10 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeKickGroupUsersFunction = goBeforeReqFunctions.beforeKickGroupUsersFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "kickgroupusers"))
 	}
+	if goBeforeReqFunctions.beforeDeclineGroupJoinRequestsFunction != nil {
+		allBeforeReqFunctions.beforeDeclineGroupJoinRequestsFunction = goBeforeReqFunctions.beforeDeclineGroupJoinRequestsFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "declinegroupjoinrequests"))
+	}
 	if goBeforeReqFunctions.beforePromoteGroupUsersFunction != nil {
 		allBeforeReqFunctions.beforePromoteGroupUsersFunction = goBeforeReqFunctions.beforePromoteGroupUsersFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "promotegroupusers"))
@@ -736,6 +988,10 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeListGroupUsersFunction = goBeforeReqFunctions.beforeListGroupUsersFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listgroupusers"))
 	}
+	if goBeforeReqFunctions.beforeListGroupJoinRequestsFunction != nil {
+		allBeforeReqFunctions.beforeListGroupJoinRequestsFunction = goBeforeReqFunctions.beforeListGroupJoinRequestsFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listgroupjoinrequests"))
+	}
 	if goBeforeReqFunctions.beforeListUserGroupsFunction != nil {
 		allBeforeReqFunctions.beforeListUserGroupsFunction = goBeforeReqFunctions.beforeListUserGroupsFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listusergroups"))
@@ -784,10 +1040,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeLinkGoogleFunction = goBeforeReqFunctions.beforeLinkGoogleFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "linkgoogle"))
 	}
+	if goBeforeReqFunctions.beforeLinkOidcFunction != nil {
+		allBeforeReqFunctions.beforeLinkOidcFunction = goBeforeReqFunctions.beforeLinkOidcFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "linkoidc"))
+	}
 	if goBeforeReqFunctions.beforeLinkSteamFunction != nil {
 		allBeforeReqFunctions.beforeLinkSteamFunction = goBeforeReqFunctions.beforeLinkSteamFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "linksteam"))
 	}
+	if goBeforeReqFunctions.beforeLinkAppleFunction != nil {
+		allBeforeReqFunctions.beforeLinkAppleFunction = goBeforeReqFunctions.beforeLinkAppleFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "linkapple"))
+	}
 	if goBeforeReqFunctions.beforeListMatchesFunction != nil {
 		allBeforeReqFunctions.beforeListMatchesFunction = goBeforeReqFunctions.beforeListMatchesFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listmatches"))
@@ -796,6 +1060,10 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeListNotificationsFunction = goBeforeReqFunctions.beforeListNotificationsFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listnotifications"))
 	}
+	if goBeforeReqFunctions.beforeListPurchasesFunction != nil {
+		allBeforeReqFunctions.beforeListPurchasesFunction = goBeforeReqFunctions.beforeListPurchasesFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listpurchases"))
+	}
 	if goBeforeReqFunctions.beforeDeleteNotificationFunction != nil {
 		allBeforeReqFunctions.beforeDeleteNotificationFunction = goBeforeReqFunctions.beforeDeleteNotificationFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "deletenotification"))
@@ -860,10 +1128,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allBeforeReqFunctions.beforeUnlinkGoogleFunction = goBeforeReqFunctions.beforeUnlinkGoogleFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "unlinkgoogle"))
 	}
+	if goBeforeReqFunctions.beforeUnlinkOidcFunction != nil {
+		allBeforeReqFunctions.beforeUnlinkOidcFunction = goBeforeReqFunctions.beforeUnlinkOidcFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if goBeforeReqFunctions.beforeUnlinkSteamFunction != nil {
 		allBeforeReqFunctions.beforeUnlinkSteamFunction = goBeforeReqFunctions.beforeUnlinkSteamFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "unlinksteam"))
 	}
+	if goBeforeReqFunctions.beforeUnlinkAppleFunction != nil {
+		allBeforeReqFunctions.beforeUnlinkAppleFunction = goBeforeReqFunctions.beforeUnlinkAppleFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "unlinkapple"))
+	}
 	if goBeforeReqFunctions.beforeGetUsersFunction != nil {
 		allBeforeReqFunctions.beforeGetUsersFunction = goBeforeReqFunctions.beforeGetUsersFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "getusers"))
@@ -873,6 +1149,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allAfterReqFunctions.afterGetAccountFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "getaccount"))
 	}
+	if allAfterReqFunctions.afterExportAccountFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "exportaccount"))
+	}
+	if allAfterReqFunctions.afterRequestAccountErasureFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "requestaccounterasure"))
+	}
+	if allAfterReqFunctions.afterSendEmailVerificationFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "sendemailverification"))
+	}
+	if allAfterReqFunctions.afterCancelAccountErasureFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "cancelaccounterasure"))
+	}
 	if allAfterReqFunctions.afterUpdateAccountFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "updateaccount"))
 	}
@@ -894,9 +1182,30 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allAfterReqFunctions.afterAuthenticateGoogleFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if allAfterReqFunctions.afterAuthenticateOidcFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if allAfterReqFunctions.afterAuthenticateSteamFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if allAfterReqFunctions.afterAuthenticateAppleFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticateapple"))
+	}
+	if allAfterReqFunctions.afterSessionRefreshFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "sessionrefresh"))
+	}
+	if allAfterReqFunctions.afterSessionLogoutFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "sessionlogout"))
+	}
+	if allAfterReqFunctions.afterResetPasswordFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "resetpassword"))
+	}
+	if allAfterReqFunctions.afterSendPasswordResetFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "sendpasswordreset"))
+	}
+	if allAfterReqFunctions.afterVerifyEmailFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "verifyemail"))
+	}
 	if allAfterReqFunctions.afterListChannelMessagesFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listchannelmessages"))
 	}
@@ -915,12 +1224,27 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allAfterReqFunctions.afterImportFacebookFriendsFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "importfacebookfriends"))
 	}
+	if allAfterReqFunctions.afterListFriendSuggestionsFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listfriendsuggestions"))
+	}
+	if allAfterReqFunctions.afterListMutualFriendsFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listmutualfriends"))
+	}
 	if allAfterReqFunctions.afterCreateGroupFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "creategroup"))
 	}
 	if allAfterReqFunctions.afterUpdateGroupFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "updategroup"))
 	}
+	if allAfterReqFunctions.afterValidatePurchaseAppleFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "validatepurchaseapple"))
+	}
+	if allAfterReqFunctions.afterValidatePurchaseGoogleFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "validatepurchasegoogle"))
+	}
+	if allAfterReqFunctions.afterValidatePurchaseHuaweiFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "validatepurchasehuawei"))
+	}
 	if allAfterReqFunctions.afterDeleteGroupFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "deletegroup"))
 	}
@@ -936,12 +1260,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allAfterReqFunctions.afterKickGroupUsersFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "kickgroupusers"))
 	}
+	if allAfterReqFunctions.afterDeclineGroupJoinRequestsFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "declinegroupjoinrequests"))
+	}
 	if allAfterReqFunctions.afterPromoteGroupUsersFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "promotegroupusers"))
 	}
 	if allAfterReqFunctions.afterListGroupUsersFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listgroupusers"))
 	}
+	if allAfterReqFunctions.afterListGroupJoinRequestsFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listgroupjoinrequests"))
+	}
 	if allAfterReqFunctions.afterListUserGroupsFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listusergroups"))
 	}
@@ -978,15 +1308,24 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allAfterReqFunctions.afterLinkGoogleFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "linkgoogle"))
 	}
+	if allAfterReqFunctions.afterLinkOidcFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "linkoidc"))
+	}
 	if allAfterReqFunctions.afterLinkSteamFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "linksteam"))
 	}
+	if allAfterReqFunctions.afterLinkAppleFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "linkapple"))
+	}
 	if allAfterReqFunctions.afterListMatchesFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listmatches"))
 	}
 	if allAfterReqFunctions.afterListNotificationsFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listnotifications"))
 	}
+	if allAfterReqFunctions.afterListPurchasesFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listpurchases"))
+	}
 	if allAfterReqFunctions.afterDeleteNotificationFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "deletenotification"))
 	}
@@ -1035,9 +1374,15 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	if allAfterReqFunctions.afterUnlinkGoogleFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "unlinkgoogle"))
 	}
+	if allAfterReqFunctions.afterUnlinkOidcFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if allAfterReqFunctions.afterUnlinkSteamFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "unlinksteam"))
 	}
+	if allAfterReqFunctions.afterUnlinkAppleFunction != nil {
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "unlinkapple"))
+	}
 	if allAfterReqFunctions.afterGetUsersFunction != nil {
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "getusers"))
 	}
@@ -1045,6 +1390,22 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterGetAccountFunction = goAfterReqFunctions.afterGetAccountFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "getaccount"))
 	}
+	if goAfterReqFunctions.afterExportAccountFunction != nil {
+		allAfterReqFunctions.afterExportAccountFunction = goAfterReqFunctions.afterExportAccountFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "exportaccount"))
+	}
+	if goAfterReqFunctions.afterRequestAccountErasureFunction != nil {
+		allAfterReqFunctions.afterRequestAccountErasureFunction = goAfterReqFunctions.afterRequestAccountErasureFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "requestaccounterasure"))
+	}
+	if goAfterReqFunctions.afterSendEmailVerificationFunction != nil {
+		allAfterReqFunctions.afterSendEmailVerificationFunction = goAfterReqFunctions.afterSendEmailVerificationFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "sendemailverification"))
+	}
+	if goAfterReqFunctions.afterCancelAccountErasureFunction != nil {
+		allAfterReqFunctions.afterCancelAccountErasureFunction = goAfterReqFunctions.afterCancelAccountErasureFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "cancelaccounterasure"))
+	}
 	if goAfterReqFunctions.afterUpdateAccountFunction != nil {
 		allAfterReqFunctions.afterUpdateAccountFunction = goAfterReqFunctions.afterUpdateAccountFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "updateaccount"))
@@ -1073,10 +1434,38 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterAuthenticateGoogleFunction = goAfterReqFunctions.afterAuthenticateGoogleFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if goAfterReqFunctions.afterAuthenticateOidcFunction != nil {
+		allAfterReqFunctions.afterAuthenticateOidcFunction = goAfterReqFunctions.afterAuthenticateOidcFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if goAfterReqFunctions.afterAuthenticateSteamFunction != nil {
 		allAfterReqFunctions.afterAuthenticateSteamFunction = goAfterReqFunctions.afterAuthenticateSteamFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticatesteam"))
 	}
+	if goAfterReqFunctions.afterAuthenticateAppleFunction != nil {
+		allAfterReqFunctions.afterAuthenticateAppleFunction = goAfterReqFunctions.afterAuthenticateAppleFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticateapple"))
+	}
+	if goAfterReqFunctions.afterSessionRefreshFunction != nil {
+		allAfterReqFunctions.afterSessionRefreshFunction = goAfterReqFunctions.afterSessionRefreshFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "sessionrefresh"))
+	}
+	if goAfterReqFunctions.afterSessionLogoutFunction != nil {
+		allAfterReqFunctions.afterSessionLogoutFunction = goAfterReqFunctions.afterSessionLogoutFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "sessionlogout"))
+	}
+	if goAfterReqFunctions.afterResetPasswordFunction != nil {
+		allAfterReqFunctions.afterResetPasswordFunction = goAfterReqFunctions.afterResetPasswordFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "resetpassword"))
+	}
+	if goAfterReqFunctions.afterSendPasswordResetFunction != nil {
+		allAfterReqFunctions.afterSendPasswordResetFunction = goAfterReqFunctions.afterSendPasswordResetFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "sendpasswordreset"))
+	}
+	if goAfterReqFunctions.afterVerifyEmailFunction != nil {
+		allAfterReqFunctions.afterVerifyEmailFunction = goAfterReqFunctions.afterVerifyEmailFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "verifyemail"))
+	}
 	if goAfterReqFunctions.afterListChannelMessagesFunction != nil {
 		allAfterReqFunctions.afterListChannelMessagesFunction = goAfterReqFunctions.afterListChannelMessagesFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listchannelmessages"))
@@ -1101,6 +1490,14 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterImportFacebookFriendsFunction = goAfterReqFunctions.afterImportFacebookFriendsFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "importfacebookfriends"))
 	}
+	if goAfterReqFunctions.afterListFriendSuggestionsFunction != nil {
+		allAfterReqFunctions.afterListFriendSuggestionsFunction = goAfterReqFunctions.afterListFriendSuggestionsFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listfriendsuggestions"))
+	}
+	if goAfterReqFunctions.afterListMutualFriendsFunction != nil {
+		allAfterReqFunctions.afterListMutualFriendsFunction = goAfterReqFunctions.afterListMutualFriendsFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listmutualfriends"))
+	}
 	if goAfterReqFunctions.afterCreateGroupFunction != nil {
 		allAfterReqFunctions.afterCreateGroupFunction = goAfterReqFunctions.afterCreateGroupFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "creategroup"))
@@ -1109,6 +1506,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterUpdateGroupFunction = goAfterReqFunctions.afterUpdateGroupFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "updategroup"))
 	}
+	if goAfterReqFunctions.afterValidatePurchaseAppleFunction != nil {
+		allAfterReqFunctions.afterValidatePurchaseAppleFunction = goAfterReqFunctions.afterValidatePurchaseAppleFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "validatepurchaseapple"))
+	}
+	if goAfterReqFunctions.afterValidatePurchaseGoogleFunction != nil {
+		allAfterReqFunctions.afterValidatePurchaseGoogleFunction = goAfterReqFunctions.afterValidatePurchaseGoogleFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "validatepurchasegoogle"))
+	}
+	if goAfterReqFunctions.afterValidatePurchaseHuaweiFunction != nil {
+		allAfterReqFunctions.afterValidatePurchaseHuaweiFunction = goAfterReqFunctions.afterValidatePurchaseHuaweiFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "validatepurchasehuawei"))
+	}
 	if goAfterReqFunctions.afterDeleteGroupFunction != nil {
 		allAfterReqFunctions.afterDeleteGroupFunction = goAfterReqFunctions.afterDeleteGroupFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "deletegroup"))
@@ -1129,6 +1538,10 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterKickGroupUsersFunction = goAfterReqFunctions.afterKickGroupUsersFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "kickgroupusers"))
 	}
+	if goAfterReqFunctions.afterDeclineGroupJoinRequestsFunction != nil {
+		allAfterReqFunctions.afterDeclineGroupJoinRequestsFunction = goAfterReqFunctions.afterDeclineGroupJoinRequestsFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "declinegroupjoinrequests"))
+	}
 	if goAfterReqFunctions.afterPromoteGroupUsersFunction != nil {
 		allAfterReqFunctions.afterPromoteGroupUsersFunction = goAfterReqFunctions.afterPromoteGroupUsersFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "promotegroupusers"))
@@ -1137,6 +1550,10 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterListGroupUsersFunction = goAfterReqFunctions.afterListGroupUsersFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listgroupusers"))
 	}
+	if goAfterReqFunctions.afterListGroupJoinRequestsFunction != nil {
+		allAfterReqFunctions.afterListGroupJoinRequestsFunction = goAfterReqFunctions.afterListGroupJoinRequestsFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listgroupjoinrequests"))
+	}
 	if goAfterReqFunctions.afterListUserGroupsFunction != nil {
 		allAfterReqFunctions.afterListUserGroupsFunction = goAfterReqFunctions.afterListUserGroupsFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listusergroups"))
@@ -1185,10 +1602,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterLinkGoogleFunction = goAfterReqFunctions.afterLinkGoogleFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "linkgoogle"))
 	}
+	if goAfterReqFunctions.afterLinkOidcFunction != nil {
+		allAfterReqFunctions.afterLinkOidcFunction = goAfterReqFunctions.afterLinkOidcFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "linkoidc"))
+	}
 	if goAfterReqFunctions.afterLinkSteamFunction != nil {
 		allAfterReqFunctions.afterLinkSteamFunction = goAfterReqFunctions.afterLinkSteamFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "linksteam"))
 	}
+	if goAfterReqFunctions.afterLinkAppleFunction != nil {
+		allAfterReqFunctions.afterLinkAppleFunction = goAfterReqFunctions.afterLinkAppleFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "linkapple"))
+	}
 	if goAfterReqFunctions.afterListMatchesFunction != nil {
 		allAfterReqFunctions.afterListMatchesFunction = goAfterReqFunctions.afterListMatchesFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listmatches"))
@@ -1197,6 +1622,10 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterListNotificationsFunction = goAfterReqFunctions.afterListNotificationsFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listnotifications"))
 	}
+	if goAfterReqFunctions.afterListPurchasesFunction != nil {
+		allAfterReqFunctions.afterListPurchasesFunction = goAfterReqFunctions.afterListPurchasesFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listpurchases"))
+	}
 	if goAfterReqFunctions.afterDeleteNotificationFunction != nil {
 		allAfterReqFunctions.afterDeleteNotificationFunction = goAfterReqFunctions.afterDeleteNotificationFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "deletenotification"))
@@ -1261,10 +1690,18 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		allAfterReqFunctions.afterUnlinkGoogleFunction = goAfterReqFunctions.afterUnlinkGoogleFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "unlinkgoogle"))
 	}
+	if goAfterReqFunctions.afterUnlinkOidcFunction != nil {
+		allAfterReqFunctions.afterUnlinkOidcFunction = goAfterReqFunctions.afterUnlinkOidcFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "unlinkoidc"))
+	}
 	if goAfterReqFunctions.afterUnlinkSteamFunction != nil {
 		allAfterReqFunctions.afterUnlinkSteamFunction = goAfterReqFunctions.afterUnlinkSteamFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "unlinksteam"))
 	}
+	if goAfterReqFunctions.afterUnlinkAppleFunction != nil {
+		allAfterReqFunctions.afterUnlinkAppleFunction = goAfterReqFunctions.afterUnlinkAppleFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "unlinkapple"))
+	}
 	if goAfterReqFunctions.afterGetUsersFunction != nil {
 		allAfterReqFunctions.afterGetUsersFunction = goAfterReqFunctions.afterGetUsersFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "getusers"))
@@ -1280,6 +1717,16 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		startupLogger.Info("Registered Lua runtime Matchmaker Matched function invocation")
 	}
 
+	var allMatchmakerOverrideFunction RuntimeMatchmakerOverrideFunction
+	switch {
+	case goMatchmakerOverrideFunction != nil:
+		allMatchmakerOverrideFunction = goMatchmakerOverrideFunction
+		startupLogger.Info("Registered Go runtime Matchmaker Override function invocation")
+	case luaMatchmakerOverrideFunction != nil:
+		allMatchmakerOverrideFunction = luaMatchmakerOverrideFunction
+		startupLogger.Info("Registered Lua runtime Matchmaker Override function invocation")
+	}
+
 	var allTournamentEndFunction RuntimeTournamentEndFunction
 	switch {
 	case goTournamentEndFunction != nil:
@@ -1310,6 +1757,16 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 		startupLogger.Info("Registered Lua runtime Leaderboard Reset function invocation")
 	}
 
+	var allEmailSendFunction RuntimeEmailSendFunction
+	switch {
+	case goEmailSendFunction != nil:
+		allEmailSendFunction = goEmailSendFunction
+		startupLogger.Info("Registered Go runtime Email Send function invocation")
+	case luaEmailSendFunction != nil:
+		allEmailSendFunction = luaEmailSendFunction
+		startupLogger.Info("Registered Lua runtime Email Send function invocation")
+	}
+
 	// Lua matches are not registered the same, list only Go ones.
 	goMatchNames := goMatchNamesListFn()
 	for _, name := range goMatchNames {
@@ -1317,17 +1774,19 @@ func NewRuntime(logger, startupLogger *zap.Logger, db *sql.DB, jsonpbMarshaler *
 	}
 
 	return &Runtime{
-		matchCreateFunction:       allMatchCreateFn,
-		rpcFunctions:              allRpcFunctions,
-		beforeRtFunctions:         allBeforeRtFunctions,
-		afterRtFunctions:          allAfterRtFunctions,
-		beforeReqFunctions:        allBeforeReqFunctions,
-		afterReqFunctions:         allAfterReqFunctions,
-		matchmakerMatchedFunction: allMatchmakerMatchedFunction,
-		tournamentEndFunction:     allTournamentEndFunction,
-		tournamentResetFunction:   allTournamentResetFunction,
-		leaderboardResetFunction:  allLeaderboardResetFunction,
-		eventFunctions:            allEventFunctions,
+		matchCreateFunction:        allMatchCreateFn,
+		rpcFunctions:               allRpcFunctions,
+		beforeRtFunctions:          allBeforeRtFunctions,
+		afterRtFunctions:           allAfterRtFunctions,
+		beforeReqFunctions:         allBeforeReqFunctions,
+		afterReqFunctions:          allAfterReqFunctions,
+		matchmakerMatchedFunction:  allMatchmakerMatchedFunction,
+		matchmakerOverrideFunction: allMatchmakerOverrideFunction,
+		tournamentEndFunction:      allTournamentEndFunction,
+		tournamentResetFunction:    allTournamentResetFunction,
+		leaderboardResetFunction:   allLeaderboardResetFunction,
+		emailSendFunction:          allEmailSendFunction,
+		eventFunctions:             allEventFunctions,
 	}, nil
 }
 
@@ -1355,6 +1814,38 @@ func (r *Runtime) AfterGetAccount() RuntimeAfterGetAccountFunction {
 	return r.afterReqFunctions.afterGetAccountFunction
 }
 
+func (r *Runtime) BeforeExportAccount() RuntimeBeforeExportAccountFunction {
+	return r.beforeReqFunctions.beforeExportAccountFunction
+}
+
+func (r *Runtime) AfterExportAccount() RuntimeAfterExportAccountFunction {
+	return r.afterReqFunctions.afterExportAccountFunction
+}
+
+func (r *Runtime) BeforeRequestAccountErasure() RuntimeBeforeRequestAccountErasureFunction {
+	return r.beforeReqFunctions.beforeRequestAccountErasureFunction
+}
+
+func (r *Runtime) AfterRequestAccountErasure() RuntimeAfterRequestAccountErasureFunction {
+	return r.afterReqFunctions.afterRequestAccountErasureFunction
+}
+
+func (r *Runtime) BeforeSendEmailVerification() RuntimeBeforeSendEmailVerificationFunction {
+	return r.beforeReqFunctions.beforeSendEmailVerificationFunction
+}
+
+func (r *Runtime) AfterSendEmailVerification() RuntimeAfterSendEmailVerificationFunction {
+	return r.afterReqFunctions.afterSendEmailVerificationFunction
+}
+
+func (r *Runtime) BeforeCancelAccountErasure() RuntimeBeforeCancelAccountErasureFunction {
+	return r.beforeReqFunctions.beforeCancelAccountErasureFunction
+}
+
+func (r *Runtime) AfterCancelAccountErasure() RuntimeAfterCancelAccountErasureFunction {
+	return r.afterReqFunctions.afterCancelAccountErasureFunction
+}
+
 func (r *Runtime) BeforeUpdateAccount() RuntimeBeforeUpdateAccountFunction {
 	return r.beforeReqFunctions.beforeUpdateAccountFunction
 }
@@ -1407,10 +1898,18 @@ func (r *Runtime) BeforeAuthenticateGoogle() RuntimeBeforeAuthenticateGoogleFunc
 	return r.beforeReqFunctions.beforeAuthenticateGoogleFunction
 }
 
+func (r *Runtime) BeforeAuthenticateOidc() RuntimeBeforeAuthenticateOidcFunction {
+	return r.beforeReqFunctions.beforeAuthenticateOidcFunction
+}
+
 func (r *Runtime) AfterAuthenticateGoogle() RuntimeAfterAuthenticateGoogleFunction {
 	return r.afterReqFunctions.afterAuthenticateGoogleFunction
 }
 
+func (r *Runtime) AfterAuthenticateOidc() RuntimeAfterAuthenticateOidcFunction {
+	return r.afterReqFunctions.afterAuthenticateOidcFunction
+}
+
 func (r *Runtime) BeforeAuthenticateSteam() RuntimeBeforeAuthenticateSteamFunction {
 	return r.beforeReqFunctions.beforeAuthenticateSteamFunction
 }
@@ -1419,6 +1918,54 @@ func (r *Runtime) AfterAuthenticateSteam() RuntimeAfterAuthenticateSteamFunction
 	return r.afterReqFunctions.afterAuthenticateSteamFunction
 }
 
+func (r *Runtime) BeforeAuthenticateApple() RuntimeBeforeAuthenticateAppleFunction {
+	return r.beforeReqFunctions.beforeAuthenticateAppleFunction
+}
+
+func (r *Runtime) AfterAuthenticateApple() RuntimeAfterAuthenticateAppleFunction {
+	return r.afterReqFunctions.afterAuthenticateAppleFunction
+}
+
+func (r *Runtime) BeforeSessionRefresh() RuntimeBeforeSessionRefreshFunction {
+	return r.beforeReqFunctions.beforeSessionRefreshFunction
+}
+
+func (r *Runtime) AfterSessionRefresh() RuntimeAfterSessionRefreshFunction {
+	return r.afterReqFunctions.afterSessionRefreshFunction
+}
+
+func (r *Runtime) BeforeSessionLogout() RuntimeBeforeSessionLogoutFunction {
+	return r.beforeReqFunctions.beforeSessionLogoutFunction
+}
+
+func (r *Runtime) AfterSessionLogout() RuntimeAfterSessionLogoutFunction {
+	return r.afterReqFunctions.afterSessionLogoutFunction
+}
+
+func (r *Runtime) BeforeResetPassword() RuntimeBeforeResetPasswordFunction {
+	return r.beforeReqFunctions.beforeResetPasswordFunction
+}
+
+func (r *Runtime) AfterResetPassword() RuntimeAfterResetPasswordFunction {
+	return r.afterReqFunctions.afterResetPasswordFunction
+}
+
+func (r *Runtime) BeforeSendPasswordReset() RuntimeBeforeSendPasswordResetFunction {
+	return r.beforeReqFunctions.beforeSendPasswordResetFunction
+}
+
+func (r *Runtime) AfterSendPasswordReset() RuntimeAfterSendPasswordResetFunction {
+	return r.afterReqFunctions.afterSendPasswordResetFunction
+}
+
+func (r *Runtime) BeforeVerifyEmail() RuntimeBeforeVerifyEmailFunction {
+	return r.beforeReqFunctions.beforeVerifyEmailFunction
+}
+
+func (r *Runtime) AfterVerifyEmail() RuntimeAfterVerifyEmailFunction {
+	return r.afterReqFunctions.afterVerifyEmailFunction
+}
+
 func (r *Runtime) BeforeListChannelMessages() RuntimeBeforeListChannelMessagesFunction {
 	return r.beforeReqFunctions.beforeListChannelMessagesFunction
 }
@@ -1467,6 +2014,22 @@ func (r *Runtime) AfterImportFacebookFriends() RuntimeAfterImportFacebookFriends
 	return r.afterReqFunctions.afterImportFacebookFriendsFunction
 }
 
+func (r *Runtime) BeforeListFriendSuggestions() RuntimeBeforeListFriendSuggestionsFunction {
+	return r.beforeReqFunctions.beforeListFriendSuggestionsFunction
+}
+
+func (r *Runtime) AfterListFriendSuggestions() RuntimeAfterListFriendSuggestionsFunction {
+	return r.afterReqFunctions.afterListFriendSuggestionsFunction
+}
+
+func (r *Runtime) BeforeListMutualFriends() RuntimeBeforeListMutualFriendsFunction {
+	return r.beforeReqFunctions.beforeListMutualFriendsFunction
+}
+
+func (r *Runtime) AfterListMutualFriends() RuntimeAfterListMutualFriendsFunction {
+	return r.afterReqFunctions.afterListMutualFriendsFunction
+}
+
 func (r *Runtime) BeforeCreateGroup() RuntimeBeforeCreateGroupFunction {
 	return r.beforeReqFunctions.beforeCreateGroupFunction
 }
@@ -1483,6 +2046,30 @@ func (r *Runtime) AfterUpdateGroup() RuntimeAfterUpdateGroupFunction {
 	return r.afterReqFunctions.afterUpdateGroupFunction
 }
 
+func (r *Runtime) BeforeValidatePurchaseApple() RuntimeBeforeValidatePurchaseAppleFunction {
+	return r.beforeReqFunctions.beforeValidatePurchaseAppleFunction
+}
+
+func (r *Runtime) AfterValidatePurchaseApple() RuntimeAfterValidatePurchaseAppleFunction {
+	return r.afterReqFunctions.afterValidatePurchaseAppleFunction
+}
+
+func (r *Runtime) BeforeValidatePurchaseGoogle() RuntimeBeforeValidatePurchaseGoogleFunction {
+	return r.beforeReqFunctions.beforeValidatePurchaseGoogleFunction
+}
+
+func (r *Runtime) AfterValidatePurchaseGoogle() RuntimeAfterValidatePurchaseGoogleFunction {
+	return r.afterReqFunctions.afterValidatePurchaseGoogleFunction
+}
+
+func (r *Runtime) BeforeValidatePurchaseHuawei() RuntimeBeforeValidatePurchaseHuaweiFunction {
+	return r.beforeReqFunctions.beforeValidatePurchaseHuaweiFunction
+}
+
+func (r *Runtime) AfterValidatePurchaseHuawei() RuntimeAfterValidatePurchaseHuaweiFunction {
+	return r.afterReqFunctions.afterValidatePurchaseHuaweiFunction
+}
+
 func (r *Runtime) BeforeDeleteGroup() RuntimeBeforeDeleteGroupFunction {
 	return r.beforeReqFunctions.beforeDeleteGroupFunction
 }
@@ -1523,6 +2110,14 @@ func (r *Runtime) AfterKickGroupUsers() RuntimeAfterKickGroupUsersFunction {
 	return r.afterReqFunctions.afterKickGroupUsersFunction
 }
 
+func (r *Runtime) BeforeDeclineGroupJoinRequests() RuntimeBeforeDeclineGroupJoinRequestsFunction {
+	return r.beforeReqFunctions.beforeDeclineGroupJoinRequestsFunction
+}
+
+func (r *Runtime) AfterDeclineGroupJoinRequests() RuntimeAfterDeclineGroupJoinRequestsFunction {
+	return r.afterReqFunctions.afterDeclineGroupJoinRequestsFunction
+}
+
 func (r *Runtime) BeforePromoteGroupUsers() RuntimeBeforePromoteGroupUsersFunction {
 	return r.beforeReqFunctions.beforePromoteGroupUsersFunction
 }
@@ -1539,6 +2134,14 @@ func (r *Runtime) AfterListGroupUsers() RuntimeAfterListGroupUsersFunction {
 	return r.afterReqFunctions.afterListGroupUsersFunction
 }
 
+func (r *Runtime) BeforeListGroupJoinRequests() RuntimeBeforeListGroupJoinRequestsFunction {
+	return r.beforeReqFunctions.beforeListGroupJoinRequestsFunction
+}
+
+func (r *Runtime) AfterListGroupJoinRequests() RuntimeAfterListGroupJoinRequestsFunction {
+	return r.afterReqFunctions.afterListGroupJoinRequestsFunction
+}
+
 func (r *Runtime) BeforeListUserGroups() RuntimeBeforeListUserGroupsFunction {
 	return r.beforeReqFunctions.beforeListUserGroupsFunction
 }
@@ -1631,10 +2234,18 @@ func (r *Runtime) BeforeLinkGoogle() RuntimeBeforeLinkGoogleFunction {
 	return r.beforeReqFunctions.beforeLinkGoogleFunction
 }
 
+func (r *Runtime) BeforeLinkOidc() RuntimeBeforeLinkOidcFunction {
+	return r.beforeReqFunctions.beforeLinkOidcFunction
+}
+
 func (r *Runtime) AfterLinkGoogle() RuntimeAfterLinkGoogleFunction {
 	return r.afterReqFunctions.afterLinkGoogleFunction
 }
 
+func (r *Runtime) AfterLinkOidc() RuntimeAfterLinkOidcFunction {
+	return r.afterReqFunctions.afterLinkOidcFunction
+}
+
 func (r *Runtime) BeforeLinkSteam() RuntimeBeforeLinkSteamFunction {
 	return r.beforeReqFunctions.beforeLinkSteamFunction
 }
@@ -1643,6 +2254,14 @@ func (r *Runtime) AfterLinkSteam() RuntimeAfterLinkSteamFunction {
 	return r.afterReqFunctions.afterLinkSteamFunction
 }
 
+func (r *Runtime) BeforeLinkApple() RuntimeBeforeLinkAppleFunction {
+	return r.beforeReqFunctions.beforeLinkAppleFunction
+}
+
+func (r *Runtime) AfterLinkApple() RuntimeAfterLinkAppleFunction {
+	return r.afterReqFunctions.afterLinkAppleFunction
+}
+
 func (r *Runtime) BeforeListMatches() RuntimeBeforeListMatchesFunction {
 	return r.beforeReqFunctions.beforeListMatchesFunction
 }
@@ -1659,6 +2278,14 @@ func (r *Runtime) AfterListNotifications() RuntimeAfterListNotificationsFunction
 	return r.afterReqFunctions.afterListNotificationsFunction
 }
 
+func (r *Runtime) BeforeListPurchases() RuntimeBeforeListPurchasesFunction {
+	return r.beforeReqFunctions.beforeListPurchasesFunction
+}
+
+func (r *Runtime) AfterListPurchases() RuntimeAfterListPurchasesFunction {
+	return r.afterReqFunctions.afterListPurchasesFunction
+}
+
 func (r *Runtime) BeforeDeleteNotification() RuntimeBeforeDeleteNotificationFunction {
 	return r.beforeReqFunctions.beforeDeleteNotificationFunction
 }
@@ -1783,10 +2410,18 @@ func (r *Runtime) BeforeUnlinkGoogle() RuntimeBeforeUnlinkGoogleFunction {
 	return r.beforeReqFunctions.beforeUnlinkGoogleFunction
 }
 
+func (r *Runtime) BeforeUnlinkOidc() RuntimeBeforeUnlinkOidcFunction {
+	return r.beforeReqFunctions.beforeUnlinkOidcFunction
+}
+
 func (r *Runtime) AfterUnlinkGoogle() RuntimeAfterUnlinkGoogleFunction {
 	return r.afterReqFunctions.afterUnlinkGoogleFunction
 }
 
+func (r *Runtime) AfterUnlinkOidc() RuntimeAfterUnlinkOidcFunction {
+	return r.afterReqFunctions.afterUnlinkOidcFunction
+}
+
 func (r *Runtime) BeforeUnlinkSteam() RuntimeBeforeUnlinkSteamFunction {
 	return r.beforeReqFunctions.beforeUnlinkSteamFunction
 }
@@ -1795,6 +2430,14 @@ func (r *Runtime) AfterUnlinkSteam() RuntimeAfterUnlinkSteamFunction {
 	return r.afterReqFunctions.afterUnlinkSteamFunction
 }
 
+func (r *Runtime) BeforeUnlinkApple() RuntimeBeforeUnlinkAppleFunction {
+	return r.beforeReqFunctions.beforeUnlinkAppleFunction
+}
+
+func (r *Runtime) AfterUnlinkApple() RuntimeAfterUnlinkAppleFunction {
+	return r.afterReqFunctions.afterUnlinkAppleFunction
+}
+
 func (r *Runtime) BeforeGetUsers() RuntimeBeforeGetUsersFunction {
 	return r.beforeReqFunctions.beforeGetUsersFunction
 }
@@ -1807,6 +2450,10 @@ func (r *Runtime) MatchmakerMatched() RuntimeMatchmakerMatchedFunction {
 	return r.matchmakerMatchedFunction
 }
 
+func (r *Runtime) MatchmakerOverride() RuntimeMatchmakerOverrideFunction {
+	return r.matchmakerOverrideFunction
+}
+
 func (r *Runtime) TournamentEnd() RuntimeTournamentEndFunction {
 	return r.tournamentEndFunction
 }
@@ -1819,6 +2466,10 @@ func (r *Runtime) LeaderboardReset() RuntimeLeaderboardResetFunction {
 	return r.leaderboardResetFunction
 }
 
+func (r *Runtime) EmailSend() RuntimeEmailSendFunction {
+	return r.emailSendFunction
+}
+
 func (r *Runtime) EventSessionStart() RuntimeEventSessionStartFunction {
 	return r.eventFunctions.sessionStartFunction
 }
@@ -31,8 +31,10 @@ type RuntimeGoMatchCore struct {
 	matchRegistry MatchRegistry
 	router        MessageRouter
 
-	deferMessageFn RuntimeMatchDeferMessageFunction
-	presenceList   *MatchPresenceList
+	deferMessageFn     RuntimeMatchDeferMessageFunction
+	presenceList       *MatchPresenceList
+	broadcastListener  RuntimeMatchBroadcastListener
+	spectatorMessageFn RuntimeMatchDeferMessageFunction
 
 	match runtime.Match
 
@@ -95,6 +97,28 @@ func (r *RuntimeGoMatchCore) MatchInit(presenceList *MatchPresenceList, deferMes
 		return nil, 0, errors.New("MatchInit returned invalid tick rate, must be between 1 and 30")
 	}
 
+	return r.matchStarted(presenceList, deferMessageFn, state, tickRate, label)
+}
+
+func (r *RuntimeGoMatchCore) MatchRestore(presenceList *MatchPresenceList, deferMessageFn RuntimeMatchDeferMessageFunction, tick int64, snapshot string) (interface{}, int, error) {
+	snapshotter, ok := r.match.(runtime.MatchSnapshotter)
+	if !ok {
+		return nil, 0, errors.New("match does not implement MatchRestore")
+	}
+
+	state, tickRate, label := snapshotter.MatchRestore(r.ctx, r.runtimeLogger, r.db, r.nk, tick, snapshot)
+
+	if len(label) > 256 {
+		return nil, 0, errors.New("MatchRestore returned invalid label, must be 256 bytes or less")
+	}
+	if tickRate > 30 || tickRate < 1 {
+		return nil, 0, errors.New("MatchRestore returned invalid tick rate, must be between 1 and 30")
+	}
+
+	return r.matchStarted(presenceList, deferMessageFn, state, tickRate, label)
+}
+
+func (r *RuntimeGoMatchCore) matchStarted(presenceList *MatchPresenceList, deferMessageFn RuntimeMatchDeferMessageFunction, state interface{}, tickRate int, label string) (interface{}, int, error) {
 	if err := r.matchRegistry.UpdateMatchLabel(r.id, label); err != nil {
 		return nil, 0, err
 	}
@@ -121,6 +145,25 @@ func (r *RuntimeGoMatchCore) MatchJoinAttempt(tick int64, state interface{}, use
 	return newState, allow, reason, nil
 }
 
+func (r *RuntimeGoMatchCore) MatchSpectatorJoinAttempt(tick int64, state interface{}, userID, sessionID uuid.UUID, username, node string, metadata map[string]string) (interface{}, bool, string, error) {
+	spectator, ok := r.match.(runtime.MatchSpectator)
+	if !ok {
+		// Match does not support spectators.
+		return state, false, "Match does not allow spectators", nil
+	}
+
+	presence := &MatchPresence{
+		Node:      node,
+		UserID:    userID,
+		SessionID: sessionID,
+		Username:  username,
+		Spectator: true,
+	}
+
+	newState, allow, reason := spectator.MatchSpectatorJoinAttempt(r.ctx, r.runtimeLogger, r.db, r.nk, r, tick, state, presence, metadata)
+	return newState, allow, reason, nil
+}
+
 func (r *RuntimeGoMatchCore) MatchJoin(tick int64, state interface{}, joins []*MatchPresence) (interface{}, error) {
 	presences := make([]runtime.Presence, len(joins))
 	for i, join := range joins {
@@ -141,16 +184,45 @@ func (r *RuntimeGoMatchCore) MatchLeave(tick int64, state interface{}, leaves []
 	return newState, nil
 }
 
-func (r *RuntimeGoMatchCore) MatchLoop(tick int64, state interface{}, inputCh <-chan *MatchDataMessage) (interface{}, error) {
-	// Drain the input queue into a slice.
-	size := len(inputCh)
-	messages := make([]runtime.MatchData, size)
-	for i := 0; i < size; i++ {
-		msg := <-inputCh
-		messages[i] = runtime.MatchData(msg)
+func (r *RuntimeGoMatchCore) MatchDisconnect(tick int64, state interface{}, disconnects []*MatchPresence) (interface{}, int, error) {
+	reconnector, ok := r.match.(runtime.MatchReconnector)
+	if !ok {
+		// Match does not hold places for disconnected players.
+		return state, 0, nil
+	}
+
+	presences := make([]runtime.Presence, len(disconnects))
+	for i, disconnect := range disconnects {
+		presences[i] = runtime.Presence(disconnect)
+	}
+
+	newState, reconnectSeconds := reconnector.MatchDisconnect(r.ctx, r.runtimeLogger, r.db, r.nk, r, tick, state, presences)
+	return newState, reconnectSeconds, nil
+}
+
+func (r *RuntimeGoMatchCore) MatchReconnect(tick int64, state interface{}, reconnects []*MatchPresence) (interface{}, error) {
+	reconnector, ok := r.match.(runtime.MatchReconnector)
+	if !ok {
+		// Should not happen, places are only held for matches that support reconnects.
+		return state, nil
+	}
+
+	presences := make([]runtime.Presence, len(reconnects))
+	for i, reconnect := range reconnects {
+		presences[i] = runtime.Presence(reconnect)
+	}
+
+	newState := reconnector.MatchReconnect(r.ctx, r.runtimeLogger, r.db, r.nk, r, tick, state, presences)
+	return newState, nil
+}
+
+func (r *RuntimeGoMatchCore) MatchLoop(tick int64, state interface{}, messages []*MatchDataMessage) (interface{}, error) {
+	data := make([]runtime.MatchData, len(messages))
+	for i, msg := range messages {
+		data[i] = runtime.MatchData(msg)
 	}
 
-	newState := r.match.MatchLoop(r.ctx, r.runtimeLogger, r.db, r.nk, r, tick, state, messages)
+	newState := r.match.MatchLoop(r.ctx, r.runtimeLogger, r.db, r.nk, r, tick, state, data)
 	return newState, nil
 }
 
@@ -159,6 +231,35 @@ func (r *RuntimeGoMatchCore) MatchTerminate(tick int64, state interface{}, grace
 	return newState, nil
 }
 
+func (r *RuntimeGoMatchCore) MatchSignal(tick int64, state interface{}, data string) (interface{}, string, error) {
+	signaler, ok := r.match.(runtime.MatchSignaler)
+	if !ok {
+		// Match does not handle signals, the signal is ignored.
+		return state, "", nil
+	}
+
+	newState, result := signaler.MatchSignal(r.ctx, r.runtimeLogger, r.db, r.nk, r, tick, state, data)
+	return newState, result, nil
+}
+
+func (r *RuntimeGoMatchCore) MatchSnapshot(tick int64, state interface{}) (string, error) {
+	snapshotter, ok := r.match.(runtime.MatchSnapshotter)
+	if !ok {
+		// Match does not support snapshots.
+		return "", nil
+	}
+
+	return snapshotter.MatchSnapshot(r.ctx, r.runtimeLogger, r.db, r.nk, r, tick, state), nil
+}
+
+func (r *RuntimeGoMatchCore) SetBroadcastListener(fn RuntimeMatchBroadcastListener) {
+	r.broadcastListener = fn
+}
+
+func (r *RuntimeGoMatchCore) SetSpectatorMessageFunction(fn RuntimeMatchDeferMessageFunction) {
+	r.spectatorMessageFn = fn
+}
+
 func (r *RuntimeGoMatchCore) Label() string {
 	return r.label.Load()
 }
@@ -168,47 +269,124 @@ func (r *RuntimeGoMatchCore) Cancel() {
 }
 
 func (r *RuntimeGoMatchCore) BroadcastMessage(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence) error {
-	presenceIDs, msg, err := r.validateBroadcast(opCode, data, presences, sender)
+	presenceIDs, spectatorIDs, msg, err := r.validateBroadcast(runtime.MatchAudienceAll, opCode, data, presences, sender, true)
 	if err != nil {
 		return err
 	}
-	if len(presenceIDs) == 0 {
-		return nil
+	return r.broadcast(presenceIDs, spectatorIDs, msg, true, false)
+}
+
+func (r *RuntimeGoMatchCore) BroadcastMessageDeferred(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence) error {
+	presenceIDs, spectatorIDs, msg, err := r.validateBroadcast(runtime.MatchAudienceAll, opCode, data, presences, sender, true)
+	if err != nil {
+		return err
 	}
+	return r.broadcast(presenceIDs, spectatorIDs, msg, true, true)
+}
 
-	r.router.SendToPresenceIDs(r.logger, presenceIDs, true, StreamModeMatchAuthoritative, msg)
+func (r *RuntimeGoMatchCore) BroadcastMessageUnreliable(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence) error {
+	presenceIDs, spectatorIDs, msg, err := r.validateBroadcast(runtime.MatchAudienceAll, opCode, data, presences, sender, false)
+	if err != nil {
+		return err
+	}
+	return r.broadcast(presenceIDs, spectatorIDs, msg, false, false)
+}
 
-	return nil
+func (r *RuntimeGoMatchCore) BroadcastMessageUnreliableDeferred(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence) error {
+	presenceIDs, spectatorIDs, msg, err := r.validateBroadcast(runtime.MatchAudienceAll, opCode, data, presences, sender, false)
+	if err != nil {
+		return err
+	}
+	return r.broadcast(presenceIDs, spectatorIDs, msg, false, true)
 }
 
-func (r *RuntimeGoMatchCore) BroadcastMessageDeferred(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence) error {
-	presenceIDs, msg, err := r.validateBroadcast(opCode, data, presences, sender)
+func (r *RuntimeGoMatchCore) BroadcastMessageToAudience(audience runtime.MatchAudience, opCode int64, data []byte, sender runtime.Presence) error {
+	presenceIDs, spectatorIDs, msg, err := r.validateBroadcast(audience, opCode, data, nil, sender, true)
+	if err != nil {
+		return err
+	}
+	return r.broadcast(presenceIDs, spectatorIDs, msg, true, false)
+}
+
+func (r *RuntimeGoMatchCore) BroadcastMessageToAudienceDeferred(audience runtime.MatchAudience, opCode int64, data []byte, sender runtime.Presence) error {
+	presenceIDs, spectatorIDs, msg, err := r.validateBroadcast(audience, opCode, data, nil, sender, true)
 	if err != nil {
 		return err
 	}
+	return r.broadcast(presenceIDs, spectatorIDs, msg, true, true)
+}
+
+func (r *RuntimeGoMatchCore) BroadcastMessageToAudienceUnreliable(audience runtime.MatchAudience, opCode int64, data []byte, sender runtime.Presence) error {
+	presenceIDs, spectatorIDs, msg, err := r.validateBroadcast(audience, opCode, data, nil, sender, false)
+	if err != nil {
+		return err
+	}
+	return r.broadcast(presenceIDs, spectatorIDs, msg, false, false)
+}
+
+func (r *RuntimeGoMatchCore) BroadcastMessageToAudienceUnreliableDeferred(audience runtime.MatchAudience, opCode int64, data []byte, sender runtime.Presence) error {
+	presenceIDs, spectatorIDs, msg, err := r.validateBroadcast(audience, opCode, data, nil, sender, false)
+	if err != nil {
+		return err
+	}
+	return r.broadcast(presenceIDs, spectatorIDs, msg, false, true)
+}
+
+func (r *RuntimeGoMatchCore) broadcast(presenceIDs, spectatorIDs []*PresenceID, msg *rtapi.Envelope, reliable, deferred bool) error {
+	if len(presenceIDs) == 0 && len(spectatorIDs) == 0 {
+		return nil
+	}
+	if r.broadcastListener != nil {
+		allPresenceIDs := presenceIDs
+		if len(spectatorIDs) != 0 {
+			allPresenceIDs = make([]*PresenceID, 0, len(presenceIDs)+len(spectatorIDs))
+			allPresenceIDs = append(append(allPresenceIDs, presenceIDs...), spectatorIDs...)
+		}
+		if !r.broadcastListener(allPresenceIDs, msg, reliable) {
+			return nil
+		}
+	}
+
+	// Spectators may see broadcasts later than players, so their copy is always handed off separately.
+	if len(spectatorIDs) != 0 {
+		if r.spectatorMessageFn != nil {
+			if err := r.spectatorMessageFn(&DeferredMessage{PresenceIDs: spectatorIDs, Envelope: msg, Reliable: reliable}); err != nil {
+				return err
+			}
+		} else {
+			r.router.SendToPresenceIDs(r.logger, spectatorIDs, true, StreamModeMatchAuthoritative, msg, reliable)
+		}
+	}
 	if len(presenceIDs) == 0 {
 		return nil
 	}
 
-	return r.deferMessageFn(&DeferredMessage{
-		PresenceIDs: presenceIDs,
-		Envelope:    msg,
-	})
+	if deferred {
+		return r.deferMessageFn(&DeferredMessage{
+			PresenceIDs: presenceIDs,
+			Envelope:    msg,
+			Reliable:    reliable,
+		})
+	}
+	r.router.SendToPresenceIDs(r.logger, presenceIDs, true, StreamModeMatchAuthoritative, msg, reliable)
+	return nil
 }
 
-func (r *RuntimeGoMatchCore) validateBroadcast(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence) ([]*PresenceID, *rtapi.Envelope, error) {
+// Returns the player and spectator recipients of a broadcast separately. Specific presences may be players or spectators,
+// otherwise the audience decides who receives it.
+func (r *RuntimeGoMatchCore) validateBroadcast(audience runtime.MatchAudience, opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence, reliable bool) ([]*PresenceID, []*PresenceID, *rtapi.Envelope, error) {
 	var presenceIDs []*PresenceID
 	if presences != nil {
 		size := len(presences)
 		if size == 0 {
-			return nil, nil, nil
+			return nil, nil, nil, nil
 		}
 
 		presenceIDs = make([]*PresenceID, size)
 		for i, presence := range presences {
 			sessionID, err := uuid.FromString(presence.GetSessionId())
 			if err != nil {
-				return nil, nil, errors.New("Presence contains an invalid Session ID")
+				return nil, nil, nil, errors.New("Presence contains an invalid Session ID")
 			}
 
 			presenceIDs[i] = &PresenceID{
@@ -223,13 +401,13 @@ func (r *RuntimeGoMatchCore) validateBroadcast(opCode int64, data []byte, presen
 		uid := sender.GetUserId()
 		_, err := uuid.FromString(uid)
 		if err != nil {
-			return nil, nil, errors.New("Sender contains an invalid User ID")
+			return nil, nil, nil, errors.New("Sender contains an invalid User ID")
 		}
 
 		sid := sender.GetSessionId()
 		_, err = uuid.FromString(sid)
 		if err != nil {
-			return nil, nil, errors.New("Sender contains an invalid Session ID")
+			return nil, nil, nil, errors.New("Sender contains an invalid Session ID")
 		}
 
 		presence = &rtapi.UserPresence{
@@ -239,17 +417,22 @@ func (r *RuntimeGoMatchCore) validateBroadcast(opCode int64, data []byte, presen
 		}
 	}
 
+	var spectatorIDs []*PresenceID
 	if presenceIDs != nil {
 		// Ensure specific presences actually exist to prevent sending bogus messages to arbitrary users.
 		if len(presenceIDs) == 1 {
 			// Shorter validation cycle if there is only one intended recipient.
 			_, err := uuid.FromString(presences[0].GetUserId())
 			if err != nil {
-				return nil, nil, errors.New("Presence contains an invalid User ID")
+				return nil, nil, nil, errors.New("Presence contains an invalid User ID")
 			}
 			if !r.presenceList.Contains(presenceIDs[0]) {
-				// The one intended recipient is not a match member.
-				return nil, nil, nil
+				if !r.presenceList.ContainsSpectator(presenceIDs[0]) {
+					// The one intended recipient is not a match member.
+					return nil, nil, nil, nil
+				}
+				spectatorIDs = presenceIDs
+				presenceIDs = nil
 			}
 		} else {
 			// Validate multiple filtered recipients.
@@ -267,15 +450,18 @@ func (r *RuntimeGoMatchCore) validateBroadcast(opCode int64, data []byte, presen
 					}
 				}
 				if !found {
+					if r.presenceList.ContainsSpectator(presenceID) {
+						spectatorIDs = append(spectatorIDs, presenceID)
+					}
 					// If this presence wasn't in the filters, it's not needed.
 					presenceIDs[i] = presenceIDs[len(presenceIDs)-1]
 					presenceIDs = presenceIDs[:len(presenceIDs)-1]
 					i--
 				}
 			}
-			if len(presenceIDs) == 0 {
+			if len(presenceIDs) == 0 && len(spectatorIDs) == 0 {
 				// None of the target presenceIDs existed in the list of match members.
-				return nil, nil, nil
+				return nil, nil, nil, nil
 			}
 		}
 	}
@@ -285,13 +471,19 @@ func (r *RuntimeGoMatchCore) validateBroadcast(opCode int64, data []byte, presen
 		Presence: presence,
 		OpCode:   opCode,
 		Data:     data,
+		Reliable: reliable,
 	}}}
 
-	if presenceIDs == nil {
-		presenceIDs = r.presenceList.List()
+	if presences == nil {
+		if audience != runtime.MatchAudienceSpectators {
+			presenceIDs = r.presenceList.List()
+		}
+		if audience != runtime.MatchAudiencePlayers {
+			spectatorIDs = r.presenceList.ListSpectators()
+		}
 	}
 
-	return presenceIDs, msg, nil
+	return presenceIDs, spectatorIDs, msg, nil
 }
 
 func (r *RuntimeGoMatchCore) MatchKick(presences []runtime.Presence) error {
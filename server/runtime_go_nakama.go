@@ -48,7 +48,9 @@ type RuntimeGoNakamaModule struct {
 	leaderboardRankCache LeaderboardRankCache
 	leaderboardScheduler LeaderboardScheduler
 	sessionRegistry      SessionRegistry
+	sessionCache         SessionCache
 	matchRegistry        MatchRegistry
+	matchmaker           Matchmaker
 	tracker              Tracker
 	streamManager        StreamManager
 	router               MessageRouter
@@ -58,7 +60,7 @@ type RuntimeGoNakamaModule struct {
 	matchCreateFn RuntimeMatchCreateFunction
 }
 
-func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, tracker Tracker, streamManager StreamManager, router MessageRouter) *RuntimeGoNakamaModule {
+func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, streamManager StreamManager, router MessageRouter) *RuntimeGoNakamaModule {
 	return &RuntimeGoNakamaModule{
 		logger:               logger,
 		db:                   db,
@@ -68,7 +70,9 @@ func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, config Config, soc
 		leaderboardRankCache: leaderboardRankCache,
 		leaderboardScheduler: leaderboardScheduler,
 		sessionRegistry:      sessionRegistry,
+		sessionCache:         sessionCache,
 		matchRegistry:        matchRegistry,
+		matchmaker:           matchmaker,
 		tracker:              tracker,
 		streamManager:        streamManager,
 		router:               router,
@@ -225,6 +229,46 @@ func (n *RuntimeGoNakamaModule) AuthenticateGoogle(ctx context.Context, token, u
 	return AuthenticateGoogle(ctx, n.logger, n.db, n.socialClient, token, username, create)
 }
 
+func (n *RuntimeGoNakamaModule) AuthenticateApple(ctx context.Context, token, username string, create bool) (string, string, bool, error) {
+	if n.config.GetSocial().Apple.BundleID == "" {
+		return "", "", false, errors.New("Apple authentication is not configured")
+	}
+
+	if token == "" {
+		return "", "", false, errors.New("expects token string")
+	}
+
+	if username == "" {
+		username = generateUsername()
+	} else if invalidCharsRegex.MatchString(username) {
+		return "", "", false, errors.New("expects username to be valid, no spaces or control characters allowed")
+	} else if len(username) > 128 {
+		return "", "", false, errors.New("expects id to be valid, must be 1-128 bytes")
+	}
+
+	return AuthenticateApple(ctx, n.logger, n.db, n.socialClient, n.config.GetSocial().Apple.BundleID, token, username, create)
+}
+
+func (n *RuntimeGoNakamaModule) AuthenticateOidc(ctx context.Context, provider, token, username string, create bool) (string, string, bool, error) {
+	if provider == "" {
+		return "", "", false, errors.New("expects provider string")
+	}
+
+	if token == "" {
+		return "", "", false, errors.New("expects token string")
+	}
+
+	if username == "" {
+		username = generateUsername()
+	} else if invalidCharsRegex.MatchString(username) {
+		return "", "", false, errors.New("expects username to be valid, no spaces or control characters allowed")
+	} else if len(username) > 128 {
+		return "", "", false, errors.New("expects id to be valid, must be 1-128 bytes")
+	}
+
+	return AuthenticateOidc(ctx, n.logger, n.db, n.socialClient, n.config, provider, token, username, create)
+}
+
 func (n *RuntimeGoNakamaModule) AuthenticateSteam(ctx context.Context, token, username string, create bool) (string, string, bool, error) {
 	if n.config.GetSocial().Steam.PublisherKey == "" || n.config.GetSocial().Steam.AppID == 0 {
 		return "", "", false, errors.New("Steam authentication is not configured")
@@ -338,6 +382,32 @@ func (n *RuntimeGoNakamaModule) AccountDeleteId(ctx context.Context, userID stri
 	return DeleteAccount(ctx, n.logger, n.db, u, recorded)
 }
 
+func (n *RuntimeGoNakamaModule) AccountMergeId(ctx context.Context, sourceUserID, targetUserID string, options *runtime.AccountMergeOptions) error {
+	sourceID, err := uuid.FromString(sourceUserID)
+	if err != nil {
+		return errors.New("expects source user ID to be a valid identifier")
+	}
+	targetID, err := uuid.FromString(targetUserID)
+	if err != nil {
+		return errors.New("expects target user ID to be a valid identifier")
+	}
+
+	mergeOptions := &AccountMergeOptions{}
+	if options != nil {
+		if mergeOptions.Identities, err = ParseAccountMergeConflict(options.Identities); err != nil {
+			return err
+		}
+		if mergeOptions.Storage, err = ParseAccountMergeConflict(options.Storage); err != nil {
+			return err
+		}
+		if mergeOptions.Leaderboard, err = ParseAccountMergeConflict(options.Leaderboard); err != nil {
+			return err
+		}
+	}
+
+	return MergeAccounts(ctx, n.logger, n.db, n.sessionCache, n.leaderboardCache, n.leaderboardRankCache, sourceID, targetID, mergeOptions)
+}
+
 func (n *RuntimeGoNakamaModule) UsersGetId(ctx context.Context, userIDs []string) ([]*api.User, error) {
 	if len(userIDs) == 0 {
 		return make([]*api.User, 0), nil
@@ -387,7 +457,7 @@ func (n *RuntimeGoNakamaModule) UsersBanId(ctx context.Context, userIDs []string
 		}
 	}
 
-	return BanUsers(ctx, n.logger, n.db, userIDs)
+	return BanUsers(ctx, n.logger, n.db, n.sessionCache, userIDs)
 }
 
 func (n *RuntimeGoNakamaModule) UsersUnbanId(ctx context.Context, userIDs []string) error {
@@ -735,7 +805,7 @@ func (n *RuntimeGoNakamaModule) StreamSend(mode uint8, subject, subcontext, labe
 		n.router.SendToStream(n.logger, stream, msg)
 	} else {
 		// Sending to a subset of stream users.
-		n.router.SendToPresenceIDs(n.logger, presenceIDs, true, stream.Mode, msg)
+		n.router.SendToPresenceIDs(n.logger, presenceIDs, true, stream.Mode, msg, true)
 	}
 
 	return nil
@@ -788,7 +858,7 @@ func (n *RuntimeGoNakamaModule) StreamSendRaw(mode uint8, subject, subcontext, l
 		n.router.SendToStream(n.logger, stream, msg)
 	} else {
 		// Sending to a subset of stream users.
-		n.router.SendToPresenceIDs(n.logger, presenceIDs, true, stream.Mode, msg)
+		n.router.SendToPresenceIDs(n.logger, presenceIDs, true, stream.Mode, msg, true)
 	}
 
 	return nil
@@ -807,6 +877,15 @@ func (n *RuntimeGoNakamaModule) SessionDisconnect(ctx context.Context, sessionID
 	return n.sessionRegistry.Disconnect(ctx, sid, node)
 }
 
+func (n *RuntimeGoNakamaModule) SessionLogout(ctx context.Context, userID, token, refreshToken string) error {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return errors.New("expects valid user id")
+	}
+
+	return SessionLogout(ctx, n.logger, n.db, n.config, n.sessionCache, uid, token, refreshToken)
+}
+
 func (n *RuntimeGoNakamaModule) MatchCreate(ctx context.Context, module string, params map[string]interface{}) (string, error) {
 	if module == "" {
 		return "", errors.New("expects module name")
@@ -835,6 +914,64 @@ func (n *RuntimeGoNakamaModule) MatchList(ctx context.Context, limit int, author
 	return n.matchRegistry.ListMatches(ctx, limit, authoritativeWrapper, labelWrapper, minSizeWrapper, maxSizeWrapper, queryWrapper)
 }
 
+func (n *RuntimeGoNakamaModule) MatchSignal(ctx context.Context, id string, data string) (string, error) {
+	// Validate the match ID.
+	idComponents := strings.SplitN(id, ".", 2)
+	if len(idComponents) != 2 {
+		return "", errors.New("invalid match id")
+	}
+	matchID, err := uuid.FromString(idComponents[0])
+	if err != nil {
+		return "", errors.New("invalid match id")
+	}
+	node := idComponents[1]
+
+	return n.matchRegistry.Signal(ctx, matchID, node, data)
+}
+
+func (n *RuntimeGoNakamaModule) MatchRecordingsList(ctx context.Context) ([]*runtime.MatchRecording, error) {
+	headers, err := ListMatchRecordings(n.logger, MatchRecordingDir(n.config))
+	if err != nil {
+		return nil, err
+	}
+
+	recordings := make([]*runtime.MatchRecording, 0, len(headers))
+	for _, header := range headers {
+		recordings = append(recordings, &runtime.MatchRecording{
+			ID:         header.ID,
+			MatchID:    header.MatchID,
+			Module:     header.Module,
+			CreateTime: header.CreateTime,
+		})
+	}
+	return recordings, nil
+}
+
+func (n *RuntimeGoNakamaModule) MatchReplay(ctx context.Context, id string) (*runtime.MatchReplayResult, error) {
+	if id == "" {
+		return nil, errors.New("expects recording id")
+	}
+
+	n.RLock()
+	fn := n.matchCreateFn
+	n.RUnlock()
+
+	return n.matchRegistry.Replay(ctx, fn, id)
+}
+
+func (n *RuntimeGoNakamaModule) MatchmakerTicketsList(ctx context.Context, limit int) ([]runtime.MatchmakerTicket, error) {
+	if limit < 0 {
+		return nil, errors.New("expects limit to be 0 or greater")
+	}
+
+	entries := n.matchmaker.List(limit)
+	tickets := make([]runtime.MatchmakerTicket, 0, len(entries))
+	for _, entry := range entries {
+		tickets = append(tickets, entry)
+	}
+	return tickets, nil
+}
+
 func (n *RuntimeGoNakamaModule) NotificationSend(ctx context.Context, userID, subject string, content map[string]interface{}, code int, sender string, persistent bool) error {
 	uid, err := uuid.FromString(userID)
 	if err != nil {
@@ -1016,6 +1153,62 @@ func (n *RuntimeGoNakamaModule) WalletLedgerList(ctx context.Context, userID str
 	return runtimeItems, nil
 }
 
+func (n *RuntimeGoNakamaModule) PurchaseValidateApple(ctx context.Context, userID, receipt string) (*api.ValidatePurchaseResponse, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, errors.New("expects a valid user id")
+	}
+
+	if receipt == "" {
+		return nil, errors.New("expects receipt")
+	}
+
+	return ValidatePurchaseApple(ctx, n.logger, n.db, n.socialClient, n.config.GetIAP().AppleSharedPassword, n.config.GetIAP().AppleBundleID, uid, receipt)
+}
+
+func (n *RuntimeGoNakamaModule) PurchaseValidateGoogle(ctx context.Context, userID, purchase string) (*api.ValidatePurchaseResponse, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, errors.New("expects a valid user id")
+	}
+
+	if purchase == "" {
+		return nil, errors.New("expects purchase")
+	}
+
+	return ValidatePurchaseGoogle(ctx, n.logger, n.db, n.socialClient, n.config.GetIAP().GoogleClientEmail, n.config.GetIAP().GooglePrivateKey, n.config.GetIAP().GooglePackageName, uid, purchase)
+}
+
+func (n *RuntimeGoNakamaModule) PurchaseValidateHuawei(ctx context.Context, userID, purchase, signature string) (*api.ValidatePurchaseResponse, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, errors.New("expects a valid user id")
+	}
+
+	if purchase == "" {
+		return nil, errors.New("expects purchase")
+	}
+
+	if signature == "" {
+		return nil, errors.New("expects signature")
+	}
+
+	return ValidatePurchaseHuawei(ctx, n.logger, n.db, n.socialClient, n.config.GetIAP().HuaweiPublicKey, n.config.GetIAP().HuaweiClientID, n.config.GetIAP().HuaweiClientSecret, uid, purchase, signature)
+}
+
+func (n *RuntimeGoNakamaModule) PurchasesList(ctx context.Context, userID string, limit int, cursor string) (*api.PurchaseList, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, errors.New("expects a valid user id")
+	}
+
+	if limit < 1 || limit > 100 {
+		return nil, errors.New("limit must be 1-100")
+	}
+
+	return ListPurchases(ctx, n.logger, n.db, uid, limit, cursor)
+}
+
 func (n *RuntimeGoNakamaModule) StorageList(ctx context.Context, userID, collection string, limit int, cursor string) ([]*api.StorageObject, string, error) {
 	var uid *uuid.UUID
 	if userID != "" {
@@ -1626,7 +1819,7 @@ func (n *RuntimeGoNakamaModule) GroupUsersKick(ctx context.Context, groupID stri
 		users = append(users, uid)
 	}
 
-	return KickGroupUsers(ctx, n.logger, n.db, uuid.Nil, group, users)
+	return KickGroupUsers(ctx, n.logger, n.db, n.router, uuid.Nil, group, users)
 }
 
 func (n *RuntimeGoNakamaModule) GroupUsersList(ctx context.Context, id string) ([]*api.GroupUserList_GroupUser, error) {
@@ -1657,6 +1850,92 @@ func (n *RuntimeGoNakamaModule) UserGroupsList(ctx context.Context, userID strin
 	return groups.UserGroups, nil
 }
 
+func (n *RuntimeGoNakamaModule) FriendsList(ctx context.Context, userID string, limit int, state *int, cursor string) ([]*api.Friend, string, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, "", errors.New("expects user ID to be a valid identifier")
+	}
+
+	if limit < 1 || limit > 100 {
+		return nil, "", errors.New("limit must be 1-100")
+	}
+
+	var stateWrapper *wrappers.Int32Value
+	if state != nil {
+		if *state < 0 || *state > 3 {
+			return nil, "", errors.New("state must be 0-3")
+		}
+		stateWrapper = &wrappers.Int32Value{Value: int32(*state)}
+	}
+
+	friends, err := ListFriends(ctx, n.logger, n.db, n.tracker, uid, limit, stateWrapper, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return friends.Friends, friends.Cursor, nil
+}
+
+func (n *RuntimeGoNakamaModule) FriendsMutualList(ctx context.Context, userID, otherUserID string, limit int) ([]*api.User, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, errors.New("expects user ID to be a valid identifier")
+	}
+
+	oid, err := uuid.FromString(otherUserID)
+	if err != nil {
+		return nil, errors.New("expects other user ID to be a valid identifier")
+	}
+
+	if limit < 1 || limit > 100 {
+		return nil, errors.New("limit must be 1-100")
+	}
+
+	users, err := ListMutualFriends(ctx, n.logger, n.db, n.tracker, uid, oid, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.Users, nil
+}
+
+func (n *RuntimeGoNakamaModule) FriendSuggestionsList(ctx context.Context, userID string, limit int) ([]*api.FriendSuggestion, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, errors.New("expects user ID to be a valid identifier")
+	}
+
+	if limit < 1 || limit > 100 {
+		return nil, errors.New("limit must be 1-100")
+	}
+
+	suggestions, err := ListFriendSuggestions(ctx, n.logger, n.db, n.tracker, uid, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestions.Suggestions, nil
+}
+
+func (n *RuntimeGoNakamaModule) FriendMetadataUpdate(ctx context.Context, userID, friendUserID string, metadata map[string]interface{}) error {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return errors.New("expects user ID to be a valid identifier")
+	}
+
+	fid, err := uuid.FromString(friendUserID)
+	if err != nil {
+		return errors.New("expects friend user ID to be a valid identifier")
+	}
+
+	metadataBytes, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Errorf("error encoding metadata: %v", err.Error())
+	}
+
+	return UpdateFriendMetadata(ctx, n.logger, n.db, uid, fid, string(metadataBytes))
+}
+
 func (n *RuntimeGoNakamaModule) SetMatchCreateFn(fn RuntimeMatchCreateFunction) {
 	n.Lock()
 	n.matchCreateFn = fn
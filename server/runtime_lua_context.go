@@ -194,3 +194,33 @@ func RuntimeLuaConvertLuaValue(lv lua.LValue) interface{} {
 		return v
 	}
 }
+
+func RuntimeLuaConvertMatchmakerEntries(l *lua.LState, entries []*MatchmakerEntry) *lua.LTable {
+	entriesTable := l.CreateTable(len(entries), 0)
+	for i, entry := range entries {
+		presenceTable := l.CreateTable(0, 4)
+		presenceTable.RawSetString("user_id", lua.LString(entry.Presence.UserId))
+		presenceTable.RawSetString("session_id", lua.LString(entry.Presence.SessionId))
+		presenceTable.RawSetString("username", lua.LString(entry.Presence.Username))
+		presenceTable.RawSetString("node", lua.LString(entry.Presence.Node))
+
+		propertiesTable := l.CreateTable(0, len(entry.StringProperties)+len(entry.NumericProperties))
+		for k, v := range entry.StringProperties {
+			propertiesTable.RawSetString(k, lua.LString(v))
+		}
+		for k, v := range entry.NumericProperties {
+			propertiesTable.RawSetString(k, lua.LNumber(v))
+		}
+
+		entryTable := l.CreateTable(0, 4)
+		entryTable.RawSetString("ticket", lua.LString(entry.Ticket))
+		entryTable.RawSetString("presence", presenceTable)
+		entryTable.RawSetString("properties", propertiesTable)
+		if entry.PartyId != "" {
+			entryTable.RawSetString("party_id", lua.LString(entry.PartyId))
+		}
+
+		entriesTable.RawSetInt(i+1, entryTable)
+	}
+	return entriesTable
+}
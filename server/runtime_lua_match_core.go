@@ -25,6 +25,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/heroiclabs/nakama/rtapi"
+	"github.com/heroiclabs/nakama/runtime"
 	"github.com/heroiclabs/nakama/social"
 	"github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
@@ -35,8 +36,10 @@ type RuntimeLuaMatchCore struct {
 	matchRegistry MatchRegistry
 	router        MessageRouter
 
-	deferMessageFn RuntimeMatchDeferMessageFunction
-	presenceList   *MatchPresenceList
+	deferMessageFn     RuntimeMatchDeferMessageFunction
+	presenceList       *MatchPresenceList
+	broadcastListener  RuntimeMatchBroadcastListener
+	spectatorMessageFn RuntimeMatchDeferMessageFunction
 
 	id     uuid.UUID
 	node   string
@@ -44,20 +47,26 @@ type RuntimeLuaMatchCore struct {
 	stream PresenceStream
 	label  *atomic.String
 
-	vm            *lua.LState
-	initFn        lua.LValue
-	joinAttemptFn lua.LValue
-	joinFn        lua.LValue
-	leaveFn       lua.LValue
-	loopFn        lua.LValue
-	terminateFn   lua.LValue
-	ctx           *lua.LTable
-	dispatcher    *lua.LTable
+	vm                     *lua.LState
+	initFn                 lua.LValue
+	joinAttemptFn          lua.LValue
+	spectatorJoinAttemptFn lua.LValue
+	joinFn                 lua.LValue
+	leaveFn                lua.LValue
+	loopFn                 lua.LValue
+	terminateFn            lua.LValue
+	signalFn               lua.LValue
+	disconnectFn           lua.LValue
+	reconnectFn            lua.LValue
+	snapshotFn             lua.LValue
+	restoreFn              lua.LValue
+	ctx                    *lua.LTable
+	dispatcher             *lua.LTable
 
 	ctxCancelFn context.CancelFunc
 }
 
-func NewRuntimeLuaMatchCore(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler *jsonpb.Unmarshaler, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, tracker Tracker, streamManager StreamManager, router MessageRouter, stdLibs map[string]lua.LGFunction, once *sync.Once, localCache *RuntimeLuaLocalCache, goMatchCreateFn RuntimeMatchCreateFunction, id uuid.UUID, node string, name string) (RuntimeMatchCore, error) {
+func NewRuntimeLuaMatchCore(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler *jsonpb.Unmarshaler, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, streamManager StreamManager, router MessageRouter, stdLibs map[string]lua.LGFunction, once *sync.Once, localCache *RuntimeLuaLocalCache, goMatchCreateFn RuntimeMatchCreateFunction, id uuid.UUID, node string, name string) (RuntimeMatchCore, error) {
 	// Set up the Lua VM that will handle this match.
 	vm := lua.NewState(lua.Options{
 		CallStackSize:       config.GetRuntime().CallStackSize,
@@ -81,10 +90,10 @@ func NewRuntimeLuaMatchCore(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler *j
 		if core != nil {
 			return core, nil
 		}
-		return NewRuntimeLuaMatchCore(logger, db, jsonpbUnmarshaler, config, socialClient, leaderboardCache, rankCache, leaderboardScheduler, sessionRegistry, matchRegistry, tracker, streamManager, router, stdLibs, once, localCache, goMatchCreateFn, id, node, name)
+		return NewRuntimeLuaMatchCore(logger, db, jsonpbUnmarshaler, config, socialClient, leaderboardCache, rankCache, leaderboardScheduler, sessionRegistry, sessionCache, matchRegistry, matchmaker, tracker, streamManager, router, stdLibs, once, localCache, goMatchCreateFn, id, node, name)
 	}
 
-	nakamaModule := NewRuntimeLuaNakamaModule(logger, db, jsonpbUnmarshaler, config, socialClient, leaderboardCache, rankCache, leaderboardScheduler, sessionRegistry, matchRegistry, tracker, streamManager, router, once, localCache, allMatchCreateFn, nil, nil)
+	nakamaModule := NewRuntimeLuaNakamaModule(logger, db, jsonpbUnmarshaler, config, socialClient, leaderboardCache, rankCache, leaderboardScheduler, sessionRegistry, sessionCache, matchRegistry, matchmaker, tracker, streamManager, router, once, localCache, allMatchCreateFn, nil, nil)
 	vm.PreloadModule("nakama", nakamaModule.Loader)
 
 	// Create the context to be used throughout this match.
@@ -120,6 +129,12 @@ func NewRuntimeLuaMatchCore(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler *j
 		ctxCancelFn()
 		return nil, errors.New("match_join_attempt not found or not a function")
 	}
+	// Optional, match modules without it reject all spectators.
+	spectatorJoinAttemptFn := tab.RawGet(lua.LString("match_spectator_join_attempt"))
+	if spectatorJoinAttemptFn.Type() != lua.LTNil && spectatorJoinAttemptFn.Type() != lua.LTFunction {
+		ctxCancelFn()
+		return nil, errors.New("match_spectator_join_attempt not a function")
+	}
 	joinFn := tab.RawGet(lua.LString("match_join"))
 	if joinFn.Type() != lua.LTFunction {
 		ctxCancelFn()
@@ -140,6 +155,30 @@ func NewRuntimeLuaMatchCore(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler *j
 		ctxCancelFn()
 		return nil, errors.New("match_terminate not found or not a function")
 	}
+	// Optional, match modules without it ignore signals.
+	signalFn := tab.RawGet(lua.LString("match_signal"))
+	if signalFn.Type() != lua.LTNil && signalFn.Type() != lua.LTFunction {
+		ctxCancelFn()
+		return nil, errors.New("match_signal not a function")
+	}
+	// Optional, but must be defined together for matches to hold the places of disconnected players.
+	disconnectFn := tab.RawGet(lua.LString("match_disconnect"))
+	reconnectFn := tab.RawGet(lua.LString("match_reconnect"))
+	if disconnectFn.Type() != lua.LTNil || reconnectFn.Type() != lua.LTNil {
+		if disconnectFn.Type() != lua.LTFunction || reconnectFn.Type() != lua.LTFunction {
+			ctxCancelFn()
+			return nil, errors.New("match_disconnect and match_reconnect must both be functions if either is defined")
+		}
+	}
+	// Optional, but must be defined together for matches to be kept across a shutdown.
+	snapshotFn := tab.RawGet(lua.LString("match_snapshot"))
+	restoreFn := tab.RawGet(lua.LString("match_restore"))
+	if snapshotFn.Type() != lua.LTNil || restoreFn.Type() != lua.LTNil {
+		if snapshotFn.Type() != lua.LTFunction || restoreFn.Type() != lua.LTFunction {
+			ctxCancelFn()
+			return nil, errors.New("match_snapshot and match_restore must both be functions if either is defined")
+		}
+	}
 
 	core := &RuntimeLuaMatchCore{
 		logger:        logger,
@@ -159,14 +198,20 @@ func NewRuntimeLuaMatchCore(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler *j
 		},
 		label: atomic.NewString(""),
 
-		vm:            vm,
-		initFn:        initFn,
-		joinAttemptFn: joinAttemptFn,
-		joinFn:        joinFn,
-		leaveFn:       leaveFn,
-		loopFn:        loopFn,
-		terminateFn:   terminateFn,
-		ctx:           ctx,
+		vm:                     vm,
+		initFn:                 initFn,
+		joinAttemptFn:          joinAttemptFn,
+		spectatorJoinAttemptFn: spectatorJoinAttemptFn,
+		joinFn:                 joinFn,
+		leaveFn:                leaveFn,
+		loopFn:                 loopFn,
+		terminateFn:            terminateFn,
+		signalFn:               signalFn,
+		disconnectFn:           disconnectFn,
+		reconnectFn:            reconnectFn,
+		snapshotFn:             snapshotFn,
+		restoreFn:              restoreFn,
+		ctx:                    ctx,
 		// dispatcher set below.
 
 		ctxCancelFn: ctxCancelFn,
@@ -198,44 +243,69 @@ func (r *RuntimeLuaMatchCore) MatchInit(presenceList *MatchPresenceList, deferMe
 		return nil, 0, err
 	}
 
+	return r.matchStarted("match_init", presenceList, deferMessageFn)
+}
+
+func (r *RuntimeLuaMatchCore) MatchRestore(presenceList *MatchPresenceList, deferMessageFn RuntimeMatchDeferMessageFunction, tick int64, snapshot string) (interface{}, int, error) {
+	if r.restoreFn.Type() != lua.LTFunction {
+		return nil, 0, errors.New("match_restore not found or not a function")
+	}
+
+	// Run the match_restore sequence.
+	r.vm.Push(LSentinel)
+	r.vm.Push(r.restoreFn)
+	r.vm.Push(r.ctx)
+	r.vm.Push(lua.LNumber(tick))
+	r.vm.Push(lua.LString(snapshot))
+
+	err := r.vm.PCall(3, lua.MultRet, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return r.matchStarted("match_restore", presenceList, deferMessageFn)
+}
+
+// Extract the state, tick rate and label returned by match_init or match_restore, and complete the match setup.
+func (r *RuntimeLuaMatchCore) matchStarted(fnName string, presenceList *MatchPresenceList, deferMessageFn RuntimeMatchDeferMessageFunction) (interface{}, int, error) {
 	// Extract desired label.
 	label := r.vm.Get(-1)
 	if label.Type() == LTSentinel {
-		return nil, 0, errors.New("match_init returned unexpected third value, must be a label string")
+		return nil, 0, fmt.Errorf("%v returned unexpected third value, must be a label string", fnName)
 	} else if label.Type() != lua.LTString {
-		return nil, 0, errors.New("match_init returned unexpected third value, must be a label string")
+		return nil, 0, fmt.Errorf("%v returned unexpected third value, must be a label string", fnName)
 	}
 	r.vm.Pop(1)
 
 	labelStr := label.String()
 	if len(labelStr) > 256 {
-		return nil, 0, errors.New("match_init returned invalid label, must be 256 bytes or less")
+		return nil, 0, fmt.Errorf("%v returned invalid label, must be 256 bytes or less", fnName)
 	}
 
 	// Extract desired tick rate.
 	rate := r.vm.Get(-1)
 	if rate.Type() == LTSentinel {
-		return nil, 0, errors.New("match_init returned unexpected second value, must be a tick rate number")
+		return nil, 0, fmt.Errorf("%v returned unexpected second value, must be a tick rate number", fnName)
 	} else if rate.Type() != lua.LTNumber {
-		return nil, 0, errors.New("match_init returned unexpected second value, must be a tick rate number")
+		return nil, 0, fmt.Errorf("%v returned unexpected second value, must be a tick rate number", fnName)
 	}
 	r.vm.Pop(1)
 
 	rateInt := int(rate.(lua.LNumber))
 	if rateInt > 30 || rateInt < 1 {
-		return nil, 0, errors.New("match_init returned invalid tick rate, must be between 1 and 30")
+		return nil, 0, fmt.Errorf("%v returned invalid tick rate, must be between 1 and 30", fnName)
 	}
 
 	// Extract initial state.
 	state := r.vm.Get(-1)
 	if state.Type() == LTSentinel {
-		return nil, 0, errors.New("match_init returned unexpected first value, must be a state")
+		return nil, 0, fmt.Errorf("%v returned unexpected first value, must be a state", fnName)
 	}
 	r.vm.Pop(1)
 
 	// Drop the sentinel value from the stack.
 	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
-		return nil, 0, errors.New("match_init returned too many arguments, must be: state, tick rate number, label string")
+		return nil, 0, fmt.Errorf("%v returned too many arguments, must be: state, tick rate number, label string", fnName)
 	}
 	r.vm.Pop(1)
 
@@ -255,6 +325,20 @@ func (r *RuntimeLuaMatchCore) MatchInit(presenceList *MatchPresenceList, deferMe
 }
 
 func (r *RuntimeLuaMatchCore) MatchJoinAttempt(tick int64, state interface{}, userID, sessionID uuid.UUID, username, node string, metadata map[string]string) (interface{}, bool, string, error) {
+	return r.joinAttempt(r.joinAttemptFn, "Match join attempt", tick, state, userID, sessionID, username, node, metadata)
+}
+
+func (r *RuntimeLuaMatchCore) MatchSpectatorJoinAttempt(tick int64, state interface{}, userID, sessionID uuid.UUID, username, node string, metadata map[string]string) (interface{}, bool, string, error) {
+	if r.spectatorJoinAttemptFn.Type() != lua.LTFunction {
+		// Match does not support spectators.
+		return state, false, "Match does not allow spectators", nil
+	}
+
+	return r.joinAttempt(r.spectatorJoinAttemptFn, "Match spectator join attempt", tick, state, userID, sessionID, username, node, metadata)
+}
+
+// Player and spectator join attempts share the same call and return conventions.
+func (r *RuntimeLuaMatchCore) joinAttempt(fn lua.LValue, fnName string, tick int64, state interface{}, userID, sessionID uuid.UUID, username, node string, metadata map[string]string) (interface{}, bool, string, error) {
 	presence := r.vm.CreateTable(0, 4)
 	presence.RawSetString("user_id", lua.LString(userID.String()))
 	presence.RawSetString("session_id", lua.LString(sessionID.String()))
@@ -266,9 +350,9 @@ func (r *RuntimeLuaMatchCore) MatchJoinAttempt(tick int64, state interface{}, us
 		metadataTable.RawSetString(k, lua.LString(v))
 	}
 
-	// Execute the match_join_attempt call.
+	// Execute the join attempt call.
 	r.vm.Push(LSentinel)
-	r.vm.Push(r.joinAttemptFn)
+	r.vm.Push(fn)
 	r.vm.Push(r.ctx)
 	r.vm.Push(r.dispatcher)
 	r.vm.Push(lua.LNumber(tick))
@@ -288,7 +372,7 @@ func (r *RuntimeLuaMatchCore) MatchJoinAttempt(tick int64, state interface{}, us
 	// Extract the join attempt response.
 	allowOrReason := r.vm.Get(-1)
 	if allowOrReason.Type() == LTSentinel {
-		return nil, false, "", errors.New("Match join attempt returned too few values, stopping match - expected: state, join result boolean, optional reject reason string")
+		return nil, false, "", fmt.Errorf("%v returned too few values, stopping match - expected: state, join result boolean, optional reject reason string", fnName)
 	} else if allowOrReason.Type() == lua.LTString {
 		// This was the optional reject reason string.
 		reason = allowOrReason.String()
@@ -297,7 +381,7 @@ func (r *RuntimeLuaMatchCore) MatchJoinAttempt(tick int64, state interface{}, us
 		allowFound = true
 		allow = lua.LVAsBool(allowOrReason)
 	} else {
-		return nil, false, "", errors.New("Match join attempt returned non-boolean join result or non-string reject reason, stopping match")
+		return nil, false, "", fmt.Errorf("%v returned non-boolean join result or non-string reject reason, stopping match", fnName)
 	}
 	r.vm.Pop(1)
 
@@ -305,9 +389,9 @@ func (r *RuntimeLuaMatchCore) MatchJoinAttempt(tick int64, state interface{}, us
 		// The previous parameter was the optional reject reason string, now look for the required join result boolean.
 		allowRequired := r.vm.Get(-1)
 		if allowRequired.Type() == LTSentinel {
-			return nil, false, "", errors.New("Match join attempt returned incorrect or too few values, stopping match - expected: state, join result boolean, optional reject reason string")
+			return nil, false, "", fmt.Errorf("%v returned incorrect or too few values, stopping match - expected: state, join result boolean, optional reject reason string", fnName)
 		} else if allowRequired.Type() != lua.LTBool {
-			return nil, false, "", errors.New("Match join attempt returned non-boolean join result, stopping match")
+			return nil, false, "", fmt.Errorf("%v returned non-boolean join result, stopping match", fnName)
 		}
 		allow = lua.LVAsBool(allowRequired)
 		r.vm.Pop(1)
@@ -321,7 +405,7 @@ func (r *RuntimeLuaMatchCore) MatchJoinAttempt(tick int64, state interface{}, us
 	r.vm.Pop(1)
 	// Check for and remove the sentinel value, will fail if there are any extra return values.
 	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
-		return nil, false, "", errors.New("Match join attempt returned too many values, stopping match")
+		return nil, false, "", fmt.Errorf("%v returned too many values, stopping match", fnName)
 	}
 	r.vm.Pop(1)
 
@@ -414,12 +498,103 @@ func (r *RuntimeLuaMatchCore) MatchLeave(tick int64, state interface{}, leaves [
 	return newState, nil
 }
 
-func (r *RuntimeLuaMatchCore) MatchLoop(tick int64, state interface{}, inputCh <-chan *MatchDataMessage) (interface{}, error) {
-	// Drain the input queue into a Lua table.
-	size := len(inputCh)
-	input := r.vm.CreateTable(size, 0)
-	for i := 1; i <= size; i++ {
-		msg := <-inputCh
+func (r *RuntimeLuaMatchCore) MatchDisconnect(tick int64, state interface{}, disconnects []*MatchPresence) (interface{}, int, error) {
+	if r.disconnectFn.Type() != lua.LTFunction {
+		// Match does not hold places for disconnected players.
+		return state, 0, nil
+	}
+
+	// Execute the match_disconnect call.
+	r.vm.Push(LSentinel)
+	r.vm.Push(r.disconnectFn)
+	r.vm.Push(r.ctx)
+	r.vm.Push(r.dispatcher)
+	r.vm.Push(lua.LNumber(tick))
+	r.vm.Push(state.(lua.LValue))
+	r.vm.Push(r.presencesTable(disconnects))
+
+	err := r.vm.PCall(5, lua.MultRet, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Extract the reconnect window.
+	seconds := r.vm.Get(-1)
+	if seconds.Type() == LTSentinel {
+		return nil, 0, errors.New("Match disconnect returned too few values, stopping match - expected: state, reconnect seconds number")
+	} else if seconds.Type() != lua.LTNumber {
+		return nil, 0, errors.New("Match disconnect returned non-number reconnect seconds, stopping match")
+	}
+	r.vm.Pop(1)
+
+	// Extract the resulting state.
+	newState := r.vm.Get(-1)
+	if newState.Type() == lua.LTNil || newState.Type() == LTSentinel {
+		return nil, 0, nil
+	}
+	r.vm.Pop(1)
+	// Check for and remove the sentinel value, will fail if there are any extra return values.
+	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
+		return nil, 0, errors.New("Match disconnect returned too many values, stopping match")
+	}
+	r.vm.Pop(1)
+
+	return newState, int(seconds.(lua.LNumber)), nil
+}
+
+func (r *RuntimeLuaMatchCore) MatchReconnect(tick int64, state interface{}, reconnects []*MatchPresence) (interface{}, error) {
+	if r.reconnectFn.Type() != lua.LTFunction {
+		// Should not happen, places are only held for matches that support reconnects.
+		return state, nil
+	}
+
+	// Execute the match_reconnect call.
+	r.vm.Push(LSentinel)
+	r.vm.Push(r.reconnectFn)
+	r.vm.Push(r.ctx)
+	r.vm.Push(r.dispatcher)
+	r.vm.Push(lua.LNumber(tick))
+	r.vm.Push(state.(lua.LValue))
+	r.vm.Push(r.presencesTable(reconnects))
+
+	err := r.vm.PCall(5, lua.MultRet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the resulting state.
+	newState := r.vm.Get(-1)
+	if newState.Type() == lua.LTNil || newState.Type() == LTSentinel {
+		return nil, nil
+	}
+	r.vm.Pop(1)
+	// Check for and remove the sentinel value, will fail if there are any extra return values.
+	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
+		return nil, errors.New("Match reconnect returned too many values, stopping match")
+	}
+	r.vm.Pop(1)
+
+	return newState, nil
+}
+
+func (r *RuntimeLuaMatchCore) presencesTable(matchPresences []*MatchPresence) *lua.LTable {
+	presences := r.vm.CreateTable(len(matchPresences), 0)
+	for i, p := range matchPresences {
+		presence := r.vm.CreateTable(0, 4)
+		presence.RawSetString("user_id", lua.LString(p.UserID.String()))
+		presence.RawSetString("session_id", lua.LString(p.SessionID.String()))
+		presence.RawSetString("username", lua.LString(p.Username))
+		presence.RawSetString("node", lua.LString(p.Node))
+
+		presences.RawSetInt(i+1, presence)
+	}
+	return presences
+}
+
+func (r *RuntimeLuaMatchCore) MatchLoop(tick int64, state interface{}, messages []*MatchDataMessage) (interface{}, error) {
+	// Convert the input messages into a Lua table.
+	input := r.vm.CreateTable(len(messages), 0)
+	for i, msg := range messages {
 
 		presence := r.vm.CreateTable(0, 4)
 		presence.RawSetString("user_id", lua.LString(msg.UserID.String()))
@@ -427,7 +602,7 @@ func (r *RuntimeLuaMatchCore) MatchLoop(tick int64, state interface{}, inputCh <
 		presence.RawSetString("username", lua.LString(msg.Username))
 		presence.RawSetString("node", lua.LString(msg.Node))
 
-		in := r.vm.CreateTable(0, 4)
+		in := r.vm.CreateTable(0, 5)
 		in.RawSetString("sender", presence)
 		in.RawSetString("op_code", lua.LNumber(msg.OpCode))
 		if msg.Data != nil {
@@ -436,8 +611,9 @@ func (r *RuntimeLuaMatchCore) MatchLoop(tick int64, state interface{}, inputCh <
 			in.RawSetString("data", lua.LNil)
 		}
 		in.RawSetString("receive_time_ms", lua.LNumber(msg.ReceiveTime))
+		in.RawSetString("reliable", lua.LBool(msg.Reliable))
 
-		input.RawSetInt(i, in)
+		input.RawSetInt(i+1, in)
 	}
 
 	// Execute the match_loop call.
@@ -499,6 +675,106 @@ func (r *RuntimeLuaMatchCore) MatchTerminate(tick int64, state interface{}, grac
 	return newState, nil
 }
 
+func (r *RuntimeLuaMatchCore) MatchSignal(tick int64, state interface{}, data string) (interface{}, string, error) {
+	if r.signalFn.Type() != lua.LTFunction {
+		// No match_signal function, the signal is ignored.
+		return state, "", nil
+	}
+
+	// Execute the match_signal call.
+	r.vm.Push(LSentinel)
+	r.vm.Push(r.signalFn)
+	r.vm.Push(r.ctx)
+	r.vm.Push(r.dispatcher)
+	r.vm.Push(lua.LNumber(tick))
+	r.vm.Push(state.(lua.LValue))
+	r.vm.Push(lua.LString(data))
+
+	err := r.vm.PCall(5, lua.MultRet, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Extract the optional result string.
+	var result string
+	resultOrState := r.vm.Get(-1)
+	if resultOrState.Type() == LTSentinel {
+		return nil, "", errors.New("Match signal returned too few values, stopping match - expected: state, optional result string")
+	}
+	newState := resultOrState
+	if sentinel := r.vm.Get(-2); sentinel.Type() != LTSentinel {
+		// Two values were returned, the last one must be the result string.
+		if resultOrState.Type() != lua.LTString && resultOrState.Type() != lua.LTNil {
+			return nil, "", errors.New("Match signal returned non-string result, stopping match")
+		}
+		if resultOrState.Type() == lua.LTString {
+			result = resultOrState.String()
+		}
+		r.vm.Pop(1)
+		newState = r.vm.Get(-1)
+	}
+
+	// Extract the resulting state.
+	if newState.Type() == lua.LTNil || newState.Type() == LTSentinel {
+		return nil, "", nil
+	}
+	r.vm.Pop(1)
+	// Check for and remove the sentinel value, will fail if there are any extra return values.
+	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
+		return nil, "", errors.New("Match signal returned too many values, stopping match")
+	}
+	r.vm.Pop(1)
+
+	return newState, result, nil
+}
+
+func (r *RuntimeLuaMatchCore) MatchSnapshot(tick int64, state interface{}) (string, error) {
+	if r.snapshotFn.Type() != lua.LTFunction {
+		// No match_snapshot function, the match does not support snapshots.
+		return "", nil
+	}
+
+	// Execute the match_snapshot call.
+	r.vm.Push(LSentinel)
+	r.vm.Push(r.snapshotFn)
+	r.vm.Push(r.ctx)
+	r.vm.Push(r.dispatcher)
+	r.vm.Push(lua.LNumber(tick))
+	r.vm.Push(state.(lua.LValue))
+
+	err := r.vm.PCall(4, lua.MultRet, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// Extract the snapshot, nil means the match should not be kept.
+	var snapshot string
+	value := r.vm.Get(-1)
+	if value.Type() == LTSentinel {
+		return "", errors.New("Match snapshot returned too few values - expected: snapshot string or nil")
+	} else if value.Type() == lua.LTString {
+		snapshot = value.String()
+	} else if value.Type() != lua.LTNil {
+		return "", errors.New("Match snapshot returned non-string snapshot")
+	}
+	r.vm.Pop(1)
+	// Check for and remove the sentinel value, will fail if there are any extra return values.
+	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
+		return "", errors.New("Match snapshot returned too many values")
+	}
+	r.vm.Pop(1)
+
+	return snapshot, nil
+}
+
+func (r *RuntimeLuaMatchCore) SetBroadcastListener(fn RuntimeMatchBroadcastListener) {
+	r.broadcastListener = fn
+}
+
+func (r *RuntimeLuaMatchCore) SetSpectatorMessageFunction(fn RuntimeMatchDeferMessageFunction) {
+	r.spectatorMessageFn = fn
+}
+
 func (r *RuntimeLuaMatchCore) Label() string {
 	return r.label.Load()
 }
@@ -508,36 +784,74 @@ func (r *RuntimeLuaMatchCore) Cancel() {
 }
 
 func (r *RuntimeLuaMatchCore) broadcastMessage(l *lua.LState) int {
-	presenceIDs, msg := r.validateBroadcast(l)
-	if len(presenceIDs) != 0 {
-		r.router.SendToPresenceIDs(r.logger, presenceIDs, true, StreamModeMatchAuthoritative, msg)
+	presenceIDs, spectatorIDs, msg := r.validateBroadcast(l)
+	if err := r.broadcast(presenceIDs, spectatorIDs, msg, false); err != nil {
+		l.RaiseError("error broadcasting message: %v", err)
 	}
 
 	return 0
 }
 
 func (r *RuntimeLuaMatchCore) broadcastMessageDeferred(l *lua.LState) int {
-	presenceIDs, msg := r.validateBroadcast(l)
-	if len(presenceIDs) != 0 {
-		if err := r.deferMessageFn(&DeferredMessage{
-			PresenceIDs: presenceIDs,
-			Envelope:    msg,
-		}); err != nil {
-			l.RaiseError("error deferring message broadcast: %v", err)
-		}
+	presenceIDs, spectatorIDs, msg := r.validateBroadcast(l)
+	if err := r.broadcast(presenceIDs, spectatorIDs, msg, true); err != nil {
+		l.RaiseError("error deferring message broadcast: %v", err)
 	}
 
 	return 0
 }
 
-func (r *RuntimeLuaMatchCore) validateBroadcast(l *lua.LState) ([]*PresenceID, *rtapi.Envelope) {
+func (r *RuntimeLuaMatchCore) broadcast(presenceIDs, spectatorIDs []*PresenceID, msg *rtapi.Envelope, deferred bool) error {
+	if len(presenceIDs) == 0 && len(spectatorIDs) == 0 {
+		return nil
+	}
+	reliable := msg.GetMatchData().Reliable
+	if r.broadcastListener != nil {
+		allPresenceIDs := presenceIDs
+		if len(spectatorIDs) != 0 {
+			allPresenceIDs = make([]*PresenceID, 0, len(presenceIDs)+len(spectatorIDs))
+			allPresenceIDs = append(append(allPresenceIDs, presenceIDs...), spectatorIDs...)
+		}
+		if !r.broadcastListener(allPresenceIDs, msg, reliable) {
+			return nil
+		}
+	}
+
+	// Spectators may see broadcasts later than players, so their copy is always handed off separately.
+	if len(spectatorIDs) != 0 {
+		if r.spectatorMessageFn != nil {
+			if err := r.spectatorMessageFn(&DeferredMessage{PresenceIDs: spectatorIDs, Envelope: msg, Reliable: reliable}); err != nil {
+				return err
+			}
+		} else {
+			r.router.SendToPresenceIDs(r.logger, spectatorIDs, true, StreamModeMatchAuthoritative, msg, reliable)
+		}
+	}
+	if len(presenceIDs) == 0 {
+		return nil
+	}
+
+	if deferred {
+		return r.deferMessageFn(&DeferredMessage{
+			PresenceIDs: presenceIDs,
+			Envelope:    msg,
+			Reliable:    reliable,
+		})
+	}
+	r.router.SendToPresenceIDs(r.logger, presenceIDs, true, StreamModeMatchAuthoritative, msg, reliable)
+	return nil
+}
+
+// Returns the player and spectator recipients of a broadcast separately. Specific presences may be players or spectators,
+// otherwise the optional audience decides who receives it.
+func (r *RuntimeLuaMatchCore) validateBroadcast(l *lua.LState) ([]*PresenceID, []*PresenceID, *rtapi.Envelope) {
 	opCode := l.CheckInt64(1)
 
 	var dataBytes []byte
 	if data := l.Get(2); data.Type() != lua.LTNil {
 		if data.Type() != lua.LTString {
 			l.ArgError(2, "expects data to be a string or nil")
-			return nil, nil
+			return nil, nil, nil
 		}
 		dataBytes = []byte(data.(lua.LString))
 	}
@@ -547,7 +861,7 @@ func (r *RuntimeLuaMatchCore) validateBroadcast(l *lua.LState) ([]*PresenceID, *
 	if filter != nil {
 		fl := filter.Len()
 		if fl == 0 {
-			return nil, nil
+			return nil, nil, nil
 		}
 		presenceIDs = make([]*PresenceID, 0, fl)
 		conversionError := false
@@ -590,13 +904,13 @@ func (r *RuntimeLuaMatchCore) validateBroadcast(l *lua.LState) ([]*PresenceID, *
 			presenceIDs = append(presenceIDs, presenceID)
 		})
 		if conversionError {
-			return nil, nil
+			return nil, nil, nil
 		}
 	}
 
 	if presenceIDs != nil && len(presenceIDs) == 0 {
 		// Filter is empty, there are no requested message targets.
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	sender := l.OptTable(4, nil)
@@ -635,13 +949,14 @@ func (r *RuntimeLuaMatchCore) validateBroadcast(l *lua.LState) ([]*PresenceID, *
 		})
 		if presence.UserId == "" || presence.SessionId == "" || presence.Username == "" {
 			l.ArgError(4, "expects presence to have a valid user_id, session_id, and username")
-			return nil, nil
+			return nil, nil, nil
 		}
 		if conversionError {
-			return nil, nil
+			return nil, nil, nil
 		}
 	}
 
+	var spectatorIDs []*PresenceID
 	if presenceIDs != nil {
 		// Ensure specific presences actually exist to prevent sending bogus messages to arbitrary users.
 		if len(presenceIDs) == 1 {
@@ -649,29 +964,33 @@ func (r *RuntimeLuaMatchCore) validateBroadcast(l *lua.LState) ([]*PresenceID, *
 			presenceValue := filter.RawGetInt(1)
 			if presenceValue == lua.LNil {
 				l.ArgError(3, "expects each presence to be non-nil")
-				return nil, nil
+				return nil, nil, nil
 			}
 			presenceTable, ok := presenceValue.(*lua.LTable)
 			if !ok {
 				l.ArgError(3, "expects each presence to be a table")
-				return nil, nil
+				return nil, nil, nil
 			}
 			userIDValue := presenceTable.RawGetString("user_id")
 			if userIDValue == nil {
 				l.ArgError(3, "expects each presence to have a valid user_id")
-				return nil, nil
+				return nil, nil, nil
 			}
 			if userIDValue.Type() != lua.LTString {
 				l.ArgError(3, "expects each presence to have a valid user_id")
-				return nil, nil
+				return nil, nil, nil
 			}
 			_, err := uuid.FromString(userIDValue.String())
 			if err != nil {
 				l.ArgError(3, "expects each presence to have a valid user_id")
-				return nil, nil
+				return nil, nil, nil
 			}
 			if !r.presenceList.Contains(presenceIDs[0]) {
-				return nil, nil
+				if !r.presenceList.ContainsSpectator(presenceIDs[0]) {
+					return nil, nil, nil
+				}
+				spectatorIDs = presenceIDs
+				presenceIDs = nil
 			}
 		} else {
 			actualPresenceIDs := r.presenceList.List()
@@ -688,31 +1007,56 @@ func (r *RuntimeLuaMatchCore) validateBroadcast(l *lua.LState) ([]*PresenceID, *
 					}
 				}
 				if !found {
+					if r.presenceList.ContainsSpectator(presenceID) {
+						spectatorIDs = append(spectatorIDs, presenceID)
+					}
 					// If this presence wasn't in the filters, it's not needed.
 					presenceIDs[i] = presenceIDs[len(presenceIDs)-1]
 					presenceIDs = presenceIDs[:len(presenceIDs)-1]
 					i--
 				}
 			}
-			if len(presenceIDs) == 0 {
+			if len(presenceIDs) == 0 && len(spectatorIDs) == 0 {
 				// None of the target presenceIDs existed in the list of match members.
-				return nil, nil
+				return nil, nil, nil
 			}
 		}
 	}
 
+	// Messages are delivered reliably unless the caller explicitly opts out.
+	reliable := l.OptBool(5, true)
+
+	// Broadcasts without specific presences go to both players and spectators unless the caller narrows them down.
+	audience := runtime.MatchAudienceAll
+	switch a := l.OptString(6, "all"); a {
+	case "all":
+	case "players":
+		audience = runtime.MatchAudiencePlayers
+	case "spectators":
+		audience = runtime.MatchAudienceSpectators
+	default:
+		l.ArgError(6, "expects audience to be one of all, players or spectators")
+		return nil, nil, nil
+	}
+
 	msg := &rtapi.Envelope{Message: &rtapi.Envelope_MatchData{MatchData: &rtapi.MatchData{
 		MatchId:  r.idStr,
 		Presence: presence,
 		OpCode:   opCode,
 		Data:     dataBytes,
+		Reliable: reliable,
 	}}}
 
-	if presenceIDs == nil {
-		presenceIDs = r.presenceList.List()
+	if filter == nil {
+		if audience != runtime.MatchAudienceSpectators {
+			presenceIDs = r.presenceList.List()
+		}
+		if audience != runtime.MatchAudiencePlayers {
+			spectatorIDs = r.presenceList.ListSpectators()
+		}
 	}
 
-	return presenceIDs, msg
+	return presenceIDs, spectatorIDs, msg
 }
 
 func (r *RuntimeLuaMatchCore) matchKick(l *lua.LState) int {
@@ -64,7 +64,9 @@ type RuntimeLuaNakamaModule struct {
 	rankCache            LeaderboardRankCache
 	leaderboardScheduler LeaderboardScheduler
 	sessionRegistry      SessionRegistry
+	sessionCache         SessionCache
 	matchRegistry        MatchRegistry
+	matchmaker           Matchmaker
 	tracker              Tracker
 	streamManager        StreamManager
 	router               MessageRouter
@@ -78,7 +80,7 @@ type RuntimeLuaNakamaModule struct {
 	matchCreateFn RuntimeMatchCreateFunction
 }
 
-func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler *jsonpb.Unmarshaler, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, tracker Tracker, streamManager StreamManager, router MessageRouter, once *sync.Once, localCache *RuntimeLuaLocalCache, matchCreateFn RuntimeMatchCreateFunction, registerCallbackFn func(RuntimeExecutionMode, string, *lua.LFunction), announceCallbackFn func(RuntimeExecutionMode, string)) *RuntimeLuaNakamaModule {
+func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler *jsonpb.Unmarshaler, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, streamManager StreamManager, router MessageRouter, once *sync.Once, localCache *RuntimeLuaLocalCache, matchCreateFn RuntimeMatchCreateFunction, registerCallbackFn func(RuntimeExecutionMode, string, *lua.LFunction), announceCallbackFn func(RuntimeExecutionMode, string)) *RuntimeLuaNakamaModule {
 	return &RuntimeLuaNakamaModule{
 		logger:               logger,
 		db:                   db,
@@ -89,7 +91,9 @@ func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler
 		rankCache:            rankCache,
 		leaderboardScheduler: leaderboardScheduler,
 		sessionRegistry:      sessionRegistry,
+		sessionCache:         sessionCache,
 		matchRegistry:        matchRegistry,
+		matchmaker:           matchmaker,
 		tracker:              tracker,
 		streamManager:        streamManager,
 		router:               router,
@@ -108,107 +112,125 @@ func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, jsonpbUnmarshaler
 
 func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 	functions := map[string]lua.LGFunction{
-		"register_rpc":                n.registerRPC,
-		"register_req_before":         n.registerReqBefore,
-		"register_req_after":          n.registerReqAfter,
-		"register_rt_before":          n.registerRTBefore,
-		"register_rt_after":           n.registerRTAfter,
-		"register_matchmaker_matched": n.registerMatchmakerMatched,
-		"register_tournament_end":     n.registerTournamentEnd,
-		"register_tournament_reset":   n.registerTournamentReset,
-		"register_leaderboard_reset":  n.registerLeaderboardReset,
-		"run_once":                    n.runOnce,
-		"get_context":                 n.getContext,
-		"localcache_get":              n.localcacheGet,
-		"localcache_put":              n.localcachePut,
-		"localcache_delete":           n.localcacheDelete,
-		"time":                        n.time,
-		"cron_next":                   n.cronNext,
-		"sql_exec":                    n.sqlExec,
-		"sql_query":                   n.sqlQuery,
-		"uuid_v4":                     n.uuidV4,
-		"uuid_bytes_to_string":        n.uuidBytesToString,
-		"uuid_string_to_bytes":        n.uuidStringToBytes,
-		"http_request":                n.httpRequest,
-		"jwt_generate":                n.jwtGenerate,
-		"json_encode":                 n.jsonEncode,
-		"json_decode":                 n.jsonDecode,
-		"base64_encode":               n.base64Encode,
-		"base64_decode":               n.base64Decode,
-		"base64url_encode":            n.base64URLEncode,
-		"base64url_decode":            n.base64URLDecode,
-		"base16_encode":               n.base16Encode,
-		"base16_decode":               n.base16Decode,
-		"aes128_encrypt":              n.aes128Encrypt,
-		"aes128_decrypt":              n.aes128Decrypt,
-		"aes256_encrypt":              n.aes256Encrypt,
-		"aes256_decrypt":              n.aes256Decrypt,
-		"md5_hash":                    n.md5Hash,
-		"sha256_hash":                 n.sha256Hash,
-		"hmac_sha256_hash":            n.hmacSHA256Hash,
-		"rsa_sha256_hash":             n.rsaSHA256Hash,
-		"bcrypt_hash":                 n.bcryptHash,
-		"bcrypt_compare":              n.bcryptCompare,
-		"authenticate_custom":         n.authenticateCustom,
-		"authenticate_device":         n.authenticateDevice,
-		"authenticate_email":          n.authenticateEmail,
-		"authenticate_facebook":       n.authenticateFacebook,
-		"authenticate_gamecenter":     n.authenticateGameCenter,
-		"authenticate_google":         n.authenticateGoogle,
-		"authenticate_steam":          n.authenticateSteam,
-		"authenticate_token_generate": n.authenticateTokenGenerate,
-		"logger_info":                 n.loggerInfo,
-		"logger_warn":                 n.loggerWarn,
-		"logger_error":                n.loggerError,
-		"account_get_id":              n.accountGetId,
-		"accounts_get_id":             n.accountsGetId,
-		"account_update_id":           n.accountUpdateId,
-		"account_delete_id":           n.accountDeleteId,
-		"users_get_id":                n.usersGetId,
-		"users_get_username":          n.usersGetUsername,
-		"users_ban_id":                n.usersBanId,
-		"users_unban_id":              n.usersUnbanId,
-		"stream_user_list":            n.streamUserList,
-		"stream_user_get":             n.streamUserGet,
-		"stream_user_join":            n.streamUserJoin,
-		"stream_user_update":          n.streamUserUpdate,
-		"stream_user_leave":           n.streamUserLeave,
-		"stream_user_kick":            n.streamUserKick,
-		"stream_count":                n.streamCount,
-		"stream_close":                n.streamClose,
-		"stream_send":                 n.streamSend,
-		"stream_send_raw":             n.streamSendRaw,
-		"session_disconnect":          n.sessionDisconnect,
-		"match_create":                n.matchCreate,
-		"match_list":                  n.matchList,
-		"notification_send":           n.notificationSend,
-		"notifications_send":          n.notificationsSend,
-		"wallet_update":               n.walletUpdate,
-		"wallets_update":              n.walletsUpdate,
-		"wallet_ledger_update":        n.walletLedgerUpdate,
-		"wallet_ledger_list":          n.walletLedgerList,
-		"storage_list":                n.storageList,
-		"storage_read":                n.storageRead,
-		"storage_write":               n.storageWrite,
-		"storage_delete":              n.storageDelete,
-		"leaderboard_create":          n.leaderboardCreate,
-		"leaderboard_delete":          n.leaderboardDelete,
-		"leaderboard_records_list":    n.leaderboardRecordsList,
-		"leaderboard_record_write":    n.leaderboardRecordWrite,
-		"leaderboard_record_delete":   n.leaderboardRecordDelete,
-		"tournament_create":           n.tournamentCreate,
-		"tournament_delete":           n.tournamentDelete,
-		"tournament_add_attempt":      n.tournamentAddAttempt,
-		"tournament_join":             n.tournamentJoin,
-		"tournament_list":             n.tournamentList,
-		"tournament_record_write":     n.tournamentRecordWrite,
-		"tournament_records_haystack": n.tournamentRecordsHaystack,
-		"groups_get_id":               n.groupsGetId,
-		"group_create":                n.groupCreate,
-		"group_update":                n.groupUpdate,
-		"group_delete":                n.groupDelete,
-		"group_users_list":            n.groupUsersList,
-		"user_groups_list":            n.userGroupsList,
+		"register_rpc":                 n.registerRPC,
+		"register_req_before":          n.registerReqBefore,
+		"register_req_after":           n.registerReqAfter,
+		"register_rt_before":           n.registerRTBefore,
+		"register_rt_after":            n.registerRTAfter,
+		"register_matchmaker_matched":  n.registerMatchmakerMatched,
+		"register_matchmaker_override": n.registerMatchmakerOverride,
+		"register_tournament_end":      n.registerTournamentEnd,
+		"register_tournament_reset":    n.registerTournamentReset,
+		"register_leaderboard_reset":   n.registerLeaderboardReset,
+		"register_email_send":          n.registerEmailSend,
+		"run_once":                     n.runOnce,
+		"get_context":                  n.getContext,
+		"localcache_get":               n.localcacheGet,
+		"localcache_put":               n.localcachePut,
+		"localcache_delete":            n.localcacheDelete,
+		"time":                         n.time,
+		"cron_next":                    n.cronNext,
+		"sql_exec":                     n.sqlExec,
+		"sql_query":                    n.sqlQuery,
+		"uuid_v4":                      n.uuidV4,
+		"uuid_bytes_to_string":         n.uuidBytesToString,
+		"uuid_string_to_bytes":         n.uuidStringToBytes,
+		"http_request":                 n.httpRequest,
+		"jwt_generate":                 n.jwtGenerate,
+		"json_encode":                  n.jsonEncode,
+		"json_decode":                  n.jsonDecode,
+		"base64_encode":                n.base64Encode,
+		"base64_decode":                n.base64Decode,
+		"base64url_encode":             n.base64URLEncode,
+		"base64url_decode":             n.base64URLDecode,
+		"base16_encode":                n.base16Encode,
+		"base16_decode":                n.base16Decode,
+		"aes128_encrypt":               n.aes128Encrypt,
+		"aes128_decrypt":               n.aes128Decrypt,
+		"aes256_encrypt":               n.aes256Encrypt,
+		"aes256_decrypt":               n.aes256Decrypt,
+		"md5_hash":                     n.md5Hash,
+		"sha256_hash":                  n.sha256Hash,
+		"hmac_sha256_hash":             n.hmacSHA256Hash,
+		"rsa_sha256_hash":              n.rsaSHA256Hash,
+		"bcrypt_hash":                  n.bcryptHash,
+		"bcrypt_compare":               n.bcryptCompare,
+		"authenticate_custom":          n.authenticateCustom,
+		"authenticate_device":          n.authenticateDevice,
+		"authenticate_email":           n.authenticateEmail,
+		"authenticate_facebook":        n.authenticateFacebook,
+		"authenticate_gamecenter":      n.authenticateGameCenter,
+		"authenticate_google":          n.authenticateGoogle,
+		"authenticate_steam":           n.authenticateSteam,
+		"authenticate_apple":           n.authenticateApple,
+		"authenticate_oidc":            n.authenticateOidc,
+		"authenticate_token_generate":  n.authenticateTokenGenerate,
+		"logger_info":                  n.loggerInfo,
+		"logger_warn":                  n.loggerWarn,
+		"logger_error":                 n.loggerError,
+		"account_get_id":               n.accountGetId,
+		"accounts_get_id":              n.accountsGetId,
+		"account_update_id":            n.accountUpdateId,
+		"account_delete_id":            n.accountDeleteId,
+		"account_merge_id":             n.accountMergeId,
+		"users_get_id":                 n.usersGetId,
+		"users_get_username":           n.usersGetUsername,
+		"users_ban_id":                 n.usersBanId,
+		"users_unban_id":               n.usersUnbanId,
+		"stream_user_list":             n.streamUserList,
+		"stream_user_get":              n.streamUserGet,
+		"stream_user_join":             n.streamUserJoin,
+		"stream_user_update":           n.streamUserUpdate,
+		"stream_user_leave":            n.streamUserLeave,
+		"stream_user_kick":             n.streamUserKick,
+		"stream_count":                 n.streamCount,
+		"stream_close":                 n.streamClose,
+		"stream_send":                  n.streamSend,
+		"stream_send_raw":              n.streamSendRaw,
+		"session_disconnect":           n.sessionDisconnect,
+		"session_logout":               n.sessionLogout,
+		"match_create":                 n.matchCreate,
+		"match_list":                   n.matchList,
+		"match_signal":                 n.matchSignal,
+		"match_recordings_list":        n.matchRecordingsList,
+		"match_replay":                 n.matchReplay,
+		"matchmaker_tickets_list":      n.matchmakerTicketsList,
+		"notification_send":            n.notificationSend,
+		"notifications_send":           n.notificationsSend,
+		"wallet_update":                n.walletUpdate,
+		"wallets_update":               n.walletsUpdate,
+		"wallet_ledger_update":         n.walletLedgerUpdate,
+		"wallet_ledger_list":           n.walletLedgerList,
+		"purchase_validate_apple":      n.purchaseValidateApple,
+		"purchase_validate_google":     n.purchaseValidateGoogle,
+		"purchase_validate_huawei":     n.purchaseValidateHuawei,
+		"purchases_list":               n.purchasesList,
+		"storage_list":                 n.storageList,
+		"storage_read":                 n.storageRead,
+		"storage_write":                n.storageWrite,
+		"storage_delete":               n.storageDelete,
+		"leaderboard_create":           n.leaderboardCreate,
+		"leaderboard_delete":           n.leaderboardDelete,
+		"leaderboard_records_list":     n.leaderboardRecordsList,
+		"leaderboard_record_write":     n.leaderboardRecordWrite,
+		"leaderboard_record_delete":    n.leaderboardRecordDelete,
+		"tournament_create":            n.tournamentCreate,
+		"tournament_delete":            n.tournamentDelete,
+		"tournament_add_attempt":       n.tournamentAddAttempt,
+		"tournament_join":              n.tournamentJoin,
+		"tournament_list":              n.tournamentList,
+		"tournament_record_write":      n.tournamentRecordWrite,
+		"tournament_records_haystack":  n.tournamentRecordsHaystack,
+		"groups_get_id":                n.groupsGetId,
+		"group_create":                 n.groupCreate,
+		"group_update":                 n.groupUpdate,
+		"group_delete":                 n.groupDelete,
+		"group_users_list":             n.groupUsersList,
+		"user_groups_list":             n.userGroupsList,
+		"friends_list":                 n.friendsList,
+		"friends_mutual_list":          n.friendsMutualList,
+		"friend_suggestions_list":      n.friendSuggestionsList,
+		"friend_metadata_update":       n.friendMetadataUpdate,
 	}
 	mod := l.SetFuncs(l.CreateTable(0, len(functions)), functions)
 
@@ -328,6 +350,18 @@ func (n *RuntimeLuaNakamaModule) registerMatchmakerMatched(l *lua.LState) int {
 	return 0
 }
 
+func (n *RuntimeLuaNakamaModule) registerMatchmakerOverride(l *lua.LState) int {
+	fn := l.CheckFunction(1)
+
+	if n.registerCallbackFn != nil {
+		n.registerCallbackFn(RuntimeExecutionModeMatchmakerOverride, "", fn)
+	}
+	if n.announceCallbackFn != nil {
+		n.announceCallbackFn(RuntimeExecutionModeMatchmakerOverride, "")
+	}
+	return 0
+}
+
 func (n *RuntimeLuaNakamaModule) registerTournamentEnd(l *lua.LState) int {
 	fn := l.CheckFunction(1)
 
@@ -364,6 +398,18 @@ func (n *RuntimeLuaNakamaModule) registerLeaderboardReset(l *lua.LState) int {
 	return 0
 }
 
+func (n *RuntimeLuaNakamaModule) registerEmailSend(l *lua.LState) int {
+	fn := l.CheckFunction(1)
+
+	if n.registerCallbackFn != nil {
+		n.registerCallbackFn(RuntimeExecutionModeEmailSend, "", fn)
+	}
+	if n.announceCallbackFn != nil {
+		n.announceCallbackFn(RuntimeExecutionModeEmailSend, "")
+	}
+	return 0
+}
+
 func (n *RuntimeLuaNakamaModule) runOnce(l *lua.LState) int {
 	n.once.Do(func() {
 		fn := l.CheckFunction(1)
@@ -1412,6 +1458,88 @@ func (n *RuntimeLuaNakamaModule) authenticateGoogle(l *lua.LState) int {
 	return 3
 }
 
+func (n *RuntimeLuaNakamaModule) authenticateApple(l *lua.LState) int {
+	if n.config.GetSocial().Apple.BundleID == "" {
+		l.RaiseError("Apple authentication is not configured")
+		return 0
+	}
+
+	// Parse token.
+	token := l.CheckString(1)
+	if token == "" {
+		l.ArgError(1, "expects token string")
+		return 0
+	}
+
+	// Parse username, if any.
+	username := l.OptString(2, "")
+	if username == "" {
+		username = generateUsername()
+	} else if invalidCharsRegex.MatchString(username) {
+		l.ArgError(2, "expects username to be valid, no spaces or control characters allowed")
+		return 0
+	} else if len(username) > 128 {
+		l.ArgError(2, "expects id to be valid, must be 1-128 bytes")
+		return 0
+	}
+
+	// Parse create flag, if any.
+	create := l.OptBool(3, true)
+
+	dbUserID, dbUsername, created, err := AuthenticateApple(l.Context(), n.logger, n.db, n.socialClient, n.config.GetSocial().Apple.BundleID, token, username, create)
+	if err != nil {
+		l.RaiseError("error authenticating: %v", err.Error())
+		return 0
+	}
+
+	l.Push(lua.LString(dbUserID))
+	l.Push(lua.LString(dbUsername))
+	l.Push(lua.LBool(created))
+	return 3
+}
+
+func (n *RuntimeLuaNakamaModule) authenticateOidc(l *lua.LState) int {
+	// Parse provider.
+	provider := l.CheckString(1)
+	if provider == "" {
+		l.ArgError(1, "expects provider string")
+		return 0
+	}
+
+	// Parse token.
+	token := l.CheckString(2)
+	if token == "" {
+		l.ArgError(2, "expects token string")
+		return 0
+	}
+
+	// Parse username, if any.
+	username := l.OptString(3, "")
+	if username == "" {
+		username = generateUsername()
+	} else if invalidCharsRegex.MatchString(username) {
+		l.ArgError(3, "expects username to be valid, no spaces or control characters allowed")
+		return 0
+	} else if len(username) > 128 {
+		l.ArgError(3, "expects id to be valid, must be 1-128 bytes")
+		return 0
+	}
+
+	// Parse create flag, if any.
+	create := l.OptBool(4, true)
+
+	dbUserID, dbUsername, created, err := AuthenticateOidc(l.Context(), n.logger, n.db, n.socialClient, n.config, provider, token, username, create)
+	if err != nil {
+		l.RaiseError("error authenticating: %v", err.Error())
+		return 0
+	}
+
+	l.Push(lua.LString(dbUserID))
+	l.Push(lua.LString(dbUsername))
+	l.Push(lua.LBool(created))
+	return 3
+}
+
 func (n *RuntimeLuaNakamaModule) authenticateSteam(l *lua.LState) int {
 	if n.config.GetSocial().Steam.PublisherKey == "" || n.config.GetSocial().Steam.AppID == 0 {
 		l.RaiseError("Steam authentication is not configured")
@@ -1556,6 +1684,9 @@ func (n *RuntimeLuaNakamaModule) accountGetId(l *lua.LState) int {
 	if account.User.SteamId != "" {
 		accountTable.RawSetString("steam_id", lua.LString(account.User.SteamId))
 	}
+	if account.User.AppleId != "" {
+		accountTable.RawSetString("apple_id", lua.LString(account.User.AppleId))
+	}
 	accountTable.RawSetString("online", lua.LBool(account.User.Online))
 	accountTable.RawSetString("edge_count", lua.LNumber(account.User.EdgeCount))
 	accountTable.RawSetString("create_time", lua.LNumber(account.User.CreateTime.Seconds))
@@ -1665,6 +1796,9 @@ func (n *RuntimeLuaNakamaModule) accountsGetId(l *lua.LState) int {
 		if account.User.SteamId != "" {
 			accountTable.RawSetString("steam_id", lua.LString(account.User.SteamId))
 		}
+		if account.User.AppleId != "" {
+			accountTable.RawSetString("apple_id", lua.LString(account.User.AppleId))
+		}
 		accountTable.RawSetString("online", lua.LBool(account.User.Online))
 		accountTable.RawSetString("edge_count", lua.LNumber(account.User.EdgeCount))
 		accountTable.RawSetString("create_time", lua.LNumber(account.User.CreateTime.Seconds))
@@ -1714,6 +1848,59 @@ func (n *RuntimeLuaNakamaModule) accountsGetId(l *lua.LState) int {
 	return 1
 }
 
+func (n *RuntimeLuaNakamaModule) accountMergeId(l *lua.LState) int {
+	sourceID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects source user ID to be a valid identifier")
+		return 0
+	}
+	targetID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects target user ID to be a valid identifier")
+		return 0
+	}
+
+	options := &AccountMergeOptions{}
+	if optionsTable := l.OptTable(3, nil); optionsTable != nil {
+		conversionError := ""
+		optionsTable.ForEach(func(k lua.LValue, v lua.LValue) {
+			if conversionError != "" {
+				return
+			}
+			var conflict *AccountMergeConflict
+			switch k.String() {
+			case "identities":
+				conflict = &options.Identities
+			case "storage":
+				conflict = &options.Storage
+			case "leaderboard":
+				conflict = &options.Leaderboard
+			default:
+				conversionError = fmt.Sprintf("unrecognised merge option: %v", k.String())
+				return
+			}
+			if v.Type() != lua.LTString {
+				conversionError = fmt.Sprintf("merge option %v must be a string", k.String())
+				return
+			}
+			var err error
+			if *conflict, err = ParseAccountMergeConflict(v.String()); err != nil {
+				conversionError = err.Error()
+			}
+		})
+		if conversionError != "" {
+			l.ArgError(3, conversionError)
+			return 0
+		}
+	}
+
+	if err := MergeAccounts(l.Context(), n.logger, n.db, n.sessionCache, n.leaderboardCache, n.rankCache, sourceID, targetID, options); err != nil {
+		l.RaiseError("error while trying to merge accounts: %v", err.Error())
+	}
+
+	return 0
+}
+
 func (n *RuntimeLuaNakamaModule) usersGetId(l *lua.LState) int {
 	// Input table validation.
 	input := l.OptTable(1, nil)
@@ -1779,6 +1966,9 @@ func (n *RuntimeLuaNakamaModule) usersGetId(l *lua.LState) int {
 		if u.SteamId != "" {
 			ut.RawSetString("steam_id", lua.LString(u.SteamId))
 		}
+		if u.AppleId != "" {
+			ut.RawSetString("apple_id", lua.LString(u.AppleId))
+		}
 		ut.RawSetString("online", lua.LBool(u.Online))
 		ut.RawSetString("edge_count", lua.LNumber(u.EdgeCount))
 		ut.RawSetString("create_time", lua.LNumber(u.CreateTime.Seconds))
@@ -1862,6 +2052,9 @@ func (n *RuntimeLuaNakamaModule) usersGetUsername(l *lua.LState) int {
 		if u.SteamId != "" {
 			ut.RawSetString("steam_id", lua.LString(u.SteamId))
 		}
+		if u.AppleId != "" {
+			ut.RawSetString("apple_id", lua.LString(u.AppleId))
+		}
 		ut.RawSetString("online", lua.LBool(u.Online))
 		ut.RawSetString("edge_count", lua.LNumber(u.EdgeCount))
 		ut.RawSetString("create_time", lua.LNumber(u.CreateTime.Seconds))
@@ -1917,7 +2110,7 @@ func (n *RuntimeLuaNakamaModule) usersBanId(l *lua.LState) int {
 	}
 
 	// Ban the user accounts.
-	err := BanUsers(l.Context(), n.logger, n.db, userIDStrings)
+	err := BanUsers(l.Context(), n.logger, n.db, n.sessionCache, userIDStrings)
 	if err != nil {
 		l.RaiseError(fmt.Sprintf("failed to ban users: %s", err.Error()))
 		return 0
@@ -2858,7 +3051,7 @@ func (n *RuntimeLuaNakamaModule) streamSend(l *lua.LState) int {
 		n.router.SendToStream(n.logger, stream, msg)
 	} else {
 		// Sending to a subset of stream users.
-		n.router.SendToPresenceIDs(n.logger, presenceIDs, true, stream.Mode, msg)
+		n.router.SendToPresenceIDs(n.logger, presenceIDs, true, stream.Mode, msg, true)
 	}
 
 	return 0
@@ -3006,7 +3199,7 @@ func (n *RuntimeLuaNakamaModule) streamSendRaw(l *lua.LState) int {
 		n.router.SendToStream(n.logger, stream, msg)
 	} else {
 		// Sending to a subset of stream users.
-		n.router.SendToPresenceIDs(n.logger, presenceIDs, true, stream.Mode, msg)
+		n.router.SendToPresenceIDs(n.logger, presenceIDs, true, stream.Mode, msg, true)
 	}
 
 	return 0
@@ -3033,6 +3226,29 @@ func (n *RuntimeLuaNakamaModule) sessionDisconnect(l *lua.LState) int {
 	return 0
 }
 
+func (n *RuntimeLuaNakamaModule) sessionLogout(l *lua.LState) int {
+	// Parse input User ID.
+	userIDString := l.CheckString(1)
+	if userIDString == "" {
+		l.ArgError(1, "expects user id")
+		return 0
+	}
+	userID, err := uuid.FromString(userIDString)
+	if err != nil {
+		l.ArgError(1, "expects valid user id")
+		return 0
+	}
+
+	// Both tokens are optional, if neither is given all of the user's sessions are logged out.
+	token := l.OptString(2, "")
+	refreshToken := l.OptString(3, "")
+
+	if err := SessionLogout(l.Context(), n.logger, n.db, n.config, n.sessionCache, userID, token, refreshToken); err != nil {
+		l.RaiseError("failed to logout: %s", err.Error())
+	}
+	return 0
+}
+
 func (n *RuntimeLuaNakamaModule) matchCreate(l *lua.LState) int {
 	// Parse the name of the Lua module that should handle the match.
 	module := l.CheckString(1)
@@ -3138,6 +3354,112 @@ func (n *RuntimeLuaNakamaModule) matchList(l *lua.LState) int {
 	return 1
 }
 
+func (n *RuntimeLuaNakamaModule) matchSignal(l *lua.LState) int {
+	// Parse match ID.
+	id := l.CheckString(1)
+	// Validate the match ID.
+	idComponents := strings.SplitN(id, ".", 2)
+	if len(idComponents) != 2 {
+		l.ArgError(1, "expects a valid match ID")
+		return 0
+	}
+	matchID, err := uuid.FromString(idComponents[0])
+	if err != nil {
+		l.ArgError(1, "expects a valid match ID")
+		return 0
+	}
+	node := idComponents[1]
+
+	// Parse signal data.
+	data := l.OptString(2, "")
+
+	result, err := n.matchRegistry.Signal(l.Context(), matchID, node, data)
+	if err != nil {
+		l.RaiseError("failed to signal match: %s", err.Error())
+		return 0
+	}
+
+	l.Push(lua.LString(result))
+	return 1
+}
+
+func (n *RuntimeLuaNakamaModule) matchRecordingsList(l *lua.LState) int {
+	headers, err := ListMatchRecordings(n.logger, MatchRecordingDir(n.config))
+	if err != nil {
+		l.RaiseError("failed to list match recordings: %s", err.Error())
+		return 0
+	}
+
+	recordings := l.CreateTable(len(headers), 0)
+	for i, header := range headers {
+		recording := l.CreateTable(0, 4)
+		recording.RawSetString("id", lua.LString(header.ID))
+		recording.RawSetString("match_id", lua.LString(header.MatchID))
+		recording.RawSetString("module", lua.LString(header.Module))
+		recording.RawSetString("create_time", lua.LNumber(header.CreateTime))
+		recordings.RawSetInt(i+1, recording)
+	}
+	l.Push(recordings)
+	return 1
+}
+
+func (n *RuntimeLuaNakamaModule) matchReplay(l *lua.LState) int {
+	id := l.CheckString(1)
+	if id == "" {
+		l.ArgError(1, "expects recording id")
+		return 0
+	}
+
+	result, err := n.matchRegistry.Replay(l.Context(), n.matchCreateFn, id)
+	if err != nil {
+		l.RaiseError("failed to replay match recording: %s", err.Error())
+		return 0
+	}
+
+	out := l.CreateTable(0, 5)
+	out.RawSetString("events", lua.LNumber(result.Events))
+	out.RawSetString("ticks", lua.LNumber(result.Ticks))
+	out.RawSetString("recorded_broadcasts", lua.LNumber(result.RecordedBroadcasts))
+	out.RawSetString("replayed_broadcasts", lua.LNumber(result.ReplayedBroadcasts))
+	out.RawSetString("diverged_tick", lua.LNumber(result.DivergedTick))
+	l.Push(out)
+	return 1
+}
+
+func (n *RuntimeLuaNakamaModule) matchmakerTicketsList(l *lua.LState) int {
+	// Parse limit.
+	limit := l.OptInt(1, 0)
+	if limit < 0 {
+		l.ArgError(1, "expects limit to be 0 or greater")
+		return 0
+	}
+
+	entries := n.matchmaker.List(limit)
+
+	tickets := RuntimeLuaConvertMatchmakerEntries(l, entries)
+	for i, entry := range entries {
+		presences := l.CreateTable(len(entry.Presences), 0)
+		for j, presence := range entry.Presences {
+			presenceTable := l.CreateTable(0, 4)
+			presenceTable.RawSetString("user_id", lua.LString(presence.UserId))
+			presenceTable.RawSetString("session_id", lua.LString(presence.SessionId))
+			presenceTable.RawSetString("username", lua.LString(presence.Username))
+			presenceTable.RawSetString("node", lua.LString(presence.Node))
+			presences.RawSetInt(j+1, presenceTable)
+		}
+
+		ticket := tickets.RawGetInt(i + 1).(*lua.LTable)
+		ticket.RawSetString("presences", presences)
+		ticket.RawSetString("query", lua.LString(entry.Query))
+		ticket.RawSetString("min_count", lua.LNumber(entry.MinCount))
+		ticket.RawSetString("max_count", lua.LNumber(entry.MaxCount))
+		ticket.RawSetString("create_time", lua.LNumber(entry.GetCreateTime()))
+		ticket.RawSetString("wait_sec", lua.LNumber(entry.WaitSec))
+	}
+	l.Push(tickets)
+	return 1
+}
+
 func (n *RuntimeLuaNakamaModule) notificationSend(l *lua.LState) int {
 	u := l.CheckString(1)
 	userID, err := uuid.FromString(u)
@@ -3582,6 +3904,129 @@ func (n *RuntimeLuaNakamaModule) walletLedgerList(l *lua.LState) int {
 	return 1
 }
 
+func (n *RuntimeLuaNakamaModule) purchaseValidateApple(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects a valid user id")
+		return 0
+	}
+
+	receipt := l.CheckString(2)
+	if receipt == "" {
+		l.ArgError(2, "expects receipt")
+		return 0
+	}
+
+	validation, err := ValidatePurchaseApple(l.Context(), n.logger, n.db, n.socialClient, n.config.GetIAP().AppleSharedPassword, n.config.GetIAP().AppleBundleID, userID, receipt)
+	if err != nil {
+		l.RaiseError("error validating Apple receipt: %v", err.Error())
+		return 0
+	}
+
+	l.Push(validatedPurchasesToLuaTable(l, validation.ValidatedPurchases))
+	return 1
+}
+
+func (n *RuntimeLuaNakamaModule) purchaseValidateGoogle(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects a valid user id")
+		return 0
+	}
+
+	purchase := l.CheckString(2)
+	if purchase == "" {
+		l.ArgError(2, "expects purchase")
+		return 0
+	}
+
+	validation, err := ValidatePurchaseGoogle(l.Context(), n.logger, n.db, n.socialClient, n.config.GetIAP().GoogleClientEmail, n.config.GetIAP().GooglePrivateKey, n.config.GetIAP().GooglePackageName, userID, purchase)
+	if err != nil {
+		l.RaiseError("error validating Google purchase: %v", err.Error())
+		return 0
+	}
+
+	l.Push(validatedPurchasesToLuaTable(l, validation.ValidatedPurchases))
+	return 1
+}
+
+func (n *RuntimeLuaNakamaModule) purchaseValidateHuawei(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects a valid user id")
+		return 0
+	}
+
+	purchase := l.CheckString(2)
+	if purchase == "" {
+		l.ArgError(2, "expects purchase")
+		return 0
+	}
+
+	signature := l.CheckString(3)
+	if signature == "" {
+		l.ArgError(3, "expects signature")
+		return 0
+	}
+
+	validation, err := ValidatePurchaseHuawei(l.Context(), n.logger, n.db, n.socialClient, n.config.GetIAP().HuaweiPublicKey, n.config.GetIAP().HuaweiClientID, n.config.GetIAP().HuaweiClientSecret, userID, purchase, signature)
+	if err != nil {
+		l.RaiseError("error validating Huawei purchase: %v", err.Error())
+		return 0
+	}
+
+	l.Push(validatedPurchasesToLuaTable(l, validation.ValidatedPurchases))
+	return 1
+}
+
+func (n *RuntimeLuaNakamaModule) purchasesList(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects a valid user id")
+		return 0
+	}
+
+	limit := l.OptInt(2, 100)
+	if limit < 1 || limit > 100 {
+		l.ArgError(2, "expects a limit 1-100")
+		return 0
+	}
+
+	cursor := l.OptString(3, "")
+
+	purchaseList, err := ListPurchases(l.Context(), n.logger, n.db, userID, limit, cursor)
+	if err != nil {
+		l.RaiseError("error retrieving purchases: %v", err.Error())
+		return 0
+	}
+
+	l.Push(validatedPurchasesToLuaTable(l, purchaseList.ValidatedPurchases))
+	if purchaseList.Cursor != "" {
+		l.Push(lua.LString(purchaseList.Cursor))
+	} else {
+		l.Push(lua.LNil)
+	}
+	return 2
+}
+
+func validatedPurchasesToLuaTable(l *lua.LState, purchases []*api.ValidatedPurchase) *lua.LTable {
+	purchasesTable := l.CreateTable(len(purchases), 0)
+	for i, p := range purchases {
+		purchaseTable := l.CreateTable(0, 8)
+		purchaseTable.RawSetString("product_id", lua.LString(p.ProductId))
+		purchaseTable.RawSetString("transaction_id", lua.LString(p.TransactionId))
+		purchaseTable.RawSetString("store", lua.LNumber(p.Store.Value))
+		purchaseTable.RawSetString("purchase_time", lua.LNumber(p.PurchaseTime.Seconds))
+		purchaseTable.RawSetString("create_time", lua.LNumber(p.CreateTime.Seconds))
+		purchaseTable.RawSetString("update_time", lua.LNumber(p.UpdateTime.Seconds))
+		purchaseTable.RawSetString("provider_response", lua.LString(p.ProviderResponse))
+		purchaseTable.RawSetString("environment", lua.LNumber(p.Environment.Value))
+
+		purchasesTable.RawSetInt(i+1, purchaseTable)
+	}
+	return purchasesTable
+}
+
 func (n *RuntimeLuaNakamaModule) storageList(l *lua.LState) int {
 	userIDString := l.OptString(1, "")
 	collection := l.OptString(2, "")
@@ -5069,7 +5514,7 @@ func (n *RuntimeLuaNakamaModule) groupUsersKick(l *lua.LState) int {
 		return 0
 	}
 
-	if err := KickGroupUsers(l.Context(), n.logger, n.db, uuid.Nil, groupID, userIDs); err != nil {
+	if err := KickGroupUsers(l.Context(), n.logger, n.db, n.router, uuid.Nil, groupID, userIDs); err != nil {
 		l.RaiseError("error while trying to kick users from a group: %v", err.Error())
 	}
 	return 0
@@ -5112,6 +5557,9 @@ func (n *RuntimeLuaNakamaModule) groupUsersList(l *lua.LState) int {
 		if u.SteamId != "" {
 			ut.RawSetString("steam_id", lua.LString(u.SteamId))
 		}
+		if u.AppleId != "" {
+			ut.RawSetString("apple_id", lua.LString(u.AppleId))
+		}
 		ut.RawSetString("online", lua.LBool(u.Online))
 		ut.RawSetString("edge_count", lua.LNumber(u.EdgeCount))
 		ut.RawSetString("create_time", lua.LNumber(u.CreateTime.Seconds))
@@ -5187,6 +5635,214 @@ func (n *RuntimeLuaNakamaModule) userGroupsList(l *lua.LState) int {
 	return 1
 }
 
+func (n *RuntimeLuaNakamaModule) friendsList(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	limit := l.OptInt(2, 100)
+	if limit < 1 || limit > 100 {
+		l.ArgError(2, "limit must be 1-100")
+		return 0
+	}
+
+	var state *wrappers.Int32Value
+	if stateL := l.Get(3); stateL != lua.LNil {
+		stateNumber := l.CheckInt(3)
+		if stateNumber < 0 || stateNumber > 3 {
+			l.ArgError(3, "state must be 0-3")
+			return 0
+		}
+		state = &wrappers.Int32Value{Value: int32(stateNumber)}
+	}
+
+	cursor := l.OptString(4, "")
+
+	friends, err := ListFriends(l.Context(), n.logger, n.db, n.tracker, userID, limit, state, cursor)
+	if err != nil {
+		l.RaiseError("error while trying to list friends for a user: %v", err.Error())
+		return 0
+	}
+
+	friendsTable := l.CreateTable(len(friends.Friends), 0)
+	for i, f := range friends.Friends {
+		ut, err := userToLuaTable(l, f.User)
+		if err != nil {
+			l.RaiseError("failed to convert metadata to json: %s", err.Error())
+			return 0
+		}
+
+		metadataMap := make(map[string]interface{})
+		err = json.Unmarshal([]byte(f.Metadata), &metadataMap)
+		if err != nil {
+			l.RaiseError("failed to convert metadata to json: %s", err.Error())
+			return 0
+		}
+
+		ft := l.CreateTable(0, 4)
+		ft.RawSetString("user", ut)
+		ft.RawSetString("state", lua.LNumber(f.State.Value))
+		ft.RawSetString("update_time", lua.LNumber(f.UpdateTime.Seconds))
+		ft.RawSetString("metadata", RuntimeLuaConvertMap(l, metadataMap))
+
+		friendsTable.RawSetInt(i+1, ft)
+	}
+
+	l.Push(friendsTable)
+	if friends.Cursor == "" {
+		l.Push(lua.LNil)
+	} else {
+		l.Push(lua.LString(friends.Cursor))
+	}
+	return 2
+}
+
+func (n *RuntimeLuaNakamaModule) friendsMutualList(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	otherUserID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects other user ID to be a valid identifier")
+		return 0
+	}
+
+	limit := l.OptInt(3, 100)
+	if limit < 1 || limit > 100 {
+		l.ArgError(3, "limit must be 1-100")
+		return 0
+	}
+
+	users, err := ListMutualFriends(l.Context(), n.logger, n.db, n.tracker, userID, otherUserID, limit)
+	if err != nil {
+		l.RaiseError("error while trying to list mutual friends: %v", err.Error())
+		return 0
+	}
+
+	usersTable := l.CreateTable(len(users.Users), 0)
+	for i, u := range users.Users {
+		ut, err := userToLuaTable(l, u)
+		if err != nil {
+			l.RaiseError("failed to convert metadata to json: %s", err.Error())
+			return 0
+		}
+		usersTable.RawSetInt(i+1, ut)
+	}
+
+	l.Push(usersTable)
+	return 1
+}
+
+func (n *RuntimeLuaNakamaModule) friendSuggestionsList(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	limit := l.OptInt(2, 100)
+	if limit < 1 || limit > 100 {
+		l.ArgError(2, "limit must be 1-100")
+		return 0
+	}
+
+	suggestions, err := ListFriendSuggestions(l.Context(), n.logger, n.db, n.tracker, userID, limit)
+	if err != nil {
+		l.RaiseError("error while trying to list friend suggestions: %v", err.Error())
+		return 0
+	}
+
+	suggestionsTable := l.CreateTable(len(suggestions.Suggestions), 0)
+	for i, s := range suggestions.Suggestions {
+		ut, err := userToLuaTable(l, s.User)
+		if err != nil {
+			l.RaiseError("failed to convert metadata to json: %s", err.Error())
+			return 0
+		}
+
+		st := l.CreateTable(0, 4)
+		st.RawSetString("user", ut)
+		st.RawSetString("mutual_friend_count", lua.LNumber(s.MutualFriendCount))
+		st.RawSetString("shared_group_count", lua.LNumber(s.SharedGroupCount))
+		st.RawSetString("played_recently", lua.LBool(s.PlayedRecently))
+
+		suggestionsTable.RawSetInt(i+1, st)
+	}
+
+	l.Push(suggestionsTable)
+	return 1
+}
+
+func (n *RuntimeLuaNakamaModule) friendMetadataUpdate(l *lua.LState) int {
+	userID, err := uuid.FromString(l.CheckString(1))
+	if err != nil {
+		l.ArgError(1, "expects user ID to be a valid identifier")
+		return 0
+	}
+
+	friendID, err := uuid.FromString(l.CheckString(2))
+	if err != nil {
+		l.ArgError(2, "expects friend user ID to be a valid identifier")
+		return 0
+	}
+
+	metadataMap := RuntimeLuaConvertLuaTable(l.CheckTable(3))
+	metadataBytes, err := json.Marshal(metadataMap)
+	if err != nil {
+		l.RaiseError("error encoding metadata: %v", err.Error())
+		return 0
+	}
+
+	if err = UpdateFriendMetadata(l.Context(), n.logger, n.db, userID, friendID, string(metadataBytes)); err != nil {
+		l.RaiseError("error while trying to update friend metadata: %v", err.Error())
+		return 0
+	}
+	return 0
+}
+
+func userToLuaTable(l *lua.LState, u *api.User) (*lua.LTable, error) {
+	ut := l.CreateTable(0, 16)
+	ut.RawSetString("user_id", lua.LString(u.Id))
+	ut.RawSetString("username", lua.LString(u.Username))
+	ut.RawSetString("display_name", lua.LString(u.DisplayName))
+	ut.RawSetString("avatar_url", lua.LString(u.AvatarUrl))
+	ut.RawSetString("lang_tag", lua.LString(u.LangTag))
+	ut.RawSetString("location", lua.LString(u.Location))
+	ut.RawSetString("timezone", lua.LString(u.Timezone))
+	if u.FacebookId != "" {
+		ut.RawSetString("facebook_id", lua.LString(u.FacebookId))
+	}
+	if u.GoogleId != "" {
+		ut.RawSetString("google_id", lua.LString(u.GoogleId))
+	}
+	if u.GamecenterId != "" {
+		ut.RawSetString("gamecenter_id", lua.LString(u.GamecenterId))
+	}
+	if u.SteamId != "" {
+		ut.RawSetString("steam_id", lua.LString(u.SteamId))
+	}
+	if u.AppleId != "" {
+		ut.RawSetString("apple_id", lua.LString(u.AppleId))
+	}
+	ut.RawSetString("online", lua.LBool(u.Online))
+	ut.RawSetString("edge_count", lua.LNumber(u.EdgeCount))
+	ut.RawSetString("create_time", lua.LNumber(u.CreateTime.Seconds))
+	ut.RawSetString("update_time", lua.LNumber(u.UpdateTime.Seconds))
+
+	metadataMap := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(u.Metadata), &metadataMap); err != nil {
+		return nil, err
+	}
+	ut.RawSetString("metadata", RuntimeLuaConvertMap(l, metadataMap))
+
+	return ut, nil
+}
+
 func (n *RuntimeLuaNakamaModule) accountUpdateId(l *lua.LState) int {
 	userID, err := uuid.FromString(l.CheckString(1))
 	if err != nil {
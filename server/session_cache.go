@@ -0,0 +1,323 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// How long the stored revocation time looked up for a user with long-lived tokens is reused before it is checked again.
+	sessionRevocationCheckTTL = 30 * time.Second
+	// Longest time a request waits on the database to check a long-lived token.
+	sessionRevocationCheckTimeout = 3 * time.Second
+)
+
+// SessionCache tracks session tokens that have been revoked before their natural expiry.
+type SessionCache interface {
+	Stop()
+
+	// IsRevoked checks if the given token, issued to the given user at the given time in milliseconds and expiring at
+	// the given time in seconds, has been revoked.
+	IsRevoked(ctx context.Context, userID uuid.UUID, tokenID string, issuedAt, exp int64) bool
+	// Revoke a single session token, and disconnect any sessions opened with it.
+	Revoke(userID uuid.UUID, tokenID string, exp int64)
+	// RevokeAll revokes all session tokens issued to the user so far, and disconnects all their sessions.
+	RevokeAll(userID uuid.UUID)
+}
+
+// LocalSessionCache keeps revocations in memory to check tokens quickly, and stores them in the database so they
+// outlive the node and are seen by nodes that start later.
+type LocalSessionCache struct {
+	sync.RWMutex
+	logger          *zap.Logger
+	db              *sql.DB
+	sessionRegistry SessionRegistry
+	tracker         Tracker
+	node            string
+	// Longest lifetime of a token issued by the server's own authentication, in milliseconds.
+	tokenLifetime int64
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	// Revoked token IDs, and when each expires in seconds.
+	tokens map[string]int64
+	// For each user, the time in milliseconds up to which all tokens issued to them are revoked.
+	users map[uuid.UUID]int64
+	// Stored revocation times looked up to check long-lived tokens, which may outlive the revocations kept in users.
+	checks map[uuid.UUID]sessionRevocationCheck
+}
+
+type sessionRevocationCheck struct {
+	// Time in milliseconds up to which all the user's tokens are revoked, or 0 if they never were.
+	revokeTime int64
+	checkedAt  time.Time
+}
+
+func NewLocalSessionCache(logger, startupLogger *zap.Logger, config Config, db *sql.DB, sessionRegistry SessionRegistry, tracker Tracker) SessionCache {
+	return newLocalSessionCache(logger, startupLogger, config, db, sessionRegistry, tracker)
+}
+
+func newLocalSessionCache(logger, startupLogger *zap.Logger, config Config, db *sql.DB, sessionRegistry SessionRegistry, tracker Tracker) *LocalSessionCache {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	c := &LocalSessionCache{
+		logger:          logger,
+		db:              db,
+		sessionRegistry: sessionRegistry,
+		tracker:         tracker,
+		node:            config.GetName(),
+		tokenLifetime:   int64(config.GetSession().TokenExpirySec) * 1000,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		tokens: make(map[string]int64),
+		users:  make(map[uuid.UUID]int64),
+		checks: make(map[uuid.UUID]sessionRevocationCheck),
+	}
+
+	if err := c.load(); err != nil {
+		startupLogger.Fatal("Error loading session revocations from database", zap.Error(err))
+	}
+
+	go func() {
+		// Tokens that have expired are rejected anyway, so there's no need to remember they were revoked.
+		ticker := time.NewTicker(time.Duration(config.GetSession().TokenExpirySec) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-ticker.C:
+				now := time.Now().UTC()
+				c.Lock()
+				for tokenID, exp := range c.tokens {
+					if exp <= now.Unix() {
+						delete(c.tokens, tokenID)
+					}
+				}
+				for userID, revokeTime := range c.users {
+					// Tokens the server issued before the revocation have all expired, any others are checked in the database.
+					if revokeTime+c.tokenLifetime <= now.UnixNano()/int64(time.Millisecond) {
+						delete(c.users, userID)
+					}
+				}
+				for userID, check := range c.checks {
+					if now.Sub(check.checkedAt) > sessionRevocationCheckTTL {
+						delete(c.checks, userID)
+					}
+				}
+				c.Unlock()
+
+				if _, err := c.db.ExecContext(c.ctx, "DELETE FROM session_revocation WHERE expiry_time <= $1", now); err != nil {
+					c.logger.Warn("Error removing expired session revocations", zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	return c
+}
+
+func (c *LocalSessionCache) Stop() {
+	c.ctxCancelFn()
+}
+
+func (c *LocalSessionCache) IsRevoked(ctx context.Context, userID uuid.UUID, tokenID string, issuedAt, exp int64) bool {
+	c.RLock()
+	revokeTime, ok := c.users[userID]
+	_, tokenRevoked := c.tokens[tokenID]
+	check, checked := c.checks[userID]
+	c.RUnlock()
+	if ok && issuedAt <= revokeTime {
+		return true
+	}
+	if tokenID != "" && tokenRevoked {
+		return true
+	}
+	if issuedAt != 0 && exp*1000-issuedAt <= c.tokenLifetime {
+		// Revocations are only dropped from memory once tokens with the usual lifetime issued before them have expired.
+		return false
+	}
+
+	// Tokens generated by the runtime with a longer expiry, or issued before revocation was introduced, may outlive
+	// the revocations held in memory. Look up the user's stored revocation time, and remember it for a while.
+	if !checked || time.Since(check.checkedAt) > sessionRevocationCheckTTL {
+		var err error
+		if check, err = c.check(ctx, userID); err != nil {
+			// Fall back on the previous answer if there is one, otherwise only the revocations held in memory apply.
+			c.logger.Warn("Error checking session revocation", zap.String("uid", userID.String()), zap.Error(err))
+		}
+	}
+	return check.revokeTime != 0 && issuedAt <= check.revokeTime
+}
+
+// Look up the stored time up to which all the user's tokens are revoked. On error the previous answer is returned, or
+// a zero value if there is none.
+func (c *LocalSessionCache) check(ctx context.Context, userID uuid.UUID) (sessionRevocationCheck, error) {
+	ctx, ctxCancelFn := context.WithTimeout(ctx, sessionRevocationCheckTimeout)
+	defer ctxCancelFn()
+
+	var revokeTime time.Time
+	err := c.db.QueryRowContext(ctx, "SELECT revoke_time FROM session_revocation WHERE user_id = $1 AND token_id = ''", userID).Scan(&revokeTime)
+	check := sessionRevocationCheck{checkedAt: time.Now()}
+	switch err {
+	case nil:
+		check.revokeTime = revokeTime.UnixNano() / int64(time.Millisecond)
+	case sql.ErrNoRows:
+	default:
+		c.RLock()
+		previous := c.checks[userID]
+		c.RUnlock()
+		return previous, err
+	}
+
+	c.Lock()
+	if current, ok := c.checks[userID]; ok && current.revokeTime > check.revokeTime {
+		// A revocation was applied while the lookup was in progress.
+		check.revokeTime = current.revokeTime
+	}
+	c.checks[userID] = check
+	c.Unlock()
+	return check, nil
+}
+
+func (c *LocalSessionCache) Revoke(userID uuid.UUID, tokenID string, exp int64) {
+	if tokenID == "" {
+		// Legacy tokens cannot be revoked individually.
+		return
+	}
+
+	query := `
+INSERT INTO session_revocation (user_id, token_id, revoke_time, expiry_time)
+VALUES ($1, $2, now(), $3)
+ON CONFLICT (user_id, token_id) DO NOTHING`
+	if _, err := c.db.ExecContext(c.ctx, query, userID, tokenID, time.Unix(exp, 0).UTC()); err != nil {
+		c.logger.Error("Error storing session revocation", zap.String("uid", userID.String()), zap.Error(err))
+	}
+
+	c.revoke(userID, tokenID, exp)
+}
+
+func (c *LocalSessionCache) RevokeAll(userID uuid.UUID) {
+	c.revokeAll(userID, c.storeRevokeAll(userID))
+}
+
+// Store a revocation of all the user's tokens issued up to now, and return its time in milliseconds.
+func (c *LocalSessionCache) storeRevokeAll(userID uuid.UUID) int64 {
+	revokeTime := time.Now().UTC().Truncate(time.Millisecond)
+	query := `
+INSERT INTO session_revocation (user_id, token_id, revoke_time)
+VALUES ($1, '', $2)
+ON CONFLICT (user_id, token_id) DO UPDATE SET revoke_time = greatest(session_revocation.revoke_time, $2)`
+	if _, err := c.db.ExecContext(c.ctx, query, userID, revokeTime); err != nil {
+		c.logger.Error("Error storing session revocation", zap.String("uid", userID.String()), zap.Error(err))
+	}
+	return revokeTime.UnixNano() / int64(time.Millisecond)
+}
+
+// Apply a revocation of a single token that has already been stored.
+func (c *LocalSessionCache) revoke(userID uuid.UUID, tokenID string, exp int64) {
+	c.Lock()
+	c.tokens[tokenID] = exp
+	c.Unlock()
+
+	c.disconnect(userID, tokenID)
+}
+
+// Apply a revocation of all the user's tokens issued up to the given time in milliseconds that has already been stored.
+func (c *LocalSessionCache) revokeAll(userID uuid.UUID, revokeTime int64) {
+	c.Lock()
+	if revokeTime > c.users[userID] {
+		c.users[userID] = revokeTime
+	}
+	if check, ok := c.checks[userID]; ok && revokeTime > check.revokeTime {
+		check.revokeTime = revokeTime
+		c.checks[userID] = check
+	}
+	c.Unlock()
+
+	c.disconnect(userID, "")
+}
+
+// Load all revocations that may still apply to tokens issued with the usual lifetime.
+func (c *LocalSessionCache) load() error {
+	now := time.Now().UTC()
+	rows, err := c.db.QueryContext(c.ctx, "SELECT user_id, token_id, revoke_time, expiry_time FROM session_revocation WHERE expiry_time > $1 OR (expiry_time IS NULL AND revoke_time > $2)", now, now.Add(-time.Duration(c.tokenLifetime)*time.Millisecond))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	tokens := make(map[string]int64)
+	users := make(map[uuid.UUID]int64)
+	for rows.Next() {
+		var userID uuid.UUID
+		var tokenID string
+		var revokeTime time.Time
+		var expiryTime *time.Time
+		if err := rows.Scan(&userID, &tokenID, &revokeTime, &expiryTime); err != nil {
+			return err
+		}
+		if tokenID != "" && expiryTime != nil {
+			tokens[tokenID] = expiryTime.Unix()
+		} else {
+			users[userID] = revokeTime.UnixNano() / int64(time.Millisecond)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	c.Lock()
+	for tokenID, exp := range tokens {
+		c.tokens[tokenID] = exp
+	}
+	for userID, revokeTime := range users {
+		if revokeTime > c.users[userID] {
+			c.users[userID] = revokeTime
+		}
+	}
+	c.Unlock()
+	return nil
+}
+
+// Disconnect any sessions connected to this node for the given user, either all of them or only those
+// opened with the given token.
+func (c *LocalSessionCache) disconnect(userID uuid.UUID, tokenID string) {
+	for _, sessionID := range c.tracker.ListLocalSessionIDByStream(PresenceStream{Mode: StreamModeNotifications, Subject: userID}) {
+		if tokenID != "" {
+			session := c.sessionRegistry.Get(sessionID)
+			if session == nil || session.TokenID() != tokenID {
+				continue
+			}
+		}
+		if err := c.sessionRegistry.Disconnect(c.ctx, sessionID, c.node); err != nil {
+			c.logger.Warn("Error disconnecting revoked session", zap.String("sid", sessionID.String()), zap.Error(err))
+		}
+	}
+}
+
+func millisecondsToTime(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
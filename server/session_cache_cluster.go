@@ -0,0 +1,85 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+)
+
+type clusterSessionRevoke struct {
+	UserID  uuid.UUID `json:"user_id"`
+	TokenID string    `json:"token_id,omitempty"`
+	Exp     int64     `json:"exp,omitempty"`
+	// Time on the sending node up to which all the user's tokens are revoked, in milliseconds.
+	RevokeTime int64 `json:"revoke_time,omitempty"`
+}
+
+// ClusterSessionCache shares token revocations with all other nodes in the cluster, so each node rejects
+// the revoked tokens and disconnects any of its own sessions opened with them. Revocations are also stored in the
+// database, and reloaded whenever a node joins in case any were missed while the cluster was split.
+type ClusterSessionCache struct {
+	*LocalSessionCache
+	cluster *Cluster
+}
+
+func NewClusterSessionCache(logger, startupLogger *zap.Logger, config Config, db *sql.DB, sessionRegistry SessionRegistry, tracker Tracker, cluster *Cluster) SessionCache {
+	c := &ClusterSessionCache{
+		LocalSessionCache: newLocalSessionCache(logger, startupLogger, config, db, sessionRegistry, tracker),
+		cluster:           cluster,
+	}
+
+	cluster.SetHandler(ClusterMessageSessionRevoke, c.handleRevoke)
+	cluster.AddMemberListener(func(node string) {
+		go func() {
+			if err := c.load(); err != nil {
+				c.logger.Warn("Error reloading session revocations", zap.String("node", node), zap.Error(err))
+			}
+		}()
+	}, func(string) {})
+
+	return c
+}
+
+func (c *ClusterSessionCache) Revoke(userID uuid.UUID, tokenID string, exp int64) {
+	if tokenID == "" {
+		return
+	}
+	c.LocalSessionCache.Revoke(userID, tokenID, exp)
+	c.cluster.Broadcast(ClusterMessageSessionRevoke, &clusterSessionRevoke{UserID: userID, TokenID: tokenID, Exp: exp})
+}
+
+func (c *ClusterSessionCache) RevokeAll(userID uuid.UUID) {
+	revokeTime := c.storeRevokeAll(userID)
+	c.revokeAll(userID, revokeTime)
+	c.cluster.Broadcast(ClusterMessageSessionRevoke, &clusterSessionRevoke{UserID: userID, RevokeTime: revokeTime})
+}
+
+func (c *ClusterSessionCache) handleRevoke(from string, payload json.RawMessage) (interface{}, error) {
+	revoke := &clusterSessionRevoke{}
+	if err := json.Unmarshal(payload, revoke); err != nil {
+		return nil, err
+	}
+	// The sending node has already stored the revocation.
+	if revoke.TokenID == "" {
+		c.revokeAll(revoke.UserID, revoke.RevokeTime)
+	} else {
+		c.revoke(revoke.UserID, revoke.TokenID, revoke.Exp)
+	}
+	return nil, nil
+}
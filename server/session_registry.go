@@ -43,12 +43,17 @@ type Session interface {
 	Username() string
 	SetUsername(string)
 
+	TokenID() string
 	Expiry() int64
 	Consume(func(logger *zap.Logger, session Session, envelope *rtapi.Envelope) bool)
 
 	Format() SessionFormat
+	BatchEnabled() bool
 	Send(isStream bool, mode uint8, envelope *rtapi.Envelope) error
 	SendBytes(isStream bool, mode uint8, payload []byte) error
+	// SendBytesUnreliable delivers at most once and in no particular order if the transport supports it, otherwise
+	// it is the same as SendBytes.
+	SendBytesUnreliable(isStream bool, mode uint8, payload []byte) error
 
 	Close(reason string)
 }
@@ -0,0 +1,55 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+)
+
+// ClusterSessionRegistry holds sessions connected to the current node, and forwards operations
+// on sessions connected to other nodes in the cluster to those nodes.
+type ClusterSessionRegistry struct {
+	SessionRegistry
+	cluster *Cluster
+}
+
+func NewClusterSessionRegistry(cluster *Cluster) SessionRegistry {
+	r := &ClusterSessionRegistry{
+		SessionRegistry: NewLocalSessionRegistry(),
+		cluster:         cluster,
+	}
+
+	cluster.SetHandler(ClusterMessageSessionDisconnect, r.handleDisconnect)
+
+	return r
+}
+
+func (r *ClusterSessionRegistry) Disconnect(ctx context.Context, sessionID uuid.UUID, node string) error {
+	if node == "" || node == r.cluster.Name() {
+		return r.SessionRegistry.Disconnect(ctx, sessionID, node)
+	}
+	return r.cluster.Request(ctx, node, ClusterMessageSessionDisconnect, sessionID, nil)
+}
+
+func (r *ClusterSessionRegistry) handleDisconnect(from string, payload json.RawMessage) (interface{}, error) {
+	var sessionID uuid.UUID
+	if err := json.Unmarshal(payload, &sessionID); err != nil {
+		return nil, err
+	}
+	return nil, r.SessionRegistry.Disconnect(context.Background(), sessionID, r.cluster.Name())
+}
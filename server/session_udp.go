@@ -0,0 +1,560 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/heroiclabs/nakama/rtapi"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrSessionIncomingQueueFull = errors.New("session incoming queue full")
+	ErrSessionMessageTooLarge   = errors.New("session message too large")
+)
+
+// A reliable message sent to the client that has not been acknowledged yet.
+type udpPendingPacket struct {
+	packet  []byte
+	sentAt  time.Time
+	resends int
+}
+
+type sessionUDP struct {
+	sync.Mutex
+	logger     *zap.Logger
+	config     Config
+	id         uuid.UUID
+	format     SessionFormat
+	batch      bool
+	userID     uuid.UUID
+	username   *atomic.String
+	tokenID    string
+	expiry     int64
+	clientIP   string
+	clientPort string
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	jsonpbMarshaler        *jsonpb.Marshaler
+	jsonpbUnmarshaler      *jsonpb.Unmarshaler
+	queuePriorityThreshold int
+	pingPeriodDuration     time.Duration
+	pongWaitDuration       time.Duration
+	resendInterval         time.Duration
+	maxResends             int
+
+	sessionRegistry SessionRegistry
+	matchmaker      Matchmaker
+	tracker         Tracker
+	runtime         *Runtime
+
+	stopped      bool
+	conn         *net.UDPConn
+	addr         *net.UDPAddr
+	key          []byte
+	closeFn      func()
+	closeReason  *atomic.String
+	lastReceived *atomic.Int64
+	incomingCh   chan []byte
+
+	// Outgoing reliable message state, guarded by the session lock.
+	sendSeq uint32
+	pending map[uint32]*udpPendingPacket
+
+	// Incoming reliable message state, only accessed by the acceptor's read loop.
+	recvSeq    uint32
+	recvBuffer map[uint32][]byte
+}
+
+func newSessionUDP(logger *zap.Logger, config Config, format SessionFormat, batch bool, userID uuid.UUID, username, tokenID string, expiry int64, clientIP string, clientPort string, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, conn *net.UDPConn, addr *net.UDPAddr, key []byte, closeFn func(), sessionRegistry SessionRegistry, matchmaker Matchmaker, tracker Tracker, runtime *Runtime) *sessionUDP {
+	sessionID := uuid.Must(uuid.NewV4())
+	sessionLogger := logger.With(zap.String("uid", userID.String()), zap.String("sid", sessionID.String()))
+
+	sessionLogger.Info("New UDP session connected", zap.Uint8("format", uint8(format)))
+
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	return &sessionUDP{
+		logger:     sessionLogger,
+		config:     config,
+		id:         sessionID,
+		format:     format,
+		batch:      batch,
+		userID:     userID,
+		username:   atomic.NewString(username),
+		tokenID:    tokenID,
+		expiry:     expiry,
+		clientIP:   clientIP,
+		clientPort: clientPort,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		jsonpbMarshaler:        jsonpbMarshaler,
+		jsonpbUnmarshaler:      jsonpbUnmarshaler,
+		queuePriorityThreshold: (config.GetSocket().OutgoingQueueSize / 3) * 2,
+		pingPeriodDuration:     time.Duration(config.GetSocket().PingPeriodMs) * time.Millisecond,
+		pongWaitDuration:       time.Duration(config.GetSocket().PongWaitMs) * time.Millisecond,
+		resendInterval:         time.Duration(config.GetSocket().UdpResendIntervalMs) * time.Millisecond,
+		maxResends:             config.GetSocket().UdpMaxResends,
+
+		sessionRegistry: sessionRegistry,
+		matchmaker:      matchmaker,
+		tracker:         tracker,
+		runtime:         runtime,
+
+		stopped:      false,
+		conn:         conn,
+		addr:         addr,
+		key:          key,
+		closeFn:      closeFn,
+		closeReason:  atomic.NewString(""),
+		lastReceived: atomic.NewInt64(time.Now().UTC().UnixNano()),
+		incomingCh:   make(chan []byte, config.GetSocket().OutgoingQueueSize),
+
+		sendSeq: 0,
+		pending: make(map[uint32]*udpPendingPacket),
+
+		recvSeq:    0,
+		recvBuffer: make(map[uint32][]byte),
+	}
+}
+
+func (s *sessionUDP) Logger() *zap.Logger {
+	return s.logger
+}
+
+func (s *sessionUDP) ID() uuid.UUID {
+	return s.id
+}
+
+func (s *sessionUDP) UserID() uuid.UUID {
+	return s.userID
+}
+
+func (s *sessionUDP) ClientIP() string {
+	return s.clientIP
+}
+
+func (s *sessionUDP) ClientPort() string {
+	return s.clientPort
+}
+
+func (s *sessionUDP) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sessionUDP) Username() string {
+	return s.username.Load()
+}
+
+func (s *sessionUDP) SetUsername(username string) {
+	s.username.Store(username)
+}
+
+func (s *sessionUDP) TokenID() string {
+	return s.tokenID
+}
+
+func (s *sessionUDP) Expiry() int64 {
+	return s.expiry
+}
+
+func (s *sessionUDP) Consume(processRequest func(logger *zap.Logger, session Session, envelope *rtapi.Envelope) bool) {
+	// Fire an event for session start.
+	if fn := s.runtime.EventSessionStart(); fn != nil {
+		fn(s.userID.String(), s.username.Load(), s.expiry, s.id.String(), s.clientIP, s.clientPort, time.Now().UTC().Unix())
+	}
+
+	// Start a routine to resend unacknowledged messages and keep the connection alive.
+	go s.processOutgoing()
+
+	var reason string
+
+IncomingLoop:
+	for {
+		var data []byte
+		select {
+		case <-s.ctx.Done():
+			// Session was closed elsewhere, or the read loop asked for it to be closed here.
+			reason = s.closeReason.Load()
+			break IncomingLoop
+		case data = <-s.incomingCh:
+		}
+
+		request := &rtapi.Envelope{}
+		var err error
+		switch s.format {
+		case SessionFormatProtobuf:
+			err = proto.Unmarshal(data, request)
+		case SessionFormatJson:
+			fallthrough
+		default:
+			err = s.jsonpbUnmarshaler.Unmarshal(bytes.NewReader(data), request)
+		}
+		if err != nil {
+			// If the payload is malformed the client is incompatible or misbehaving, either way disconnect it now.
+			s.logger.Warn("Received malformed payload", zap.Binary("data", data))
+			reason = "received malformed payload"
+			break
+		}
+
+		switch request.Cid {
+		case "":
+			if !processRequest(s.logger, s, request) {
+				reason = "error processing message"
+				break IncomingLoop
+			}
+		default:
+			requestLogger := s.logger.With(zap.String("cid", request.Cid))
+			if !processRequest(requestLogger, s, request) {
+				reason = "error processing message"
+				break IncomingLoop
+			}
+		}
+	}
+
+	s.Close(reason)
+}
+
+// Check a packet was sent from this session's client address and signed with its key.
+func (s *sessionUDP) verify(addr *net.UDPAddr, packet []byte) bool {
+	if addr.Port != s.addr.Port || !addr.IP.Equal(s.addr.IP) {
+		return false
+	}
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(packet[:17])
+	mac.Write(packet[udpClientHeaderSize:])
+	return hmac.Equal(mac.Sum(nil), packet[17:udpClientHeaderSize])
+}
+
+// Handle a single verified packet received from this session's client. Called from the acceptor's read loop, so it
+// must never block on anything but the network.
+func (s *sessionUDP) receive(packetType byte, data []byte) {
+	if s.ctx.Err() != nil {
+		// Session is closing, ignore anything else the client sends.
+		return
+	}
+	s.lastReceived.Store(time.Now().UTC().UnixNano())
+
+	switch packetType {
+	case udpPacketUnreliable:
+		select {
+		case s.incomingCh <- data:
+		default:
+			// Unreliable messages may be dropped if the session can't keep up.
+		}
+	case udpPacketReliable:
+		if len(data) < 4 {
+			return
+		}
+		s.receiveReliable(binary.BigEndian.Uint32(data), data[4:])
+	case udpPacketAck:
+		if len(data) < 4 {
+			return
+		}
+		s.Lock()
+		delete(s.pending, binary.BigEndian.Uint32(data))
+		s.Unlock()
+	case udpPacketPing:
+		s.write([]byte{udpPacketPong})
+	case udpPacketPong:
+		// Nothing to do, the receive time has already been updated.
+	case udpPacketDisconnect:
+		s.closeAsync("client disconnected")
+	}
+}
+
+func (s *sessionUDP) receiveReliable(seq uint32, payload []byte) {
+	diff := seq - s.recvSeq
+	switch {
+	case diff == 0:
+		// The next expected message, deliver it along with any buffered messages that follow it.
+		if !s.queueIncoming(payload) {
+			return
+		}
+		s.recvSeq++
+		for {
+			next, ok := s.recvBuffer[s.recvSeq]
+			if !ok {
+				break
+			}
+			delete(s.recvBuffer, s.recvSeq)
+			if !s.queueIncoming(next) {
+				return
+			}
+			s.recvSeq++
+		}
+	case diff > 1<<31:
+		// Already delivered, the client did not receive the ack.
+	case int(diff) < cap(s.incomingCh):
+		// Arrived ahead of an earlier message, hold it until the gap is filled.
+		s.recvBuffer[seq] = payload
+	default:
+		// Too far ahead of the expected sequence, drop it and let the client resend later.
+		return
+	}
+
+	ack := make([]byte, 5)
+	ack[0] = udpPacketAck
+	binary.BigEndian.PutUint32(ack[1:], seq)
+	s.write(ack)
+}
+
+func (s *sessionUDP) queueIncoming(payload []byte) bool {
+	select {
+	case s.incomingCh <- payload:
+		return true
+	default:
+		// The client is sending reliable messages faster than they can be processed.
+		s.logger.Warn("Could not queue message, session incoming queue full")
+		s.closeAsync(ErrSessionIncomingQueueFull.Error())
+		return false
+	}
+}
+
+func (s *sessionUDP) processOutgoing() {
+	resendTicker := time.NewTicker(s.resendInterval)
+	defer resendTicker.Stop()
+	pingTicker := time.NewTicker(s.pingPeriodDuration)
+	defer pingTicker.Stop()
+
+	var reason string
+
+OutgoingLoop:
+	for {
+		select {
+		case <-s.ctx.Done():
+			// Session is closing, close the outgoing process routine.
+			return
+		case <-pingTicker.C:
+			if time.Now().UTC().UnixNano()-s.lastReceived.Load() > int64(s.pongWaitDuration) {
+				reason = "timed out waiting for client"
+				break OutgoingLoop
+			}
+			// Periodically send pings.
+			s.write([]byte{udpPacketPing})
+		case <-resendTicker.C:
+			// Resend any reliable messages that have not been acknowledged in time.
+			now := time.Now()
+			s.Lock()
+			if s.stopped {
+				s.Unlock()
+				return
+			}
+			for _, p := range s.pending {
+				if now.Sub(p.sentAt) < s.resendInterval {
+					continue
+				}
+				if p.resends >= s.maxResends {
+					s.Unlock()
+					reason = "timed out waiting for ack"
+					break OutgoingLoop
+				}
+				p.resends++
+				p.sentAt = now
+				s.write(p.packet)
+			}
+			s.Unlock()
+		}
+	}
+
+	s.Close(reason)
+}
+
+// Ask the session's own consume routine to close it, so the acceptor's read loop is not held up by session cleanup.
+func (s *sessionUDP) closeAsync(reason string) {
+	s.closeReason.Store(reason)
+	s.ctxCancelFn()
+}
+
+func (s *sessionUDP) writeConnectAck() {
+	packet := make([]byte, 0, 1+16+len(s.key))
+	packet = append(packet, udpPacketConnectAck)
+	packet = append(packet, s.id.Bytes()...)
+	s.write(append(packet, s.key...))
+}
+
+func (s *sessionUDP) write(packet []byte) {
+	if _, err := s.conn.WriteToUDP(packet, s.addr); err != nil {
+		s.logger.Debug("Could not write packet", zap.Error(err))
+	}
+}
+
+func (s *sessionUDP) Format() SessionFormat {
+	return s.format
+}
+
+func (s *sessionUDP) BatchEnabled() bool {
+	return s.batch
+}
+
+func (s *sessionUDP) Send(isStream bool, mode uint8, envelope *rtapi.Envelope) error {
+	var payload []byte
+	var err error
+	switch s.format {
+	case SessionFormatProtobuf:
+		payload, err = proto.Marshal(envelope)
+	case SessionFormatJson:
+		fallthrough
+	default:
+		var buf bytes.Buffer
+		if err = s.jsonpbMarshaler.Marshal(&buf, envelope); err == nil {
+			payload = buf.Bytes()
+		}
+	}
+	if err != nil {
+		s.logger.Warn("Could not marshal envelope", zap.Error(err))
+		return err
+	}
+
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		switch envelope.Message.(type) {
+		case *rtapi.Envelope_Error:
+			s.logger.Debug("Sending error message", zap.Binary("payload", payload))
+		default:
+			s.logger.Debug(fmt.Sprintf("Sending %T message", envelope.Message), zap.Any("envelope", envelope))
+		}
+	}
+
+	return s.SendBytes(isStream, mode, payload)
+}
+
+func (s *sessionUDP) SendBytesUnreliable(isStream bool, mode uint8, payload []byte) error {
+	if 1+len(payload) > udpMaxPacketSize {
+		s.logger.Warn("Could not write message, too large for a single packet", zap.Int("size", len(payload)))
+		return ErrSessionMessageTooLarge
+	}
+
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return nil
+	}
+
+	// Unreliable messages are sent once, with no sequence number and no ack expected.
+	s.write(append([]byte{udpPacketUnreliable}, payload...))
+	s.Unlock()
+	return nil
+}
+
+func (s *sessionUDP) SendBytes(isStream bool, mode uint8, payload []byte) error {
+	if 5+len(payload) > udpMaxPacketSize {
+		// Never queue a message that can't be written, it would only be resent until the client is disconnected.
+		s.logger.Warn("Could not write message, too large for a single packet", zap.Int("size", len(payload)))
+		return ErrSessionMessageTooLarge
+	}
+
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return nil
+	}
+
+	if isStream {
+		switch mode {
+		case StreamModeChannel:
+			fallthrough
+		case StreamModeGroup:
+			fallthrough
+		case StreamModeDM:
+			// Chat messages are only allowed if the current number of unacknowledged messages is below the
+			// threshold to ensure there is always room to queue higher priority messages.
+			if len(s.pending) >= s.queuePriorityThreshold {
+				s.Unlock()
+				return nil
+			}
+		}
+	}
+
+	if len(s.pending) >= s.config.GetSocket().OutgoingQueueSize {
+		// Too many messages are waiting for an ack, likely because the remote client can't keep up.
+		s.Unlock()
+		s.logger.Warn("Could not write message, session outgoing queue full")
+		s.Close(ErrSessionQueueFull.Error())
+		return ErrSessionQueueFull
+	}
+
+	packet := make([]byte, 5+len(payload))
+	packet[0] = udpPacketReliable
+	binary.BigEndian.PutUint32(packet[1:], s.sendSeq)
+	copy(packet[5:], payload)
+	s.pending[s.sendSeq] = &udpPendingPacket{packet: packet, sentAt: time.Now()}
+	s.sendSeq++
+	s.write(packet)
+	s.Unlock()
+	return nil
+}
+
+func (s *sessionUDP) Close(reason string) {
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return
+	}
+	s.stopped = true
+	s.pending = nil
+	s.Unlock()
+
+	// Cancel any ongoing operations tied to this session.
+	s.ctxCancelFn()
+
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaning up closed client connection")
+	}
+
+	// When connection close originates internally in the session, ensure cleanup of external resources and references.
+	if err := s.matchmaker.RemoveAll(s.id); err != nil {
+		s.logger.Warn("Failed to remove all matchmaking tickets", zap.Error(err))
+	}
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection matchmaker")
+	}
+	s.tracker.UntrackAll(s.id)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection tracker")
+	}
+	s.sessionRegistry.Remove(s.id)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection session registry")
+	}
+
+	// Let the client know the session is over, and stop routing its packets to this session.
+	s.write([]byte{udpPacketDisconnect})
+	s.closeFn()
+
+	s.logger.Info("Closed client connection")
+
+	// Fire an event for session end.
+	if fn := s.runtime.EventSessionEnd(); fn != nil {
+		fn(s.userID.String(), s.username.Load(), s.expiry, s.id.String(), s.clientIP, s.clientPort, time.Now().UTC().Unix(), reason)
+	}
+}
@@ -41,8 +41,10 @@ type sessionWS struct {
 	config     Config
 	id         uuid.UUID
 	format     SessionFormat
+	batch      bool
 	userID     uuid.UUID
 	username   *atomic.String
+	tokenID    string
 	expiry     int64
 	clientIP   string
 	clientPort string
@@ -71,7 +73,7 @@ type sessionWS struct {
 	outgoingCh             chan []byte
 }
 
-func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, userID uuid.UUID, username string, expiry int64, clientIP string, clientPort string, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, conn *websocket.Conn, sessionRegistry SessionRegistry, matchmaker Matchmaker, tracker Tracker, runtime *Runtime) Session {
+func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, batch bool, userID uuid.UUID, username, tokenID string, expiry int64, clientIP string, clientPort string, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, conn *websocket.Conn, sessionRegistry SessionRegistry, matchmaker Matchmaker, tracker Tracker, runtime *Runtime) Session {
 	sessionID := uuid.Must(uuid.NewV4())
 	sessionLogger := logger.With(zap.String("uid", userID.String()), zap.String("sid", sessionID.String()))
 
@@ -89,8 +91,10 @@ func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, userI
 		config:     config,
 		id:         sessionID,
 		format:     format,
+		batch:      batch,
 		userID:     userID,
 		username:   atomic.NewString(username),
+		tokenID:    tokenID,
 		expiry:     expiry,
 		clientIP:   clientIP,
 		clientPort: clientPort,
@@ -152,6 +156,10 @@ func (s *sessionWS) SetUsername(username string) {
 	s.username.Store(username)
 }
 
+func (s *sessionWS) TokenID() string {
+	return s.tokenID
+}
+
 func (s *sessionWS) Expiry() int64 {
 	return s.expiry
 }
@@ -342,6 +350,10 @@ func (s *sessionWS) Format() SessionFormat {
 	return s.format
 }
 
+func (s *sessionWS) BatchEnabled() bool {
+	return s.batch
+}
+
 func (s *sessionWS) Send(isStream bool, mode uint8, envelope *rtapi.Envelope) error {
 	var payload []byte
 	var err error
@@ -373,6 +385,11 @@ func (s *sessionWS) Send(isStream bool, mode uint8, envelope *rtapi.Envelope) er
 	return s.SendBytes(isStream, mode, []byte(payload))
 }
 
+func (s *sessionWS) SendBytesUnreliable(isStream bool, mode uint8, payload []byte) error {
+	// Messages are always sent reliably over WebSocket connections.
+	return s.SendBytes(isStream, mode, payload)
+}
+
 func (s *sessionWS) SendBytes(isStream bool, mode uint8, payload []byte) error {
 	s.Lock()
 	if s.stopped {
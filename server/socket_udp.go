@@ -0,0 +1,273 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/jsonpb"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/trace"
+	"go.uber.org/zap"
+)
+
+// Every UDP packet starts with a single byte identifying its type. Packets sent by the client after connecting follow it
+// with the 16 byte session ID and a 32 byte HMAC-SHA256 of the type, session ID and body using the session key, so
+// packets from anyone who does not hold the key are dropped. The type-specific body comes last:
+//
+// Connect: a URL encoded query string with the same "token", "format", "batch", and "status" parameters as WebSocket connections.
+// Connect ack: the 16 byte session ID followed by the 32 byte session key.
+// Unreliable: an envelope, delivered at most once and in no particular order.
+// Reliable: a 4 byte big-endian sequence number followed by an envelope, delivered exactly once and in order.
+// Ack: the 4 byte big-endian sequence number of the reliable packet being acknowledged.
+// Ping, pong: no body.
+// Disconnect: an optional reason string.
+//
+// Envelopes must each fit in a single packet, and use the format requested when connecting.
+const (
+	udpPacketConnect byte = iota + 1
+	udpPacketConnectAck
+	udpPacketUnreliable
+	udpPacketReliable
+	udpPacketAck
+	udpPacketPing
+	udpPacketPong
+	udpPacketDisconnect
+)
+
+const (
+	// Size of the session ID and signature on packets sent by a connected client.
+	udpClientHeaderSize = 1 + 16 + sha256.Size
+	// Largest UDP payload that can be sent over IPv4.
+	udpMaxPacketSize = 65507
+)
+
+var SocketUdpStatsCtx = context.Background()
+
+type SocketUdpAcceptor struct {
+	logger            *zap.Logger
+	config            Config
+	sessionRegistry   SessionRegistry
+	sessionCache      SessionCache
+	matchmaker        Matchmaker
+	tracker           Tracker
+	runtime           *Runtime
+	jsonpbMarshaler   *jsonpb.Marshaler
+	jsonpbUnmarshaler *jsonpb.Unmarshaler
+	pipeline          *Pipeline
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	conn *net.UDPConn
+	// Sessions keyed by session ID.
+	sessions *sync.Map
+	// Sessions keyed by client address, only used to answer repeated connect packets.
+	addresses *sync.Map
+}
+
+func StartSocketUdpAcceptor(logger, startupLogger *zap.Logger, config Config, sessionRegistry SessionRegistry, sessionCache SessionCache, matchmaker Matchmaker, tracker Tracker, runtime *Runtime, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, pipeline *Pipeline) *SocketUdpAcceptor {
+	network := strings.Replace(config.GetSocket().Protocol, "tcp", "udp", 1)
+	addr := &net.UDPAddr{IP: net.ParseIP(config.GetSocket().Address), Port: config.GetSocket().UdpPort}
+	conn, err := net.ListenUDP(network, addr)
+	if err != nil {
+		startupLogger.Fatal("UDP socket listener failed to start", zap.Error(err))
+	}
+
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	a := &SocketUdpAcceptor{
+		logger:            logger,
+		config:            config,
+		sessionRegistry:   sessionRegistry,
+		sessionCache:      sessionCache,
+		matchmaker:        matchmaker,
+		tracker:           tracker,
+		runtime:           runtime,
+		jsonpbMarshaler:   jsonpbMarshaler,
+		jsonpbUnmarshaler: jsonpbUnmarshaler,
+		pipeline:          pipeline,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		conn:      conn,
+		sessions:  &sync.Map{},
+		addresses: &sync.Map{},
+	}
+
+	startupLogger.Info("Starting UDP socket listener", zap.Int("port", config.GetSocket().UdpPort))
+	go a.processIncoming()
+
+	return a
+}
+
+func (a *SocketUdpAcceptor) Stop() {
+	a.ctxCancelFn()
+
+	a.sessions.Range(func(_, session interface{}) bool {
+		session.(*sessionUDP).Close("server shutting down")
+		return true
+	})
+
+	if err := a.conn.Close(); err != nil {
+		a.logger.Error("UDP socket listener shutdown failed", zap.Error(err))
+	}
+}
+
+func (a *SocketUdpAcceptor) processIncoming() {
+	// Leave room for the packet header and sequence number on top of the largest allowed message.
+	buf := make([]byte, a.config.GetSocket().MaxMessageSizeBytes+udpClientHeaderSize+4)
+	for {
+		n, addr, err := a.conn.ReadFromUDP(buf)
+		if err != nil {
+			select {
+			case <-a.ctx.Done():
+				return
+			default:
+			}
+			a.logger.Debug("Error reading UDP packet", zap.Error(err))
+			continue
+		}
+		if n < 1 {
+			continue
+		}
+
+		if buf[0] == udpPacketConnect {
+			if session, ok := a.addresses.Load(addr.String()); ok {
+				// The client did not receive the original connect ack, send it again.
+				session.(*sessionUDP).writeConnectAck()
+				continue
+			}
+			a.connect(addr, buf[1:n])
+			continue
+		}
+
+		// Any other packets must be signed by a connected session, and sent from its address.
+		if n < udpClientHeaderSize {
+			continue
+		}
+		sessionID, err := uuid.FromBytes(buf[1:17])
+		if err != nil {
+			continue
+		}
+		session, ok := a.sessions.Load(sessionID)
+		if !ok || !session.(*sessionUDP).verify(addr, buf[:n]) {
+			continue
+		}
+
+		// Copy the body, the read buffer is reused for the next packet.
+		data := make([]byte, n-udpClientHeaderSize)
+		copy(data, buf[udpClientHeaderSize:n])
+		session.(*sessionUDP).receive(buf[0], data)
+	}
+}
+
+func (a *SocketUdpAcceptor) connect(addr *net.UDPAddr, data []byte) {
+	query, err := url.ParseQuery(string(data))
+	if err != nil {
+		a.reject(addr, "Invalid connect parameters")
+		return
+	}
+
+	// Check format.
+	var format SessionFormat
+	switch query.Get("format") {
+	case "protobuf":
+		format = SessionFormatProtobuf
+	case "json":
+		fallthrough
+	case "":
+		format = SessionFormatJson
+	default:
+		// Invalid values are rejected.
+		a.reject(addr, "Invalid format parameter")
+		return
+	}
+
+	// Check authentication.
+	token := query.Get("token")
+	if token == "" {
+		a.reject(addr, "Missing or invalid token")
+		return
+	}
+	userID, username, tokenID, expiry, issuedAt, ok := parseToken([]byte(a.config.GetSession().EncryptionKey), token)
+	if !ok || a.sessionCache.IsRevoked(a.ctx, userID, tokenID, issuedAt, expiry) {
+		a.reject(addr, "Missing or invalid token")
+		return
+	}
+
+	batch := query.Get("batch") == "true"
+	status := query.Get("status") == "true"
+
+	// Every packet the client sends from now on is signed with this key.
+	sessionKey := make([]byte, sha256.Size)
+	if _, err := rand.Read(sessionKey); err != nil {
+		a.logger.Error("Could not generate UDP session key", zap.Error(err))
+		a.reject(addr, "Could not create session")
+		return
+	}
+
+	// Mark the start of the session.
+	startNanos := time.Now().UTC().UnixNano()
+	stats.Record(SocketUdpStatsCtx, MetricsSocketUdpOpenCount.M(1))
+	_, span := trace.StartSpan(SocketUdpStatsCtx, "nakama.session.udp")
+
+	// Wrap the client address for application handling.
+	addrKey := addr.String()
+	var session *sessionUDP
+	session = newSessionUDP(a.logger, a.config, format, batch, userID, username, tokenID, expiry, addr.IP.String(), strconv.Itoa(addr.Port), a.jsonpbMarshaler, a.jsonpbUnmarshaler, a.conn, addr, sessionKey, func() {
+		a.sessions.Delete(session.ID())
+		a.addresses.Delete(addrKey)
+	}, a.sessionRegistry, a.matchmaker, a.tracker, a.runtime)
+
+	// Route further packets signed for this session to it, and let the client know it's connected.
+	a.sessions.Store(session.ID(), session)
+	a.addresses.Store(addrKey, session)
+	session.writeConnectAck()
+
+	go func() {
+		// Add to the session registry.
+		a.sessionRegistry.Add(session)
+
+		// Register initial presences for this session.
+		a.tracker.Track(session.ID(), PresenceStream{Mode: StreamModeNotifications, Subject: session.UserID()}, session.UserID(), PresenceMeta{Format: session.Format(), Username: session.Username(), Hidden: true}, true)
+		if status {
+			a.tracker.Track(session.ID(), PresenceStream{Mode: StreamModeStatus, Subject: session.UserID()}, session.UserID(), PresenceMeta{Format: session.Format(), Username: session.Username(), Status: ""}, false)
+		}
+
+		// Allow the server to begin processing incoming messages from this session.
+		session.Consume(a.pipeline.ProcessRequest)
+
+		// Mark the end of the session.
+		span.End()
+		stats.Record(SocketUdpStatsCtx, MetricsSocketUdpTimeSpentMsec.M(float64(time.Now().UTC().UnixNano()-startNanos)/1000), MetricsSocketUdpCloseCount.M(1))
+	}()
+}
+
+func (a *SocketUdpAcceptor) reject(addr *net.UDPAddr, reason string) {
+	if _, err := a.conn.WriteToUDP(append([]byte{udpPacketDisconnect}, reason...), addr); err != nil {
+		a.logger.Debug("Could not write packet", zap.Error(err))
+	}
+}
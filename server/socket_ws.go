@@ -28,11 +28,13 @@ import (
 
 var SocketWsStatsCtx = context.Background()
 
-func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, matchmaker Matchmaker, tracker Tracker, runtime *Runtime, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, pipeline *Pipeline) func(http.ResponseWriter, *http.Request) {
+func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, sessionCache SessionCache, matchmaker Matchmaker, tracker Tracker, runtime *Runtime, jsonpbMarshaler *jsonpb.Marshaler, jsonpbUnmarshaler *jsonpb.Unmarshaler, pipeline *Pipeline) func(http.ResponseWriter, *http.Request) {
 	upgrader := &websocket.Upgrader{
 		ReadBufferSize:  int(config.GetSocket().MaxMessageSizeBytes),
 		WriteBufferSize: int(config.GetSocket().MaxMessageSizeBytes),
 		CheckOrigin:     func(r *http.Request) bool { return true },
+		// Only used if the client also requests compression.
+		EnableCompression: config.GetSocket().Compression,
 	}
 
 	// This handler will be attached to the API Gateway server.
@@ -58,12 +60,15 @@ func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry Sess
 			http.Error(w, "Missing or invalid token", 401)
 			return
 		}
-		userID, username, expiry, ok := parseToken([]byte(config.GetSession().EncryptionKey), token)
-		if !ok {
+		userID, username, tokenID, expiry, issuedAt, ok := parseToken([]byte(config.GetSession().EncryptionKey), token)
+		if !ok || sessionCache.IsRevoked(r.Context(), userID, tokenID, issuedAt, expiry) {
 			http.Error(w, "Missing or invalid token", 401)
 			return
 		}
 
+		// Check if the client accepts batched messages.
+		batch := r.URL.Query().Get("batch") == "true"
+
 		clientIP, clientPort := extractClientAddressFromRequest(logger, r)
 
 		status := false
@@ -85,7 +90,7 @@ func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry Sess
 		_, span := trace.StartSpan(SocketWsStatsCtx, "nakama.session.ws")
 
 		// Wrap the connection for application handling.
-		session := NewSessionWS(logger, config, format, userID, username, expiry, clientIP, clientPort, jsonpbMarshaler, jsonpbUnmarshaler, conn, sessionRegistry, matchmaker, tracker, runtime)
+		session := NewSessionWS(logger, config, format, batch, userID, username, tokenID, expiry, clientIP, clientPort, jsonpbMarshaler, jsonpbUnmarshaler, conn, sessionRegistry, matchmaker, tracker, runtime)
 
 		// Add to the session registry.
 		sessionRegistry.Add(session)
@@ -37,6 +37,8 @@ const (
 	StreamModeDM
 	StreamModeMatchRelayed
 	StreamModeMatchAuthoritative
+	StreamModeParty
+	StreamModeMatchSpectator
 )
 
 type PresenceID struct {
@@ -104,11 +106,14 @@ func (p *Presence) GetStatus() string {
 type PresenceEvent struct {
 	Joins  []Presence
 	Leaves []Presence
+	// Leaves are due to the session disconnecting, rather than explicitly leaving.
+	Disconnect bool
 }
 
 type Tracker interface {
 	SetMatchJoinListener(func(id uuid.UUID, joins []*MatchPresence))
 	SetMatchLeaveListener(func(id uuid.UUID, leaves []*MatchPresence))
+	SetPartyLeaveListener(func(id uuid.UUID, leaves []*PresenceID))
 	Stop()
 
 	// Track returns success true/false, and new presence true/false.
@@ -158,6 +163,7 @@ type LocalTracker struct {
 	logger             *zap.Logger
 	matchJoinListener  func(id uuid.UUID, leaves []*MatchPresence)
 	matchLeaveListener func(id uuid.UUID, leaves []*MatchPresence)
+	partyLeaveListener func(id uuid.UUID, leaves []*PresenceID)
 	sessionRegistry    SessionRegistry
 	jsonpbMarshaler    *jsonpb.Marshaler
 	name               string
@@ -208,6 +214,10 @@ func (t *LocalTracker) SetMatchLeaveListener(f func(id uuid.UUID, leaves []*Matc
 	t.matchLeaveListener = f
 }
 
+func (t *LocalTracker) SetPartyLeaveListener(f func(id uuid.UUID, leaves []*PresenceID)) {
+	t.partyLeaveListener = f
+}
+
 func (t *LocalTracker) Stop() {
 	// No need to explicitly clean up the events channel, just let the application exit.
 	t.ctxCancelFn()
@@ -261,13 +271,19 @@ func (t *LocalTracker) Track(sessionID uuid.UUID, stream PresenceStream, userID
 				Presence{ID: pc.ID, Stream: stream, UserID: userID, Meta: meta},
 			},
 			nil,
+			false,
 		)
 	}
 	return true, true
 }
 
 func (t *LocalTracker) Untrack(sessionID uuid.UUID, stream PresenceStream, userID uuid.UUID) {
-	pc := presenceCompact{ID: PresenceID{Node: t.name, SessionID: sessionID}, Stream: stream, UserID: userID}
+	t.untrack(t.name, sessionID, stream, userID)
+}
+
+// Untrack a presence belonging to any node.
+func (t *LocalTracker) untrack(node string, sessionID uuid.UUID, stream PresenceStream, userID uuid.UUID) {
+	pc := presenceCompact{ID: PresenceID{Node: node, SessionID: sessionID}, Stream: stream, UserID: userID}
 	t.Lock()
 
 	bySession, anyTracked := t.presencesBySession[sessionID]
@@ -320,6 +336,7 @@ func (t *LocalTracker) Untrack(sessionID uuid.UUID, stream PresenceStream, userI
 			[]Presence{
 				Presence{ID: pc.ID, Stream: stream, UserID: userID, Meta: meta},
 			},
+			false,
 		)
 	}
 }
@@ -370,12 +387,18 @@ func (t *LocalTracker) UntrackAll(sessionID uuid.UUID) {
 		t.queueEvent(
 			nil,
 			leaves,
+			true,
 		)
 	}
 }
 
 func (t *LocalTracker) Update(sessionID uuid.UUID, stream PresenceStream, userID uuid.UUID, meta PresenceMeta, allowIfFirstForSession bool) bool {
-	pc := presenceCompact{ID: PresenceID{Node: t.name, SessionID: sessionID}, Stream: stream, UserID: userID}
+	return t.update(t.name, sessionID, stream, userID, meta, allowIfFirstForSession)
+}
+
+// Update a presence belonging to any node.
+func (t *LocalTracker) update(node string, sessionID uuid.UUID, stream PresenceStream, userID uuid.UUID, meta PresenceMeta, allowIfFirstForSession bool) bool {
+	pc := presenceCompact{ID: PresenceID{Node: node, SessionID: sessionID}, Stream: stream, UserID: userID}
 	t.Lock()
 
 	bySession, anyTracked := t.presencesBySession[sessionID]
@@ -428,46 +451,22 @@ func (t *LocalTracker) Update(sessionID uuid.UUID, stream PresenceStream, userID
 		t.queueEvent(
 			joins,
 			leaves,
+			false,
 		)
 	}
 	return true
 }
 
 func (t *LocalTracker) UntrackLocalByStream(stream PresenceStream) {
-	// NOTE: Generates no presence notifications as everyone on the stream is going away all at once.
-	t.Lock()
-
-	byStream, anyTracked := t.presencesByStream[stream.Mode][stream]
-	if !anyTracked {
-		// Nothing tracked for the stream.
-		t.Unlock()
-		return
-	}
-
-	// Drop the presences from tracking for each session.
-	for pc, _ := range byStream {
-		if bySession := t.presencesBySession[pc.ID.SessionID]; len(bySession) == 1 {
-			// This is the only presence for that session, discard the whole list.
-			delete(t.presencesBySession, pc.ID.SessionID)
-		} else {
-			// There were other presences for the session, drop just this one.
-			delete(bySession, pc)
-		}
-	}
-
-	// Discard the tracking for stream.
-	if byStreamMode := t.presencesByStream[stream.Mode]; len(byStreamMode) == 1 {
-		// This is the only stream for this stream mode.
-		delete(t.presencesByStream, stream.Mode)
-	} else {
-		// There are other streams for this stream mode.
-		delete(byStreamMode, stream)
-	}
-
-	t.Unlock()
+	t.untrackByStream(stream, t.name)
 }
 
 func (t *LocalTracker) UntrackByStream(stream PresenceStream) {
+	t.untrackByStream(stream, "")
+}
+
+// Untrack all presences on a stream belonging to the given node, or to any node if empty.
+func (t *LocalTracker) untrackByStream(stream PresenceStream, node string) {
 	// NOTE: Generates no presence notifications as everyone on the stream is going away all at once.
 	t.Lock()
 
@@ -480,6 +479,10 @@ func (t *LocalTracker) UntrackByStream(stream PresenceStream) {
 
 	// Drop the presences from tracking for each session.
 	for pc, _ := range byStream {
+		if node != "" && pc.ID.Node != node {
+			// Presence belongs to another node.
+			continue
+		}
 		if bySession := t.presencesBySession[pc.ID.SessionID]; len(bySession) == 1 {
 			// This is the only presence for that session, discard the whole list.
 			delete(t.presencesBySession, pc.ID.SessionID)
@@ -487,29 +490,31 @@ func (t *LocalTracker) UntrackByStream(stream PresenceStream) {
 			// There were other presences for the session, drop just this one.
 			delete(bySession, pc)
 		}
+		delete(byStream, pc)
 	}
 
-	// Discard the tracking for stream.
-	if byStreamMode := t.presencesByStream[stream.Mode]; len(byStreamMode) == 1 {
-		// This is the only stream for this stream mode.
-		delete(t.presencesByStream, stream.Mode)
-	} else {
-		// There are other streams for this stream mode.
-		delete(byStreamMode, stream)
+	// Discard the tracking for stream if it's now empty.
+	if len(byStream) == 0 {
+		if byStreamMode := t.presencesByStream[stream.Mode]; len(byStreamMode) == 1 {
+			// This is the only stream for this stream mode.
+			delete(t.presencesByStream, stream.Mode)
+		} else {
+			// There are other streams for this stream mode.
+			delete(byStreamMode, stream)
+		}
 	}
 
 	t.Unlock()
 }
 
 func (t *LocalTracker) ListNodesForStream(stream PresenceStream) map[string]struct{} {
+	nodes := make(map[string]struct{})
 	t.RLock()
-	_, anyTracked := t.presencesByStream[stream.Mode][stream]
-	t.RUnlock()
-	if anyTracked {
-		// For the local tracker having any presences for this stream is enough.
-		return map[string]struct{}{t.name: struct{}{}}
+	for pc, _ := range t.presencesByStream[stream.Mode][stream] {
+		nodes[pc.ID.Node] = struct{}{}
 	}
-	return map[string]struct{}{}
+	t.RUnlock()
+	return nodes
 }
 
 func (t *LocalTracker) StreamExists(stream PresenceStream) bool {
@@ -618,6 +623,10 @@ func (t *LocalTracker) ListLocalSessionIDByStream(stream PresenceStream) []uuid.
 	}
 	ps := make([]uuid.UUID, 0, len(byStream))
 	for pc, _ := range byStream {
+		if pc.ID.Node != t.name {
+			// Presences from other nodes may be tracked when running as part of a cluster.
+			continue
+		}
 		ps = append(ps, pc.ID.SessionID)
 	}
 	t.RUnlock()
@@ -640,9 +649,54 @@ func (t *LocalTracker) ListPresenceIDByStream(stream PresenceStream) []*Presence
 	return ps
 }
 
-func (t *LocalTracker) queueEvent(joins, leaves []Presence) {
+// List all presences belonging to the given node, including hidden ones.
+func (t *LocalTracker) listByNode(node string) []Presence {
+	t.RLock()
+	ps := make([]Presence, 0)
+	for _, bySession := range t.presencesBySession {
+		for pc, meta := range bySession {
+			if pc.ID.Node == node {
+				ps = append(ps, Presence{ID: pc.ID, Stream: pc.Stream, UserID: pc.UserID, Meta: meta})
+			}
+		}
+	}
+	t.RUnlock()
+	return ps
+}
+
+// Replace all presences belonging to the given node with the given set, generating events only for the differences.
+func (t *LocalTracker) replaceByNode(node string, presences []Presence) {
+	current := make(map[presenceCompact]PresenceMeta)
+	for _, p := range t.listByNode(node) {
+		current[presenceCompact{ID: p.ID, Stream: p.Stream, UserID: p.UserID}] = p.Meta
+	}
+
+	for _, p := range presences {
+		pc := presenceCompact{ID: PresenceID{Node: node, SessionID: p.ID.SessionID}, Stream: p.Stream, UserID: p.UserID}
+		if meta, found := current[pc]; found {
+			delete(current, pc)
+			if meta == p.Meta {
+				// Already up to date.
+				continue
+			}
+		}
+		t.update(node, pc.ID.SessionID, pc.Stream, pc.UserID, p.Meta, true)
+	}
+
+	// Anything left was not in the new set.
+	for pc, _ := range current {
+		t.untrack(node, pc.ID.SessionID, pc.Stream, pc.UserID)
+	}
+}
+
+// Untrack all presences belonging to the given node, for example when it has left the cluster.
+func (t *LocalTracker) untrackByNode(node string) {
+	t.replaceByNode(node, nil)
+}
+
+func (t *LocalTracker) queueEvent(joins, leaves []Presence, disconnect bool) {
 	select {
-	case t.eventsCh <- &PresenceEvent{Joins: joins, Leaves: leaves}:
+	case t.eventsCh <- &PresenceEvent{Joins: joins, Leaves: leaves, Disconnect: disconnect}:
 		// Event queued for asynchronous dispatch.
 	default:
 		// Event queue is full, log an error and completely drain the queue.
@@ -671,6 +725,9 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 	matchJoins := make(map[uuid.UUID][]*MatchPresence, 0)
 	matchLeaves := make(map[uuid.UUID][]*MatchPresence, 0)
 
+	// Track grouped leaves from parties hosted on the current node.
+	partyLeaves := make(map[uuid.UUID][]*PresenceID, 0)
+
 	for _, p := range e.Joins {
 		pWire := &rtapi.UserPresence{
 			UserId:      p.UserID.String(),
@@ -689,12 +746,13 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 		}
 
 		// We only care about authoritative match joins where the match host is the current node.
-		if p.Stream.Mode == StreamModeMatchAuthoritative && p.Stream.Label == t.name {
+		if (p.Stream.Mode == StreamModeMatchAuthoritative || p.Stream.Mode == StreamModeMatchSpectator) && p.Stream.Label == t.name {
 			mp := &MatchPresence{
 				Node:      p.ID.Node,
 				UserID:    p.UserID,
 				SessionID: p.ID.SessionID,
 				Username:  p.Meta.Username,
+				Spectator: p.Stream.Mode == StreamModeMatchSpectator,
 			}
 			if j, ok := matchJoins[p.Stream.Subject]; ok {
 				matchJoins[p.Stream.Subject] = append(j, mp)
@@ -721,12 +779,15 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 		}
 
 		// We only care about authoritative match leaves where the match host is the current node.
-		if p.Stream.Mode == StreamModeMatchAuthoritative && p.Stream.Label == t.name {
+		if (p.Stream.Mode == StreamModeMatchAuthoritative || p.Stream.Mode == StreamModeMatchSpectator) && p.Stream.Label == t.name {
 			mp := &MatchPresence{
 				Node:      p.ID.Node,
 				UserID:    p.UserID,
 				SessionID: p.ID.SessionID,
 				Username:  p.Meta.Username,
+				Spectator: p.Stream.Mode == StreamModeMatchSpectator,
+				// Only players can reconnect, spectators just join again.
+				Disconnected: e.Disconnect && p.Stream.Mode == StreamModeMatchAuthoritative,
 			}
 			if l, ok := matchLeaves[p.Stream.Subject]; ok {
 				matchLeaves[p.Stream.Subject] = append(l, mp)
@@ -734,6 +795,12 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 				matchLeaves[p.Stream.Subject] = []*MatchPresence{mp}
 			}
 		}
+
+		// We only care about party leaves where the party host is the current node.
+		if p.Stream.Mode == StreamModeParty && p.Stream.Label == t.name {
+			pid := p.ID
+			partyLeaves[p.Stream.Subject] = append(partyLeaves[p.Stream.Subject], &pid)
+		}
 	}
 
 	// Notify locally hosted authoritative matches of join and leave events.
@@ -744,6 +811,11 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 		t.matchLeaveListener(matchID, leaves)
 	}
 
+	// Notify locally hosted parties of leave events.
+	for partyID, leaves := range partyLeaves {
+		t.partyLeaveListener(partyID, leaves)
+	}
+
 	// Send joins, together with any leaves for the same stream.
 	for stream, joins := range streamJoins {
 		leaves, ok := streamLeaves[stream]
@@ -763,8 +835,16 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 			streamWire.Subcontext = stream.Subcontext.String()
 		}
 
+		// Spectators are not announced to anyone, but see player presence events.
+		if stream.Mode == StreamModeMatchSpectator {
+			continue
+		}
+
 		// Find the list of event recipients first so we can skip event encoding work if it's not necessary.
 		sessionIDs := t.ListLocalSessionIDByStream(stream)
+		if stream.Mode == StreamModeMatchAuthoritative {
+			sessionIDs = append(sessionIDs, t.ListLocalSessionIDByStream(PresenceStream{Mode: StreamModeMatchSpectator, Subject: stream.Subject, Label: stream.Label})...)
+		}
 		if len(sessionIDs) == 0 {
 			continue
 		}
@@ -801,6 +881,12 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 				Joins:   joins,
 				Leaves:  leaves,
 			}}}
+		case StreamModeParty:
+			envelope = &rtapi.Envelope{Message: &rtapi.Envelope_PartyPresenceEvent{PartyPresenceEvent: &rtapi.PartyPresenceEvent{
+				PartyId: fmt.Sprintf("%v.%v", stream.Subject.String(), stream.Label),
+				Joins:   joins,
+				Leaves:  leaves,
+			}}}
 		default:
 			envelope = &rtapi.Envelope{Message: &rtapi.Envelope_StreamPresenceEvent{StreamPresenceEvent: &rtapi.StreamPresenceEvent{
 				Stream: streamWire,
@@ -868,8 +954,16 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 			streamWire.Subcontext = stream.Subcontext.String()
 		}
 
+		// Spectators are not announced to anyone, but see player presence events.
+		if stream.Mode == StreamModeMatchSpectator {
+			continue
+		}
+
 		// Find the list of event recipients first so we can skip event encoding work if it's not necessary.
 		sessionIDs := t.ListLocalSessionIDByStream(stream)
+		if stream.Mode == StreamModeMatchAuthoritative {
+			sessionIDs = append(sessionIDs, t.ListLocalSessionIDByStream(PresenceStream{Mode: StreamModeMatchSpectator, Subject: stream.Subject, Label: stream.Label})...)
+		}
 		if len(sessionIDs) == 0 {
 			continue
 		}
@@ -906,6 +1000,12 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 				// No joins.
 				Leaves: leaves,
 			}}}
+		case StreamModeParty:
+			envelope = &rtapi.Envelope{Message: &rtapi.Envelope_PartyPresenceEvent{PartyPresenceEvent: &rtapi.PartyPresenceEvent{
+				PartyId: fmt.Sprintf("%v.%v", stream.Subject.String(), stream.Label),
+				// No joins.
+				Leaves: leaves,
+			}}}
 		default:
 			envelope = &rtapi.Envelope{Message: &rtapi.Envelope_StreamPresenceEvent{StreamPresenceEvent: &rtapi.StreamPresenceEvent{
 				Stream: streamWire,
@@ -0,0 +1,284 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+const (
+	clusterPresenceOpTrack uint8 = iota
+	clusterPresenceOpUntrack
+	clusterPresenceOpUntrackAll
+	clusterPresenceOpUntrackLocalByStream
+	clusterPresenceOpUntrackByStream
+)
+
+type clusterPresenceOp struct {
+	Op        uint8          `json:"op"`
+	SessionID uuid.UUID      `json:"session_id,omitempty"`
+	Stream    PresenceStream `json:"stream,omitempty"`
+	UserID    uuid.UUID      `json:"user_id,omitempty"`
+	Meta      PresenceMeta   `json:"meta,omitempty"`
+}
+
+// A single change to the presences owned by the sending node.
+type clusterPresenceDelta struct {
+	Version uint64             `json:"version"`
+	Op      *clusterPresenceOp `json:"op"`
+}
+
+// All presences owned by the sending node as of a given version.
+type clusterPresenceSync struct {
+	Version   uint64               `json:"version"`
+	Presences []*clusterPresenceOp `json:"presences"`
+}
+
+type clusterTrackerRemote struct {
+	version uint64
+	syncing bool
+}
+
+// ClusterTracker is a tracker that shares presences with the other nodes in a cluster.
+// Each node replicates changes to its own presences to all other nodes, tagged with an increasing version.
+// Nodes that miss a change, or have just joined, request a full copy of that node's presences to catch up.
+type ClusterTracker struct {
+	*LocalTracker
+	cluster *Cluster
+
+	// Held while applying local changes, so they are replicated in the same order they were applied.
+	replicationMu sync.Mutex
+	version       uint64
+
+	remotesMu sync.Mutex
+	remotes   map[string]*clusterTrackerRemote
+}
+
+func StartClusterTracker(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, jsonpbMarshaler *jsonpb.Marshaler, cluster *Cluster) Tracker {
+	t := &ClusterTracker{
+		LocalTracker: StartLocalTracker(logger, config, sessionRegistry, jsonpbMarshaler).(*LocalTracker),
+		cluster:      cluster,
+
+		remotes: make(map[string]*clusterTrackerRemote),
+	}
+
+	cluster.SetHandler(ClusterMessagePresenceDelta, t.handleDelta)
+	cluster.SetHandler(ClusterMessagePresenceVersion, t.handleVersion)
+	cluster.SetHandler(ClusterMessagePresenceSync, t.handleSync)
+	cluster.AddMemberListener(func(node string) {
+		// A new node, or one that has restarted, fetch its presences.
+		go t.sync(node)
+	}, func(node string) {
+		t.remotesMu.Lock()
+		delete(t.remotes, node)
+		t.untrackByNode(node)
+		t.remotesMu.Unlock()
+	})
+
+	go func() {
+		// Periodically announce the current version, so other nodes can detect if they missed any changes.
+		ticker := time.NewTicker(time.Duration(config.GetCluster().GossipIntervalMs) * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-t.ctx.Done():
+				return
+			case <-ticker.C:
+				t.replicationMu.Lock()
+				version := t.version
+				t.replicationMu.Unlock()
+				t.cluster.Broadcast(ClusterMessagePresenceVersion, version)
+			}
+		}
+	}()
+
+	return t
+}
+
+func (t *ClusterTracker) Track(sessionID uuid.UUID, stream PresenceStream, userID uuid.UUID, meta PresenceMeta, allowIfFirstForSession bool) (bool, bool) {
+	t.replicationMu.Lock()
+	success, isNew := t.LocalTracker.Track(sessionID, stream, userID, meta, allowIfFirstForSession)
+	if isNew {
+		t.replicate(&clusterPresenceOp{Op: clusterPresenceOpTrack, SessionID: sessionID, Stream: stream, UserID: userID, Meta: meta})
+	}
+	t.replicationMu.Unlock()
+	return success, isNew
+}
+
+func (t *ClusterTracker) Untrack(sessionID uuid.UUID, stream PresenceStream, userID uuid.UUID) {
+	t.replicationMu.Lock()
+	if t.LocalTracker.GetLocalBySessionIDStreamUserID(sessionID, stream, userID) != nil {
+		t.LocalTracker.Untrack(sessionID, stream, userID)
+		t.replicate(&clusterPresenceOp{Op: clusterPresenceOpUntrack, SessionID: sessionID, Stream: stream, UserID: userID})
+	}
+	t.replicationMu.Unlock()
+}
+
+func (t *ClusterTracker) UntrackAll(sessionID uuid.UUID) {
+	t.replicationMu.Lock()
+	t.LocalTracker.UntrackAll(sessionID)
+	t.replicate(&clusterPresenceOp{Op: clusterPresenceOpUntrackAll, SessionID: sessionID})
+	t.replicationMu.Unlock()
+}
+
+func (t *ClusterTracker) Update(sessionID uuid.UUID, stream PresenceStream, userID uuid.UUID, meta PresenceMeta, allowIfFirstForSession bool) bool {
+	t.replicationMu.Lock()
+	success := t.LocalTracker.Update(sessionID, stream, userID, meta, allowIfFirstForSession)
+	if success {
+		t.replicate(&clusterPresenceOp{Op: clusterPresenceOpTrack, SessionID: sessionID, Stream: stream, UserID: userID, Meta: meta})
+	}
+	t.replicationMu.Unlock()
+	return success
+}
+
+func (t *ClusterTracker) UntrackLocalByStream(stream PresenceStream) {
+	t.replicationMu.Lock()
+	t.LocalTracker.UntrackLocalByStream(stream)
+	t.replicate(&clusterPresenceOp{Op: clusterPresenceOpUntrackLocalByStream, Stream: stream})
+	t.replicationMu.Unlock()
+}
+
+func (t *ClusterTracker) UntrackByStream(stream PresenceStream) {
+	// Every node applies this to all presences on the stream, including their own, so all nodes agree on the result.
+	t.replicationMu.Lock()
+	t.LocalTracker.UntrackByStream(stream)
+	t.replicate(&clusterPresenceOp{Op: clusterPresenceOpUntrackByStream, Stream: stream})
+	t.replicationMu.Unlock()
+}
+
+// Must be called while holding the replication lock.
+func (t *ClusterTracker) replicate(op *clusterPresenceOp) {
+	t.version++
+	t.cluster.Broadcast(ClusterMessagePresenceDelta, &clusterPresenceDelta{Version: t.version, Op: op})
+}
+
+// Apply a change to presences owned by the given node.
+func (t *ClusterTracker) apply(node string, op *clusterPresenceOp) {
+	switch op.Op {
+	case clusterPresenceOpTrack:
+		t.update(node, op.SessionID, op.Stream, op.UserID, op.Meta, true)
+	case clusterPresenceOpUntrack:
+		t.untrack(node, op.SessionID, op.Stream, op.UserID)
+	case clusterPresenceOpUntrackAll:
+		// Session IDs are unique across the cluster, so all its presences belong to the sending node.
+		t.LocalTracker.UntrackAll(op.SessionID)
+	case clusterPresenceOpUntrackLocalByStream:
+		t.untrackByStream(op.Stream, node)
+	case clusterPresenceOpUntrackByStream:
+		t.untrackByStream(op.Stream, "")
+	default:
+		t.logger.Warn("Unknown cluster presence operation", zap.String("node", node), zap.Uint8("op", op.Op))
+	}
+}
+
+func (t *ClusterTracker) handleDelta(from string, payload json.RawMessage) (interface{}, error) {
+	delta := &clusterPresenceDelta{}
+	if err := json.Unmarshal(payload, delta); err != nil {
+		return nil, err
+	}
+
+	t.remotesMu.Lock()
+	remote := t.remote(from)
+	switch {
+	case remote.syncing || delta.Version <= remote.version:
+		// Either a full sync is already underway, or this change has already been applied.
+	case delta.Version == remote.version+1:
+		t.apply(from, delta.Op)
+		remote.version = delta.Version
+	default:
+		// Missed one or more changes.
+		remote.syncing = true
+		go t.sync(from)
+	}
+	t.remotesMu.Unlock()
+	return nil, nil
+}
+
+func (t *ClusterTracker) handleVersion(from string, payload json.RawMessage) (interface{}, error) {
+	var version uint64
+	if err := json.Unmarshal(payload, &version); err != nil {
+		return nil, err
+	}
+
+	t.remotesMu.Lock()
+	remote := t.remote(from)
+	if !remote.syncing && version > remote.version {
+		// Missed one or more changes.
+		remote.syncing = true
+		go t.sync(from)
+	}
+	t.remotesMu.Unlock()
+	return nil, nil
+}
+
+func (t *ClusterTracker) handleSync(from string, payload json.RawMessage) (interface{}, error) {
+	t.replicationMu.Lock()
+	presences := t.listByNode(t.name)
+	version := t.version
+	t.replicationMu.Unlock()
+
+	result := &clusterPresenceSync{
+		Version:   version,
+		Presences: make([]*clusterPresenceOp, 0, len(presences)),
+	}
+	for _, p := range presences {
+		result.Presences = append(result.Presences, &clusterPresenceOp{SessionID: p.ID.SessionID, Stream: p.Stream, UserID: p.UserID, Meta: p.Meta})
+	}
+	return result, nil
+}
+
+// Fetch and apply all presences owned by the given node.
+func (t *ClusterTracker) sync(node string) {
+	result := &clusterPresenceSync{}
+	err := t.cluster.Request(context.Background(), node, ClusterMessagePresenceSync, nil, result)
+
+	t.remotesMu.Lock()
+	if _, addressErr := t.cluster.address(node); addressErr != nil {
+		// The node has left while the sync was underway.
+		delete(t.remotes, node)
+		t.remotesMu.Unlock()
+		return
+	}
+	remote := t.remote(node)
+	remote.syncing = false
+	if err != nil {
+		t.logger.Warn("Error syncing presences from cluster node", zap.String("node", node), zap.Error(err))
+	} else if result.Version >= remote.version {
+		presences := make([]Presence, 0, len(result.Presences))
+		for _, p := range result.Presences {
+			presences = append(presences, Presence{ID: PresenceID{Node: node, SessionID: p.SessionID}, Stream: p.Stream, UserID: p.UserID, Meta: p.Meta})
+		}
+		t.replaceByNode(node, presences)
+		remote.version = result.Version
+	}
+	t.remotesMu.Unlock()
+}
+
+// Must be called while holding the remotes lock.
+func (t *ClusterTracker) remote(node string) *clusterTrackerRemote {
+	remote, ok := t.remotes[node]
+	if !ok {
+		remote = &clusterTrackerRemote{}
+		t.remotes[node] = remote
+	}
+	return remote
+}
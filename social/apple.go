@@ -0,0 +1,149 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package social
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	appleIssuer = "https://appleid.apple.com"
+	// How long fetched Apple public keys are used before they are refreshed.
+	appleKeysRefreshSec = 3600
+	// Minimum time between refreshes triggered by tokens signed with unknown keys.
+	appleKeysMinRefreshSec = 60
+)
+
+// AppleProfile is an abbreviated version of a Sign in with Apple profile extracted from a verified identity token.
+type AppleProfile struct {
+	ID            string
+	Email         string
+	EmailVerified bool
+}
+
+// SetAppleKeysURL replaces the URL of the JSON Web Key Set used to verify Sign in with Apple identity tokens, for
+// example to point at a test stub.
+func (c *Client) SetAppleKeysURL(keysURL string) {
+	c.appleKeysMu.Lock()
+	c.appleKeysURL = keysURL
+	c.appleKeys = nil
+	c.appleKeysFetchedAt = 0
+	c.appleKeysMu.Unlock()
+}
+
+// CheckAppleToken extracts the user's Apple profile from a given identity token, verifying it was issued for the
+// given bundle ID.
+// See: https://developer.apple.com/documentation/sign_in_with_apple/sign_in_with_apple_rest_api/verifying_a_user
+func (c *Client) CheckAppleToken(ctx context.Context, bundleID, idToken string) (*AppleProfile, error) {
+	if bundleID == "" {
+		return nil, errors.New("apple id token cannot be checked without a bundle id")
+	}
+
+	token, err := jwt.Parse(idToken, func(token *jwt.Token) (interface{}, error) {
+		if s, ok := token.Method.(*jwt.SigningMethodRSA); !ok || s.Hash != crypto.SHA256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, errors.New("apple id token kid header missing")
+		}
+		claims := token.Claims.(jwt.MapClaims)
+		if !claims.VerifyIssuer(appleIssuer, true) {
+			return nil, fmt.Errorf("unexpected issuer: %v", claims["iss"])
+		}
+		if !claims.VerifyAudience(bundleID, true) {
+			return nil, fmt.Errorf("unexpected audience: %v", claims["aud"])
+		}
+		return c.getAppleKey(ctx, kid)
+	})
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("apple id token invalid: %v", err)
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+	profile := &AppleProfile{}
+	if v, ok := claims["sub"]; ok {
+		if profile.ID, ok = v.(string); !ok || profile.ID == "" {
+			return nil, errors.New("apple id token sub field invalid")
+		}
+	} else {
+		return nil, errors.New("apple id token sub field missing")
+	}
+	if v, ok := claims["email"]; ok {
+		if profile.Email, ok = v.(string); !ok {
+			return nil, errors.New("apple id token email field invalid")
+		}
+	}
+	if v, ok := claims["email_verified"]; ok {
+		// Apple sends boolean claims as either booleans or strings.
+		switch v.(type) {
+		case bool:
+			profile.EmailVerified = v.(bool)
+		case string:
+			profile.EmailVerified = v.(string) == "true"
+		default:
+			return nil, errors.New("apple id token email_verified field invalid")
+		}
+	}
+
+	return profile, nil
+}
+
+func (c *Client) getAppleKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	now := time.Now().UTC().Unix()
+	c.appleKeysMu.RLock()
+	key, found := c.appleKeys[kid]
+	fetchedAt := c.appleKeysFetchedAt
+	c.appleKeysMu.RUnlock()
+	if found && fetchedAt+appleKeysRefreshSec > now {
+		return key, nil
+	}
+
+	c.appleKeysMu.Lock()
+	defer c.appleKeysMu.Unlock()
+	// Another request may have refreshed the keys while waiting for the lock.
+	key, found = c.appleKeys[kid]
+	if found && c.appleKeysFetchedAt+appleKeysRefreshSec > now {
+		return key, nil
+	}
+	// Unknown key IDs trigger a refresh as Apple may have rotated its keys, but not too often.
+	if !found && c.appleKeysFetchedAt+appleKeysMinRefreshSec > now {
+		return nil, fmt.Errorf("apple id token signed with unknown key: %v", kid)
+	}
+
+	var jwks jsonWebKeySet
+	if err := c.request(ctx, "apple keys", c.appleKeysURL, nil, &jwks); err != nil {
+		return nil, err
+	}
+	keys := jwks.rsaKeys()
+	if len(keys) == 0 {
+		return nil, errors.New("error finding valid apple keys")
+	}
+	c.appleKeys = keys
+	c.appleKeysFetchedAt = now
+
+	key, found = keys[kid]
+	if !found {
+		return nil, fmt.Errorf("apple id token signed with unknown key: %v", kid)
+	}
+	return key, nil
+}
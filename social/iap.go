@@ -0,0 +1,335 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package social
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	appleReceiptStatusOK          = 0
+	appleReceiptStatusSandboxUsed = 21007
+
+	googlePublisherScope = "https://www.googleapis.com/auth/androidpublisher"
+
+	huaweiResponseCodeOK = "0"
+)
+
+// PurchaseEndpoints are the provider URLs used to validate in-app purchases.
+type PurchaseEndpoints struct {
+	AppleProduction string
+	AppleSandbox    string
+	GoogleToken     string
+	GooglePurchases string
+	HuaweiToken     string
+	HuaweiPurchases string
+}
+
+// DefaultPurchaseEndpoints returns the live provider URLs used to validate in-app purchases.
+func DefaultPurchaseEndpoints() PurchaseEndpoints {
+	return PurchaseEndpoints{
+		AppleProduction: "https://buy.itunes.apple.com/verifyReceipt",
+		AppleSandbox:    "https://sandbox.itunes.apple.com/verifyReceipt",
+		GoogleToken:     "https://oauth2.googleapis.com/token",
+		GooglePurchases: "https://androidpublisher.googleapis.com/androidpublisher/v3/applications",
+		HuaweiToken:     "https://oauth-login.cloud.huawei.com/oauth2/v3/token",
+		HuaweiPurchases: "https://orders-drcn.iap.cloud.huawei.com/applications/purchases/tokens/verify",
+	}
+}
+
+// SetPurchaseEndpoints replaces the provider URLs used to validate in-app purchases, for example to point at test stubs.
+func (c *Client) SetPurchaseEndpoints(endpoints PurchaseEndpoints) {
+	c.Lock()
+	c.purchaseEndpoints = endpoints
+	c.Unlock()
+}
+
+type accessToken struct {
+	token     string
+	expiresAt int64
+}
+
+type accessTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// AppleReceiptInApp is a single in-app purchase contained in an Apple receipt.
+type AppleReceiptInApp struct {
+	Quantity              string `json:"quantity"`
+	ProductID             string `json:"product_id"`
+	TransactionID         string `json:"transaction_id"`
+	OriginalTransactionID string `json:"original_transaction_id"`
+	PurchaseDateMs        string `json:"purchase_date_ms"`
+	ExpiresDateMs         string `json:"expires_date_ms"`
+	CancellationDateMs    string `json:"cancellation_date_ms"`
+}
+
+// AppleReceipt is an abbreviated version of a decoded Apple receipt.
+type AppleReceipt struct {
+	BundleID string               `json:"bundle_id"`
+	InApp    []*AppleReceiptInApp `json:"in_app"`
+}
+
+// AppleReceiptResponse is the response to an Apple receipt validation request.
+type AppleReceiptResponse struct {
+	Status      int           `json:"status"`
+	Environment string        `json:"environment"`
+	IsRetryable bool          `json:"is-retryable"`
+	Receipt     *AppleReceipt `json:"receipt"`
+}
+
+// GooglePurchase is the state of a Google Play in-app product purchase.
+type GooglePurchase struct {
+	PackageName          string `json:"-"`
+	ProductID            string `json:"-"`
+	PurchaseToken        string `json:"-"`
+	OrderID              string `json:"orderId"`
+	PurchaseTimeMillis   string `json:"purchaseTimeMillis"`
+	PurchaseState        int    `json:"purchaseState"`
+	ConsumptionState     int    `json:"consumptionState"`
+	AcknowledgementState int    `json:"acknowledgementState"`
+	// Only set for purchases not made through the standard in-app billing flow, 0 is a test purchase.
+	PurchaseType *int `json:"purchaseType"`
+}
+
+// HuaweiPurchase is an abbreviated version of Huawei in-app purchase data.
+type HuaweiPurchase struct {
+	OrderID       string `json:"orderId"`
+	ProductID     string `json:"productId"`
+	PurchaseToken string `json:"purchaseToken"`
+	PurchaseTime  int64  `json:"purchaseTime"`
+	PurchaseState int    `json:"purchaseState"`
+	// Only set for sandbox purchases, where it is 0.
+	PurchaseType *int `json:"purchaseType"`
+}
+
+type huaweiVerifyResponse struct {
+	ResponseCode      string `json:"responseCode"`
+	ResponseMessage   string `json:"responseMessage"`
+	PurchaseTokenData string `json:"purchaseTokenData"`
+	DataSignature     string `json:"dataSignature"`
+}
+
+// ValidateApplePurchase validates a base64 encoded App Store receipt, retrying against the sandbox if needed.
+// Returns the parsed response along with the raw response body.
+// See: https://developer.apple.com/documentation/appstorereceipts/verifyreceipt
+func (c *Client) ValidateApplePurchase(ctx context.Context, password, receipt string) (*AppleReceiptResponse, []byte, error) {
+	c.RLock()
+	endpoints := c.purchaseEndpoints
+	c.RUnlock()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"receipt-data":             receipt,
+		"password":                 password,
+		"exclude-old-transactions": true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response AppleReceiptResponse
+	raw, err := c.requestPost(ctx, "apple purchase", endpoints.AppleProduction, map[string]string{"Content-Type": "application/json"}, body, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+	if response.Status == appleReceiptStatusSandboxUsed {
+		// Receipt is from the test environment, but was sent to the production environment for validation.
+		response = AppleReceiptResponse{}
+		raw, err = c.requestPost(ctx, "apple purchase", endpoints.AppleSandbox, map[string]string{"Content-Type": "application/json"}, body, &response)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if response.Status != appleReceiptStatusOK {
+		return nil, nil, fmt.Errorf("apple purchase error: status %v", response.Status)
+	}
+	if response.Receipt == nil {
+		return nil, nil, errors.New("apple purchase error: no receipt")
+	}
+	return &response, raw, nil
+}
+
+// ValidateGooglePurchase checks the state of a Google Play in-app product purchase using service account credentials.
+// Returns the parsed response along with the raw response body.
+// See: https://developers.google.com/android-publisher/api-ref/rest/v3/purchases.products/get
+func (c *Client) ValidateGooglePurchase(ctx context.Context, clientEmail, privateKey, packageName, productID, purchaseToken string) (*GooglePurchase, []byte, error) {
+	c.RLock()
+	endpoints := c.purchaseEndpoints
+	c.RUnlock()
+
+	token, err := c.getGoogleAccessToken(ctx, endpoints.GoogleToken, clientEmail, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := endpoints.GooglePurchases + "/" + url.PathEscape(packageName) + "/purchases/products/" + url.PathEscape(productID) + "/tokens/" + url.PathEscape(purchaseToken)
+	raw, err := c.requestRaw(ctx, "google purchase", path, map[string]string{"Authorization": "Bearer " + token})
+	if err != nil {
+		return nil, nil, err
+	}
+	var purchase GooglePurchase
+	if err := json.Unmarshal(raw, &purchase); err != nil {
+		return nil, nil, err
+	}
+	purchase.PackageName = packageName
+	purchase.ProductID = productID
+	purchase.PurchaseToken = purchaseToken
+	return &purchase, raw, nil
+}
+
+// ValidateHuaweiPurchase checks the signature of Huawei in-app purchase data, then confirms the purchase with the order service.
+// Returns the parsed purchase data along with the raw order service response body.
+// See: https://developer.huawei.com/consumer/en/doc/HMSCore-References/api-purchase-token-verification-v2-0000001050746113
+func (c *Client) ValidateHuaweiPurchase(ctx context.Context, clientID, clientSecret, publicKey, purchaseData, signature string) (*HuaweiPurchase, []byte, error) {
+	if err := verifyHuaweiSignature(publicKey, purchaseData, signature); err != nil {
+		return nil, nil, err
+	}
+	var purchase HuaweiPurchase
+	if err := json.Unmarshal([]byte(purchaseData), &purchase); err != nil {
+		return nil, nil, fmt.Errorf("huawei purchase error: invalid purchase data: %v", err.Error())
+	}
+
+	c.RLock()
+	endpoints := c.purchaseEndpoints
+	c.RUnlock()
+
+	token, err := c.getHuaweiAccessToken(ctx, endpoints.HuaweiToken, clientID, clientSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"purchaseToken": purchase.PurchaseToken,
+		"productId":     purchase.ProductID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	headers := map[string]string{
+		"Content-Type":  "application/json; charset=UTF-8",
+		"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte("APPAT:"+token)),
+	}
+	var response huaweiVerifyResponse
+	raw, err := c.requestPost(ctx, "huawei purchase", endpoints.HuaweiPurchases, headers, body, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+	if response.ResponseCode != huaweiResponseCodeOK {
+		return nil, nil, fmt.Errorf("huawei purchase error: response code %v, %v", response.ResponseCode, response.ResponseMessage)
+	}
+	return &purchase, raw, nil
+}
+
+func verifyHuaweiSignature(publicKey, purchaseData, signature string) error {
+	keyBytes, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return fmt.Errorf("huawei purchase error: invalid public key: %v", err.Error())
+	}
+	key, err := x509.ParsePKIXPublicKey(keyBytes)
+	if err != nil {
+		return fmt.Errorf("huawei purchase error: invalid public key: %v", err.Error())
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return errors.New("huawei purchase error: public key is not an RSA key")
+	}
+	signatureBytes, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("huawei purchase error: invalid signature: %v", err.Error())
+	}
+	hashed := sha256.Sum256([]byte(purchaseData))
+	if err := rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, hashed[:], signatureBytes); err != nil {
+		return fmt.Errorf("huawei purchase error: signature mismatch: %v", err.Error())
+	}
+	return nil
+}
+
+func (c *Client) getGoogleAccessToken(ctx context.Context, tokenURL, clientEmail, privateKey string) (string, error) {
+	now := time.Now().UTC().Unix()
+	c.RLock()
+	cached, found := c.googleAccessTokens[clientEmail]
+	c.RUnlock()
+	if found && cached.expiresAt > now {
+		return cached.token, nil
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKey))
+	if err != nil {
+		return "", fmt.Errorf("google purchase error: invalid private key: %v", err.Error())
+	}
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   clientEmail,
+		"scope": googlePublisherScope,
+		"aud":   tokenURL,
+		"iat":   now,
+		"exp":   now + 3600,
+	}).SignedString(key)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+	return c.requestAccessToken(ctx, "google purchase", tokenURL, form, c.googleAccessTokens, clientEmail)
+}
+
+func (c *Client) getHuaweiAccessToken(ctx context.Context, tokenURL, clientID, clientSecret string) (string, error) {
+	c.RLock()
+	cached, found := c.huaweiAccessTokens[clientID]
+	c.RUnlock()
+	if found && cached.expiresAt > time.Now().UTC().Unix() {
+		return cached.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", clientID)
+	form.Set("client_secret", clientSecret)
+	return c.requestAccessToken(ctx, "huawei purchase", tokenURL, form, c.huaweiAccessTokens, clientID)
+}
+
+func (c *Client) requestAccessToken(ctx context.Context, provider, tokenURL string, form url.Values, cache map[string]*accessToken, key string) (string, error) {
+	var response accessTokenResponse
+	_, err := c.requestPost(ctx, provider, tokenURL, map[string]string{"Content-Type": "application/x-www-form-urlencoded"}, []byte(form.Encode()), &response)
+	if err != nil {
+		return "", err
+	}
+	if response.AccessToken == "" {
+		return "", fmt.Errorf("%v error: no access token", provider)
+	}
+
+	// Refresh a minute early to avoid using tokens that expire in flight.
+	c.Lock()
+	cache[key] = &accessToken{
+		token:     response.AccessToken,
+		expiresAt: time.Now().UTC().Unix() + response.ExpiresIn - 60,
+	}
+	c.Unlock()
+	return response.AccessToken, nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package social
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	// How long fetched OpenID Connect provider public keys are used before they are refreshed.
+	oidcKeysRefreshSec = 3600
+	// Minimum time between refreshes triggered by tokens signed with unknown keys.
+	oidcKeysMinRefreshSec = 60
+)
+
+// OidcProfile is an abbreviated version of an OpenID Connect profile extracted from a verified identity token.
+type OidcProfile struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []*jsonWebKey `json:"keys"`
+}
+
+// rsaKeys returns the valid RSA keys in the set, keyed by key ID.
+func (s *jsonWebKeySet) rsaKeys() map[string]*rsa.PublicKey {
+	keys := make(map[string]*rsa.PublicKey, len(s.Keys))
+	for _, jwk := range s.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			// Only RSA signing keys are used to verify identity tokens.
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	return keys
+}
+
+type oidcKeySet struct {
+	keys      map[string]*rsa.PublicKey
+	fetchedAt int64
+}
+
+// CheckOidcToken extracts the user's profile from a given OpenID Connect identity token, verifying it was signed by
+// one of the keys published at the given JWKS URL, and issued by the given issuer for the given audience.
+// See: https://openid.net/specs/openid-connect-core-1_0.html#IDTokenValidation
+func (c *Client) CheckOidcToken(ctx context.Context, issuer, audience, jwksURL, idToken string) (*OidcProfile, error) {
+	if issuer == "" || audience == "" || jwksURL == "" {
+		return nil, errors.New("oidc id token cannot be checked without an issuer, audience and jwks url")
+	}
+
+	token, err := jwt.Parse(idToken, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, errors.New("oidc id token kid header missing")
+		}
+		claims := token.Claims.(jwt.MapClaims)
+		if !claims.VerifyIssuer(issuer, true) {
+			return nil, fmt.Errorf("unexpected issuer: %v", claims["iss"])
+		}
+		if !claims.VerifyAudience(audience, true) {
+			return nil, fmt.Errorf("unexpected audience: %v", claims["aud"])
+		}
+		return c.getOidcKey(ctx, jwksURL, kid)
+	})
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("oidc id token invalid: %v", err)
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+	if _, ok := claims["exp"]; !ok {
+		// Identity tokens must always expire, the JWT library only checks expiry when present.
+		return nil, errors.New("oidc id token exp field missing")
+	}
+	profile := &OidcProfile{}
+	if v, ok := claims["sub"]; ok {
+		if profile.Subject, ok = v.(string); !ok || profile.Subject == "" || len(profile.Subject) > 255 {
+			return nil, errors.New("oidc id token sub field invalid")
+		}
+	} else {
+		return nil, errors.New("oidc id token sub field missing")
+	}
+	if v, ok := claims["email"]; ok {
+		if profile.Email, ok = v.(string); !ok {
+			return nil, errors.New("oidc id token email field invalid")
+		}
+	}
+	if v, ok := claims["email_verified"]; ok {
+		// Some providers send boolean claims as strings.
+		switch v.(type) {
+		case bool:
+			profile.EmailVerified = v.(bool)
+		case string:
+			profile.EmailVerified = v.(string) == "true"
+		default:
+			return nil, errors.New("oidc id token email_verified field invalid")
+		}
+	}
+
+	return profile, nil
+}
+
+func (c *Client) getOidcKey(ctx context.Context, jwksURL, kid string) (*rsa.PublicKey, error) {
+	now := time.Now().UTC().Unix()
+	c.oidcKeysMu.RLock()
+	keySet, found := c.oidcKeys[jwksURL]
+	c.oidcKeysMu.RUnlock()
+	if found {
+		if key, ok := keySet.keys[kid]; ok && keySet.fetchedAt+oidcKeysRefreshSec > now {
+			return key, nil
+		}
+	}
+
+	c.oidcKeysMu.Lock()
+	defer c.oidcKeysMu.Unlock()
+	// Another request may have refreshed the keys while waiting for the lock.
+	keySet, found = c.oidcKeys[jwksURL]
+	if found {
+		key, ok := keySet.keys[kid]
+		if ok && keySet.fetchedAt+oidcKeysRefreshSec > now {
+			return key, nil
+		}
+		// Unknown key IDs trigger a refresh as the provider may have rotated its keys, but not too often.
+		if !ok && keySet.fetchedAt+oidcKeysMinRefreshSec > now {
+			return nil, fmt.Errorf("oidc id token signed with unknown key: %v", kid)
+		}
+	}
+
+	var jwks jsonWebKeySet
+	if err := c.request(ctx, "oidc keys", jwksURL, nil, &jwks); err != nil {
+		return nil, err
+	}
+	keys := jwks.rsaKeys()
+	if len(keys) == 0 {
+		return nil, errors.New("error finding valid oidc keys")
+	}
+	c.oidcKeys[jwksURL] = &oidcKeySet{keys: keys, fetchedAt: now}
+
+	key, found := keys[kid]
+	if !found {
+		return nil, fmt.Errorf("oidc id token signed with unknown key: %v", kid)
+	}
+	return key, nil
+}
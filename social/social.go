@@ -26,6 +26,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -44,6 +45,21 @@ type Client struct {
 	googleCertsRefreshAt int64
 	gamecenterCaCert     *x509.Certificate
 	client               *http.Client
+
+	// Apple keys have their own lock, so fetching them does not hold up other providers.
+	appleKeysMu        sync.RWMutex
+	appleKeys          map[string]*rsa.PublicKey
+	appleKeysFetchedAt int64
+	appleKeysURL       string
+	// OpenID Connect keys per JWKS URL, likewise with their own lock.
+	oidcKeysMu sync.RWMutex
+	oidcKeys   map[string]*oidcKeySet
+
+	purchaseEndpoints PurchaseEndpoints
+	// Google Play API access token cached per service account.
+	googleAccessTokens map[string]*accessToken
+	// Huawei order service access token cached per client ID.
+	huaweiAccessTokens map[string]*accessToken
 }
 
 // FacebookProfile is an abbreviated version of a Facebook profile.
@@ -155,6 +171,12 @@ dAUK75fDiSKxH3fzvc1D1PFMqT+1i4SvZPLQFCE=
 			Timeout: timeout,
 		},
 		gamecenterCaCert: caCert,
+		appleKeysURL:     "https://appleid.apple.com/auth/keys",
+		oidcKeys:         make(map[string]*oidcKeySet),
+
+		purchaseEndpoints:  DefaultPurchaseEndpoints(),
+		googleAccessTokens: make(map[string]*accessToken),
+		huaweiAccessTokens: make(map[string]*accessToken),
 	}
 }
 
@@ -475,7 +497,23 @@ func (c *Client) request(ctx context.Context, provider, path string, headers map
 }
 
 func (c *Client) requestRaw(ctx context.Context, provider, path string, headers map[string]string) ([]byte, error) {
-	req, err := http.NewRequest("GET", path, nil)
+	return c.send(ctx, provider, "GET", path, headers, nil)
+}
+
+func (c *Client) requestPost(ctx context.Context, provider, path string, headers map[string]string, body []byte, to interface{}) ([]byte, error) {
+	raw, err := c.send(ctx, provider, "POST", path, headers, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, to)
+	if err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+func (c *Client) send(ctx context.Context, provider, method, path string, headers map[string]string, reqBody io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, path, reqBody)
 	if err != nil {
 		return nil, err
 	}
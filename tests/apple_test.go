@@ -0,0 +1,87 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/heroiclabs/nakama/social"
+)
+
+func appleTestClient(t *testing.T) (*social.Client, *rsa.PrivateKey, func()) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+		e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+		fmt.Fprintf(w, `{"keys":[{"kty":"RSA","kid":"test","use":"sig","alg":"RS256","n":"%v","e":"%v"}]}`, n, e)
+	}))
+
+	client := social.NewClient(5 * time.Second)
+	client.SetAppleKeysURL(server.URL)
+	return client, key, server.Close
+}
+
+func signAppleToken(t *testing.T, key *rsa.PrivateKey, audience string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            "https://appleid.apple.com",
+		"aud":            audience,
+		"sub":            "001234.apple.user",
+		"email":          "user@example.com",
+		"email_verified": "true",
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("error signing token: %v", err)
+	}
+	return signed
+}
+
+func TestCheckAppleToken(t *testing.T) {
+	client, key, closeFn := appleTestClient(t)
+	defer closeFn()
+
+	profile, err := client.CheckAppleToken(context.Background(), "com.heroiclabs.test", signAppleToken(t, key, "com.heroiclabs.test"))
+	if err != nil {
+		t.Fatalf("error checking token: %v", err)
+	}
+	if profile.ID != "001234.apple.user" || profile.Email != "user@example.com" || !profile.EmailVerified {
+		t.Fatalf("unexpected profile: %v", profile)
+	}
+}
+
+func TestCheckAppleTokenWrongAudience(t *testing.T) {
+	client, key, closeFn := appleTestClient(t)
+	defer closeFn()
+
+	if _, err := client.CheckAppleToken(context.Background(), "com.heroiclabs.test", signAppleToken(t, key, "com.heroiclabs.other")); err == nil {
+		t.Fatal("expected error checking token issued for another bundle ID")
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
+	"github.com/heroiclabs/nakama/server"
+)
+
+type clusterTestSession struct {
+	DummySession
+	sync.Mutex
+	sid uuid.UUID
+}
+
+func (s *clusterTestSession) ID() uuid.UUID {
+	return s.sid
+}
+
+func (s *clusterTestSession) SendBytes(isStream bool, mode uint8, payload []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	return s.DummySession.SendBytes(isStream, mode, payload)
+}
+
+func (s *clusterTestSession) SendBytesUnreliable(isStream bool, mode uint8, payload []byte) error {
+	return s.SendBytes(isStream, mode, payload)
+}
+
+func (s *clusterTestSession) count() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.messages)
+}
+
+type clusterTestNode struct {
+	name            string
+	address         string
+	config          server.Config
+	cluster         *server.Cluster
+	sessionRegistry server.SessionRegistry
+	tracker         server.Tracker
+	router          server.MessageRouter
+}
+
+// Start a cluster node on a free localhost port, joining through the given addresses.
+func newClusterTestNode(t *testing.T, name string, join ...string) *clusterTestNode {
+	return newClusterTestNodeWithSecret(t, name, "secret", join...)
+}
+
+func newClusterTestNodeWithSecret(t *testing.T, name, secret string, join ...string) *clusterTestNode {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error finding free port: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	nodeConfig := server.NewConfig(logger)
+	nodeConfig.Name = name
+	nodeConfig.GetCluster().GossipPort = port
+	nodeConfig.GetCluster().Join = join
+	nodeConfig.GetCluster().GossipIntervalMs = 50
+	nodeConfig.GetCluster().FailureTimeoutMs = 1000
+	nodeConfig.GetCluster().Secret = secret
+
+	cluster := server.NewCluster(logger, nodeConfig)
+	sessionRegistry := server.NewClusterSessionRegistry(cluster)
+	tracker := server.StartClusterTracker(logger, nodeConfig, sessionRegistry, jsonpbMarshaler, cluster)
+	router := server.NewClusterMessageRouter(logger, sessionRegistry, tracker, jsonpbMarshaler, cluster)
+	tracker.SetMatchJoinListener(func(uuid.UUID, []*server.MatchPresence) {})
+	tracker.SetMatchLeaveListener(func(uuid.UUID, []*server.MatchPresence) {})
+	tracker.SetPartyLeaveListener(func(uuid.UUID, []*server.PresenceID) {})
+	if err := cluster.Start(); err != nil {
+		t.Fatalf("error starting cluster node: %v", err)
+	}
+
+	return &clusterTestNode{
+		name:            name,
+		address:         fmt.Sprintf("127.0.0.1:%v", port),
+		config:          nodeConfig,
+		cluster:         cluster,
+		sessionRegistry: sessionRegistry,
+		tracker:         tracker,
+		router:          router,
+	}
+}
+
+func (n *clusterTestNode) stop() {
+	n.cluster.Stop()
+	n.tracker.Stop()
+}
+
+func waitFor(t *testing.T, description string, condition func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %v", description)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestClusterPresenceAndRouting(t *testing.T) {
+	nodeA := newClusterTestNode(t, "a")
+	defer nodeA.stop()
+	nodeB := newClusterTestNode(t, "b", nodeA.address)
+	defer nodeB.stop()
+	nodeC := newClusterTestNode(t, "c", nodeB.address)
+
+	// Every node learns about every other node, even those it did not join through directly.
+	for _, node := range []*clusterTestNode{nodeA, nodeB, nodeC} {
+		node := node
+		waitFor(t, node.name+" to see all nodes", func() bool {
+			return len(node.cluster.Members()) == 2
+		})
+	}
+
+	sessionA := &clusterTestSession{DummySession: DummySession{uid: uuid.Must(uuid.NewV4())}, sid: uuid.Must(uuid.NewV4())}
+	nodeA.sessionRegistry.Add(sessionA)
+	sessionC := &clusterTestSession{DummySession: DummySession{uid: uuid.Must(uuid.NewV4())}, sid: uuid.Must(uuid.NewV4())}
+	nodeC.sessionRegistry.Add(sessionC)
+
+	stream := server.PresenceStream{Mode: server.StreamModeChannel, Subject: uuid.Must(uuid.NewV4())}
+	nodeA.tracker.Track(sessionA.ID(), stream, sessionA.UserID(), server.PresenceMeta{Format: server.SessionFormatJson}, true)
+	nodeC.tracker.Track(sessionC.ID(), stream, sessionC.UserID(), server.PresenceMeta{Format: server.SessionFormatJson}, true)
+
+	// Presences on either node are visible to all nodes.
+	waitFor(t, "presences to replicate", func() bool {
+		return nodeB.tracker.CountByStream(stream) == 2
+	})
+	nodes := nodeB.tracker.ListNodesForStream(stream)
+	if _, ok := nodes["a"]; !ok {
+		t.Fatalf("expected node a to have presences on stream, got %v", nodes)
+	}
+	if _, ok := nodes["c"]; !ok {
+		t.Fatalf("expected node c to have presences on stream, got %v", nodes)
+	}
+
+	// A message sent from a node with no local recipients reaches sessions connected to other nodes.
+	nodeB.router.SendToStream(logger, stream, &rtapi.Envelope{Message: &rtapi.Envelope_StreamData{StreamData: &rtapi.StreamData{Data: "test"}}})
+	waitFor(t, "message delivery to node a", func() bool {
+		return sessionA.count() >= 1
+	})
+	waitFor(t, "message delivery to node c", func() bool {
+		return sessionC.count() >= 1
+	})
+
+	// When a node leaves, all other nodes drop its presences.
+	nodeC.stop()
+	waitFor(t, "node c presences to be removed", func() bool {
+		return nodeA.tracker.CountByStream(stream) == 1 && nodeB.tracker.CountByStream(stream) == 1
+	})
+}
+
+func TestClusterRejectsWrongSecret(t *testing.T) {
+	nodeA := newClusterTestNode(t, "a")
+	defer nodeA.stop()
+	nodeB := newClusterTestNodeWithSecret(t, "b", "wrong", nodeA.address)
+	defer nodeB.stop()
+
+	// Give the node with the wrong secret several gossip rounds to try joining.
+	time.Sleep(500 * time.Millisecond)
+	if members := nodeA.cluster.Members(); len(members) != 0 {
+		t.Fatalf("expected node a to reject node b, got members %v", members)
+	}
+	if members := nodeB.cluster.Members(); len(members) != 0 {
+		t.Fatalf("expected node b to not join node a, got members %v", members)
+	}
+
+	// Connections sending unsigned or wrongly signed messages are dropped without any reply.
+	for _, frame := range []string{
+		`{"kind":6,"from":"b","id":1,"payload":"00000000-0000-0000-0000-000000000000"}`,
+		`{"kind":6,"from":"b","id":1,"payload":"00000000-0000-0000-0000-000000000000","mac":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="}`,
+	} {
+		expectFrameRejected(t, nodeA.address, frame)
+	}
+}
+
+func TestClusterRejectsReplayedMessage(t *testing.T) {
+	nodeA := newClusterTestNode(t, "a")
+	defer nodeA.stop()
+
+	// Record the first frame node b sends to node a, by having node b join through a proxy.
+	proxy, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error starting proxy: %v", err)
+	}
+	defer proxy.Close()
+	recordedCh := make(chan string, 1)
+	go func() {
+		client, err := proxy.Accept()
+		if err != nil {
+			return
+		}
+		defer client.Close()
+		upstream, err := net.Dial("tcp", nodeA.address)
+		if err != nil {
+			return
+		}
+		defer upstream.Close()
+		go io.Copy(client, upstream)
+		frame, err := bufio.NewReader(io.TeeReader(client, upstream)).ReadString('\n')
+		if err != nil {
+			return
+		}
+		recordedCh <- strings.TrimSpace(frame)
+		io.Copy(upstream, client)
+	}()
+
+	nodeB := newClusterTestNode(t, "b", proxy.Addr().String())
+	defer nodeB.stop()
+
+	var recorded string
+	select {
+	case recorded = <-recordedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for node b to send a frame")
+	}
+
+	// The captured frame is correctly signed, but is bound to the connection it was sent on.
+	expectFrameRejected(t, nodeA.address, recorded)
+}
+
+// Send a single frame on a new connection, and check the node closes the connection without replying to it.
+func expectFrameRejected(t *testing.T, address, frame string) {
+	conn, err := net.Dial("tcp", address)
+	if err != nil {
+		t.Fatalf("error connecting to node: %v", err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(conn)
+
+	// Nodes open each connection with a hello carrying its nonce.
+	if _, err := reader.ReadString('\n'); err != nil {
+		t.Fatalf("error reading hello: %v", err)
+	}
+	if _, err := conn.Write([]byte(frame + "\n")); err != nil {
+		t.Fatalf("error writing frame: %v", err)
+	}
+	n, err := reader.Read(make([]byte, 1024))
+	if err == nil || n != 0 {
+		t.Fatalf("expected connection to be closed without reply, got %v bytes", n)
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		t.Fatal("expected connection to be closed, timed out waiting")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/heroiclabs/nakama/api"
+	"github.com/heroiclabs/nakama/console"
+	"github.com/heroiclabs/nakama/server"
+)
+
+func TestExportAccountArchive(t *testing.T) {
+	export := &console.AccountExport{
+		Account:  &api.Account{User: &api.User{Id: "a2d4a5ea-2c44-4e4c-94d0-5e4b8e5ab1b8", Username: "alice"}},
+		Messages: []*api.ChannelMessage{&api.ChannelMessage{MessageId: "m1", Username: "alice"}},
+	}
+
+	archive, err := server.ExportAccountArchive(logger, export)
+	if err != nil {
+		t.Fatalf("error creating archive: %v", err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(archive.Data), int64(len(archive.Data)))
+	if err != nil {
+		t.Fatalf("error reading archive: %v", err)
+	}
+
+	files := make(map[string][]byte, len(reader.File))
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("error opening %v: %v", f.Name, err)
+		}
+		data, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("error reading %v: %v", f.Name, err)
+		}
+		files[f.Name] = data
+	}
+
+	// Every data set is present, even when empty.
+	for _, name := range []string{"account.json", "messages.json", "purchases.json", "sessions.json", "group_memberships.json"} {
+		if _, found := files[name]; !found {
+			t.Fatalf("expected %v in archive, got %v files", name, len(files))
+		}
+	}
+	var messages []map[string]interface{}
+	if err := json.Unmarshal(files["messages.json"], &messages); err != nil {
+		t.Fatalf("error decoding messages: %v", err)
+	}
+	if len(messages) != 1 || messages[0]["message_id"] != "m1" {
+		t.Fatalf("expected exported message, got %v", messages)
+	}
+}
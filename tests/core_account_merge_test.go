@@ -0,0 +1,94 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/heroiclabs/nakama/api"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeAccounts(t *testing.T) {
+	db := NewDB(t)
+	ctx := context.Background()
+	sessionCache := server.NewLocalSessionCache(logger, logger, config, db, nil, &server.LocalTracker{})
+
+	sourceUserID, _, _, err := server.AuthenticateCustom(ctx, logger, db, GenerateString(), GenerateString(), true)
+	if err != nil {
+		t.Fatalf("error creating user: %v", err)
+	}
+	targetUserID, _, _, err := server.AuthenticateCustom(ctx, logger, db, GenerateString(), GenerateString(), true)
+	if err != nil {
+		t.Fatalf("error creating user: %v", err)
+	}
+	sourceID, targetID := uuid.FromStringOrNil(sourceUserID), uuid.FromStringOrNil(targetUserID)
+
+	nk := server.NewRuntimeGoNakamaModule(logger, db, config, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	if err := nk.WalletUpdate(ctx, sourceUserID, map[string]interface{}{"coins": 10}, nil, true); err != nil {
+		t.Fatalf("error updating wallet: %v", err)
+	}
+	if err := nk.WalletUpdate(ctx, targetUserID, map[string]interface{}{"coins": 5}, nil, true); err != nil {
+		t.Fatalf("error updating wallet: %v", err)
+	}
+
+	key := GenerateString()
+	ops := server.StorageOpWrites{}
+	for _, ownerID := range []string{sourceUserID, targetUserID} {
+		ops = append(ops, &server.StorageOpWrite{
+			OwnerID: ownerID,
+			Object: &api.WriteStorageObject{
+				Collection:      "testcollection",
+				Key:             key,
+				Value:           "{\"owner\":\"" + ownerID + "\"}",
+				PermissionRead:  &wrappers.Int32Value{Value: 1},
+				PermissionWrite: &wrappers.Int32Value{Value: 1},
+			},
+		})
+	}
+	if _, _, err := server.StorageWriteObjects(ctx, logger, db, true, ops); err != nil {
+		t.Fatalf("error writing storage objects: %v", err)
+	}
+
+	options := &server.AccountMergeOptions{Storage: server.AccountMergeConflictKeepSource}
+	if err := server.MergeAccounts(ctx, logger, db, sessionCache, nil, nil, sourceID, targetID, options); err != nil {
+		t.Fatalf("error merging accounts: %v", err)
+	}
+
+	account, err := server.GetAccount(ctx, logger, db, nil, targetID)
+	if err != nil {
+		t.Fatalf("error getting account: %v", err)
+	}
+	var wallet map[string]interface{}
+	if err := json.Unmarshal([]byte(account.Wallet), &wallet); err != nil {
+		t.Fatalf("error decoding wallet: %v", err)
+	}
+	assert.EqualValues(t, 15, wallet["coins"], "wallets were not combined")
+
+	objects, err := server.StorageReadObjects(ctx, logger, db, targetID, []*api.ReadStorageObjectId{{Collection: "testcollection", Key: key, UserId: targetUserID}})
+	if err != nil {
+		t.Fatalf("error reading storage objects: %v", err)
+	}
+	assert.Len(t, objects.Objects, 1, "storage objects were not merged")
+	assert.Contains(t, objects.Objects[0].Value, sourceUserID, "source storage object was not kept")
+
+	_, err = server.GetAccount(ctx, logger, db, nil, sourceID)
+	assert.Equal(t, server.ErrAccountNotFound, err, "source account was not deleted")
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/server"
+)
+
+type fakeEmailSender struct {
+	messages []*server.EmailMessage
+}
+
+func (s *fakeEmailSender) Send(ctx context.Context, message *server.EmailMessage) error {
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+func TestEmailVerification(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+
+	email := uuid.Must(uuid.NewV4()).String() + "@example.com"
+	userID, _, _, err := server.AuthenticateEmail(context.Background(), logger, db, email, "password", uuid.Must(uuid.NewV4()).String(), true)
+	if err != nil {
+		t.Fatalf("error creating user: %v", err)
+	}
+
+	sender := &fakeEmailSender{}
+	if err := server.SendEmailVerification(context.Background(), logger, db, config, sender, uuid.FromStringOrNil(userID)); err != nil {
+		t.Fatalf("error sending email verification: %v", err)
+	}
+	if len(sender.messages) != 1 || sender.messages[0].Type != server.EmailMessageTypeVerify || sender.messages[0].Email != email {
+		t.Fatalf("expected one verification message, got %v", sender.messages)
+	}
+
+	token := sender.messages[0].Token
+	if err := server.VerifyEmail(context.Background(), logger, db, token); err != nil {
+		t.Fatalf("error verifying email: %v", err)
+	}
+	account, err := server.GetAccount(context.Background(), logger, db, nil, uuid.FromStringOrNil(userID))
+	if err != nil {
+		t.Fatalf("error getting account: %v", err)
+	}
+	if account.VerifyTime == nil {
+		t.Fatal("expected email to be verified")
+	}
+
+	if err := server.VerifyEmail(context.Background(), logger, db, token); err == nil {
+		t.Fatal("expected error reusing verification token")
+	}
+}
+
+func TestPasswordReset(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+
+	email := uuid.Must(uuid.NewV4()).String() + "@example.com"
+	username := uuid.Must(uuid.NewV4()).String()
+	if _, _, _, err := server.AuthenticateEmail(context.Background(), logger, db, email, "password", username, true); err != nil {
+		t.Fatalf("error creating user: %v", err)
+	}
+
+	sender := &fakeEmailSender{}
+	if err := server.SendPasswordReset(context.Background(), logger, db, config, sender, email); err != nil {
+		t.Fatalf("error sending password reset: %v", err)
+	}
+	if len(sender.messages) != 1 || sender.messages[0].Type != server.EmailMessageTypeReset {
+		t.Fatalf("expected one password reset message, got %v", sender.messages)
+	}
+
+	tracker := server.StartLocalTracker(logger, config, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	sessionCache := server.NewLocalSessionCache(logger, logger, config, db, nil, tracker)
+	if err := server.ResetPassword(context.Background(), logger, db, sessionCache, sender.messages[0].Token, "new-password"); err != nil {
+		t.Fatalf("error resetting password: %v", err)
+	}
+	if _, _, _, err := server.AuthenticateEmail(context.Background(), logger, db, email, "new-password", username, false); err != nil {
+		t.Fatalf("error authenticating with new password: %v", err)
+	}
+
+	// Unknown email addresses are not revealed.
+	if err := server.SendPasswordReset(context.Background(), logger, db, config, sender, "unknown-"+email); err != nil {
+		t.Fatalf("expected no error for unknown email, got %v", err)
+	}
+	if len(sender.messages) != 1 {
+		t.Fatalf("expected no message for unknown email, got %v", sender.messages)
+	}
+}
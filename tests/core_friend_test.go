@@ -0,0 +1,124 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListFriendsPaginated(t *testing.T) {
+	db := NewDB(t)
+	ctx := context.Background()
+	tracker := &server.LocalTracker{}
+
+	userID := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userID)
+	friendIDs := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		friendID := uuid.Must(uuid.NewV4())
+		InsertUser(t, db, friendID)
+		friendIDs = append(friendIDs, friendID.String())
+	}
+	if err := server.AddFriends(ctx, logger, db, &DummyMessageRouter{}, userID, userID.String(), friendIDs); err != nil {
+		t.Fatalf("error adding friends: %v", err)
+	}
+
+	page, err := server.ListFriends(ctx, logger, db, tracker, userID, 2, &wrappers.Int32Value{Value: 1}, "")
+	if err != nil {
+		t.Fatalf("error listing friends: %v", err)
+	}
+	assert.Len(t, page.Friends, 2)
+	assert.NotEmpty(t, page.Cursor)
+
+	next, err := server.ListFriends(ctx, logger, db, tracker, userID, 2, &wrappers.Int32Value{Value: 1}, page.Cursor)
+	if err != nil {
+		t.Fatalf("error listing friends: %v", err)
+	}
+	assert.Len(t, next.Friends, 1)
+	assert.Empty(t, next.Cursor)
+
+	_, err = server.ListFriends(ctx, logger, db, tracker, userID, 2, &wrappers.Int32Value{Value: 0}, page.Cursor)
+	assert.Equal(t, server.ErrFriendInvalidCursor, err, "cursor must not be reused with a different state")
+
+	friendID := uuid.FromStringOrNil(next.Friends[0].User.Id)
+	if err := server.UpdateFriendMetadata(ctx, logger, db, userID, friendID, `{"nickname":"foo"}`); err != nil {
+		t.Fatalf("error updating friend metadata: %v", err)
+	}
+	all, err := server.ListFriends(ctx, logger, db, tracker, userID, 0, nil, "")
+	if err != nil {
+		t.Fatalf("error listing friends: %v", err)
+	}
+	for _, f := range all.Friends {
+		if f.User.Id == friendID.String() {
+			assert.JSONEq(t, `{"nickname":"foo"}`, f.Metadata)
+		}
+	}
+
+	err = server.UpdateFriendMetadata(ctx, logger, db, userID, uuid.Must(uuid.NewV4()), `{}`)
+	assert.Equal(t, server.ErrFriendNotFound, err)
+}
+
+func TestListFriendSuggestions(t *testing.T) {
+	db := NewDB(t)
+	ctx := context.Background()
+	tracker := &server.LocalTracker{}
+	router := &DummyMessageRouter{}
+
+	// User A and user C are both friends with B, and C has blocked D.
+	ids := make([]uuid.UUID, 4)
+	for i := range ids {
+		ids[i] = uuid.Must(uuid.NewV4())
+		InsertUser(t, db, ids[i])
+	}
+	a, b, c, d := ids[0], ids[1], ids[2], ids[3]
+	for _, pair := range [][2]uuid.UUID{{a, b}, {c, b}} {
+		if err := server.AddFriends(ctx, logger, db, router, pair[0], pair[0].String(), []string{pair[1].String()}); err != nil {
+			t.Fatalf("error adding friend: %v", err)
+		}
+		if err := server.AddFriends(ctx, logger, db, router, pair[1], pair[1].String(), []string{pair[0].String()}); err != nil {
+			t.Fatalf("error accepting friend: %v", err)
+		}
+	}
+	if err := server.BlockFriends(ctx, logger, db, d, []string{a.String()}); err != nil {
+		t.Fatalf("error blocking user: %v", err)
+	}
+	if err := server.RecordMatchCoplay(ctx, logger, db, a, "match", []uuid.UUID{c, d}); err != nil {
+		t.Fatalf("error recording coplay: %v", err)
+	}
+
+	mutual, err := server.ListMutualFriends(ctx, logger, db, tracker, a, c, 100)
+	if err != nil {
+		t.Fatalf("error listing mutual friends: %v", err)
+	}
+	if assert.Len(t, mutual.Users, 1) {
+		assert.Equal(t, b.String(), mutual.Users[0].Id)
+	}
+
+	suggestions, err := server.ListFriendSuggestions(ctx, logger, db, tracker, a, 100)
+	if err != nil {
+		t.Fatalf("error listing friend suggestions: %v", err)
+	}
+	if assert.Len(t, suggestions.Suggestions, 1, "blocking users must not be suggested") {
+		assert.Equal(t, c.String(), suggestions.Suggestions[0].User.Id)
+		assert.EqualValues(t, 1, suggestions.Suggestions[0].MutualFriendCount)
+		assert.True(t, suggestions.Suggestions[0].PlayedRecently)
+	}
+}
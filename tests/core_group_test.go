@@ -0,0 +1,72 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeclineGroupJoinRequests(t *testing.T) {
+	db := NewDB(t)
+	ctx := context.Background()
+	tracker := &server.LocalTracker{}
+	router := &DummyMessageRouter{}
+
+	adminID := uuid.Must(uuid.NewV4())
+	requesterID := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, adminID)
+	InsertUser(t, db, requesterID)
+
+	group, err := server.CreateGroup(ctx, logger, db, adminID, adminID, GenerateString(), "en", "", "", "", false, 10)
+	if err != nil {
+		t.Fatalf("error creating group: %v", err)
+	}
+	groupID := uuid.FromStringOrNil(group.Id)
+	if err := server.JoinGroup(ctx, logger, db, router, groupID, requesterID, requesterID.String()); err != nil {
+		t.Fatalf("error requesting to join group: %v", err)
+	}
+
+	_, err = server.ListGroupJoinRequests(ctx, logger, db, tracker, requesterID, groupID, 100, "")
+	assert.Equal(t, server.ErrGroupPermissionDenied, err, "only admins may list join requests")
+
+	requests, err := server.ListGroupJoinRequests(ctx, logger, db, tracker, adminID, groupID, 100, "")
+	if err != nil {
+		t.Fatalf("error listing join requests: %v", err)
+	}
+	if assert.Len(t, requests.GroupUsers, 1) {
+		assert.Equal(t, requesterID.String(), requests.GroupUsers[0].User.Id)
+	}
+
+	if err := server.DeclineGroupJoinRequests(ctx, logger, db, router, adminID, groupID, []uuid.UUID{requesterID}); err != nil {
+		t.Fatalf("error declining join requests: %v", err)
+	}
+
+	requests, err = server.ListGroupJoinRequests(ctx, logger, db, tracker, adminID, groupID, 100, "")
+	if err != nil {
+		t.Fatalf("error listing join requests: %v", err)
+	}
+	assert.Len(t, requests.GroupUsers, 0)
+
+	var code int32
+	if err := db.QueryRowContext(ctx, "SELECT code FROM notification WHERE user_id = $1", requesterID).Scan(&code); err != nil {
+		t.Fatalf("error reading notification: %v", err)
+	}
+	assert.Equal(t, server.NotificationCodeGroupJoinDecline, code)
+}
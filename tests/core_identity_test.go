@@ -0,0 +1,81 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/heroiclabs/nakama/api"
+	"github.com/heroiclabs/nakama/server"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAccountIdentityActivity(t *testing.T) {
+	runtime, err := runtimeWithModules(t, map[string]string{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	apiserver, _ := NewAPIServer(t, runtime)
+	defer apiserver.Stop()
+
+	customID := GenerateString()
+	conn, client, session, ctx := NewAuthenticatedAPIClient(t, customID)
+	defer conn.Close()
+	userID, err := UserIDFromSession(session)
+	if err != nil {
+		t.Fatalf("error reading user ID: %v", err)
+	}
+
+	deviceID := GenerateString()
+	if _, err := client.LinkDevice(ctx, &api.AccountDevice{Id: deviceID}); err != nil {
+		t.Fatalf("error linking device: %v", err)
+	}
+	basicCtx := metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{
+		"authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte("defaultkey:")),
+	}))
+	if _, err := client.AuthenticateDevice(basicCtx, &api.AuthenticateDeviceRequest{Account: &api.AccountDevice{Id: deviceID}}); err != nil {
+		t.Fatalf("error authenticating with linked device: %v", err)
+	}
+
+	db := NewDB(t)
+	defer db.Close()
+	account, err := server.GetAccount(context.Background(), logger, db, nil, userID)
+	if err != nil {
+		t.Fatalf("error getting account: %v", err)
+	}
+
+	identities := make(map[string]*api.AccountIdentity, len(account.Identities))
+	for _, identity := range account.Identities {
+		identities[identity.Provider] = identity
+	}
+	for provider, id := range map[string]string{server.IdentityProviderCustom: customID, server.IdentityProviderDevice: deviceID} {
+		identity, ok := identities[provider]
+		if !ok {
+			t.Fatalf("expected %v identity, got %v", provider, account.Identities)
+		}
+		if identity.Id != id {
+			t.Fatalf("expected %v identity %v, got %v", provider, id, identity.Id)
+		}
+		if identity.LinkTime == nil || identity.LinkTime.Seconds == 0 {
+			t.Fatalf("expected %v identity link time, got %v", provider, identity.LinkTime)
+		}
+		if identity.LastAuthTime == nil || identity.LastAuthTime.Seconds < identity.LinkTime.Seconds {
+			t.Fatalf("expected %v identity last authentication time at or after link time %v, got %v", provider, identity.LinkTime, identity.LastAuthTime)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func signSessionToken(claims jwt.MapClaims) string {
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.GetSession().EncryptionKey))
+	return token
+}
+
+func TestSessionTokenRejectsOtherTokens(t *testing.T) {
+	db := NewDB(t)
+	tracker := server.StartLocalTracker(logger, config, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	sessionCache := server.NewLocalSessionCache(logger, logger, config, db, nil, tracker)
+	userID := uuid.Must(uuid.NewV4())
+	exp := time.Now().UTC().Add(time.Hour).Unix()
+
+	for name, claims := range map[string]jwt.MapClaims{
+		"match reconnect token": {"typ": "match_reconnect", "mid": uuid.Must(uuid.NewV4()).String() + ".node", "uid": userID.String(), "exp": exp},
+		"missing username":      {"uid": userID.String(), "exp": exp},
+		"non-string user ID":    {"uid": 1, "usn": "user", "exp": exp},
+		"missing expiry":        {"uid": userID.String(), "usn": "user"},
+	} {
+		err := server.SessionLogout(context.Background(), logger, db, config, sessionCache, userID, signSessionToken(claims), "")
+		assert.Equal(t, server.ErrSessionTokenInvalid, err, name)
+	}
+
+	token := signSessionToken(jwt.MapClaims{"tid": uuid.Must(uuid.NewV4()).String(), "uid": userID.String(), "usn": "user", "exp": exp, "iat": time.Now().UTC().Unix()})
+	assert.NoError(t, server.SessionLogout(context.Background(), logger, db, config, sessionCache, userID, token, ""))
+}
+
+func TestSessionCacheRevokeAllAllowsLaterTokens(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	tracker := server.StartLocalTracker(logger, config, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	sessionCache := server.NewLocalSessionCache(logger, logger, config, db, nil, tracker)
+	defer sessionCache.Stop()
+	userID := uuid.Must(uuid.NewV4())
+	exp := time.Now().UTC().Add(time.Duration(config.GetSession().TokenExpirySec) * time.Second).Unix()
+
+	issuedAt := time.Now().UTC().UnixNano() / int64(time.Millisecond)
+	time.Sleep(2 * time.Millisecond)
+	sessionCache.RevokeAll(userID)
+	time.Sleep(2 * time.Millisecond)
+	reissuedAt := time.Now().UTC().UnixNano() / int64(time.Millisecond)
+
+	assert.True(t, sessionCache.IsRevoked(context.Background(), userID, uuid.Must(uuid.NewV4()).String(), issuedAt, exp))
+	assert.False(t, sessionCache.IsRevoked(context.Background(), userID, uuid.Must(uuid.NewV4()).String(), reissuedAt, exp), "token issued within the same second after revocation")
+
+	// Revocations are stored, so a node started later also rejects the earlier tokens.
+	restartedCache := server.NewLocalSessionCache(logger, logger, config, db, nil, tracker)
+	defer restartedCache.Stop()
+	assert.True(t, restartedCache.IsRevoked(context.Background(), userID, uuid.Must(uuid.NewV4()).String(), issuedAt, exp))
+	assert.False(t, restartedCache.IsRevoked(context.Background(), userID, uuid.Must(uuid.NewV4()).String(), reissuedAt, exp))
+
+	// Tokens with a longer lifetime are checked against the stored revocations.
+	longExp := time.Now().UTC().Add(24 * time.Hour).Unix()
+	assert.True(t, restartedCache.IsRevoked(context.Background(), userID, uuid.Must(uuid.NewV4()).String(), issuedAt, longExp))
+	assert.True(t, restartedCache.IsRevoked(context.Background(), userID, "", 0, longExp), "legacy token")
+	assert.False(t, restartedCache.IsRevoked(context.Background(), userID, uuid.Must(uuid.NewV4()).String(), reissuedAt, longExp))
+}
+
+func createRefreshTestUser(t *testing.T, db *sql.DB) (string, string) {
+	userID, username, _, err := server.AuthenticateCustom(context.Background(), logger, db, uuid.Must(uuid.NewV4()).String(), uuid.Must(uuid.NewV4()).String(), true)
+	if err != nil {
+		t.Fatalf("error creating user: %v", err)
+	}
+	return userID, username
+}
+
+func TestSessionRefreshTokenSingleUse(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	userID, username := createRefreshTestUser(t, db)
+
+	refreshToken, err := server.SessionRefreshTokenCreate(context.Background(), logger, db, config, userID, username)
+	if err != nil {
+		t.Fatalf("error creating refresh token: %v", err)
+	}
+
+	refreshedUserID, refreshedUsername, nextRefreshToken, err := server.SessionRefresh(context.Background(), logger, db, config, refreshToken)
+	assert.NoError(t, err)
+	assert.Equal(t, userID, refreshedUserID)
+	assert.Equal(t, username, refreshedUsername)
+	assert.NotEmpty(t, nextRefreshToken)
+
+	_, _, _, err = server.SessionRefresh(context.Background(), logger, db, config, refreshToken)
+	assert.Error(t, err, "refresh token used twice")
+	_, _, _, err = server.SessionRefresh(context.Background(), logger, db, config, nextRefreshToken)
+	assert.NoError(t, err, "replacement refresh token")
+}
+
+func TestSessionRefreshTokenSeparateKeys(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	tracker := server.StartLocalTracker(logger, config, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	sessionCache := server.NewLocalSessionCache(logger, logger, config, db, nil, tracker)
+	defer sessionCache.Stop()
+	userID, username := createRefreshTestUser(t, db)
+	uid := uuid.FromStringOrNil(userID)
+
+	refreshToken, err := server.SessionRefreshTokenCreate(context.Background(), logger, db, config, userID, username)
+	if err != nil {
+		t.Fatalf("error creating refresh token: %v", err)
+	}
+	sessionToken := signSessionToken(jwt.MapClaims{"tid": uuid.Must(uuid.NewV4()).String(), "uid": userID, "usn": username, "exp": time.Now().UTC().Add(time.Hour).Unix(), "iat": time.Now().UTC().Unix()})
+
+	assert.Equal(t, server.ErrSessionTokenInvalid, server.SessionLogout(context.Background(), logger, db, config, sessionCache, uid, refreshToken, ""), "refresh token as session token")
+	assert.Equal(t, server.ErrRefreshTokenInvalid, server.SessionLogout(context.Background(), logger, db, config, sessionCache, uid, "", sessionToken), "session token as refresh token")
+	_, _, _, err = server.SessionRefresh(context.Background(), logger, db, config, sessionToken)
+	assert.Error(t, err, "session token refresh")
+
+	// The refresh token was not affected by any of the above.
+	_, _, _, err = server.SessionRefresh(context.Background(), logger, db, config, refreshToken)
+	assert.NoError(t, err)
+}
+
+func TestSessionRefreshTokenExpired(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	userID, username := createRefreshTestUser(t, db)
+
+	cfg := server.NewConfig(logger)
+	cfg.GetSession().RefreshTokenExpirySec = -1
+	refreshToken, err := server.SessionRefreshTokenCreate(context.Background(), logger, db, cfg, userID, username)
+	if err != nil {
+		t.Fatalf("error creating refresh token: %v", err)
+	}
+
+	_, _, _, err = server.SessionRefresh(context.Background(), logger, db, cfg, refreshToken)
+	assert.Error(t, err)
+}
+
+func TestSessionRefreshTokenBannedUser(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	tracker := server.StartLocalTracker(logger, config, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	sessionCache := server.NewLocalSessionCache(logger, logger, config, db, nil, tracker)
+	defer sessionCache.Stop()
+	userID, username := createRefreshTestUser(t, db)
+
+	refreshToken, err := server.SessionRefreshTokenCreate(context.Background(), logger, db, config, userID, username)
+	if err != nil {
+		t.Fatalf("error creating refresh token: %v", err)
+	}
+	if err := server.BanUsers(context.Background(), logger, db, sessionCache, []string{userID}); err != nil {
+		t.Fatalf("error banning user: %v", err)
+	}
+
+	_, _, _, err = server.SessionRefresh(context.Background(), logger, db, config, refreshToken)
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/runtime"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// Holds places for a second after a disconnect, and reports the leaves and reconnects it has seen to any signal.
+type reconnectTestMatch struct {
+	replayTestMatch
+	leaves     int
+	reconnects int
+}
+
+func (m *reconnectTestMatch) MatchLeave(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	m.leaves += len(presences)
+	return state
+}
+
+func (m *reconnectTestMatch) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	return state, fmt.Sprintf("%v %v", m.leaves, m.reconnects)
+}
+
+func (m *reconnectTestMatch) MatchDisconnect(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) (interface{}, int) {
+	return state, 1
+}
+
+func (m *reconnectTestMatch) MatchReconnect(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	m.reconnects += len(presences)
+	return state
+}
+
+func TestMatchReconnect(t *testing.T) {
+	cfg := server.NewConfig(logger)
+	tracker := server.StartLocalTracker(logger, cfg, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	router := &DummyMessageRouter{}
+	registry := server.NewLocalMatchRegistry(logger, logger, cfg, nil, tracker, router, "node")
+
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (server.RuntimeMatchCore, error) {
+		return server.NewRuntimeGoMatchCore(logger, registry, router, id, node, nil, nil, nil, &reconnectTestMatch{})
+	}
+	matchIDStr, err := registry.CreateMatch(context.Background(), logger, createFn, "reconnect", nil)
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	matchID := uuid.FromStringOrNil(strings.SplitN(matchIDStr, ".", 2)[0])
+	// Signals are processed in order with joins and leaves, so also confirm those have been handled.
+	counts := func() string {
+		result, err := registry.Signal(context.Background(), matchID, "node", "")
+		if err != nil {
+			t.Fatalf("error signalling match: %v", err)
+		}
+		return result
+	}
+
+	presence := &server.MatchPresence{Node: "node", UserID: uuid.Must(uuid.NewV4()), SessionID: uuid.Must(uuid.NewV4()), Username: "player"}
+	_, allow, _, _ := registry.JoinAttempt(context.Background(), matchID, "node", presence.UserID, presence.SessionID, presence.Username, "node", nil, false)
+	assert.True(t, allow)
+
+	// A disconnect holds the place rather than leaving, until the user reconnects from a new session.
+	presence.Disconnected = true
+	registry.Leave(matchID, []*server.MatchPresence{presence})
+	assert.Equal(t, "0 0", counts())
+	mh := registry.GetMatch(matchID)
+	assert.Equal(t, 1, mh.PresenceList.Size())
+
+	_, allow, _, _ = registry.Reconnect(context.Background(), matchID, "node", uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()), "other", "node")
+	assert.False(t, allow, "only the disconnected user can reconnect")
+	sessionID := uuid.Must(uuid.NewV4())
+	_, allow, _, _ = registry.Reconnect(context.Background(), matchID, "node", presence.UserID, sessionID, presence.Username, "node")
+	assert.True(t, allow)
+	assert.Equal(t, "0 1", counts())
+	assert.Equal(t, 1, mh.PresenceList.Size())
+	assert.True(t, mh.PresenceList.Contains(&server.PresenceID{Node: "node", SessionID: sessionID}))
+
+	// Users who don't reconnect in time leave the match.
+	presence.SessionID = sessionID
+	registry.Leave(matchID, []*server.MatchPresence{presence})
+	time.Sleep(1500 * time.Millisecond)
+	assert.Equal(t, "1 1", counts())
+	assert.Equal(t, 0, mh.PresenceList.Size())
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/runtime"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// Sums the size of all data it receives, and broadcasts the running total to all participants each tick.
+type replayTestMatch struct {
+	signalTestMatch
+}
+
+type replayTestState struct {
+	total int
+}
+
+func (m *replayTestMatch) MatchInit(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, params map[string]interface{}) (interface{}, int, string) {
+	return &replayTestState{}, 30, ""
+}
+
+func (m *replayTestMatch) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
+	s := state.(*replayTestState)
+	for _, message := range messages {
+		s.total += len(message.GetData())
+	}
+	if len(messages) != 0 {
+		dispatcher.BroadcastMessage(1, []byte(strconv.Itoa(s.total)), nil, nil)
+	}
+	return s
+}
+
+func TestMatchReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nakama_match_replay_test")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := server.NewConfig(logger)
+	cfg.Datadir = dir
+	cfg.Match.ReplayRecording = true
+	tracker := server.StartLocalTracker(logger, cfg, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	router := &DummyMessageRouter{}
+	registry := server.NewLocalMatchRegistry(logger, logger, cfg, nil, tracker, router, "node")
+
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (server.RuntimeMatchCore, error) {
+		return server.NewRuntimeGoMatchCore(logger, registry, router, id, node, nil, nil, nil, &replayTestMatch{})
+	}
+	matchIDStr, err := registry.CreateMatch(context.Background(), logger, createFn, "replay", nil)
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	matchID := uuid.FromStringOrNil(strings.SplitN(matchIDStr, ".", 2)[0])
+
+	userID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+	found, allow, _, _ := registry.JoinAttempt(context.Background(), matchID, "node", userID, sessionID, "user", "node", nil, false)
+	assert.True(t, found)
+	assert.True(t, allow)
+
+	for i := 1; i <= 3; i++ {
+		registry.SendData(matchID, "node", userID, sessionID, "user", "node", 1, make([]byte, i), true, time.Now().UTC().UnixNano()/int64(time.Millisecond))
+		time.Sleep(100 * time.Millisecond)
+	}
+	// Stopping from inside the match ensures everything it did has been recorded.
+	if _, err := registry.Signal(context.Background(), matchID, "node", "stop"); err != server.ErrMatchNotFound {
+		t.Fatalf("expected match to stop, got: %v", err)
+	}
+
+	recordings, err := server.ListMatchRecordings(logger, server.MatchRecordingDir(cfg))
+	if err != nil {
+		t.Fatalf("error listing recordings: %v", err)
+	}
+	if !assert.Len(t, recordings, 1) {
+		return
+	}
+	assert.Equal(t, matchIDStr, recordings[0].MatchID)
+	assert.Equal(t, "replay", recordings[0].Module)
+
+	result, err := registry.Replay(context.Background(), createFn, recordings[0].ID)
+	if err != nil {
+		t.Fatalf("error replaying match: %v", err)
+	}
+	assert.Equal(t, 3, result.RecordedBroadcasts)
+	assert.Equal(t, 3, result.ReplayedBroadcasts)
+	assert.Equal(t, int64(-1), result.DivergedTick)
+
+	_, err = registry.Replay(context.Background(), createFn, "../"+recordings[0].ID)
+	assert.Equal(t, server.ErrMatchRecordingNotFound, err)
+}
@@ -0,0 +1,274 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/runtime"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// Echoes the data of each signal back as its result, and stops when signalled "stop".
+type signalTestMatch struct{}
+
+func (m *signalTestMatch) MatchInit(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, params map[string]interface{}) (interface{}, int, string) {
+	return struct{}{}, 30, ""
+}
+
+func (m *signalTestMatch) MatchJoinAttempt(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presence runtime.Presence, metadata map[string]string) (interface{}, bool, string) {
+	return state, true, ""
+}
+
+func (m *signalTestMatch) MatchJoin(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	return state
+}
+
+func (m *signalTestMatch) MatchLeave(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	return state
+}
+
+func (m *signalTestMatch) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
+	return state
+}
+
+func (m *signalTestMatch) MatchTerminate(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{} {
+	return state
+}
+
+func (m *signalTestMatch) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	if data == "stop" {
+		return nil, ""
+	}
+	return state, data
+}
+
+// Blocks inside any "block" signal until released, to keep the match busy.
+type blockingSignalTestMatch struct {
+	signalTestMatch
+	blocked chan struct{}
+	release chan struct{}
+}
+
+func (m *blockingSignalTestMatch) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	if data == "block" {
+		m.blocked <- struct{}{}
+		<-m.release
+	}
+	return state, data
+}
+
+// Exposes only the required match functions of the match it wraps.
+type plainTestMatch struct {
+	runtime.Match
+}
+
+func createSignalTestMatch(t *testing.T, registry server.MatchRegistry, createFn server.RuntimeMatchCreateFunction, name string) uuid.UUID {
+	matchIDStr, err := registry.CreateMatch(context.Background(), logger, createFn, name, nil)
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	return uuid.FromStringOrNil(strings.SplitN(matchIDStr, ".", 2)[0])
+}
+
+func TestMatchSignal(t *testing.T) {
+	cfg := server.NewConfig(logger)
+	tracker := server.StartLocalTracker(logger, cfg, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	router := &DummyMessageRouter{}
+	registry := server.NewLocalMatchRegistry(logger, logger, cfg, nil, tracker, router, "node")
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (server.RuntimeMatchCore, error) {
+		return server.NewRuntimeGoMatchCore(logger, registry, router, id, node, nil, nil, nil, &signalTestMatch{})
+	}
+	matchID := createSignalTestMatch(t, registry, createFn, "signal")
+
+	result, err := registry.Signal(context.Background(), matchID, "node", "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", result)
+
+	_, err = registry.Signal(context.Background(), uuid.Must(uuid.NewV4()), "node", "hello")
+	assert.Equal(t, server.ErrMatchNotFound, err, "unknown match")
+	_, err = registry.Signal(context.Background(), matchID, "other", "hello")
+	assert.Equal(t, server.ErrMatchNotFound, err, "match on another node")
+
+	// A nil state stops the match, so neither that signal nor any later one finds it.
+	_, err = registry.Signal(context.Background(), matchID, "node", "stop")
+	assert.Equal(t, server.ErrMatchNotFound, err)
+	waitFor(t, "match to stop", func() bool { return registry.GetMatch(matchID) == nil })
+	_, err = registry.Signal(context.Background(), matchID, "node", "hello")
+	assert.Equal(t, server.ErrMatchNotFound, err)
+}
+
+func TestMatchSignalIgnored(t *testing.T) {
+	cfg := server.NewConfig(logger)
+	tracker := server.StartLocalTracker(logger, cfg, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	router := &DummyMessageRouter{}
+	registry := server.NewLocalMatchRegistry(logger, logger, cfg, nil, tracker, router, "node")
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (server.RuntimeMatchCore, error) {
+		return server.NewRuntimeGoMatchCore(logger, registry, router, id, node, nil, nil, nil, &plainTestMatch{&signalTestMatch{}})
+	}
+	matchID := createSignalTestMatch(t, registry, createFn, "ignored")
+
+	// Matches that do not implement MatchSignal keep running with their state unchanged.
+	result, err := registry.Signal(context.Background(), matchID, "node", "stop")
+	assert.NoError(t, err)
+	assert.Equal(t, "", result)
+	assert.NotNil(t, registry.GetMatch(matchID))
+}
+
+func TestMatchSignalBusy(t *testing.T) {
+	cfg := server.NewConfig(logger)
+	cfg.GetMatch().CallQueueSize = 1
+	tracker := server.StartLocalTracker(logger, cfg, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	router := &DummyMessageRouter{}
+	registry := server.NewLocalMatchRegistry(logger, logger, cfg, nil, tracker, router, "node")
+	matches := map[string]*blockingSignalTestMatch{
+		"timeout": {blocked: make(chan struct{}, 1), release: make(chan struct{})},
+		"full":    {blocked: make(chan struct{}, 1), release: make(chan struct{})},
+	}
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (server.RuntimeMatchCore, error) {
+		return server.NewRuntimeGoMatchCore(logger, registry, router, id, node, nil, nil, nil, matches[name])
+	}
+	block := func(matchID uuid.UUID, match *blockingSignalTestMatch) {
+		go registry.Signal(context.Background(), matchID, "node", "block")
+		<-match.blocked
+	}
+
+	// A signal left waiting in the call queue while the match is busy gives up after the timeout.
+	timeoutMatchID := createSignalTestMatch(t, registry, createFn, "timeout")
+	block(timeoutMatchID, matches["timeout"])
+	var timeoutErr error
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, timeoutErr = registry.Signal(context.Background(), timeoutMatchID, "node", "queued")
+	}()
+
+	// A signal that finds the call queue full is rejected straight away.
+	fullMatchID := createSignalTestMatch(t, registry, createFn, "full")
+	block(fullMatchID, matches["full"])
+	assert.True(t, registry.GetMatch(fullMatchID).QueueSignal(context.Background(), make(chan *server.MatchSignalResult, 1), "queued"))
+	_, err := registry.Signal(context.Background(), fullMatchID, "node", "rejected")
+	assert.Equal(t, server.ErrMatchBusy, err, "full call queue")
+
+	wg.Wait()
+	assert.Equal(t, server.ErrMatchBusy, timeoutErr, "timed out signal")
+	for _, match := range matches {
+		close(match.release)
+	}
+}
+
+func TestMatchSignalLua(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nakama_match_signal_test")
+	if err != nil {
+		t.Fatalf("error creating modules dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	callbacks := `
+local function match_init(context, params) return {}, 1, "" end
+local function match_join_attempt(context, dispatcher, tick, state, presence, metadata) return state, true end
+local function match_join(context, dispatcher, tick, state, presences) return state end
+local function match_leave(context, dispatcher, tick, state, presences) return state end
+local function match_loop(context, dispatcher, tick, state, messages) return state end
+local function match_terminate(context, dispatcher, tick, state, grace_seconds) return state end
+`
+	modules := map[string]string{
+		"signal_result": callbacks + `
+local function match_signal(context, dispatcher, tick, state, data) return state, "lua " .. data end
+return {match_init = match_init, match_join_attempt = match_join_attempt, match_join = match_join, match_leave = match_leave, match_loop = match_loop, match_terminate = match_terminate, match_signal = match_signal}`,
+		"signal_absent": callbacks + `
+return {match_init = match_init, match_join_attempt = match_join_attempt, match_join = match_join, match_leave = match_leave, match_loop = match_loop, match_terminate = match_terminate}`,
+		"signal_number": callbacks + `
+local function match_signal(context, dispatcher, tick, state, data) return state, 1 end
+return {match_init = match_init, match_join_attempt = match_join_attempt, match_join = match_join, match_leave = match_leave, match_loop = match_loop, match_terminate = match_terminate, match_signal = match_signal}`,
+	}
+	for name, module := range modules {
+		if err := ioutil.WriteFile(filepath.Join(dir, name+".lua"), []byte(module), 0644); err != nil {
+			t.Fatalf("error writing module: %v", err)
+		}
+	}
+
+	cfg := server.NewConfig(logger)
+	cfg.Runtime.Path = dir
+	tracker := server.StartLocalTracker(logger, cfg, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	router := &DummyMessageRouter{}
+	registry := server.NewLocalMatchRegistry(logger, logger, cfg, nil, tracker, router, "node")
+	rt, err := server.NewRuntime(logger, logger, nil, jsonpbMarshaler, jsonpbUnmarshaler, cfg, nil, nil, nil, nil, nil, nil, registry, nil, tracker, nil, router)
+	if err != nil {
+		t.Fatalf("error creating runtime: %v", err)
+	}
+
+	matchID := createSignalTestMatch(t, registry, rt.MatchCreateFunction(), "signal_result")
+	result, err := registry.Signal(context.Background(), matchID, "node", "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "lua hello", result)
+
+	// Matches without match_signal ignore signals and keep running.
+	matchID = createSignalTestMatch(t, registry, rt.MatchCreateFunction(), "signal_absent")
+	result, err = registry.Signal(context.Background(), matchID, "node", "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "", result)
+	assert.NotNil(t, registry.GetMatch(matchID))
+
+	// A result that is not a string is an error that stops the match.
+	matchID = createSignalTestMatch(t, registry, rt.MatchCreateFunction(), "signal_number")
+	_, err = registry.Signal(context.Background(), matchID, "node", "hello")
+	assert.Equal(t, server.ErrMatchNotFound, err)
+	waitFor(t, "match to stop", func() bool { return registry.GetMatch(matchID) == nil })
+}
+
+func TestMatchSignalCluster(t *testing.T) {
+	nodeA := newClusterTestNode(t, "a")
+	defer nodeA.stop()
+	nodeB := newClusterTestNode(t, "b", nodeA.address)
+	defer nodeB.stop()
+	registryA := server.NewClusterMatchRegistry(logger, logger, nodeA.config, nil, nodeA.tracker, nodeA.router, nodeA.cluster)
+	registryB := server.NewClusterMatchRegistry(logger, logger, nodeB.config, nil, nodeB.tracker, nodeB.router, nodeB.cluster)
+	waitFor(t, "nodes to join", func() bool { return len(nodeB.cluster.Members()) == 1 })
+
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (server.RuntimeMatchCore, error) {
+		return server.NewRuntimeGoMatchCore(logger, registryA, nodeA.router, id, node, nil, nil, nil, &signalTestMatch{})
+	}
+	matchID := createSignalTestMatch(t, registryA, createFn, "signal")
+
+	// Signals for matches hosted on other nodes are forwarded to them.
+	result, err := registryB.Signal(context.Background(), matchID, "a", "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", result)
+	_, err = registryB.Signal(context.Background(), uuid.Must(uuid.NewV4()), "a", "hello")
+	assert.Equal(t, server.ErrMatchNotFound, err, "unknown match")
+	_, err = registryB.Signal(context.Background(), matchID, "c", "hello")
+	assert.Equal(t, server.ErrMatchNotFound, err, "unknown node")
+
+	_, err = registryB.Signal(context.Background(), matchID, "a", "stop")
+	assert.Equal(t, server.ErrMatchNotFound, err)
+	waitFor(t, "match to stop", func() bool { return registryA.GetMatch(matchID) == nil })
+	_, err = registryB.Signal(context.Background(), matchID, "a", "hello")
+	assert.Equal(t, server.ErrMatchNotFound, err)
+}
@@ -0,0 +1,96 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/runtime"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// Keeps a string as its state, returns it from any signal, and remembers the tick it was restored at.
+type snapshotTestMatch struct {
+	signalTestMatch
+	restoredTick int64
+}
+
+func (m *snapshotTestMatch) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	return state, state.(string)
+}
+
+func (m *snapshotTestMatch) MatchSnapshot(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}) string {
+	return state.(string)
+}
+
+func (m *snapshotTestMatch) MatchRestore(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, tick int64, snapshot string) (interface{}, int, string) {
+	m.restoredTick = tick
+	return snapshot, 30, ""
+}
+
+func TestMatchRestoreFromSnapshot(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	cfg := server.NewConfig(logger)
+	tracker := server.StartLocalTracker(logger, cfg, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	router := &DummyMessageRouter{}
+	registry := server.NewLocalMatchRegistry(logger, logger, cfg, db, tracker, router, "node")
+
+	matchID := uuid.Must(uuid.NewV4())
+	missingMatchID := uuid.Must(uuid.NewV4())
+	for id, module := range map[uuid.UUID]string{matchID: "snapshot", missingMatchID: "missing"} {
+		if err := server.StoreMatchSnapshot(context.Background(), logger, db, &server.MatchSnapshot{ID: id, Node: "previous", Module: module, Tick: 1234, State: []byte("saved state")}); err != nil {
+			t.Fatalf("error storing match snapshot: %v", err)
+		}
+	}
+	defer db.Exec("DELETE FROM match_snapshot WHERE id = $1", missingMatchID)
+
+	match := &snapshotTestMatch{}
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (server.RuntimeMatchCore, error) {
+		if name != "snapshot" {
+			// Module no longer registered.
+			return nil, nil
+		}
+		return server.NewRuntimeGoMatchCore(logger, registry, router, id, node, nil, nil, nil, match)
+	}
+	if err := registry.RestoreMatches(context.Background(), createFn); err != nil {
+		t.Fatalf("error restoring matches: %v", err)
+	}
+
+	// The match runs again with the same ID, tick and state.
+	assert.NotNil(t, registry.GetMatch(matchID))
+	assert.EqualValues(t, 1234, match.restoredTick)
+	state, err := registry.Signal(context.Background(), matchID, "node", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "saved state", state)
+
+	// Restored snapshots are removed, and those that could not be restored are kept for a later attempt.
+	var count int
+	if err := db.QueryRow("SELECT count(*) FROM match_snapshot WHERE id = $1", matchID).Scan(&count); err != nil {
+		t.Fatalf("error counting match snapshots: %v", err)
+	}
+	assert.Equal(t, 0, count, "restored snapshot")
+	if err := db.QueryRow("SELECT count(*) FROM match_snapshot WHERE id = $1", missingMatchID).Scan(&count); err != nil {
+		t.Fatalf("error counting match snapshots: %v", err)
+	}
+	assert.Equal(t, 1, count, "snapshot that failed to restore")
+	assert.Nil(t, registry.GetMatch(missingMatchID))
+}
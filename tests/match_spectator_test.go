@@ -0,0 +1,119 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
+	"github.com/heroiclabs/nakama/runtime"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// Allows spectators, and broadcasts to the audience named in each signal it receives.
+type spectatorTestMatch struct {
+	replayTestMatch
+}
+
+func (m *spectatorTestMatch) MatchSpectatorJoinAttempt(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presence runtime.Presence, metadata map[string]string) (interface{}, bool, string) {
+	return state, true, ""
+}
+
+func (m *spectatorTestMatch) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	audiences := map[string]runtime.MatchAudience{
+		"all":        runtime.MatchAudienceAll,
+		"players":    runtime.MatchAudiencePlayers,
+		"spectators": runtime.MatchAudienceSpectators,
+	}
+	dispatcher.BroadcastMessageToAudience(audiences[data], 1, []byte(data), nil)
+	return state, ""
+}
+
+func TestMatchSpectator(t *testing.T) {
+	cfg := server.NewConfig(logger)
+	tracker := server.StartLocalTracker(logger, cfg, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	defer tracker.Stop()
+	router := &DummyMessageRouter{}
+	registry := server.NewLocalMatchRegistry(logger, logger, cfg, nil, tracker, router, "node")
+
+	// Capture who each broadcast goes to instead of delivering it.
+	var recipients []*server.PresenceID
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, name string) (server.RuntimeMatchCore, error) {
+		var match runtime.Match = &spectatorTestMatch{}
+		if name == "players" {
+			match = &replayTestMatch{}
+		}
+		core, err := server.NewRuntimeGoMatchCore(logger, registry, router, id, node, nil, nil, nil, match)
+		if err != nil {
+			return nil, err
+		}
+		core.SetBroadcastListener(func(presenceIDs []*server.PresenceID, envelope *rtapi.Envelope, reliable bool) bool {
+			recipients = presenceIDs
+			return false
+		})
+		return core, nil
+	}
+	matchIDStr, err := registry.CreateMatch(context.Background(), logger, createFn, "spectators", nil)
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	matchID := uuid.FromStringOrNil(strings.SplitN(matchIDStr, ".", 2)[0])
+
+	playerSessionID := uuid.Must(uuid.NewV4())
+	found, allow, _, _ := registry.JoinAttempt(context.Background(), matchID, "node", uuid.Must(uuid.NewV4()), playerSessionID, "player", "node", nil, false)
+	assert.True(t, found)
+	assert.True(t, allow)
+	spectatorSessionID := uuid.Must(uuid.NewV4())
+	found, allow, _, _ = registry.JoinAttempt(context.Background(), matchID, "node", uuid.Must(uuid.NewV4()), spectatorSessionID, "spectator", "node", nil, true)
+	assert.True(t, found)
+	assert.True(t, allow)
+
+	for audience, expected := range map[string][]uuid.UUID{
+		"all":        {playerSessionID, spectatorSessionID},
+		"players":    {playerSessionID},
+		"spectators": {spectatorSessionID},
+	} {
+		recipients = nil
+		if _, err := registry.Signal(context.Background(), matchID, "node", audience); err != nil {
+			t.Fatalf("error signalling match: %v", err)
+		}
+		sessionIDs := make([]uuid.UUID, 0, len(recipients))
+		for _, recipient := range recipients {
+			sessionIDs = append(sessionIDs, recipient.SessionID)
+		}
+		assert.Equal(t, expected, sessionIDs, audience)
+	}
+
+	// Spectators never count towards the match size.
+	mh := registry.GetMatch(matchID)
+	assert.Equal(t, 1, mh.PresenceList.Size())
+	assert.Len(t, mh.PresenceList.ListSpectators(), 1)
+
+	// Matches that don't opt in to spectators reject them.
+	matchIDStr, err = registry.CreateMatch(context.Background(), logger, createFn, "players", nil)
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	matchID = uuid.FromStringOrNil(strings.SplitN(matchIDStr, ".", 2)[0])
+	found, allow, _, _ = registry.JoinAttempt(context.Background(), matchID, "node", uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()), "spectator", "node", nil, true)
+	assert.True(t, found)
+	assert.False(t, allow)
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type capturingMessageRouter struct {
+	sync.Mutex
+	DummyMessageRouter
+	envelopes []*rtapi.Envelope
+}
+
+func (r *capturingMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs []*server.PresenceID, isStream bool, mode uint8, envelope *rtapi.Envelope, reliable bool) {
+	r.Lock()
+	r.envelopes = append(r.envelopes, envelope)
+	r.Unlock()
+}
+
+func newTestMatchmaker(t *testing.T) (*server.LocalMatchmaker, *capturingMessageRouter) {
+	router := &capturingMessageRouter{}
+	matchmaker := server.NewLocalMatchmaker(logger, logger, config, router).(*server.LocalMatchmaker)
+	return matchmaker, router
+}
+
+func addTestTicket(matchmaker server.Matchmaker, query string, minCount int, maxCount int, stringProperties map[string]string, numericProperties map[string]float64, numericRanges map[string]*server.MatchmakerRange, relaxations []*server.MatchmakerRelaxation) (string, error) {
+	sessionID := uuid.Must(uuid.NewV4())
+	presences := []*server.MatchmakerPresence{&server.MatchmakerPresence{
+		UserId:    uuid.Must(uuid.NewV4()).String(),
+		SessionId: sessionID.String(),
+		Username:  "username",
+		Node:      "node1",
+	}}
+	return matchmaker.Add(presences, sessionID, "", query, minCount, maxCount, stringProperties, numericProperties, numericRanges, relaxations)
+}
+
+func TestMatchmakerMutualMatch(t *testing.T) {
+	matchmaker, router := newTestMatchmaker(t)
+
+	if _, err := addTestTicket(matchmaker, "+properties.region:europe", 2, 2, map[string]string{"region": "europe"}, nil, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+	if _, err := addTestTicket(matchmaker, "+properties.region:europe", 2, 2, map[string]string{"region": "europe"}, nil, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+
+	// Nothing is matched until the matchmaker processes its tickets.
+	assert.Len(t, router.envelopes, 0)
+
+	matchmaker.Process()
+
+	assert.Len(t, router.envelopes, 2)
+	for _, envelope := range router.envelopes {
+		assert.Len(t, envelope.GetMatchmakerMatched().Users, 2)
+		assert.NotEmpty(t, envelope.GetMatchmakerMatched().GetToken())
+	}
+}
+
+func TestMatchmakerRejectsOneSidedMatch(t *testing.T) {
+	matchmaker, router := newTestMatchmaker(t)
+
+	// The first ticket accepts anyone, but the second ticket does not accept the first.
+	if _, err := addTestTicket(matchmaker, "*", 2, 2, nil, map[string]float64{"skill": 10}, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+	if _, err := addTestTicket(matchmaker, "+properties.skill:>=50", 2, 2, nil, map[string]float64{"skill": 60}, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+
+	matchmaker.Process()
+
+	assert.Len(t, router.envelopes, 0)
+}
+
+func TestMatchmakerListTickets(t *testing.T) {
+	matchmaker, _ := newTestMatchmaker(t)
+
+	first, err := addTestTicket(matchmaker, "*", 3, 3, map[string]string{"mode": "ranked"}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+	// Ensure the tickets have distinct creation times, so list order is predictable.
+	time.Sleep(5 * time.Millisecond)
+	if _, err := addTestTicket(matchmaker, "*", 3, 3, nil, nil, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+
+	matchmaker.Process()
+
+	assert.Equal(t, 2, matchmaker.Count())
+	assert.Len(t, matchmaker.List(0), 2)
+
+	entries := matchmaker.List(1)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, first, entries[0].Ticket)
+	assert.Equal(t, "ranked", entries[0].StringProperties["mode"])
+	assert.Len(t, entries[0].Presences, 1)
+	// Runtimes see the creation time in seconds.
+	assert.InDelta(t, time.Now().Unix(), entries[0].GetCreateTime(), 1)
+}
+
+func TestMatchmakerInvalidQuery(t *testing.T) {
+	matchmaker, _ := newTestMatchmaker(t)
+
+	_, err := addTestTicket(matchmaker, "+properties.skill:>=", 2, 2, nil, nil, nil, nil)
+	assert.Equal(t, server.ErrMatchmakerQueryInvalid, err)
+}
+
+func TestMatchmakerRelaxationWidensRange(t *testing.T) {
+	matchmaker, router := newTestMatchmaker(t)
+
+	// Each ticket only accepts opponents within 5 skill, widened by 20 after waiting 1 second.
+	relaxations := func() []*server.MatchmakerRelaxation {
+		return []*server.MatchmakerRelaxation{{WaitSec: 1, WidenRanges: map[string]float64{"skill": 20}}}
+	}
+	if _, err := addTestTicket(matchmaker, "*", 2, 2, nil, map[string]float64{"skill": 10}, map[string]*server.MatchmakerRange{"skill": {Min: 5, Max: 15}}, relaxations()); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+	if _, err := addTestTicket(matchmaker, "*", 2, 2, nil, map[string]float64{"skill": 30}, map[string]*server.MatchmakerRange{"skill": {Min: 25, Max: 35}}, relaxations()); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+
+	matchmaker.Process()
+	assert.Len(t, router.envelopes, 0)
+
+	time.Sleep(1100 * time.Millisecond)
+
+	matchmaker.Process()
+	assert.Len(t, router.envelopes, 2)
+}
+
+func TestMatchmakerQueryOnWaitTime(t *testing.T) {
+	matchmaker, router := newTestMatchmaker(t)
+
+	// The second ticket only accepts players who have already waited a while.
+	if _, err := addTestTicket(matchmaker, "*", 2, 2, nil, nil, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+	if _, err := addTestTicket(matchmaker, "+wait_sec:>=1", 2, 2, nil, nil, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+
+	matchmaker.Process()
+	assert.Len(t, router.envelopes, 0)
+
+	time.Sleep(1100 * time.Millisecond)
+
+	matchmaker.Process()
+	assert.Len(t, router.envelopes, 2)
+}
+
+func TestMatchmakerOverride(t *testing.T) {
+	modules := map[string]string{
+		"test": `
+local nakama = require("nakama")
+local function matchmaker_override(context, candidates)
+	return { candidates }
+end
+nakama.register_matchmaker_override(matchmaker_override)`,
+	}
+
+	runtime, err := runtimeWithModules(t, modules)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	matchmaker, router := newTestMatchmaker(t)
+	matchmaker.Start(runtime)
+	matchmaker.Stop()
+
+	// The default matching would reject these tickets, but the override groups them regardless.
+	if _, err := addTestTicket(matchmaker, "+properties.region:europe", 2, 2, map[string]string{"region": "asia"}, nil, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+	if _, err := addTestTicket(matchmaker, "+properties.region:europe", 2, 2, map[string]string{"region": "asia"}, nil, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+
+	matchmaker.Process()
+
+	assert.Len(t, router.envelopes, 2)
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/rtapi"
+	"github.com/heroiclabs/nakama/server"
+)
+
+type batchTestSession struct {
+	DummySession
+	sid   uuid.UUID
+	batch bool
+}
+
+func (s *batchTestSession) ID() uuid.UUID {
+	return s.sid
+}
+
+func (s *batchTestSession) BatchEnabled() bool {
+	return s.batch
+}
+
+func TestMessageRouterSendDeferredBatch(t *testing.T) {
+	sessionRegistry := server.NewLocalSessionRegistry()
+	batchSession := &batchTestSession{sid: uuid.Must(uuid.NewV4()), batch: true}
+	plainSession := &batchTestSession{sid: uuid.Must(uuid.NewV4()), batch: false}
+	sessionRegistry.Add(batchSession)
+	sessionRegistry.Add(plainSession)
+
+	router := server.NewLocalMessageRouter(sessionRegistry, nil, jsonpbMarshaler)
+
+	presenceIDs := []*server.PresenceID{
+		&server.PresenceID{Node: "node1", SessionID: batchSession.sid},
+		&server.PresenceID{Node: "node1", SessionID: plainSession.sid},
+	}
+	router.SendDeferred(logger, true, server.StreamModeMatchAuthoritative, []*server.DeferredMessage{
+		&server.DeferredMessage{
+			PresenceIDs: presenceIDs,
+			Envelope:    &rtapi.Envelope{Message: &rtapi.Envelope_MatchData{MatchData: &rtapi.MatchData{OpCode: 1}}},
+			Reliable:    false,
+		},
+		&server.DeferredMessage{
+			PresenceIDs: presenceIDs,
+			Envelope:    &rtapi.Envelope{Message: &rtapi.Envelope_MatchData{MatchData: &rtapi.MatchData{OpCode: 2}}},
+			Reliable:    true,
+		},
+	})
+
+	if len(plainSession.messages) != 2 {
+		t.Fatalf("expected 2 separate messages, got %v", len(plainSession.messages))
+	}
+	if len(batchSession.messages) != 1 {
+		t.Fatalf("expected 1 batched message, got %v", len(batchSession.messages))
+	}
+	batch := batchSession.messages[0].GetBatch()
+	if batch == nil || len(batch.Envelopes) != 2 {
+		t.Fatalf("expected a batch of 2 messages, got %v", batchSession.messages[0])
+	}
+	if batch.Envelopes[0].GetMatchData().OpCode != 1 || batch.Envelopes[1].GetMatchData().OpCode != 2 {
+		t.Fatalf("expected batched messages in order, got %v", batch)
+	}
+}
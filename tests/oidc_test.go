@@ -0,0 +1,100 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/heroiclabs/nakama/social"
+)
+
+const (
+	oidcTestIssuer   = "https://sso.example.com"
+	oidcTestAudience = "nakama-test"
+)
+
+func oidcTestKeys(t *testing.T) (*rsa.PrivateKey, string, func()) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+		e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+		fmt.Fprintf(w, `{"keys":[{"kty":"RSA","kid":"test","use":"sig","alg":"RS256","n":"%v","e":"%v"}]}`, n, e)
+	}))
+	return key, server.URL, server.Close
+}
+
+func signOidcToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("error signing token: %v", err)
+	}
+	return signed
+}
+
+func TestCheckOidcToken(t *testing.T) {
+	key, jwksURL, closeFn := oidcTestKeys(t)
+	defer closeFn()
+	client := social.NewClient(5 * time.Second)
+
+	token := signOidcToken(t, key, jwt.MapClaims{
+		"iss":            oidcTestIssuer,
+		"aud":            oidcTestAudience,
+		"sub":            "user-1234",
+		"email":          "user@example.com",
+		"email_verified": true,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	})
+	profile, err := client.CheckOidcToken(context.Background(), oidcTestIssuer, oidcTestAudience, jwksURL, token)
+	if err != nil {
+		t.Fatalf("error checking token: %v", err)
+	}
+	if profile.Subject != "user-1234" || profile.Email != "user@example.com" || !profile.EmailVerified {
+		t.Fatalf("unexpected profile: %v", profile)
+	}
+}
+
+func TestCheckOidcTokenInvalidClaims(t *testing.T) {
+	key, jwksURL, closeFn := oidcTestKeys(t)
+	defer closeFn()
+	client := social.NewClient(5 * time.Second)
+
+	for name, claims := range map[string]jwt.MapClaims{
+		"wrong issuer":   {"iss": "https://other.example.com", "aud": oidcTestAudience, "sub": "user-1234", "exp": time.Now().Add(time.Hour).Unix()},
+		"wrong audience": {"iss": oidcTestIssuer, "aud": "other", "sub": "user-1234", "exp": time.Now().Add(time.Hour).Unix()},
+		"expired":        {"iss": oidcTestIssuer, "aud": oidcTestAudience, "sub": "user-1234", "exp": time.Now().Add(-time.Hour).Unix()},
+		"no expiry":      {"iss": oidcTestIssuer, "aud": oidcTestAudience, "sub": "user-1234"},
+		"no subject":     {"iss": oidcTestIssuer, "aud": oidcTestAudience, "exp": time.Now().Add(time.Hour).Unix()},
+	} {
+		if _, err := client.CheckOidcToken(context.Background(), oidcTestIssuer, oidcTestAudience, jwksURL, signOidcToken(t, key, claims)); err == nil {
+			t.Fatalf("expected error checking token with %v", name)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/server"
+	"github.com/stretchr/testify/assert"
+)
+
+type partyTestSession struct {
+	DummySession
+	sid uuid.UUID
+}
+
+func (s *partyTestSession) ID() uuid.UUID {
+	return s.sid
+}
+
+// Create a session with a stable session ID, and its initial notifications presence as on socket connect.
+func newPartyTestSession(tracker server.Tracker) *partyTestSession {
+	session := &partyTestSession{DummySession: DummySession{uid: uuid.Must(uuid.NewV4())}, sid: uuid.Must(uuid.NewV4())}
+	tracker.Track(session.ID(), server.PresenceStream{Mode: server.StreamModeNotifications, Subject: session.UserID()}, session.UserID(), server.PresenceMeta{Format: session.Format()}, true)
+	return session
+}
+
+func newTestPartyRegistry(t *testing.T) (server.PartyRegistry, server.Tracker, *server.LocalMatchmaker, *capturingMessageRouter) {
+	tracker := server.StartLocalTracker(logger, config, server.NewLocalSessionRegistry(), jsonpbMarshaler)
+	matchmaker, router := newTestMatchmaker(t)
+	partyRegistry := server.NewLocalPartyRegistry(logger, matchmaker, tracker, router, config.GetName())
+	tracker.SetPartyLeaveListener(partyRegistry.Leave)
+	return partyRegistry, tracker, matchmaker, router
+}
+
+func TestPartyLeaderLeavePromotesMember(t *testing.T) {
+	partyRegistry, tracker, _, _ := newTestPartyRegistry(t)
+	defer tracker.Stop()
+
+	leader := newPartyTestSession(tracker)
+	member := newPartyTestSession(tracker)
+
+	handler, err := partyRegistry.Create(leader, 2)
+	if err != nil {
+		t.Fatalf("error creating party: %v", err)
+	}
+	party, err := handler.Join(member)
+	if err != nil {
+		t.Fatalf("error joining party: %v", err)
+	}
+	assert.Equal(t, leader.ID().String(), party.Leader.SessionId)
+	assert.Len(t, party.Presences, 2)
+
+	// The party is now full.
+	_, err = handler.Join(newPartyTestSession(tracker))
+	assert.Equal(t, server.ErrPartyFull, err)
+
+	partyRegistry.Leave(handler.ID, []*server.PresenceID{&server.PresenceID{Node: config.GetName(), SessionID: leader.ID()}})
+
+	party, err = handler.Join(member)
+	if err != nil {
+		t.Fatalf("error reading party: %v", err)
+	}
+	assert.Equal(t, member.ID().String(), party.Leader.SessionId)
+	assert.Len(t, party.Presences, 1)
+
+	// The last member leaving closes the party.
+	partyRegistry.Leave(handler.ID, []*server.PresenceID{&server.PresenceID{Node: config.GetName(), SessionID: member.ID()}})
+	assert.Nil(t, partyRegistry.Get(handler.ID))
+}
+
+func TestPartyMatchmakerTicket(t *testing.T) {
+	partyRegistry, tracker, matchmaker, router := newTestPartyRegistry(t)
+	defer tracker.Stop()
+
+	leader := newPartyTestSession(tracker)
+	member := newPartyTestSession(tracker)
+
+	handler, err := partyRegistry.Create(leader, 0)
+	if err != nil {
+		t.Fatalf("error creating party: %v", err)
+	}
+	if _, err := handler.Join(member); err != nil {
+		t.Fatalf("error joining party: %v", err)
+	}
+
+	// Only the leader may submit a ticket, and the party must fit within the maximum count.
+	_, err = handler.MatchmakerAdd(member.ID(), "*", 2, 3, nil, nil, nil, nil)
+	assert.Equal(t, server.ErrPartyNotLeader, err)
+	_, err = handler.MatchmakerAdd(leader.ID(), "*", 1, 1, nil, nil, nil, nil)
+	assert.Equal(t, server.ErrPartyExceedsMaxCount, err)
+
+	if _, err := handler.MatchmakerAdd(leader.ID(), "*", 3, 3, nil, nil, nil, nil); err != nil {
+		t.Fatalf("error adding party ticket: %v", err)
+	}
+
+	// The party covers two of the three users, so a single solo ticket completes the match.
+	matchmaker.Process()
+	assert.Len(t, router.envelopes, 0)
+
+	if _, err := addTestTicket(matchmaker, "*", 3, 3, nil, nil, nil, nil); err != nil {
+		t.Fatalf("error adding ticket: %v", err)
+	}
+	matchmaker.Process()
+
+	assert.Len(t, router.envelopes, 3)
+	partyCount := 0
+	for _, envelope := range router.envelopes {
+		assert.Len(t, envelope.GetMatchmakerMatched().Users, 3)
+		if envelope.GetMatchmakerMatched().Self.PartyId == handler.IDStr {
+			partyCount++
+		}
+	}
+	assert.Equal(t, 2, partyCount)
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/heroiclabs/nakama/social"
+)
+
+func TestValidateApplePurchaseSandboxRetry(t *testing.T) {
+	production := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":21007}`))
+	}))
+	defer production.Close()
+	sandbox := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["receipt-data"] != "receipt" || body["password"] != "secret" {
+			w.Write([]byte(`{"status":21002}`))
+			return
+		}
+		w.Write([]byte(`{"status":0,"environment":"Sandbox","receipt":{"bundle_id":"com.heroiclabs.test","in_app":[{"product_id":"gems","transaction_id":"1000","purchase_date_ms":"1554000000000"}]}}`))
+	}))
+	defer sandbox.Close()
+
+	client := social.NewClient(5 * time.Second)
+	endpoints := social.DefaultPurchaseEndpoints()
+	endpoints.AppleProduction = production.URL
+	endpoints.AppleSandbox = sandbox.URL
+	client.SetPurchaseEndpoints(endpoints)
+
+	validation, raw, err := client.ValidateApplePurchase(context.Background(), "secret", "receipt")
+	if err != nil {
+		t.Fatalf("error validating purchase: %v", err)
+	}
+	if validation.Environment != "Sandbox" || len(raw) == 0 {
+		t.Fatalf("expected sandbox response, got %v", validation)
+	}
+	if len(validation.Receipt.InApp) != 1 || validation.Receipt.InApp[0].TransactionID != "1000" {
+		t.Fatalf("expected one purchase, got %v", validation.Receipt.InApp)
+	}
+}
+
+func TestValidateApplePurchaseInvalid(t *testing.T) {
+	production := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":21003}`))
+	}))
+	defer production.Close()
+
+	client := social.NewClient(5 * time.Second)
+	endpoints := social.DefaultPurchaseEndpoints()
+	endpoints.AppleProduction = production.URL
+	client.SetPurchaseEndpoints(endpoints)
+
+	if _, _, err := client.ValidateApplePurchase(context.Background(), "secret", "receipt"); err == nil {
+		t.Fatal("expected error validating an unauthenticated receipt")
+	}
+}
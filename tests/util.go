@@ -55,7 +55,7 @@ func (d *DummyMessageRouter) SendDeferred(*zap.Logger, bool, uint8, []*server.De
 	panic("unused")
 }
 
-func (d *DummyMessageRouter) SendToPresenceIDs(*zap.Logger, []*server.PresenceID, bool, uint8, *rtapi.Envelope) {
+func (d *DummyMessageRouter) SendToPresenceIDs(*zap.Logger, []*server.PresenceID, bool, uint8, *rtapi.Envelope, bool) {
 }
 func (d *DummyMessageRouter) SendToStream(*zap.Logger, server.PresenceStream, *rtapi.Envelope) {}
 
@@ -77,6 +77,9 @@ func (d *DummySession) Username() string {
 	return ""
 }
 func (d *DummySession) SetUsername(string) {}
+func (d *DummySession) TokenID() string {
+	return ""
+}
 func (d *DummySession) Expiry() int64 {
 	return int64(0)
 }
@@ -85,6 +88,9 @@ func (d *DummySession) Consume(func(logger *zap.Logger, session server.Session,
 func (d *DummySession) Format() server.SessionFormat {
 	return server.SessionFormatJson
 }
+func (d *DummySession) BatchEnabled() bool {
+	return false
+}
 func (d *DummySession) ClientIP() string {
 	return ""
 }
@@ -104,6 +110,9 @@ func (d *DummySession) SendBytes(isStream bool, mode uint8, payload []byte) erro
 	d.messages = append(d.messages, envelope)
 	return nil
 }
+func (d *DummySession) SendBytesUnreliable(isStream bool, mode uint8, payload []byte) error {
+	return d.SendBytes(isStream, mode, payload)
+}
 
 func (d *DummySession) Close(reason string) {}
 
@@ -162,8 +171,8 @@ func NewAPIServer(t *testing.T, runtime *server.Runtime) (*server.ApiServer, *se
 	db := NewDB(t)
 	router := &DummyMessageRouter{}
 	tracker := &server.LocalTracker{}
-	pipeline := server.NewPipeline(logger, config, db, jsonpbMarshaler, jsonpbUnmarshaler, nil, nil, nil, tracker, router, runtime)
-	apiServer := server.StartApiServer(logger, logger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, nil, nil, nil, nil, nil, nil, tracker, router, pipeline, runtime)
+	pipeline := server.NewPipeline(logger, config, db, jsonpbMarshaler, jsonpbUnmarshaler, nil, nil, nil, nil, tracker, router, runtime)
+	apiServer := server.StartApiServer(logger, logger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, nil, nil, nil, nil, server.NewLocalSessionCache(logger, logger, config, db, nil, tracker), nil, nil, tracker, router, pipeline, runtime)
 	return apiServer, pipeline
 }
 